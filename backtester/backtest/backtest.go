@@ -13,16 +13,20 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/api"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/database"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/live"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/trade"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/exits"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/settings"
@@ -44,6 +48,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gcttrade "github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -68,6 +73,13 @@ func (bt *BackTest) Reset() {
 // NewFromConfig takes a strategy config and configures a backtester variable to run
 func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.Engine) (*BackTest, error) {
 	log.Infoln(log.BackTester, "loading config...")
+	return newFromConfig(cfg, templatePath, output, bot, nil)
+}
+
+// newFromConfig configures a backtester variable to run. If a loaded backtester
+// is provided, its bot, data and exchange settings are reused rather than being
+// setup and retrieved again
+func newFromConfig(cfg *config.Config, templatePath, output string, bot *engine.Engine, loaded *loadedRun) (*BackTest, error) {
 	if cfg == nil {
 		return nil, errNilConfig
 	}
@@ -84,9 +96,15 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 	}
 	bt.Reports = reports
 
-	err := bt.setupBot(cfg, bot)
-	if err != nil {
-		return nil, err
+	var err error
+	if loaded == nil {
+		err = bt.setupBot(cfg, bot)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		bt.Bot = bot
+		bt.Datas = loaded.datas
 	}
 
 	buyRule := config.MinMax{
@@ -132,6 +150,8 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 
 	portfolioRisk := &risk.Risk{
 		CurrencySettings: make(map[string]map[asset.Item]map[currency.Pair]*risk.CurrencySettings),
+		CanUseLeverage:   cfg.PortfolioSettings.Leverage.CanUseLeverage,
+		MaximumLeverage:  cfg.PortfolioSettings.Leverage.MaximumLeverageRate,
 	}
 	for i := range cfg.CurrencySettings {
 		if portfolioRisk.CurrencySettings[cfg.CurrencySettings[i].ExchangeName] == nil {
@@ -251,6 +271,21 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 				return nil, err
 			}
 		}
+		if cfg.CurrencySettings[i].FuturesDetails != nil {
+			var futuresPair *funding.Pair
+			futuresPair, err = funds.GetFundingForEAP(cfg.CurrencySettings[i].ExchangeName, a, curr)
+			if err != nil {
+				return nil, err
+			}
+			err = futuresPair.SetupFutures(funding.MarginRequirements{
+				InitialMarginRatio:     cfg.CurrencySettings[i].FuturesDetails.InitialMarginRatio,
+				MaintenanceMarginRatio: cfg.CurrencySettings[i].FuturesDetails.MaintenanceMarginRatio,
+				LiquidationFeeRate:     cfg.CurrencySettings[i].FuturesDetails.LiquidationFeeRate,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 	bt.Funding = funds
 	var p *portfolio.Portfolio
@@ -277,13 +312,20 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 		StrategyGoal:                cfg.Goal,
 		ExchangeAssetPairStatistics: make(map[string]map[asset.Item]map[currency.Pair]*currencystatistics.CurrencyStatistic),
 		RiskFreeRate:                cfg.StatisticSettings.RiskFreeRate,
+		RobustnessSettings:          cfg.StatisticSettings.Robustness,
 	}
 	bt.Statistic = stats
 	reports.Statistics = stats
 
-	e, err := bt.setupExchangeSettings(cfg)
-	if err != nil {
-		return nil, err
+	var e exchange.Exchange
+	if loaded == nil {
+		e, err = bt.setupExchangeSettings(cfg)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		e.CurrencySettings = make([]exchange.Settings, len(loaded.exchangeSettings))
+		copy(e.CurrencySettings, loaded.exchangeSettings)
 	}
 
 	bt.Exchange = &e
@@ -300,10 +342,18 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 		lookup.ComplianceManager = compliance.Manager{
 			Snapshots: []compliance.Snapshot{},
 		}
+		if e.CurrencySettings[i].ExitRules != nil {
+			lookup.ExitManager, err = exits.NewManager(e.CurrencySettings[i].ExitRules)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 	bt.Portfolio = p
 
-	cfg.PrintSetting()
+	if loaded == nil {
+		cfg.PrintSetting()
+	}
 
 	return bt, nil
 }
@@ -324,11 +374,11 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 
 		exchangeName := strings.ToLower(exch.GetName())
 		bt.Datas.Setup()
-		klineData, err := bt.loadData(cfg, exch, pair, a)
+		dataHandler, err := bt.loadData(cfg, exch, pair, a)
 		if err != nil {
 			return resp, err
 		}
-		bt.Datas.SetDataForCurrency(exchangeName, a, pair, klineData)
+		bt.Datas.SetDataForCurrency(exchangeName, a, pair, dataHandler)
 		var makerFee, takerFee decimal.Decimal
 		if cfg.CurrencySettings[i].MakerFee.GreaterThan(decimal.Zero) {
 			makerFee = cfg.CurrencySettings[i].MakerFee
@@ -399,6 +449,25 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 				cfg.CurrencySettings[i].ShowExchangeOrderLimitWarning = true
 			}
 		}
+		var fundingRates []fundingrate.Rate
+		if cfg.CurrencySettings[i].FuturesDetails != nil &&
+			cfg.CurrencySettings[i].FuturesDetails.FundingRateCSVPath != "" {
+			fundingRates, err = fundingrate.LoadCSV(cfg.CurrencySettings[i].FuturesDetails.FundingRateCSVPath)
+			if err != nil {
+				return resp, err
+			}
+		}
+		var orderbooks []orderbook.Snapshot
+		if cfg.CurrencySettings[i].OrderbookCSVPath != "" {
+			orderbooks, err = orderbook.LoadCSV(cfg.CurrencySettings[i].OrderbookCSVPath)
+			if err != nil {
+				return resp, err
+			}
+		}
+		exitRules := cfg.PortfolioSettings.ExitRules
+		if cfg.CurrencySettings[i].ExitRules != nil {
+			exitRules = cfg.CurrencySettings[i].ExitRules
+		}
 		resp.CurrencySettings = append(resp.CurrencySettings, exchange.Settings{
 			ExchangeName:        cfg.CurrencySettings[i].ExchangeName,
 			MinimumSlippageRate: cfg.CurrencySettings[i].MinimumSlippagePercent,
@@ -406,8 +475,8 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 			CurrencyPair:        pair,
 			AssetType:           a,
 			ExchangeFee:         takerFee,
-			MakerFee:            takerFee,
-			TakerFee:            makerFee,
+			MakerFee:            makerFee,
+			TakerFee:            takerFee,
 			UseRealOrders:       realOrders,
 			BuySide:             buyRule,
 			SellSide:            sellRule,
@@ -419,6 +488,9 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 			Limits:                  limits,
 			SkipCandleVolumeFitting: cfg.CurrencySettings[i].SkipCandleVolumeFitting,
 			CanUseExchangeLimits:    cfg.CurrencySettings[i].CanUseExchangeLimits,
+			FundingRates:            fundingRates,
+			Orderbooks:              orderbooks,
+			ExitRules:               exitRules,
 		})
 	}
 
@@ -515,7 +587,8 @@ func getFees(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.
 
 // loadData will create kline data from the sources defined in start config files. It can exist from databases, csv or API endpoints
 // it can also be generated from trade data which will be converted into kline data
-func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) (*kline.DataFromKline, error) {
+// or trade data which is replayed one trade at a time when using the tick data type
+func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) (data.Handler, error) {
 	if exch == nil {
 		return nil, engine.ErrExchangeNotFound
 	}
@@ -542,11 +615,23 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 
 	log.Infof(log.BackTester, "loading data for %v %v %v...\n", exch.GetName(), a, fPair)
 	resp := &kline.DataFromKline{}
+	var trades []gcttrade.Data
 	switch {
 	case cfg.DataSettings.CSVData != nil:
 		if cfg.DataSettings.Interval <= 0 {
 			return nil, errIntervalUnset
 		}
+		if dataType == common.DataTick {
+			trades, err = csv.LoadTrades(
+				cfg.DataSettings.CSVData.FullPath,
+				strings.ToLower(exch.GetName()),
+				fPair,
+				a)
+			if err != nil {
+				return nil, fmt.Errorf("%v. Please check your GoCryptoTrader configuration", err)
+			}
+			break
+		}
 		resp, err = csv.LoadData(
 			dataType,
 			cfg.DataSettings.CSVData.FullPath,
@@ -600,6 +685,13 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 				log.Error(log.BackTester, stopErr)
 			}
 		}()
+		if dataType == common.DataTick {
+			trades, err = loadDatabaseTrades(cfg, exch.GetName(), fPair, a)
+			if err != nil {
+				return nil, fmt.Errorf("unable to retrieve data from GoCryptoTrader database. Error: %v. Please ensure the database is setup correctly and has data before use", err)
+			}
+			break
+		}
 		resp, err = loadDatabaseData(cfg, exch.GetName(), fPair, a, dataType)
 		if err != nil {
 			return nil, fmt.Errorf("unable to retrieve data from GoCryptoTrader database. Error: %v. Please ensure the database is setup correctly and has data before use", err)
@@ -625,6 +717,13 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if cfg.DataSettings.APIData.InclusiveEndDate {
 			cfg.DataSettings.APIData.EndDate = cfg.DataSettings.APIData.EndDate.Add(cfg.DataSettings.Interval)
 		}
+		if dataType == common.DataTick {
+			trades, err = loadAPITrades(cfg, exch, fPair, a)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
 		resp, err = loadAPIData(
 			cfg,
 			exch,
@@ -639,6 +738,9 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if len(cfg.CurrencySettings) > 1 {
 			return nil, errors.New("live data simulation only supports one currency")
 		}
+		if dataType == common.DataTick {
+			return nil, errTickLiveData
+		}
 		err = loadLiveData(cfg, b)
 		if err != nil {
 			return nil, err
@@ -652,6 +754,9 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 			dataType)
 		return resp, nil
 	}
+	if dataType == common.DataTick {
+		return bt.loadTickData(cfg, exch, fPair, a, trades)
+	}
 	if resp == nil {
 		return nil, fmt.Errorf("processing error, response returned nil")
 	}
@@ -667,10 +772,38 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	if err != nil {
 		return nil, err
 	}
+	for i := range cfg.DataSettings.AdditionalIntervals {
+		err = resp.LoadInterval(gctkline.Interval(cfg.DataSettings.AdditionalIntervals[i]))
+		if err != nil {
+			return nil, fmt.Errorf("could not load additional interval for %v %v %v, %w", exch.GetName(), a, fPair, err)
+		}
+	}
 	bt.Reports.AddKlineItem(&resp.Item)
 	return resp, nil
 }
 
+// loadTickData sets up trades to be replayed one trade at a time.
+// The trades are converted into candles of the config's interval for the report
+func (bt *BackTest) loadTickData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, trades []gcttrade.Data) (*trade.DataFromTrade, error) {
+	resp := &trade.DataFromTrade{
+		Exchange: strings.ToLower(exch.GetName()),
+		Asset:    a,
+		Pair:     fPair,
+		Interval: gctkline.Interval(cfg.DataSettings.Interval),
+		Trades:   trades,
+	}
+	err := resp.Load()
+	if err != nil {
+		return nil, fmt.Errorf("could not load tick data for %v %v %v, %w", exch.GetName(), a, fPair, err)
+	}
+	candles, err := resp.ConvertToCandles()
+	if err != nil {
+		return nil, err
+	}
+	bt.Reports.AddKlineItem(candles)
+	return resp, nil
+}
+
 func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a asset.Item, dataType int64) (*kline.DataFromKline, error) {
 	if cfg == nil || cfg.DataSettings.DatabaseData == nil {
 		return nil, errors.New("nil config data received")
@@ -689,6 +822,37 @@ func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a as
 		a)
 }
 
+func loadDatabaseTrades(cfg *config.Config, name string, fPair currency.Pair, a asset.Item) ([]gcttrade.Data, error) {
+	if cfg == nil || cfg.DataSettings.DatabaseData == nil {
+		return nil, errors.New("nil config data received")
+	}
+	if cfg.DataSettings.Interval <= 0 {
+		return nil, errIntervalUnset
+	}
+	return database.LoadTrades(
+		cfg.DataSettings.DatabaseData.StartDate,
+		cfg.DataSettings.DatabaseData.EndDate,
+		strings.ToLower(name),
+		fPair,
+		a)
+}
+
+func loadAPITrades(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) ([]gcttrade.Data, error) {
+	if cfg.DataSettings.Interval <= 0 {
+		return nil, errIntervalUnset
+	}
+	trades, err := api.LoadTrades(context.TODO(),
+		cfg.DataSettings.APIData.StartDate,
+		cfg.DataSettings.APIData.EndDate,
+		exch,
+		fPair,
+		a)
+	if err != nil {
+		return nil, fmt.Errorf("%v. Please check your GoCryptoTrader configuration", err)
+	}
+	return trades, nil
+}
+
 func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, resultLimit uint32, dataType int64) (*kline.DataFromKline, error) {
 	if cfg.DataSettings.Interval <= 0 {
 		return nil, errIntervalUnset
@@ -764,6 +928,13 @@ func (bt *BackTest) Run() error {
 dataLoadingIssue:
 	for ev := bt.EventQueue.NextEvent(); ; ev = bt.EventQueue.NextEvent() {
 		if ev == nil {
+			select {
+			case <-bt.shutdown:
+				log.Info(log.BackTester, "backtester run stopped")
+				return nil
+			default:
+			}
+			var latest common.DataEventHandler
 			dataHandlerMap := bt.Datas.GetAllData()
 			for exchangeName, exchangeMap := range dataHandlerMap {
 				for assetItem, assetMap := range exchangeMap {
@@ -781,9 +952,13 @@ dataLoadingIssue:
 						}
 						bt.EventQueue.AppendEvent(d)
 						hasProcessedData = true
+						latest = d
 					}
 				}
 			}
+			if bt.ProgressHandler != nil && latest != nil {
+				bt.ProgressHandler(latest)
+			}
 		}
 		if ev != nil {
 			err := bt.handleEvent(ev)
@@ -835,6 +1010,8 @@ func (bt *BackTest) processSingleDataEvent(ev common.DataEventHandler, funds fun
 		return err
 	}
 	d := bt.Datas.GetDataForCurrency(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	bt.processOpenOrders(d)
+	bt.processExits(d)
 	s, err := bt.Strategy.OnSignal(d, bt.Funding)
 	if err != nil {
 		if errors.Is(err, base.ErrTooMuchBadData) {
@@ -876,6 +1053,8 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 				if err != nil && err == statistics.ErrAlreadyProcessed {
 					continue
 				}
+				bt.processOpenOrders(dataHandler)
+				bt.processExits(dataHandler)
 				dataEvents = append(dataEvents, dataHandler)
 			}
 		}
@@ -899,6 +1078,64 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 	return nil
 }
 
+// processOpenOrders checks whether the latest data event has filled or expired
+// any orders resting on the exchange and appends the resulting fill events to the queue
+func (bt *BackTest) processOpenOrders(d data.Handler) {
+	if d == nil || bt.Exchange == nil {
+		return
+	}
+	latest := d.Latest()
+	if latest == nil {
+		return
+	}
+	funds, err := bt.Funding.GetFundingForEvent(latest)
+	if err != nil {
+		log.Error(log.BackTester, err)
+		return
+	}
+	fills, err := bt.Exchange.ProcessOpenOrders(d, bt.Bot, funds)
+	if err != nil {
+		log.Error(log.BackTester, err)
+	}
+	for i := range fills {
+		err = bt.Statistic.SetEventForOffset(fills[i])
+		if err != nil {
+			log.Error(log.BackTester, err)
+		}
+		bt.EventQueue.AppendEvent(fills[i])
+	}
+}
+
+// processExits checks whether the latest data event has crossed any of the
+// portfolio's exit rules, appending an order to close the position when it has
+func (bt *BackTest) processExits(d data.Handler) {
+	if d == nil || bt.Portfolio == nil {
+		return
+	}
+	latest := d.Latest()
+	if latest == nil {
+		return
+	}
+	funds, err := bt.Funding.GetFundingForEvent(latest)
+	if err != nil {
+		log.Error(log.BackTester, err)
+		return
+	}
+	o, err := bt.Portfolio.CheckExits(latest, funds)
+	if err != nil {
+		log.Error(log.BackTester, err)
+		return
+	}
+	if o == nil {
+		return
+	}
+	err = bt.Statistic.SetEventForOffset(o)
+	if err != nil {
+		log.Error(log.BackTester, err)
+	}
+	bt.EventQueue.AppendEvent(o)
+}
+
 // updateStatsForDataEvent makes various systems aware of price movements from
 // data events
 func (bt *BackTest) updateStatsForDataEvent(ev common.DataEventHandler, funds funding.IPairReader) error {
@@ -910,6 +1147,8 @@ func (bt *BackTest) updateStatsForDataEvent(ev common.DataEventHandler, funds fu
 		}
 		log.Error(log.BackTester, err)
 	}
+	// mark futures positions to the latest price before valuing holdings
+	bt.updateFuturesPosition(ev)
 	// update portfolio manager with the latest price
 	err = bt.Portfolio.UpdateHoldings(ev, funds)
 	if err != nil {
@@ -918,6 +1157,32 @@ func (bt *BackTest) updateStatsForDataEvent(ev common.DataEventHandler, funds fu
 	return nil
 }
 
+// updateFuturesPosition applies liquidations, mark prices and funding rates
+// to futures positions. Liquidation fill events are appended to the queue
+func (bt *BackTest) updateFuturesPosition(ev common.DataEventHandler) {
+	if bt.Exchange == nil || !common.IsFuturesAsset(ev.GetAssetType()) {
+		return
+	}
+	funds, err := bt.Funding.GetFundingForEvent(ev)
+	if err != nil {
+		log.Error(log.BackTester, err)
+		return
+	}
+	f, err := bt.Exchange.UpdateFuturesPosition(ev, bt.Bot, funds)
+	if err != nil {
+		log.Error(log.BackTester, err)
+		return
+	}
+	if f == nil {
+		return
+	}
+	err = bt.Statistic.SetEventForOffset(f)
+	if err != nil {
+		log.Error(log.BackTester, err)
+	}
+	bt.EventQueue.AppendEvent(f)
+}
+
 // processSignalEvent receives an event from the strategy for processing under the portfolio
 func (bt *BackTest) processSignalEvent(ev signal.Event, funds funding.IPairReserver) {
 	cs, err := bt.Exchange.GetCurrencySettings(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
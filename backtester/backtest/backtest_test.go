@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/trade"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
@@ -350,6 +351,120 @@ func TestLoadDataCSV(t *testing.T) {
 	}
 }
 
+func TestSetupExchangeSettingsFees(t *testing.T) {
+	t.Parallel()
+	bot := newBotWithExchange()
+	exch, err := bot.GetExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	b := exch.GetBase()
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	b.CurrencyPairs.Pairs[asset.Spot] = &currency.PairStore{
+		Available:     currency.Pairs{cp},
+		Enabled:       currency.Pairs{cp},
+		AssetEnabled:  convert.BoolPtr(true),
+		ConfigFormat:  &currency.PairFormat{Uppercase: true},
+		RequestFormat: &currency.PairFormat{Uppercase: true}}
+	bt := BackTest{
+		Reports: &report.Data{},
+		Bot:     bot,
+		Datas:   &data.HandlerPerCurrency{},
+	}
+	makerFee := decimal.NewFromFloat(0.001)
+	takerFee := decimal.NewFromFloat(0.002)
+	cfg := &config.Config{
+		CurrencySettings: []config.CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USD.String(),
+				InitialQuoteFunds: leet,
+				MakerFee:          makerFee,
+				TakerFee:          takerFee,
+			},
+		},
+		DataSettings: config.DataSettings{
+			DataType: common.CandleStr,
+			Interval: gctkline.OneDay.Duration(),
+			CSVData: &config.CSVData{
+				FullPath: filepath.Join("..", "..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv"),
+			},
+		},
+	}
+	resp, err := bt.setupExchangeSettings(cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(resp.CurrencySettings) != 1 {
+		t.Fatalf("received: %v, expected: %v", len(resp.CurrencySettings), 1)
+	}
+	// The maker and taker fees were previously assigned to each other, so
+	// resting orders were charged the taker fee and market orders the maker
+	// fee
+	if !resp.CurrencySettings[0].MakerFee.Equal(makerFee) {
+		t.Errorf("received: %v, expected: %v", resp.CurrencySettings[0].MakerFee, makerFee)
+	}
+	if !resp.CurrencySettings[0].TakerFee.Equal(takerFee) {
+		t.Errorf("received: %v, expected: %v", resp.CurrencySettings[0].TakerFee, takerFee)
+	}
+}
+
+func TestLoadDataTick(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{
+		Reports: &report.Data{},
+		Bot:     &engine.Engine{},
+	}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	cfg := &config.Config{
+		CurrencySettings: []config.CurrencySettings{
+			{
+				ExchangeName:      "Binance",
+				Asset:             asset.Spot.String(),
+				Base:              cp.Base.String(),
+				Quote:             cp.Quote.String(),
+				InitialQuoteFunds: leet,
+			},
+		},
+		DataSettings: config.DataSettings{
+			DataType: common.TickStr,
+			Interval: gctkline.OneMin.Duration(),
+			CSVData: &config.CSVData{
+				FullPath: filepath.Join("..", "..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv"),
+			}},
+	}
+	em := engine.ExchangeManager{}
+	exch, err := em.NewExchangeByName("Binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	resp, err := bt.loadData(cfg, exch, cp, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	tickData, ok := resp.(*trade.DataFromTrade)
+	if !ok {
+		t.Fatalf("received: %T, expected: %T", resp, &trade.DataFromTrade{})
+	}
+	if len(tickData.List()) != 1000 {
+		t.Errorf("received: %v, expected: %v", len(tickData.List()), 1000)
+	}
+	if len(bt.Reports.(*report.Data).OriginalCandles) != 1 {
+		t.Error("expected tick data to be added to the report as candles")
+	}
+
+	cfg.DataSettings.CSVData = nil
+	cfg.DataSettings.LiveData = &config.LiveData{}
+	_, err = bt.loadData(cfg, exch, cp, asset.Spot)
+	if !errors.Is(err, errTickLiveData) {
+		t.Errorf("received: %v, expected: %v", err, errTickLiveData)
+	}
+}
+
 func TestLoadDataLive(t *testing.T) {
 	t.Parallel()
 	bt := BackTest{
@@ -593,10 +708,27 @@ func TestFullCycle(t *testing.T) {
 	}
 	bt.Datas.SetDataForCurrency(ex, a, cp, &k)
 
+	var progressed int
+	bt.ProgressHandler = func(common.DataEventHandler) {
+		progressed++
+	}
+	err = bt.Run()
+	if err != nil {
+		t.Error(err)
+	}
+	if progressed != 1 {
+		t.Errorf("received '%v' expected '%v'", progressed, 1)
+	}
+
+	bt.shutdown = make(chan struct{})
+	bt.Stop()
 	err = bt.Run()
 	if err != nil {
 		t.Error(err)
 	}
+	if progressed != 1 {
+		t.Errorf("received '%v' expected '%v'", progressed, 1)
+	}
 }
 
 func TestStop(t *testing.T) {
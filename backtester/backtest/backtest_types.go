@@ -2,6 +2,11 @@ package backtest
 
 import (
 	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
@@ -25,6 +30,11 @@ var (
 	errLiveDataTimeout     = errors.New("no data returned in 5 minutes, shutting down")
 	errNilData             = errors.New("nil data received")
 	errNilExchange         = errors.New("nil exchange received")
+	errTickLiveData        = errors.New("tick data cannot be used with live data")
+
+	errNoOptimisationSettings      = errors.New("no optimisation settings set in config")
+	errUnsupportedOptimisationData = errors.New("optimisation only supports candle data")
+	errWalkForwardWindowTooSmall   = errors.New("not enough data to split into walk forward windows")
 )
 
 // BackTest is the main holder of all backtesting functionality
@@ -40,4 +50,41 @@ type BackTest struct {
 	EventQueue      eventholder.EventHolder
 	Reports         report.Handler
 	Funding         funding.IFundingManager
+	// ProgressHandler is called with a data event each time Run
+	// advances every data handler to the next interval
+	ProgressHandler func(common.DataEventHandler)
+}
+
+// loadedRun holds the data and exchange settings of a loaded backtester
+// so optimisation runs do not retrieve them again
+type loadedRun struct {
+	datas            data.Holder
+	exchangeSettings []exchange.Settings
+}
+
+// OptimisationResult holds the ranked results of every parameter combination.
+// When walk forward is used, the results of each window are stored instead
+type OptimisationResult struct {
+	Metric                  string              `json:"metric"`
+	Runs                    []OptimisationRun   `json:"runs,omitempty"`
+	WalkForwardWindows      []WalkForwardWindow `json:"walk-forward-windows,omitempty"`
+	AverageOutOfSampleScore decimal.Decimal     `json:"average-out-of-sample-score"`
+}
+
+// OptimisationRun holds the score and results of a single parameter combination
+type OptimisationRun struct {
+	Parameters config.ParameterSet             `json:"parameters"`
+	Score      decimal.Decimal                 `json:"score"`
+	Results    []statistics.FinalResultsHolder `json:"results"`
+}
+
+// WalkForwardWindow holds the best in sample run of a window
+// and how that parameter combination performed out of sample
+type WalkForwardWindow struct {
+	InSampleStart    time.Time       `json:"in-sample-start"`
+	InSampleEnd      time.Time       `json:"in-sample-end"`
+	OutOfSampleStart time.Time       `json:"out-of-sample-start"`
+	OutOfSampleEnd   time.Time       `json:"out-of-sample-end"`
+	InSample         OptimisationRun `json:"in-sample"`
+	OutOfSample      OptimisationRun `json:"out-of-sample"`
 }
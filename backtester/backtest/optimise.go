@@ -0,0 +1,325 @@
+package backtest
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Optimise runs the strategy against every combination of the config's
+// optimisation parameters in parallel and ranks them by the optimisation metric.
+// The data loaded by the backtester is shared by every run.
+// If walk forward settings are set, each window is optimised against its in
+// sample data and the best combination is scored against its out of sample data
+func (bt *BackTest) Optimise(cfg *config.Config) (*OptimisationResult, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.OptimisationSettings == nil {
+		return nil, errNoOptimisationSettings
+	}
+	e, ok := bt.Exchange.(*exchange.Exchange)
+	if !ok {
+		return nil, errNilExchange
+	}
+	loaded := &loadedRun{
+		datas:            bt.Datas,
+		exchangeSettings: e.CurrencySettings,
+	}
+	start, end, err := getDataRange(bt.Datas, cfg.DataSettings.Interval)
+	if err != nil {
+		return nil, err
+	}
+	sets := cfg.OptimisationSettings.GenerateParameterSets()
+	log.Infof(log.BackTester, "optimising %v parameter combinations by %v", len(sets), cfg.OptimisationSettings.Metric)
+	resp := &OptimisationResult{
+		Metric: cfg.OptimisationSettings.Metric,
+	}
+	if cfg.OptimisationSettings.WalkForward == nil {
+		resp.Runs, err = bt.optimiseRange(cfg, sets, loaded, start, end)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	resp.WalkForwardWindows, err = calculateWalkForwardWindows(start, end, cfg.DataSettings.Interval, cfg.OptimisationSettings.WalkForward)
+	if err != nil {
+		return nil, err
+	}
+	totalScore := decimal.Zero
+	for i := range resp.WalkForwardWindows {
+		w := &resp.WalkForwardWindows[i]
+		log.Infof(log.BackTester, "optimising walk forward window %v of %v. In sample: %v - %v. Out of sample: %v - %v",
+			i+1,
+			len(resp.WalkForwardWindows),
+			w.InSampleStart,
+			w.InSampleEnd,
+			w.OutOfSampleStart,
+			w.OutOfSampleEnd)
+		var runs []OptimisationRun
+		runs, err = bt.optimiseRange(cfg, sets, loaded, w.InSampleStart, w.InSampleEnd)
+		if err != nil {
+			return nil, err
+		}
+		w.InSample = runs[0]
+		runs, err = bt.optimiseRange(cfg, []config.ParameterSet{w.InSample.Parameters}, loaded, w.OutOfSampleStart, w.OutOfSampleEnd)
+		if err != nil {
+			return nil, err
+		}
+		w.OutOfSample = runs[0]
+		totalScore = totalScore.Add(w.OutOfSample.Score)
+	}
+	resp.AverageOutOfSampleScore = totalScore.Div(decimal.NewFromInt(int64(len(resp.WalkForwardWindows))))
+	return resp, nil
+}
+
+// optimiseRange runs every parameter set against the data between start and end
+// and returns the runs ranked by score
+func (bt *BackTest) optimiseRange(cfg *config.Config, sets []config.ParameterSet, loaded *loadedRun, start, end time.Time) ([]OptimisationRun, error) {
+	workers := int(cfg.OptimisationSettings.MaximumConcurrentRuns)
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(sets) {
+		workers = len(sets)
+	}
+	resp := make([]OptimisationRun, len(sets))
+	jobs := make(chan int)
+	// setting up a run uses the shared bot's exchange manager
+	var setupMtx, errMtx sync.Mutex
+	var runErr error
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				errMtx.Lock()
+				failed := runErr != nil
+				errMtx.Unlock()
+				if failed {
+					continue
+				}
+				run, err := bt.optimisationRun(cfg, sets[j], loaded, start, end, &setupMtx)
+				if err != nil {
+					errMtx.Lock()
+					if runErr == nil {
+						runErr = fmt.Errorf("%v %w", sets[j], err)
+					}
+					errMtx.Unlock()
+					continue
+				}
+				resp[j] = *run
+			}
+		}()
+	}
+	for i := range sets {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	if runErr != nil {
+		return nil, runErr
+	}
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].Score.GreaterThan(resp[j].Score)
+	})
+	return resp, nil
+}
+
+// optimisationRun runs a backtest with the parameter set applied to the config
+// against a copy of the loaded data between start and end
+func (bt *BackTest) optimisationRun(cfg *config.Config, ps config.ParameterSet, loaded *loadedRun, start, end time.Time, setupMtx *sync.Mutex) (*OptimisationRun, error) {
+	runCfg, err := cfg.ApplyParameterSet(ps)
+	if err != nil {
+		return nil, err
+	}
+	datas, err := copyDataForRange(loaded.datas, cfg.DataSettings.Interval, cfg.DataSettings.AdditionalIntervals, start, end)
+	if err != nil {
+		return nil, err
+	}
+	setupMtx.Lock()
+	run, err := newFromConfig(runCfg, "", "", bt.Bot, &loadedRun{
+		datas:            datas,
+		exchangeSettings: loaded.exchangeSettings,
+	})
+	setupMtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	defer run.Reset()
+	err = run.Run()
+	if err != nil {
+		return nil, err
+	}
+	err = run.Statistic.CalculateAllResults(run.Funding)
+	if err != nil {
+		return nil, err
+	}
+	results := run.Statistic.GetFinalResults()
+	return &OptimisationRun{
+		Parameters: ps,
+		Score:      scoreResults(cfg.OptimisationSettings.Metric, results),
+		Results:    results,
+	}, nil
+}
+
+// scoreResults returns the average of the metric across every
+// exchange, asset and currency pair result
+func scoreResults(metric string, results []statistics.FinalResultsHolder) decimal.Decimal {
+	if len(results) == 0 {
+		return decimal.Zero
+	}
+	total := decimal.Zero
+	for i := range results {
+		switch metric {
+		case config.SharpeRatioMetric:
+			total = total.Add(results[i].SharpeRatio)
+		case config.SortinoRatioMetric:
+			total = total.Add(results[i].SortinoRatio)
+		case config.CalmarRatioMetric:
+			total = total.Add(results[i].CalmarRatio)
+		case config.TotalReturnMetric:
+			total = total.Add(results[i].StrategyMovement)
+		}
+	}
+	return total.Div(decimal.NewFromInt(int64(len(results))))
+}
+
+// getDataRange returns the time of the earliest candle and the end of the latest candle
+func getDataRange(datas data.Holder, interval time.Duration) (start, end time.Time, err error) {
+	for _, exchangeMap := range datas.GetAllData() {
+		for _, assetMap := range exchangeMap {
+			for _, dataHandler := range assetMap {
+				k, ok := dataHandler.(*kline.DataFromKline)
+				if !ok {
+					return time.Time{}, time.Time{}, errUnsupportedOptimisationData
+				}
+				for i := range k.Item.Candles {
+					if start.IsZero() || k.Item.Candles[i].Time.Before(start) {
+						start = k.Item.Candles[i].Time
+					}
+					if k.Item.Candles[i].Time.Add(interval).After(end) {
+						end = k.Item.Candles[i].Time.Add(interval)
+					}
+				}
+			}
+		}
+	}
+	if start.IsZero() {
+		return time.Time{}, time.Time{}, errNilData
+	}
+	return start, end, nil
+}
+
+// copyDataForRange creates new data handlers with the candles between start and end
+// so that each run processes its own data stream. Additional intervals are
+// converted from the copied candles so that they do not extend past the range
+func copyDataForRange(datas data.Holder, interval time.Duration, additionalIntervals []time.Duration, start, end time.Time) (data.Holder, error) {
+	resp := &data.HandlerPerCurrency{}
+	resp.Setup()
+	for exchangeName, exchangeMap := range datas.GetAllData() {
+		for assetItem, assetMap := range exchangeMap {
+			for currencyPair, dataHandler := range assetMap {
+				k, ok := dataHandler.(*kline.DataFromKline)
+				if !ok {
+					return nil, fmt.Errorf("%v %v %v %w", exchangeName, assetItem, currencyPair, errUnsupportedOptimisationData)
+				}
+				cpy := &kline.DataFromKline{
+					Item: k.Item,
+				}
+				cpy.Item.Candles = nil
+				for i := range k.Item.Candles {
+					if k.Item.Candles[i].Time.Before(start) || !k.Item.Candles[i].Time.Before(end) {
+						continue
+					}
+					cpy.Item.Candles = append(cpy.Item.Candles, k.Item.Candles[i])
+				}
+				var err error
+				cpy.RangeHolder, err = gctkline.CalculateCandleDateRanges(start, end, gctkline.Interval(interval), 0)
+				if err != nil {
+					return nil, err
+				}
+				cpy.RangeHolder.SetHasDataFromCandles(cpy.Item.Candles)
+				err = cpy.Load()
+				if err != nil {
+					return nil, fmt.Errorf("%v %v %v %w", exchangeName, assetItem, currencyPair, err)
+				}
+				for i := range additionalIntervals {
+					err = cpy.LoadInterval(gctkline.Interval(additionalIntervals[i]))
+					if err != nil {
+						return nil, fmt.Errorf("could not load additional interval for %v %v %v between %v and %v, %w",
+							exchangeName,
+							assetItem,
+							currencyPair,
+							start,
+							end,
+							err)
+					}
+				}
+				resp.SetDataForCurrency(exchangeName, assetItem, currencyPair, cpy)
+			}
+		}
+	}
+	return resp, nil
+}
+
+// calculateWalkForwardWindows splits the time between start and end into
+// consecutive windows of in sample and out of sample data
+func calculateWalkForwardWindows(start, end time.Time, interval time.Duration, wf *config.WalkForward) ([]WalkForwardWindow, error) {
+	if interval <= 0 {
+		return nil, errIntervalUnset
+	}
+	candles := int64(end.Sub(start) / interval)
+	windowCandles := candles / wf.Windows
+	inSampleCandles := decimal.NewFromInt(windowCandles).Mul(wf.InSampleRatio).IntPart()
+	if inSampleCandles < 1 || inSampleCandles >= windowCandles {
+		return nil, fmt.Errorf("%w. %v candles cannot be split into %v windows with an in sample ratio of %v",
+			errWalkForwardWindowTooSmall,
+			candles,
+			wf.Windows,
+			wf.InSampleRatio)
+	}
+	resp := make([]WalkForwardWindow, wf.Windows)
+	for i := range resp {
+		windowStart := start.Add(interval * time.Duration(int64(i)*windowCandles))
+		resp[i].InSampleStart = windowStart
+		resp[i].InSampleEnd = windowStart.Add(interval * time.Duration(inSampleCandles))
+		resp[i].OutOfSampleStart = resp[i].InSampleEnd
+		resp[i].OutOfSampleEnd = windowStart.Add(interval * time.Duration(windowCandles))
+	}
+	// any remaining candles are included in the final out of sample period
+	resp[len(resp)-1].OutOfSampleEnd = end
+	return resp, nil
+}
+
+// PrintResults outputs the ranked optimisation results to the CMD
+func (o *OptimisationResult) PrintResults() {
+	log.Info(log.BackTester, "------------------Optimisation Results-----------------------")
+	log.Infof(log.BackTester, "Metric: %v", o.Metric)
+	for i := range o.Runs {
+		log.Infof(log.BackTester, "%v. Score: %v Parameters: %v", i+1, o.Runs[i].Score.Round(4), o.Runs[i].Parameters)
+	}
+	if len(o.WalkForwardWindows) == 0 {
+		return
+	}
+	for i := range o.WalkForwardWindows {
+		w := &o.WalkForwardWindows[i]
+		log.Infof(log.BackTester, "Window %v. Parameters: %v", i+1, w.InSample.Parameters)
+		log.Infof(log.BackTester, "In sample %v - %v score: %v", w.InSampleStart, w.InSampleEnd, w.InSample.Score.Round(4))
+		log.Infof(log.BackTester, "Out of sample %v - %v score: %v", w.OutOfSampleStart, w.OutOfSampleEnd, w.OutOfSample.Score.Round(4))
+	}
+	log.Infof(log.BackTester, "Average out of sample score: %v", o.AverageOutOfSampleScore.Round(4))
+}
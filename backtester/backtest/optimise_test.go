@@ -0,0 +1,382 @@
+package backtest
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var (
+	optimiseStart    = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	optimiseExchange = strings.ToLower(testExchange)
+)
+
+func newOptimiseData(t *testing.T, candles int, cp currency.Pair) data.Holder {
+	t.Helper()
+	k := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: optimiseExchange,
+			Pair:     cp,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneDay,
+		},
+	}
+	for i := 0; i < candles; i++ {
+		price := 1000 + 200*math.Sin(float64(i)/3)
+		k.Item.Candles = append(k.Item.Candles, gctkline.Candle{
+			Time:   optimiseStart.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+			Open:   price,
+			High:   price + 10,
+			Low:    price - 10,
+			Close:  price,
+			Volume: 1337,
+		})
+	}
+	var err error
+	k.RangeHolder, err = gctkline.CalculateCandleDateRanges(
+		optimiseStart,
+		optimiseStart.Add(gctkline.OneDay.Duration()*time.Duration(candles)),
+		gctkline.OneDay,
+		0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.RangeHolder.SetHasDataFromCandles(k.Item.Candles)
+	err = k.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &data.HandlerPerCurrency{}
+	d.Setup()
+	d.SetDataForCurrency(optimiseExchange, asset.Spot, cp, k)
+	return d
+}
+
+func newOptimiseBackTest(t *testing.T) (*BackTest, *config.Config) {
+	t.Helper()
+	bot := newBotWithExchange()
+	var err error
+	bot.OrderManager, err = engine.SetupOrderManager(bot.ExchangeManager, &engine.CommunicationManager{}, &bot.ServicesWG, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = bot.OrderManager.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	exch, err := bot.GetExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := exch.GetBase()
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Spot: {
+			Available:     currency.Pairs{cp},
+			Enabled:       currency.Pairs{cp},
+			AssetEnabled:  convert.BoolPtr(true),
+			ConfigFormat:  &currency.PairFormat{Uppercase: true},
+			RequestFormat: &currency.PairFormat{Uppercase: true},
+		},
+	}
+	cp, err = b.FormatExchangeCurrency(cp, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee := decimal.NewFromFloat(0.001)
+	bt := &BackTest{
+		Bot:   bot,
+		Datas: newOptimiseData(t, 60, cp),
+		Exchange: &exchange.Exchange{
+			CurrencySettings: []exchange.Settings{
+				{
+					ExchangeName:            optimiseExchange,
+					CurrencyPair:            cp,
+					AssetType:               asset.Spot,
+					MinimumSlippageRate:     decimal.NewFromInt(100),
+					MaximumSlippageRate:     decimal.NewFromInt(100),
+					ExchangeFee:             fee,
+					MakerFee:                fee,
+					TakerFee:                fee,
+					SkipCandleVolumeFitting: true,
+				},
+			},
+		},
+	}
+	cfg := &config.Config{
+		StrategySettings: config.StrategySettings{
+			Name: "rsi",
+		},
+		CurrencySettings: []config.CurrencySettings{
+			{
+				ExchangeName:      optimiseExchange,
+				Asset:             asset.Spot.String(),
+				Base:              cp.Base.String(),
+				Quote:             cp.Quote.String(),
+				InitialQuoteFunds: leet,
+				BuySide: config.MinMax{
+					MaximumSize: decimal.NewFromFloat(0.1),
+				},
+				SellSide: config.MinMax{
+					MaximumSize: decimal.NewFromFloat(0.1),
+				},
+				MakerFee: fee,
+				TakerFee: fee,
+			},
+		},
+		DataSettings: config.DataSettings{
+			Interval: gctkline.OneDay.Duration(),
+			DataType: common.CandleStr,
+		},
+		OptimisationSettings: &config.OptimisationSettings{
+			Metric:                config.TotalReturnMetric,
+			MaximumConcurrentRuns: 2,
+			CustomSettings: []config.ParameterRange{
+				{
+					Name:   "rsi-period",
+					Values: []decimal.Decimal{decimal.NewFromInt(3), decimal.NewFromInt(5)},
+				},
+				{
+					Name:    "rsi-high",
+					Minimum: decimal.NewFromInt(60),
+					Maximum: decimal.NewFromInt(70),
+					Step:    decimal.NewFromInt(10),
+				},
+			},
+		},
+	}
+	return bt, cfg
+}
+
+func TestOptimise(t *testing.T) {
+	t.Parallel()
+	bt, cfg := newOptimiseBackTest(t)
+	_, err := bt.Optimise(nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = bt.Optimise(&config.Config{})
+	if !errors.Is(err, errNoOptimisationSettings) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOptimisationSettings)
+	}
+
+	resp, err := bt.Optimise(cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Runs) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Runs), 4)
+	}
+	for i := range resp.Runs {
+		if len(resp.Runs[i].Results) != 1 {
+			t.Errorf("received '%v' expected '%v'", len(resp.Runs[i].Results), 1)
+		}
+		if !resp.Runs[i].Score.Equal(resp.Runs[i].Results[0].StrategyMovement) {
+			t.Errorf("received '%v' expected '%v'", resp.Runs[i].Score, resp.Runs[i].Results[0].StrategyMovement)
+		}
+		if i > 0 && resp.Runs[i].Score.GreaterThan(resp.Runs[i-1].Score) {
+			t.Errorf("run %v scored '%v' higher than run %v '%v'", i, resp.Runs[i].Score, i-1, resp.Runs[i-1].Score)
+		}
+	}
+	for _, d := range bt.Datas.GetAllData()[optimiseExchange][asset.Spot] {
+		if d.Offset() != 0 {
+			t.Error("expected loaded data to be unaffected by optimisation runs")
+		}
+	}
+}
+
+func TestOptimiseWalkForward(t *testing.T) {
+	t.Parallel()
+	bt, cfg := newOptimiseBackTest(t)
+	cfg.OptimisationSettings.WalkForward = &config.WalkForward{
+		Windows:       2,
+		InSampleRatio: decimal.NewFromFloat(0.7),
+	}
+	resp, err := bt.Optimise(cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Runs) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Runs), 0)
+	}
+	if len(resp.WalkForwardWindows) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.WalkForwardWindows), 2)
+	}
+	total := decimal.Zero
+	for i := range resp.WalkForwardWindows {
+		w := resp.WalkForwardWindows[i]
+		if w.OutOfSample.Parameters.String() != w.InSample.Parameters.String() {
+			t.Errorf("received '%v' expected '%v'", w.OutOfSample.Parameters, w.InSample.Parameters)
+		}
+		total = total.Add(w.OutOfSample.Score)
+	}
+	if !resp.AverageOutOfSampleScore.Equal(total.Div(decimal.NewFromInt(2))) {
+		t.Errorf("received '%v' expected '%v'", resp.AverageOutOfSampleScore, total.Div(decimal.NewFromInt(2)))
+	}
+
+	cfg.OptimisationSettings.WalkForward.Windows = 60
+	_, err = bt.Optimise(cfg)
+	if !errors.Is(err, errWalkForwardWindowTooSmall) {
+		t.Errorf("received '%v' expected '%v'", err, errWalkForwardWindowTooSmall)
+	}
+}
+
+func TestCalculateWalkForwardWindows(t *testing.T) {
+	t.Parallel()
+	end := optimiseStart.Add(gctkline.OneDay.Duration() * 10)
+	wf := &config.WalkForward{
+		Windows:       3,
+		InSampleRatio: decimal.NewFromFloat(0.5),
+	}
+	_, err := calculateWalkForwardWindows(optimiseStart, end, 0, wf)
+	if !errors.Is(err, errIntervalUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errIntervalUnset)
+	}
+	resp, err := calculateWalkForwardWindows(optimiseStart, end, gctkline.OneDay.Duration(), wf)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 3)
+	}
+	day := gctkline.OneDay.Duration()
+	if !resp[1].InSampleStart.Equal(optimiseStart.Add(day * 3)) {
+		t.Errorf("received '%v' expected '%v'", resp[1].InSampleStart, optimiseStart.Add(day*3))
+	}
+	if !resp[1].InSampleEnd.Equal(optimiseStart.Add(day * 4)) {
+		t.Errorf("received '%v' expected '%v'", resp[1].InSampleEnd, optimiseStart.Add(day*4))
+	}
+	if !resp[1].OutOfSampleStart.Equal(resp[1].InSampleEnd) {
+		t.Errorf("received '%v' expected '%v'", resp[1].OutOfSampleStart, resp[1].InSampleEnd)
+	}
+	if !resp[1].OutOfSampleEnd.Equal(optimiseStart.Add(day * 6)) {
+		t.Errorf("received '%v' expected '%v'", resp[1].OutOfSampleEnd, optimiseStart.Add(day*6))
+	}
+	if !resp[2].OutOfSampleEnd.Equal(end) {
+		t.Errorf("received '%v' expected '%v'", resp[2].OutOfSampleEnd, end)
+	}
+
+	wf.Windows = 10
+	_, err = calculateWalkForwardWindows(optimiseStart, end, day, wf)
+	if !errors.Is(err, errWalkForwardWindowTooSmall) {
+		t.Errorf("received '%v' expected '%v'", err, errWalkForwardWindowTooSmall)
+	}
+}
+
+func TestCopyDataForRange(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	d := newOptimiseData(t, 10, cp)
+	day := gctkline.OneDay.Duration()
+	resp, err := copyDataForRange(d, day, nil, optimiseStart.Add(day*2), optimiseStart.Add(day*5))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	cpy := resp.GetDataForCurrency(optimiseExchange, asset.Spot, cp)
+	if len(cpy.List()) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(cpy.List()), 3)
+	}
+	if !cpy.Next().GetTime().Equal(optimiseStart.Add(day * 2)) {
+		t.Errorf("received '%v' expected '%v'", cpy.Latest().GetTime(), optimiseStart.Add(day*2))
+	}
+	if d.GetDataForCurrency(optimiseExchange, asset.Spot, cp).Offset() != 0 {
+		t.Error("expected original data offset to be unaffected")
+	}
+
+	_, err = copyDataForRange(d, day, nil, optimiseStart.Add(day*20), optimiseStart.Add(day*25))
+	if err == nil {
+		t.Error("expected error copying range without data")
+	}
+
+	// additional intervals are converted from the candles within the range
+	_, err = cpy.GetHistoryFor(gctkline.ThreeDay)
+	if !errors.Is(err, data.ErrIntervalNotLoaded) {
+		t.Errorf("received '%v' expected '%v'", err, data.ErrIntervalNotLoaded)
+	}
+	threeDays := []time.Duration{gctkline.ThreeDay.Duration()}
+	_, err = copyDataForRange(d, day, threeDays, optimiseStart.Add(day*2), optimiseStart.Add(day*3))
+	if err == nil {
+		t.Error("expected error converting a range shorter than the additional interval")
+	}
+	resp, err = copyDataForRange(d, day, threeDays, optimiseStart.Add(day*2), optimiseStart.Add(day*10))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	cpy = resp.GetDataForCurrency(optimiseExchange, asset.Spot, cp)
+	for range cpy.List() {
+		cpy.Next()
+	}
+	history, err := cpy.GetHistoryFor(gctkline.ThreeDay)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(history) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(history), 2)
+	}
+	for i := range history {
+		if history[i].GetTime().Before(optimiseStart.Add(day * 2)) {
+			t.Errorf("received candle at '%v' before the range", history[i].GetTime())
+		}
+	}
+
+	_, _, err = getDataRange(&data.HandlerPerCurrency{}, day)
+	if !errors.Is(err, errNilData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilData)
+	}
+	start, end, err := getDataRange(d, day)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !start.Equal(optimiseStart) {
+		t.Errorf("received '%v' expected '%v'", start, optimiseStart)
+	}
+	if !end.Equal(optimiseStart.Add(day * 10)) {
+		t.Errorf("received '%v' expected '%v'", end, optimiseStart.Add(day*10))
+	}
+}
+
+func TestScoreResults(t *testing.T) {
+	t.Parallel()
+	if !scoreResults(config.SharpeRatioMetric, nil).IsZero() {
+		t.Error("expected zero score without results")
+	}
+	results := []statistics.FinalResultsHolder{
+		{
+			SharpeRatio:      decimal.NewFromInt(1),
+			SortinoRatio:     decimal.NewFromInt(2),
+			CalmarRatio:      decimal.NewFromInt(3),
+			StrategyMovement: decimal.NewFromInt(4),
+		},
+		{
+			SharpeRatio:      decimal.NewFromInt(3),
+			SortinoRatio:     decimal.NewFromInt(4),
+			CalmarRatio:      decimal.NewFromInt(5),
+			StrategyMovement: decimal.NewFromInt(6),
+		},
+	}
+	for metric, expected := range map[string]int64{
+		config.SharpeRatioMetric:  2,
+		config.SortinoRatioMetric: 3,
+		config.CalmarRatioMetric:  4,
+		config.TotalReturnMetric:  5,
+	} {
+		if score := scoreResults(metric, results); !score.Equal(decimal.NewFromInt(expected)) {
+			t.Errorf("%v received '%v' expected '%v'", metric, score, expected)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package common
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
 
 // DataTypeToInt converts the config string value into an int
 func DataTypeToInt(dataType string) (int64, error) {
@@ -9,7 +13,19 @@ func DataTypeToInt(dataType string) (int64, error) {
 		return DataCandle, nil
 	case TradeStr:
 		return DataTrade, nil
+	case TickStr:
+		return DataTick, nil
 	default:
 		return 0, fmt.Errorf("unrecognised dataType '%v'", dataType)
 	}
 }
+
+// IsFuturesAsset returns whether the asset is traded as a margined contract
+// with long and short positions rather than spot holdings
+func IsFuturesAsset(a asset.Item) bool {
+	switch a {
+	case asset.Futures, asset.PerpetualSwap, asset.USDTMarginedFutures:
+		return true
+	}
+	return false
+}
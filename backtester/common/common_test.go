@@ -3,6 +3,8 @@ package common
 import (
 	"fmt"
 	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 func TestDataTypeConversion(t *testing.T) {
@@ -22,6 +24,11 @@ func TestDataTypeConversion(t *testing.T) {
 			dataType: TradeStr,
 			want:     DataTrade,
 		},
+		{
+			title:    "Tick data type",
+			dataType: TickStr,
+			want:     DataTick,
+		},
 		{
 			title:     "Unknown data type",
 			dataType:  "unknown",
@@ -43,3 +50,18 @@ func TestDataTypeConversion(t *testing.T) {
 		})
 	}
 }
+
+func TestIsFuturesAsset(t *testing.T) {
+	t.Parallel()
+	if IsFuturesAsset(asset.Spot) {
+		t.Error("expected false for spot")
+	}
+	if IsFuturesAsset(asset.CoinMarginedFutures) {
+		t.Error("expected false for coin margined futures")
+	}
+	for _, a := range []asset.Item{asset.Futures, asset.PerpetualSwap, asset.USDTMarginedFutures} {
+		if !IsFuturesAsset(a) {
+			t.Errorf("expected true for %v", a)
+		}
+	}
+}
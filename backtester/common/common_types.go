@@ -26,16 +26,38 @@ const (
 	// MissingData is signalled during the strategy/signal phase when data has been identified as missing
 	// No buy or sell events can occur
 	MissingData order.Side = "MISSING DATA"
+	// CancelOrder is raised in the strategy/signal phase to cancel a resting order
+	// on the simulated exchange, referenced by its order ID
+	CancelOrder order.Side = "CANCEL ORDER"
+	// AmendOrder is raised in the strategy/signal phase to change the limit price,
+	// trigger price or expiry of a resting order on the simulated exchange
+	AmendOrder order.Side = "AMEND ORDER"
+	// OrderPlaced is flagged on a fill event when an order has been accepted onto the
+	// simulated exchange's order book, but has not yet been filled
+	OrderPlaced order.Side = "ORDER PLACED"
+	// OrderExpired is flagged on a fill event when a resting order reaches its expiry
+	// without being filled and its reserved funds have been released
+	OrderExpired order.Side = "ORDER EXPIRED"
+	// ClosePosition is raised in the strategy/signal phase to close the entirety
+	// of an open futures position, regardless of whether it is long or short
+	ClosePosition order.Side = "CLOSE POSITION"
+	// Liquidated is flagged on a fill event when the simulated exchange closes a futures
+	// position as its margin has fallen below the maintenance margin requirement
+	Liquidated order.Side = "LIQUIDATED"
 	// CandleStr is a config readable data type to tell the backtester to retrieve candle data
 	CandleStr = "candle"
 	// TradeStr is a config readable data type to tell the backtester to retrieve trade data
 	TradeStr = "trade"
+	// TickStr is a config readable data type to tell the backtester to retrieve trade data
+	// and replay each trade as an individual data event
+	TickStr = "tick"
 )
 
 // DataCandle is an int64 representation of a candle data type
 const (
 	DataCandle = iota
 	DataTrade
+	DataTick
 )
 
 var (
@@ -6,12 +6,16 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strings"
+	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/gctscript"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -94,6 +98,17 @@ func (c *Config) PrintSetting() {
 		log.Infof(log.BackTester, "Sell rules: %+v", c.CurrencySettings[i].SellSide)
 		log.Infof(log.BackTester, "Leverage rules: %+v", c.CurrencySettings[i].Leverage)
 		log.Infof(log.BackTester, "Can use exchange defined order execution limits: %+v", c.CurrencySettings[i].CanUseExchangeLimits)
+		if c.CurrencySettings[i].ExitRules != nil {
+			log.Infof(log.BackTester, "Exit rules: %v", c.CurrencySettings[i].ExitRules)
+		}
+		if c.CurrencySettings[i].FuturesDetails != nil {
+			log.Infof(log.BackTester, "Initial margin ratio: %v", c.CurrencySettings[i].FuturesDetails.InitialMarginRatio.Round(8))
+			log.Infof(log.BackTester, "Maintenance margin ratio: %v", c.CurrencySettings[i].FuturesDetails.MaintenanceMarginRatio.Round(8))
+			log.Infof(log.BackTester, "Liquidation fee rate: %v", c.CurrencySettings[i].FuturesDetails.LiquidationFeeRate.Round(8))
+			if c.CurrencySettings[i].FuturesDetails.FundingRateCSVPath != "" {
+				log.Infof(log.BackTester, "Funding rate CSV file: %v", c.CurrencySettings[i].FuturesDetails.FundingRateCSVPath)
+			}
+		}
 	}
 
 	log.Info(log.BackTester, "-------------------------------------------------------------")
@@ -102,6 +117,18 @@ func (c *Config) PrintSetting() {
 	log.Infof(log.BackTester, "Buy rules: %+v", c.PortfolioSettings.BuySide)
 	log.Infof(log.BackTester, "Sell rules: %+v", c.PortfolioSettings.SellSide)
 	log.Infof(log.BackTester, "Leverage rules: %+v", c.PortfolioSettings.Leverage)
+	if c.PortfolioSettings.ExitRules != nil {
+		log.Infof(log.BackTester, "Exit rules: %v", c.PortfolioSettings.ExitRules)
+	}
+	if c.StatisticSettings.Robustness != nil {
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Info(log.BackTester, "------------------Robustness Settings------------------------")
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Infof(log.BackTester, "Method: %v", c.StatisticSettings.Robustness.Method)
+		log.Infof(log.BackTester, "Iterations: %v", c.StatisticSettings.Robustness.Iterations)
+		log.Infof(log.BackTester, "Confidence level: %v", c.StatisticSettings.Robustness.ConfidenceLevel)
+		log.Infof(log.BackTester, "Ruin threshold: %v%%", c.StatisticSettings.Robustness.RuinThreshold)
+	}
 	if c.DataSettings.LiveData != nil {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
 		log.Info(log.BackTester, "------------------Live Settings------------------------------")
@@ -117,6 +144,9 @@ func (c *Config) PrintSetting() {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
 		log.Infof(log.BackTester, "Data type: %v", c.DataSettings.DataType)
 		log.Infof(log.BackTester, "Interval: %v", c.DataSettings.Interval)
+		if len(c.DataSettings.AdditionalIntervals) > 0 {
+			log.Infof(log.BackTester, "Additional intervals: %v", c.DataSettings.AdditionalIntervals)
+		}
 		log.Infof(log.BackTester, "Start date: %v", c.DataSettings.APIData.StartDate.Format(gctcommon.SimpleTimeFormat))
 		log.Infof(log.BackTester, "End date: %v", c.DataSettings.APIData.EndDate.Format(gctcommon.SimpleTimeFormat))
 	}
@@ -126,6 +156,9 @@ func (c *Config) PrintSetting() {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
 		log.Infof(log.BackTester, "Data type: %v", c.DataSettings.DataType)
 		log.Infof(log.BackTester, "Interval: %v", c.DataSettings.Interval)
+		if len(c.DataSettings.AdditionalIntervals) > 0 {
+			log.Infof(log.BackTester, "Additional intervals: %v", c.DataSettings.AdditionalIntervals)
+		}
 		log.Infof(log.BackTester, "CSV file: %v", c.DataSettings.CSVData.FullPath)
 	}
 	if c.DataSettings.DatabaseData != nil {
@@ -134,6 +167,9 @@ func (c *Config) PrintSetting() {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
 		log.Infof(log.BackTester, "Data type: %v", c.DataSettings.DataType)
 		log.Infof(log.BackTester, "Interval: %v", c.DataSettings.Interval)
+		if len(c.DataSettings.AdditionalIntervals) > 0 {
+			log.Infof(log.BackTester, "Additional intervals: %v", c.DataSettings.AdditionalIntervals)
+		}
 		log.Infof(log.BackTester, "Start date: %v", c.DataSettings.DatabaseData.StartDate.Format(gctcommon.SimpleTimeFormat))
 		log.Infof(log.BackTester, "End date: %v", c.DataSettings.DatabaseData.EndDate.Format(gctcommon.SimpleTimeFormat))
 	}
@@ -146,6 +182,10 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = c.validateAdditionalIntervals()
+	if err != nil {
+		return err
+	}
 	err = c.validateStrategySettings()
 	if err != nil {
 		return err
@@ -154,7 +194,23 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
-	return c.validateMinMaxes()
+	err = c.validateMinMaxes()
+	if err != nil {
+		return err
+	}
+	err = c.validateOptimisationSettings()
+	if err != nil {
+		return err
+	}
+	err = c.validateExportSettings()
+	if err != nil {
+		return err
+	}
+	err = c.validateExitRules()
+	if err != nil {
+		return err
+	}
+	return c.validateRobustnessSettings()
 }
 
 // validate ensures no one sets bad config values on purpose
@@ -227,6 +283,11 @@ func (c *Config) validateStrategySettings() error {
 			}
 		}
 	}
+	if gctscript.IsScript(c.StrategySettings.Name) {
+		// scripts are compiled to ensure they are valid before any data is loaded
+		_, err := gctscript.New(c.StrategySettings.Name)
+		return err
+	}
 	strats := strategies.GetStrategies()
 	for i := range strats {
 		if strings.EqualFold(strats[i].Name(), c.StrategySettings.Name) {
@@ -262,11 +323,48 @@ func (c *Config) validateDate() error {
 	return nil
 }
 
+// validateAdditionalIntervals ensures additional intervals can be converted
+// from the data's interval
+func (c *Config) validateAdditionalIntervals() error {
+	if len(c.DataSettings.AdditionalIntervals) == 0 {
+		return nil
+	}
+	if strings.EqualFold(c.DataSettings.DataType, common.TickStr) {
+		return errAdditionalIntervalsTickData
+	}
+	if c.DataSettings.LiveData != nil {
+		return errAdditionalIntervalsLiveData
+	}
+	seen := make(map[time.Duration]bool)
+	for _, i := range c.DataSettings.AdditionalIntervals {
+		if c.DataSettings.Interval <= 0 ||
+			i <= c.DataSettings.Interval ||
+			i%c.DataSettings.Interval != 0 {
+			return fmt.Errorf("%w, received %v for %v interval", errInvalidAdditionalInterval, i, c.DataSettings.Interval)
+		}
+		if seen[i] {
+			return fmt.Errorf("%w %v", errDuplicateAdditionalInterval, i)
+		}
+		seen[i] = true
+	}
+	return nil
+}
+
 // validateCurrencySettings checks whether someone has set invalid currency setting data in their config
 func (c *Config) validateCurrencySettings() error {
 	if len(c.CurrencySettings) == 0 {
 		return errNoCurrencySettings
 	}
+	if strings.EqualFold(c.DataSettings.DataType, common.TickStr) {
+		// trades are replayed one by one, so multiple currencies
+		// cannot be kept in step with each other
+		if len(c.CurrencySettings) > 1 {
+			return errTickDataMultipleCurrencies
+		}
+		if c.DataSettings.LiveData != nil {
+			return errTickDataLiveData
+		}
+	}
 	for i := range c.CurrencySettings {
 		if c.CurrencySettings[i].InitialLegacyFunds > 0 {
 			// temporarily migrate legacy start config value
@@ -318,6 +416,172 @@ func (c *Config) validateCurrencySettings() error {
 			return errBadSlippageRates
 		}
 		c.CurrencySettings[i].ExchangeName = strings.ToLower(c.CurrencySettings[i].ExchangeName)
+		err := c.validateFuturesDetails(&c.CurrencySettings[i])
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// validateFuturesDetails ensures futures assets have sensible margin requirements
+// and that leverage is enabled when the margin requirements allow for it
+func (c *Config) validateFuturesDetails(cs *CurrencySettings) error {
+	isFutures := common.IsFuturesAsset(asset.Item(strings.ToLower(cs.Asset)))
+	if !isFutures {
+		if cs.FuturesDetails != nil {
+			return fmt.Errorf("%v %v %v-%v %w", cs.ExchangeName, cs.Asset, cs.Base, cs.Quote, errFuturesDetailsUnsupported)
+		}
+		return nil
+	}
+	if cs.FuturesDetails == nil {
+		return fmt.Errorf("%v %v %v-%v %w", cs.ExchangeName, cs.Asset, cs.Base, cs.Quote, errFuturesDetailsRequired)
+	}
+	if cs.InitialBaseFunds != nil && cs.InitialBaseFunds.GreaterThan(decimal.Zero) {
+		return fmt.Errorf("%v %v %v-%v futures positions are collateralised by quote funds, base %w",
+			cs.ExchangeName, cs.Asset, cs.Base, cs.Quote, errBadInitialFunds)
+	}
+	one := decimal.NewFromInt(1)
+	fd := cs.FuturesDetails
+	if fd.InitialMarginRatio.LessThanOrEqual(decimal.Zero) ||
+		fd.InitialMarginRatio.GreaterThan(one) ||
+		fd.MaintenanceMarginRatio.LessThanOrEqual(decimal.Zero) ||
+		fd.MaintenanceMarginRatio.GreaterThanOrEqual(fd.InitialMarginRatio) {
+		return fmt.Errorf("%v %v %v-%v %w. Initial: %v Maintenance: %v",
+			cs.ExchangeName, cs.Asset, cs.Base, cs.Quote, errInvalidMarginRatio, fd.InitialMarginRatio, fd.MaintenanceMarginRatio)
+	}
+	if fd.LiquidationFeeRate.IsNegative() || fd.LiquidationFeeRate.GreaterThanOrEqual(one) {
+		return fmt.Errorf("%v %v %v-%v %w %v", cs.ExchangeName, cs.Asset, cs.Base, cs.Quote, errInvalidLiquidationFee, fd.LiquidationFeeRate)
+	}
+	if fd.InitialMarginRatio.LessThan(one) &&
+		(!cs.Leverage.CanUseLeverage || !c.PortfolioSettings.Leverage.CanUseLeverage) {
+		return fmt.Errorf("%v %v %v-%v %w", cs.ExchangeName, cs.Asset, cs.Base, cs.Quote, errLeverageRequired)
+	}
+	return nil
+}
+
+// validateExportSettings ensures that only supported export formats are requested
+func (c *Config) validateExportSettings() error {
+	if c.ExportSettings == nil {
+		return nil
+	}
+	if len(c.ExportSettings.Formats) == 0 {
+		return errNoExportFormats
+	}
+	for i := range c.ExportSettings.Formats {
+		c.ExportSettings.Formats[i] = strings.ToLower(strings.TrimSpace(c.ExportSettings.Formats[i]))
+		switch c.ExportSettings.Formats[i] {
+		case JSONExportFormat, CSVExportFormat:
+		default:
+			return fmt.Errorf("%w '%v'", errUnsupportedExportFormat, c.ExportSettings.Formats[i])
+		}
+	}
+	return nil
+}
+
+// validateRobustnessSettings ensures the robustness analysis can be run
+func (c *Config) validateRobustnessSettings() error {
+	r := c.StatisticSettings.Robustness
+	if r == nil {
+		return nil
+	}
+	r.Method = strings.ToLower(strings.TrimSpace(r.Method))
+	switch r.Method {
+	case BootstrapMethod, ShuffleMethod:
+	default:
+		return fmt.Errorf("%w '%v'", errInvalidRobustnessMethod, r.Method)
+	}
+	if r.Iterations <= 0 {
+		return errInvalidRobustnessIterations
+	}
+	if r.ConfidenceLevel.LessThanOrEqual(decimal.Zero) || r.ConfidenceLevel.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w, received %v", errInvalidConfidenceLevel, r.ConfidenceLevel)
+	}
+	if r.RuinThreshold.LessThanOrEqual(decimal.Zero) || r.RuinThreshold.GreaterThan(decimal.NewFromInt(100)) {
+		return fmt.Errorf("%w, received %v", errInvalidRuinThreshold, r.RuinThreshold)
+	}
+	return nil
+}
+
+// validateExitRules ensures every exit rule sets a single valid distance
+func (c *Config) validateExitRules() error {
+	err := c.PortfolioSettings.ExitRules.validate()
+	if err != nil {
+		return fmt.Errorf("portfolio settings %w", err)
+	}
+	for i := range c.CurrencySettings {
+		err = c.CurrencySettings[i].ExitRules.validate()
+		if err != nil {
+			return fmt.Errorf("%v %v %v-%v %w",
+				c.CurrencySettings[i].ExchangeName,
+				c.CurrencySettings[i].Asset,
+				c.CurrencySettings[i].Base,
+				c.CurrencySettings[i].Quote,
+				err)
+		}
+	}
+	return nil
+}
+
+func (e *ExitRules) validate() error {
+	if e == nil {
+		return nil
+	}
+	err := e.StopLoss.validate(true)
+	if err != nil {
+		return fmt.Errorf("stop-loss %w", err)
+	}
+	err = e.TakeProfit.validate(false)
+	if err != nil {
+		return fmt.Errorf("take-profit %w", err)
+	}
+	err = e.TrailingStop.validate(true)
+	if err != nil {
+		return fmt.Errorf("trailing-stop %w", err)
+	}
+	return nil
+}
+
+// validate checks the rule's distance. A stop of 100 percent or more
+// would place a long position's stop at or below zero
+func (e *ExitRule) validate(isStop bool) error {
+	if e == nil {
+		return nil
+	}
+	if e.Percent.IsNegative() || e.Absolute.IsNegative() {
+		return errExitRuleNegative
+	}
+	if e.Percent.IsZero() && e.Absolute.IsZero() {
+		return errExitRuleDistanceUnset
+	}
+	if !e.Percent.IsZero() && !e.Absolute.IsZero() {
+		return errExitRuleDistanceAmbiguous
+	}
+	if isStop && e.Percent.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+		return errExitRulePercentTooHigh
+	}
+	return nil
+}
+
+// String lists the rules which are set
+func (e *ExitRules) String() string {
+	var rules []string
+	if e.StopLoss != nil {
+		rules = append(rules, "stop-loss "+e.StopLoss.String())
+	}
+	if e.TakeProfit != nil {
+		rules = append(rules, "take-profit "+e.TakeProfit.String())
+	}
+	if e.TrailingStop != nil {
+		rules = append(rules, "trailing-stop "+e.TrailingStop.String())
+	}
+	return strings.Join(rules, ", ")
+}
+
+// String returns the rule's distance
+func (e *ExitRule) String() string {
+	if !e.Percent.IsZero() {
+		return e.Percent.String() + "%"
+	}
+	return e.Absolute.String()
+}
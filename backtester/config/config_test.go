@@ -708,6 +708,217 @@ func TestGenerateConfigForDCACSVCandles(t *testing.T) {
 	}
 }
 
+func TestGenerateConfigForDCACSVCandlesExitRules(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+	cfg := Config{
+		Nickname: "ExampleStrategyDCACSVCandlesExitRules",
+		Goal:     "To demonstrate the DCA strategy using CSV candle data with positions protected by portfolio managed exits",
+		StrategySettings: StrategySettings{
+			Name: dca,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			CSVData: &CSVData{
+				FullPath: fp,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+			ExitRules: &ExitRules{
+				StopLoss: &ExitRule{
+					Percent: decimal.NewFromInt(10),
+				},
+				TakeProfit: &ExitRule{
+					Percent: decimal.NewFromInt(25),
+				},
+				TrailingStop: &ExitRule{
+					Percent: decimal.NewFromInt(15),
+				},
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-csv-candles-exit-rules.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForDCACSVCandlesRobustness(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+	cfg := Config{
+		Nickname: "ExampleStrategyDCACSVCandlesRobustness",
+		Goal:     "To demonstrate the DCA strategy using CSV candle data with exit rules closing its trades, and bootstrapped trade returns to test whether its results depend on the order of trades",
+		StrategySettings: StrategySettings{
+			Name: dca,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			CSVData: &CSVData{
+				FullPath: fp,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+			ExitRules: &ExitRules{
+				StopLoss: &ExitRule{
+					Percent: decimal.NewFromInt(10),
+				},
+				TakeProfit: &ExitRule{
+					Percent: decimal.NewFromInt(25),
+				},
+				TrailingStop: &ExitRule{
+					Percent: decimal.NewFromInt(15),
+				},
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+			Robustness: &RobustnessSettings{
+				Method:          BootstrapMethod,
+				Iterations:      1000,
+				ConfidenceLevel: decimal.NewFromFloat(0.95),
+				RuinThreshold:   decimal.NewFromInt(20),
+				Seed:            1337,
+			},
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-csv-candles-robustness.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForDCACSVCandlesFutures(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+	cfg := Config{
+		Nickname: "ExampleStrategyDCACSVCandlesFutures",
+		Goal:     "To demonstrate the DCA strategy using CSV candle data against a USDT margined perpetual contract with funding rates",
+		StrategySettings: StrategySettings{
+			Name: dca,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.USDTMarginedFutures.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage:      true,
+					MaximumLeverageRate: decimal.NewFromInt(10),
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+				FuturesDetails: &FuturesDetails{
+					InitialMarginRatio:     decimal.NewFromFloat(0.1),
+					MaintenanceMarginRatio: decimal.NewFromFloat(0.05),
+					LiquidationFeeRate:     decimal.NewFromFloat(0.01),
+					FundingRateCSVPath:     filepath.Join("..", "testdata", "binance_BTCUSDT_funding_rates_2019_01_01.csv"),
+				},
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			CSVData: &CSVData{
+				FullPath: fp,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage:      true,
+				MaximumLeverageRate: decimal.NewFromInt(10),
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-csv-candles-futures.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func TestGenerateConfigForDCACSVTrades(t *testing.T) {
 	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv")
 	cfg := Config{
@@ -762,6 +973,159 @@ func TestGenerateConfigForDCACSVTrades(t *testing.T) {
 	}
 }
 
+func TestGenerateConfigForDCACSVTicks(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv")
+	cfg := Config{
+		Nickname: "ExampleStrategyDCACSVTicks",
+		Goal:     "To demonstrate the DCA strategy replaying CSV trade data one trade at a time with orders filled against recorded orderbook snapshots",
+		StrategySettings: StrategySettings{
+			Name: dca,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide: MinMax{
+					MaximumSize: decimal.NewFromFloat(0.01),
+				},
+				SellSide: MinMax{
+					MaximumSize: decimal.NewFromFloat(0.01),
+				},
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee:         makerFee,
+				TakerFee:         takerFee,
+				OrderbookCSVPath: filepath.Join("..", "testdata", "binance_BTCUSDT_orderbook_2020_11_16.csv"),
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneMin.Duration(),
+			DataType: common.TickStr,
+			CSVData: &CSVData{
+				FullPath: fp,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-csv-ticks.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForRSICSVCandlesOptimisation(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+	cfg := Config{
+		Nickname: "ExampleStrategyRSICSVCandlesOptimisation",
+		Goal:     "To demonstrate optimising the RSI strategy's custom settings and portfolio buy side sizing using CSV candle data and walk forward analysis",
+		StrategySettings: StrategySettings{
+			Name: "rsi",
+			CustomSettings: map[string]interface{}{
+				"rsi-low":    30.0,
+				"rsi-high":   70.0,
+				"rsi-period": 14,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			CSVData: &CSVData{
+				FullPath: fp,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+		OptimisationSettings: &OptimisationSettings{
+			Metric: SharpeRatioMetric,
+			CustomSettings: []ParameterRange{
+				{
+					Name:    "rsi-period",
+					Minimum: decimal.NewFromInt(10),
+					Maximum: decimal.NewFromInt(20),
+					Step:    decimal.NewFromInt(2),
+				},
+				{
+					Name:   "rsi-low",
+					Values: []decimal.Decimal{decimal.NewFromInt(20), decimal.NewFromInt(30)},
+				},
+				{
+					Name:   "rsi-high",
+					Values: []decimal.Decimal{decimal.NewFromInt(70), decimal.NewFromInt(80)},
+				},
+			},
+			PortfolioSettings: []ParameterRange{
+				{
+					Name:   BuySideMaximumSizeParameter,
+					Values: []decimal.Decimal{decimal.NewFromFloat(0.5), decimal.NewFromInt(1)},
+				},
+			},
+			WalkForward: &WalkForward{
+				Windows:       3,
+				InSampleRatio: decimal.NewFromFloat(0.7),
+			},
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "rsi-csv-candles-optimisation.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func TestGenerateConfigForDCADatabaseCandles(t *testing.T) {
 	cfg := Config{
 		Nickname: "ExampleStrategyDCADatabaseCandles",
@@ -1050,6 +1414,97 @@ func TestValidateCurrencySettings(t *testing.T) {
 	if !errors.Is(err, errBadSlippageRates) {
 		t.Errorf("received: %v, expected: %v", err, errBadSlippageRates)
 	}
+	c.CurrencySettings[0].MinimumSlippagePercent = decimal.Zero
+	c.CurrencySettings[0].MaximumSlippagePercent = decimal.Zero
+	c.DataSettings.DataType = common.TickStr
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errTickDataLiveData) {
+		t.Errorf("received: %v, expected: %v", err, errTickDataLiveData)
+	}
+	c.DataSettings.LiveData = nil
+	err = c.validateCurrencySettings()
+	if err != nil {
+		t.Error(err)
+	}
+	c.CurrencySettings = append(c.CurrencySettings, c.CurrencySettings[0])
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errTickDataMultipleCurrencies) {
+		t.Errorf("received: %v, expected: %v", err, errTickDataMultipleCurrencies)
+	}
+}
+
+func TestValidateFuturesDetails(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	cs := &CurrencySettings{
+		ExchangeName: testExchange,
+		Asset:        asset.Spot.String(),
+		Base:         "BTC",
+		Quote:        "USDT",
+	}
+	err := c.validateFuturesDetails(cs)
+	if err != nil {
+		t.Error(err)
+	}
+	cs.FuturesDetails = &FuturesDetails{}
+	err = c.validateFuturesDetails(cs)
+	if !errors.Is(err, errFuturesDetailsUnsupported) {
+		t.Errorf("received: %v, expected: %v", err, errFuturesDetailsUnsupported)
+	}
+
+	cs.Asset = asset.USDTMarginedFutures.String()
+	cs.FuturesDetails = nil
+	err = c.validateFuturesDetails(cs)
+	if !errors.Is(err, errFuturesDetailsRequired) {
+		t.Errorf("received: %v, expected: %v", err, errFuturesDetailsRequired)
+	}
+
+	cs.FuturesDetails = &FuturesDetails{}
+	cs.InitialBaseFunds = initialBaseFunds
+	err = c.validateFuturesDetails(cs)
+	if !errors.Is(err, errBadInitialFunds) {
+		t.Errorf("received: %v, expected: %v", err, errBadInitialFunds)
+	}
+
+	cs.InitialBaseFunds = nil
+	err = c.validateFuturesDetails(cs)
+	if !errors.Is(err, errInvalidMarginRatio) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidMarginRatio)
+	}
+	cs.FuturesDetails.InitialMarginRatio = decimal.NewFromFloat(0.1)
+	cs.FuturesDetails.MaintenanceMarginRatio = decimal.NewFromFloat(0.1)
+	err = c.validateFuturesDetails(cs)
+	if !errors.Is(err, errInvalidMarginRatio) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidMarginRatio)
+	}
+
+	cs.FuturesDetails.MaintenanceMarginRatio = decimal.NewFromFloat(0.05)
+	cs.FuturesDetails.LiquidationFeeRate = decimal.NewFromInt(-1)
+	err = c.validateFuturesDetails(cs)
+	if !errors.Is(err, errInvalidLiquidationFee) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidLiquidationFee)
+	}
+
+	cs.FuturesDetails.LiquidationFeeRate = decimal.NewFromFloat(0.005)
+	err = c.validateFuturesDetails(cs)
+	if !errors.Is(err, errLeverageRequired) {
+		t.Errorf("received: %v, expected: %v", err, errLeverageRequired)
+	}
+
+	cs.Leverage.CanUseLeverage = true
+	c.PortfolioSettings.Leverage.CanUseLeverage = true
+	err = c.validateFuturesDetails(cs)
+	if err != nil {
+		t.Error(err)
+	}
+
+	c.PortfolioSettings.Leverage.CanUseLeverage = false
+	cs.FuturesDetails.InitialMarginRatio = decimal.NewFromInt(1)
+	err = c.validateFuturesDetails(cs)
+	if err != nil {
+		t.Error(err)
+	}
 }
 
 func TestValidateMinMaxes(t *testing.T) {
@@ -1162,6 +1617,17 @@ func TestValidateStrategySettings(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Errorf("received %v expected %v", err, nil)
 	}
+	c.StrategySettings.Name = filepath.Join("..", "eventhandlers", "strategies", "gctscript", "examples", "rsi.gct")
+	err = c.validateStrategySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.StrategySettings.Name = "hello.gct"
+	err = c.validateStrategySettings()
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("received %v expected %v", err, os.ErrNotExist)
+	}
+	c.StrategySettings.Name = dca
 	c.StrategySettings.UseExchangeLevelFunding = true
 	err = c.validateStrategySettings()
 	if !errors.Is(err, errSimultaneousProcessingRequired) {
@@ -1213,3 +1679,185 @@ func TestValidate(t *testing.T) {
 		t.Errorf("received %v expected %v", err, nil)
 	}
 }
+
+func TestValidateExportSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateExportSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.ExportSettings = &ExportSettings{}
+	err = c.validateExportSettings()
+	if !errors.Is(err, errNoExportFormats) {
+		t.Errorf("received %v expected %v", err, errNoExportFormats)
+	}
+
+	c.ExportSettings.Formats = []string{"xml"}
+	err = c.validateExportSettings()
+	if !errors.Is(err, errUnsupportedExportFormat) {
+		t.Errorf("received %v expected %v", err, errUnsupportedExportFormat)
+	}
+
+	c.ExportSettings.Formats = []string{" JSON", "csv"}
+	err = c.validateExportSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if c.ExportSettings.Formats[0] != JSONExportFormat {
+		t.Errorf("received %v expected %v", c.ExportSettings.Formats[0], JSONExportFormat)
+	}
+}
+
+func TestValidateExitRules(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		CurrencySettings: []CurrencySettings{{}},
+	}
+	err := c.validateExitRules()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.PortfolioSettings.ExitRules = &ExitRules{
+		StopLoss: &ExitRule{},
+	}
+	err = c.validateExitRules()
+	if !errors.Is(err, errExitRuleDistanceUnset) {
+		t.Errorf("received %v expected %v", err, errExitRuleDistanceUnset)
+	}
+
+	c.PortfolioSettings.ExitRules.StopLoss.Percent = decimal.NewFromInt(5)
+	c.PortfolioSettings.ExitRules.StopLoss.Absolute = decimal.NewFromInt(5)
+	err = c.validateExitRules()
+	if !errors.Is(err, errExitRuleDistanceAmbiguous) {
+		t.Errorf("received %v expected %v", err, errExitRuleDistanceAmbiguous)
+	}
+
+	c.PortfolioSettings.ExitRules.StopLoss.Absolute = decimal.Zero
+	c.PortfolioSettings.ExitRules.TakeProfit = &ExitRule{Percent: decimal.NewFromInt(-1)}
+	err = c.validateExitRules()
+	if !errors.Is(err, errExitRuleNegative) {
+		t.Errorf("received %v expected %v", err, errExitRuleNegative)
+	}
+
+	// take profits can be more than 100 percent above the entry price
+	c.PortfolioSettings.ExitRules.TakeProfit.Percent = decimal.NewFromInt(150)
+	err = c.validateExitRules()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.CurrencySettings[0].ExitRules = &ExitRules{
+		TrailingStop: &ExitRule{Percent: decimal.NewFromInt(100)},
+	}
+	err = c.validateExitRules()
+	if !errors.Is(err, errExitRulePercentTooHigh) {
+		t.Errorf("received %v expected %v", err, errExitRulePercentTooHigh)
+	}
+
+	c.CurrencySettings[0].ExitRules.TrailingStop.Percent = decimal.NewFromInt(10)
+	err = c.validateExitRules()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateRobustnessSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateRobustnessSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.StatisticSettings.Robustness = &RobustnessSettings{Method: "hello"}
+	err = c.validateRobustnessSettings()
+	if !errors.Is(err, errInvalidRobustnessMethod) {
+		t.Errorf("received %v expected %v", err, errInvalidRobustnessMethod)
+	}
+
+	c.StatisticSettings.Robustness.Method = " Bootstrap"
+	err = c.validateRobustnessSettings()
+	if !errors.Is(err, errInvalidRobustnessIterations) {
+		t.Errorf("received %v expected %v", err, errInvalidRobustnessIterations)
+	}
+	if c.StatisticSettings.Robustness.Method != BootstrapMethod {
+		t.Errorf("received %v expected %v", c.StatisticSettings.Robustness.Method, BootstrapMethod)
+	}
+
+	c.StatisticSettings.Robustness.Iterations = 1000
+	c.StatisticSettings.Robustness.ConfidenceLevel = decimal.NewFromInt(1)
+	err = c.validateRobustnessSettings()
+	if !errors.Is(err, errInvalidConfidenceLevel) {
+		t.Errorf("received %v expected %v", err, errInvalidConfidenceLevel)
+	}
+
+	c.StatisticSettings.Robustness.ConfidenceLevel = decimal.NewFromFloat(0.95)
+	c.StatisticSettings.Robustness.RuinThreshold = decimal.NewFromInt(101)
+	err = c.validateRobustnessSettings()
+	if !errors.Is(err, errInvalidRuinThreshold) {
+		t.Errorf("received %v expected %v", err, errInvalidRuinThreshold)
+	}
+
+	c.StatisticSettings.Robustness.RuinThreshold = decimal.NewFromInt(50)
+	err = c.validateRobustnessSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateAdditionalIntervals(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateAdditionalIntervals()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.DataSettings.AdditionalIntervals = []time.Duration{kline.OneDay.Duration()}
+	c.DataSettings.DataType = common.TickStr
+	err = c.validateAdditionalIntervals()
+	if !errors.Is(err, errAdditionalIntervalsTickData) {
+		t.Errorf("received %v expected %v", err, errAdditionalIntervalsTickData)
+	}
+
+	c.DataSettings.DataType = common.CandleStr
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateAdditionalIntervals()
+	if !errors.Is(err, errAdditionalIntervalsLiveData) {
+		t.Errorf("received %v expected %v", err, errAdditionalIntervalsLiveData)
+	}
+
+	c.DataSettings.LiveData = nil
+	err = c.validateAdditionalIntervals()
+	if !errors.Is(err, errInvalidAdditionalInterval) {
+		t.Errorf("received %v expected %v", err, errInvalidAdditionalInterval)
+	}
+
+	c.DataSettings.Interval = kline.OneDay.Duration()
+	err = c.validateAdditionalIntervals()
+	if !errors.Is(err, errInvalidAdditionalInterval) {
+		t.Errorf("received %v expected %v", err, errInvalidAdditionalInterval)
+	}
+
+	c.DataSettings.Interval = kline.OneHour.Duration()
+	c.DataSettings.AdditionalIntervals = []time.Duration{kline.FourHour.Duration(), time.Minute * 90}
+	err = c.validateAdditionalIntervals()
+	if !errors.Is(err, errInvalidAdditionalInterval) {
+		t.Errorf("received %v expected %v", err, errInvalidAdditionalInterval)
+	}
+
+	c.DataSettings.AdditionalIntervals = []time.Duration{kline.FourHour.Duration(), kline.FourHour.Duration()}
+	err = c.validateAdditionalIntervals()
+	if !errors.Is(err, errDuplicateAdditionalInterval) {
+		t.Errorf("received %v expected %v", err, errDuplicateAdditionalInterval)
+	}
+
+	c.DataSettings.AdditionalIntervals = []time.Duration{kline.FourHour.Duration(), kline.OneDay.Duration()}
+	err = c.validateAdditionalIntervals()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
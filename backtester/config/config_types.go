@@ -24,29 +24,97 @@ var (
 	errSizeLessThanZero                 = errors.New("size less than zero")
 	errMaxSizeMinSizeMismatch           = errors.New("maximum size must be greater to minimum size")
 	errMinMaxEqual                      = errors.New("minimum and maximum limits cannot be equal")
+	errFuturesDetailsRequired           = errors.New("futures details must be set for futures assets, please check your config")
+	errFuturesDetailsUnsupported        = errors.New("futures details can only be set for futures assets, please check your config")
+	errInvalidMarginRatio               = errors.New("invalid margin ratio, initial margin ratio must be greater than maintenance margin ratio and neither can exceed 1")
+	errInvalidLiquidationFee            = errors.New("invalid liquidation fee rate")
+	errLeverageRequired                 = errors.New("initial margin ratio below 1 requires leverage to be enabled in both currency and portfolio settings")
+	errOptimisationLiveData             = errors.New("optimisation settings cannot be used with live data, please check your config")
+	errInvalidOptimisationMetric        = errors.New("invalid optimisation metric")
+	errNoOptimisationParameters         = errors.New("optimisation settings require at least one custom or portfolio setting range")
+	errUnnamedParameter                 = errors.New("optimisation parameter name unset")
+	errDuplicateParameter               = errors.New("duplicate optimisation parameter")
+	errInvalidParameterRange            = errors.New("invalid optimisation parameter range, step must be greater than zero and maximum must not be less than minimum")
+	errUnsupportedPortfolioParameter    = errors.New("unsupported portfolio setting optimisation parameter")
+	errInvalidConcurrentRuns            = errors.New("maximum concurrent runs cannot be less than zero")
+	errInvalidWalkForwardWindows        = errors.New("walk forward windows must be greater than zero")
+	errInvalidInSampleRatio             = errors.New("walk forward in sample ratio must be between 0 and 1")
+	errTickDataMultipleCurrencies       = errors.New("tick data only supports one currency setting, please check your config")
+	errTickDataLiveData                 = errors.New("tick data cannot be used with live data, please check your config")
+	errNoExportFormats                  = errors.New("export settings set without any formats, please check your config")
+	errUnsupportedExportFormat          = errors.New("unsupported export format")
+	errExitRuleDistanceUnset            = errors.New("exit rule requires either a percent or absolute distance, please check your config")
+	errExitRuleDistanceAmbiguous        = errors.New("exit rule cannot set both a percent and absolute distance, please check your config")
+	errExitRuleNegative                 = errors.New("exit rule distance cannot be negative")
+	errExitRulePercentTooHigh           = errors.New("stop exit rule percent must be less than 100")
+	errInvalidRobustnessMethod          = errors.New("invalid robustness method")
+	errInvalidRobustnessIterations      = errors.New("robustness iterations must be greater than zero")
+	errInvalidConfidenceLevel           = errors.New("robustness confidence level must be greater than 0 and less than 1")
+	errInvalidRuinThreshold             = errors.New("robustness ruin threshold must be greater than 0 and no more than 100")
+	errAdditionalIntervalsTickData      = errors.New("additional intervals cannot be used with tick data, please check your config")
+	errAdditionalIntervalsLiveData      = errors.New("additional intervals cannot be used with live data, please check your config")
+	errInvalidAdditionalInterval        = errors.New("additional intervals must be larger than, and a multiple of, the data interval")
+	errDuplicateAdditionalInterval      = errors.New("duplicate additional interval")
+)
+
+// Optimisation metrics used to rank parameter combinations
+const (
+	SharpeRatioMetric  = "sharpe-ratio"
+	SortinoRatioMetric = "sortino-ratio"
+	CalmarRatioMetric  = "calmar-ratio"
+	TotalReturnMetric  = "total-return"
+)
+
+// Robustness methods used to resample a run's returns
+const (
+	// BootstrapMethod draws returns at random with replacement
+	BootstrapMethod = "bootstrap"
+	// ShuffleMethod reorders the returns, keeping every return exactly once
+	ShuffleMethod = "shuffle"
+)
+
+// Export formats used to output machine-readable results
+const (
+	JSONExportFormat = "json"
+	CSVExportFormat  = "csv"
+)
+
+// Portfolio setting optimisation parameters
+const (
+	BuySideMinimumSizeParameter                = "buy-side.minimum-size"
+	BuySideMaximumSizeParameter                = "buy-side.maximum-size"
+	BuySideMaximumTotalParameter               = "buy-side.maximum-total"
+	SellSideMinimumSizeParameter               = "sell-side.minimum-size"
+	SellSideMaximumSizeParameter               = "sell-side.maximum-size"
+	SellSideMaximumTotalParameter              = "sell-side.maximum-total"
+	LeverageMaximumOrdersWithLeverageParameter = "leverage.maximum-orders-with-leverage-ratio"
+	LeverageMaximumLeverageRateParameter       = "leverage.maximum-leverage-rate"
 )
 
 // Config defines what is in an individual strategy config
 type Config struct {
-	Nickname                 string             `json:"nickname"`
-	Goal                     string             `json:"goal"`
-	StrategySettings         StrategySettings   `json:"strategy-settings"`
-	CurrencySettings         []CurrencySettings `json:"currency-settings"`
-	DataSettings             DataSettings       `json:"data-settings"`
-	PortfolioSettings        PortfolioSettings  `json:"portfolio-settings"`
-	StatisticSettings        StatisticSettings  `json:"statistic-settings"`
-	GoCryptoTraderConfigPath string             `json:"gocryptotrader-config-path"`
+	Nickname                 string                `json:"nickname"`
+	Goal                     string                `json:"goal"`
+	StrategySettings         StrategySettings      `json:"strategy-settings"`
+	CurrencySettings         []CurrencySettings    `json:"currency-settings"`
+	DataSettings             DataSettings          `json:"data-settings"`
+	PortfolioSettings        PortfolioSettings     `json:"portfolio-settings"`
+	StatisticSettings        StatisticSettings     `json:"statistic-settings"`
+	GoCryptoTraderConfigPath string                `json:"gocryptotrader-config-path"`
+	OptimisationSettings     *OptimisationSettings `json:"optimisation-settings,omitempty"`
+	ExportSettings           *ExportSettings       `json:"export-settings,omitempty"`
 }
 
 // DataSettings is a container for each type of data retrieval setting.
 // Only ONE can be populated per config
 type DataSettings struct {
-	Interval     time.Duration `json:"interval"`
-	DataType     string        `json:"data-type"`
-	APIData      *APIData      `json:"api-data,omitempty"`
-	DatabaseData *DatabaseData `json:"database-data,omitempty"`
-	LiveData     *LiveData     `json:"live-data,omitempty"`
-	CSVData      *CSVData      `json:"csv-data,omitempty"`
+	Interval            time.Duration   `json:"interval"`
+	AdditionalIntervals []time.Duration `json:"additional-intervals,omitempty"`
+	DataType            string          `json:"data-type"`
+	APIData             *APIData        `json:"api-data,omitempty"`
+	DatabaseData        *DatabaseData   `json:"database-data,omitempty"`
+	LiveData            *LiveData       `json:"live-data,omitempty"`
+	CSVData             *CSVData        `json:"csv-data,omitempty"`
 }
 
 // StrategySettings contains what strategy to load, along with custom settings map
@@ -79,6 +147,29 @@ type ExchangeLevelFunding struct {
 // proper data is currently lacking
 type StatisticSettings struct {
 	RiskFreeRate decimal.Decimal `json:"risk-free-rate"`
+	// Robustness enables Monte Carlo analysis of each currency's returns
+	Robustness *RobustnessSettings `json:"robustness,omitempty"`
+}
+
+// RobustnessSettings resample the returns of a run over many iterations to
+// determine the distribution of final equity and drawdown had the returns
+// occurred in a different order
+type RobustnessSettings struct {
+	Method     string `json:"method"`
+	Iterations int64  `json:"iterations"`
+	// ConfidenceLevel sets the confidence intervals reported, eg 0.95
+	ConfidenceLevel decimal.Decimal `json:"confidence-level"`
+	// RuinThreshold is the percentage loss from the starting equity
+	// which is considered ruin
+	RuinThreshold decimal.Decimal `json:"ruin-threshold"`
+	// Seed makes the results reproducible when set
+	Seed int64 `json:"seed,omitempty"`
+}
+
+// ExportSettings determines which machine-readable formats the
+// results are exported in alongside the report
+type ExportSettings struct {
+	Formats []string `json:"formats"`
 }
 
 // PortfolioSettings act as a global protector for strategies
@@ -88,6 +179,30 @@ type PortfolioSettings struct {
 	Leverage Leverage `json:"leverage"`
 	BuySide  MinMax   `json:"buy-side"`
 	SellSide MinMax   `json:"sell-side"`
+	// ExitRules apply to every currency which does not set its own
+	ExitRules *ExitRules `json:"exit-rules,omitempty"`
+}
+
+// ExitRules are protective exits the portfolio manager attaches to positions.
+// When a data event's price range crosses an exit, the portfolio manager closes
+// the entire position regardless of the strategy's signals
+type ExitRules struct {
+	// StopLoss is the distance below a long position's entry price,
+	// or above a short position's entry price
+	StopLoss *ExitRule `json:"stop-loss,omitempty"`
+	// TakeProfit is the distance above a long position's entry price,
+	// or below a short position's entry price
+	TakeProfit *ExitRule `json:"take-profit,omitempty"`
+	// TrailingStop is the distance below the highest price since a long
+	// position was opened, or above the lowest price for a short position
+	TrailingStop *ExitRule `json:"trailing-stop,omitempty"`
+}
+
+// ExitRule is the distance from a price which triggers an exit.
+// Only one of percent or absolute can be set
+type ExitRule struct {
+	Percent  decimal.Decimal `json:"percent"`
+	Absolute decimal.Decimal `json:"absolute"`
 }
 
 // Leverage rules are used to allow or limit the use of leverage in orders
@@ -134,6 +249,59 @@ type CurrencySettings struct {
 	CanUseExchangeLimits          bool `json:"use-exchange-order-limits"`
 	SkipCandleVolumeFitting       bool `json:"skip-candle-volume-fitting"`
 	ShowExchangeOrderLimitWarning bool `json:"-"`
+
+	FuturesDetails *FuturesDetails `json:"futures-details,omitempty"`
+	// OrderbookCSVPath points to recorded orderbook snapshots which
+	// market orders are filled against
+	OrderbookCSVPath string `json:"orderbook-csv-path,omitempty"`
+	// ExitRules override the portfolio's exit rules for the currency
+	ExitRules *ExitRules `json:"exit-rules,omitempty"`
+}
+
+// FuturesDetails contains the margin requirements and funding rate data
+// used when backtesting futures and perpetual swap contracts.
+// Positions are collateralised with the quote currency
+type FuturesDetails struct {
+	InitialMarginRatio     decimal.Decimal `json:"initial-margin-ratio"`
+	MaintenanceMarginRatio decimal.Decimal `json:"maintenance-margin-ratio"`
+	LiquidationFeeRate     decimal.Decimal `json:"liquidation-fee-rate"`
+	FundingRateCSVPath     string          `json:"funding-rate-csv-path,omitempty"`
+}
+
+// OptimisationSettings declares the ranges of custom and portfolio settings
+// to run a strategy against. Every combination is run against the same data
+// and ranked by the metric
+type OptimisationSettings struct {
+	Metric                string           `json:"metric"`
+	MaximumConcurrentRuns int64            `json:"maximum-concurrent-runs"`
+	CustomSettings        []ParameterRange `json:"custom-settings,omitempty"`
+	PortfolioSettings     []ParameterRange `json:"portfolio-settings,omitempty"`
+	WalkForward           *WalkForward     `json:"walk-forward,omitempty"`
+}
+
+// ParameterRange defines the values a setting can take during optimisation.
+// Values is used as a grid when set, otherwise values are stepped
+// from minimum to maximum
+type ParameterRange struct {
+	Name    string            `json:"name"`
+	Minimum decimal.Decimal   `json:"minimum"`
+	Maximum decimal.Decimal   `json:"maximum"`
+	Step    decimal.Decimal   `json:"step"`
+	Values  []decimal.Decimal `json:"values,omitempty"`
+}
+
+// WalkForward splits the data into consecutive windows. Each window is
+// optimised against its in sample portion and the best combination is
+// scored against the remaining out of sample portion
+type WalkForward struct {
+	Windows       int64           `json:"windows"`
+	InSampleRatio decimal.Decimal `json:"in-sample-ratio"`
+}
+
+// ParameterSet is a single combination of optimisation parameter values
+type ParameterSet struct {
+	CustomSettings    map[string]decimal.Decimal `json:"custom-settings,omitempty"`
+	PortfolioSettings map[string]decimal.Decimal `json:"portfolio-settings,omitempty"`
 }
 
 // APIData defines all fields to configure API based data
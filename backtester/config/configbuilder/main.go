@@ -182,10 +182,13 @@ func parseStatisticsSettings(cfg *config.Config, reader *bufio.Reader) error {
 
 func parseDataSettings(cfg *config.Config, reader *bufio.Reader) error {
 	var err error
-	fmt.Println("Will you be using \"candle\" or \"trade\" data?")
+	fmt.Println("Will you be using \"candle\", \"trade\" or \"tick\" data?")
 	cfg.DataSettings.DataType = quickParse(reader)
-	if cfg.DataSettings.DataType == common.TradeStr {
+	switch cfg.DataSettings.DataType {
+	case common.TradeStr:
 		fmt.Println("Trade data will be converted into candles")
+	case common.TickStr:
+		fmt.Println("Trade data will be replayed one trade at a time, the candle interval is used to chart the trades")
 	}
 	fmt.Println("What candle time interval will you use?")
 	cfg.DataSettings.Interval, err = parseKlineInterval(reader)
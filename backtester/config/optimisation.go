@@ -0,0 +1,208 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// validateOptimisationSettings ensures the parameter ranges and walk forward
+// settings can be used to generate runs
+func (c *Config) validateOptimisationSettings() error {
+	o := c.OptimisationSettings
+	if o == nil {
+		return nil
+	}
+	if c.DataSettings.LiveData != nil {
+		return errOptimisationLiveData
+	}
+	switch o.Metric {
+	case SharpeRatioMetric, SortinoRatioMetric, CalmarRatioMetric, TotalReturnMetric:
+	default:
+		return fmt.Errorf("%w '%v'", errInvalidOptimisationMetric, o.Metric)
+	}
+	if o.MaximumConcurrentRuns < 0 {
+		return errInvalidConcurrentRuns
+	}
+	if len(o.CustomSettings) == 0 && len(o.PortfolioSettings) == 0 {
+		return errNoOptimisationParameters
+	}
+	err := validateParameterRanges(o.CustomSettings)
+	if err != nil {
+		return err
+	}
+	err = validateParameterRanges(o.PortfolioSettings)
+	if err != nil {
+		return err
+	}
+	for i := range o.PortfolioSettings {
+		err = new(PortfolioSettings).setParameter(o.PortfolioSettings[i].Name, decimal.Zero)
+		if err != nil {
+			return err
+		}
+	}
+	if o.WalkForward != nil {
+		if o.WalkForward.Windows <= 0 {
+			return errInvalidWalkForwardWindows
+		}
+		if o.WalkForward.InSampleRatio.LessThanOrEqual(decimal.Zero) ||
+			o.WalkForward.InSampleRatio.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+			return fmt.Errorf("%w, received %v", errInvalidInSampleRatio, o.WalkForward.InSampleRatio)
+		}
+	}
+	return nil
+}
+
+func validateParameterRanges(ranges []ParameterRange) error {
+	names := make(map[string]bool, len(ranges))
+	for i := range ranges {
+		if ranges[i].Name == "" {
+			return errUnnamedParameter
+		}
+		if names[ranges[i].Name] {
+			return fmt.Errorf("%w '%v'", errDuplicateParameter, ranges[i].Name)
+		}
+		names[ranges[i].Name] = true
+		if len(ranges[i].Values) > 0 {
+			continue
+		}
+		if ranges[i].Step.LessThanOrEqual(decimal.Zero) ||
+			ranges[i].Maximum.LessThan(ranges[i].Minimum) {
+			return fmt.Errorf("%w. '%v' minimum: %v maximum: %v step: %v",
+				errInvalidParameterRange,
+				ranges[i].Name,
+				ranges[i].Minimum,
+				ranges[i].Maximum,
+				ranges[i].Step)
+		}
+	}
+	return nil
+}
+
+// GetValues returns every value the parameter will be run against
+func (p *ParameterRange) GetValues() []decimal.Decimal {
+	if len(p.Values) > 0 {
+		return p.Values
+	}
+	var resp []decimal.Decimal
+	if p.Step.LessThanOrEqual(decimal.Zero) {
+		return resp
+	}
+	for v := p.Minimum; v.LessThanOrEqual(p.Maximum); v = v.Add(p.Step) {
+		resp = append(resp, v)
+	}
+	return resp
+}
+
+// GenerateParameterSets returns every combination of the custom and
+// portfolio setting parameter values
+func (o *OptimisationSettings) GenerateParameterSets() []ParameterSet {
+	resp := []ParameterSet{{}}
+	for i := range o.CustomSettings {
+		resp = expandParameterSets(resp, &o.CustomSettings[i], true)
+	}
+	for i := range o.PortfolioSettings {
+		resp = expandParameterSets(resp, &o.PortfolioSettings[i], false)
+	}
+	return resp
+}
+
+// expandParameterSets creates a copy of every set for each value of the parameter
+func expandParameterSets(sets []ParameterSet, p *ParameterRange, isCustomSetting bool) []ParameterSet {
+	values := p.GetValues()
+	resp := make([]ParameterSet, 0, len(sets)*len(values))
+	for i := range sets {
+		for j := range values {
+			ps := ParameterSet{
+				CustomSettings:    make(map[string]decimal.Decimal, len(sets[i].CustomSettings)+1),
+				PortfolioSettings: make(map[string]decimal.Decimal, len(sets[i].PortfolioSettings)+1),
+			}
+			for k, v := range sets[i].CustomSettings {
+				ps.CustomSettings[k] = v
+			}
+			for k, v := range sets[i].PortfolioSettings {
+				ps.PortfolioSettings[k] = v
+			}
+			if isCustomSetting {
+				ps.CustomSettings[p.Name] = values[j]
+			} else {
+				ps.PortfolioSettings[p.Name] = values[j]
+			}
+			resp = append(resp, ps)
+		}
+	}
+	return resp
+}
+
+// ApplyParameterSet returns a copy of the config with the parameter set
+// applied to its custom and portfolio settings. The copy has no optimisation settings
+func (c *Config) ApplyParameterSet(ps ParameterSet) (*Config, error) {
+	resp := *c
+	resp.OptimisationSettings = nil
+	resp.CurrencySettings = make([]CurrencySettings, len(c.CurrencySettings))
+	copy(resp.CurrencySettings, c.CurrencySettings)
+	resp.StrategySettings.CustomSettings = make(map[string]interface{}, len(c.StrategySettings.CustomSettings)+len(ps.CustomSettings))
+	for k, v := range c.StrategySettings.CustomSettings {
+		resp.StrategySettings.CustomSettings[k] = v
+	}
+	for k, v := range ps.CustomSettings {
+		// custom settings are parsed by strategies as JSON numbers
+		f, _ := v.Float64()
+		resp.StrategySettings.CustomSettings[k] = f
+	}
+	for k, v := range ps.PortfolioSettings {
+		err := resp.PortfolioSettings.setParameter(k, v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := resp.PortfolioSettings.BuySide.validate()
+	if err != nil {
+		return nil, fmt.Errorf("%v %w", ps, err)
+	}
+	err = resp.PortfolioSettings.SellSide.validate()
+	if err != nil {
+		return nil, fmt.Errorf("%v %w", ps, err)
+	}
+	return &resp, nil
+}
+
+// setParameter sets a portfolio setting by its optimisation parameter name
+func (p *PortfolioSettings) setParameter(name string, value decimal.Decimal) error {
+	switch name {
+	case BuySideMinimumSizeParameter:
+		p.BuySide.MinimumSize = value
+	case BuySideMaximumSizeParameter:
+		p.BuySide.MaximumSize = value
+	case BuySideMaximumTotalParameter:
+		p.BuySide.MaximumTotal = value
+	case SellSideMinimumSizeParameter:
+		p.SellSide.MinimumSize = value
+	case SellSideMaximumSizeParameter:
+		p.SellSide.MaximumSize = value
+	case SellSideMaximumTotalParameter:
+		p.SellSide.MaximumTotal = value
+	case LeverageMaximumOrdersWithLeverageParameter:
+		p.Leverage.MaximumOrdersWithLeverageRatio = value
+	case LeverageMaximumLeverageRateParameter:
+		p.Leverage.MaximumLeverageRate = value
+	default:
+		return fmt.Errorf("%w '%v'", errUnsupportedPortfolioParameter, name)
+	}
+	return nil
+}
+
+// String returns the parameter names and values in alphabetical order
+func (ps ParameterSet) String() string {
+	var resp []string
+	for k, v := range ps.CustomSettings {
+		resp = append(resp, fmt.Sprintf("%v: %v", k, v))
+	}
+	for k, v := range ps.PortfolioSettings {
+		resp = append(resp, fmt.Sprintf("%v: %v", k, v))
+	}
+	sort.Strings(resp)
+	return strings.Join(resp, ", ")
+}
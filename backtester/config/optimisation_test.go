@@ -0,0 +1,225 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestValidateOptimisationSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateOptimisationSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	c.OptimisationSettings = &OptimisationSettings{}
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errOptimisationLiveData) {
+		t.Errorf("received '%v' expected '%v'", err, errOptimisationLiveData)
+	}
+
+	c.DataSettings.LiveData = nil
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidOptimisationMetric) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidOptimisationMetric)
+	}
+
+	c.OptimisationSettings.Metric = SharpeRatioMetric
+	c.OptimisationSettings.MaximumConcurrentRuns = -1
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidConcurrentRuns) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidConcurrentRuns)
+	}
+
+	c.OptimisationSettings.MaximumConcurrentRuns = 0
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errNoOptimisationParameters) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOptimisationParameters)
+	}
+
+	c.OptimisationSettings.CustomSettings = []ParameterRange{{}}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errUnnamedParameter) {
+		t.Errorf("received '%v' expected '%v'", err, errUnnamedParameter)
+	}
+
+	c.OptimisationSettings.CustomSettings[0].Name = "rsi-period"
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidParameterRange) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidParameterRange)
+	}
+
+	c.OptimisationSettings.CustomSettings[0].Step = decimal.NewFromInt(1)
+	c.OptimisationSettings.CustomSettings[0].Minimum = decimal.NewFromInt(2)
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidParameterRange) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidParameterRange)
+	}
+
+	c.OptimisationSettings.CustomSettings[0].Maximum = decimal.NewFromInt(4)
+	c.OptimisationSettings.CustomSettings = append(c.OptimisationSettings.CustomSettings, c.OptimisationSettings.CustomSettings[0])
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errDuplicateParameter) {
+		t.Errorf("received '%v' expected '%v'", err, errDuplicateParameter)
+	}
+
+	c.OptimisationSettings.CustomSettings = c.OptimisationSettings.CustomSettings[:1]
+	c.OptimisationSettings.PortfolioSettings = []ParameterRange{
+		{
+			Name:   "buy-side.maximum-hodl",
+			Values: []decimal.Decimal{decimal.NewFromInt(1)},
+		},
+	}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errUnsupportedPortfolioParameter) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedPortfolioParameter)
+	}
+
+	c.OptimisationSettings.PortfolioSettings[0].Name = BuySideMaximumSizeParameter
+	c.OptimisationSettings.WalkForward = &WalkForward{}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidWalkForwardWindows) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidWalkForwardWindows)
+	}
+
+	c.OptimisationSettings.WalkForward.Windows = 3
+	c.OptimisationSettings.WalkForward.InSampleRatio = decimal.NewFromInt(1)
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidInSampleRatio) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidInSampleRatio)
+	}
+
+	c.OptimisationSettings.WalkForward.InSampleRatio = decimal.NewFromFloat(0.7)
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestGetValues(t *testing.T) {
+	t.Parallel()
+	p := ParameterRange{}
+	if len(p.GetValues()) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(p.GetValues()), 0)
+	}
+	p.Minimum = decimal.NewFromInt(10)
+	p.Maximum = decimal.NewFromInt(20)
+	p.Step = decimal.NewFromInt(4)
+	v := p.GetValues()
+	if len(v) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(v), 3)
+	}
+	if !v[2].Equal(decimal.NewFromInt(18)) {
+		t.Errorf("received '%v' expected '%v'", v[2], 18)
+	}
+	p.Values = []decimal.Decimal{decimal.NewFromInt(1)}
+	if len(p.GetValues()) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(p.GetValues()), 1)
+	}
+}
+
+func TestGenerateParameterSets(t *testing.T) {
+	t.Parallel()
+	o := OptimisationSettings{
+		CustomSettings: []ParameterRange{
+			{
+				Name:   "rsi-period",
+				Values: []decimal.Decimal{decimal.NewFromInt(10), decimal.NewFromInt(14)},
+			},
+			{
+				Name:    "rsi-high",
+				Minimum: decimal.NewFromInt(60),
+				Maximum: decimal.NewFromInt(80),
+				Step:    decimal.NewFromInt(10),
+			},
+		},
+		PortfolioSettings: []ParameterRange{
+			{
+				Name:   BuySideMaximumSizeParameter,
+				Values: []decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(2)},
+			},
+		},
+	}
+	sets := o.GenerateParameterSets()
+	if len(sets) != 12 {
+		t.Fatalf("received '%v' expected '%v'", len(sets), 12)
+	}
+	seen := make(map[string]bool)
+	for i := range sets {
+		if len(sets[i].CustomSettings) != 2 || len(sets[i].PortfolioSettings) != 1 {
+			t.Errorf("received '%v' expected two custom settings and one portfolio setting", sets[i])
+		}
+		seen[sets[i].String()] = true
+	}
+	if len(seen) != 12 {
+		t.Errorf("received '%v' unique sets expected '%v'", len(seen), 12)
+	}
+	if sets[0].String() != "buy-side.maximum-size: 1, rsi-high: 60, rsi-period: 10" {
+		t.Errorf("received '%v'", sets[0])
+	}
+}
+
+func TestApplyParameterSet(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		StrategySettings: StrategySettings{
+			CustomSettings: map[string]interface{}{
+				"rsi-low": 30.0,
+			},
+		},
+		CurrencySettings:     []CurrencySettings{{ExchangeName: testExchange}},
+		OptimisationSettings: &OptimisationSettings{},
+	}
+	ps := ParameterSet{
+		CustomSettings: map[string]decimal.Decimal{
+			"rsi-period": decimal.NewFromInt(14),
+		},
+		PortfolioSettings: map[string]decimal.Decimal{
+			SellSideMaximumTotalParameter:        decimal.NewFromInt(1337),
+			LeverageMaximumLeverageRateParameter: decimal.NewFromInt(2),
+		},
+	}
+	resp, err := c.ApplyParameterSet(ps)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.OptimisationSettings != nil {
+		t.Error("expected optimisation settings to be removed")
+	}
+	if resp.StrategySettings.CustomSettings["rsi-period"] != 14.0 {
+		t.Errorf("received '%v' expected '%v'", resp.StrategySettings.CustomSettings["rsi-period"], 14.0)
+	}
+	if resp.StrategySettings.CustomSettings["rsi-low"] != 30.0 {
+		t.Errorf("received '%v' expected '%v'", resp.StrategySettings.CustomSettings["rsi-low"], 30.0)
+	}
+	if _, ok := c.StrategySettings.CustomSettings["rsi-period"]; ok {
+		t.Error("expected original custom settings to be unchanged")
+	}
+	if !resp.PortfolioSettings.SellSide.MaximumTotal.Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", resp.PortfolioSettings.SellSide.MaximumTotal, 1337)
+	}
+	if !resp.PortfolioSettings.Leverage.MaximumLeverageRate.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", resp.PortfolioSettings.Leverage.MaximumLeverageRate, 2)
+	}
+	resp.CurrencySettings[0].ExchangeName = "hello"
+	if c.CurrencySettings[0].ExchangeName != testExchange {
+		t.Error("expected original currency settings to be unchanged")
+	}
+
+	ps.PortfolioSettings[BuySideMinimumSizeParameter] = decimal.NewFromInt(5)
+	ps.PortfolioSettings[BuySideMaximumSizeParameter] = decimal.NewFromInt(1)
+	_, err = c.ApplyParameterSet(ps)
+	if !errors.Is(err, errMaxSizeMinSizeMismatch) {
+		t.Errorf("received '%v' expected '%v'", err, errMaxSizeMinSizeMismatch)
+	}
+
+	ps.PortfolioSettings["hello"] = decimal.NewFromInt(1)
+	_, err = c.ApplyParameterSet(ps)
+	if !errors.Is(err, errUnsupportedPortfolioParameter) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedPortfolioParameter)
+	}
+}
@@ -1,12 +1,14 @@
 package data
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 )
 
 // Setup creates a basic map
@@ -51,6 +53,7 @@ func (b *Base) Reset() {
 	b.latest = nil
 	b.offset = 0
 	b.stream = nil
+	b.intervals = nil
 }
 
 // GetStream will return entire data list
@@ -96,6 +99,36 @@ func (b *Base) History() []common.DataEventHandler {
 	return b.stream[:b.offset]
 }
 
+// SetIntervalStream sets the data stream of an additional interval.
+// The stream is sorted by timestamp
+func (b *Base) SetIntervalStream(i kline.Interval, s []common.DataEventHandler) {
+	if b.intervals == nil {
+		b.intervals = make(map[kline.Interval][]common.DataEventHandler)
+	}
+	sort.Slice(s, func(x, y int) bool {
+		return s[x].GetTime().Before(s[y].GetTime())
+	})
+	b.intervals[i] = s
+}
+
+// GetHistoryFor returns all data events of an additional interval which
+// had closed by the close of the latest data event. A candle still in
+// progress is never returned, so there is no lookahead
+func (b *Base) GetHistoryFor(i kline.Interval) ([]common.DataEventHandler, error) {
+	s, ok := b.intervals[i]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", ErrIntervalNotLoaded, i)
+	}
+	if b.latest == nil {
+		return nil, nil
+	}
+	closed := b.latest.GetTime().Add(b.latest.GetInterval().Duration())
+	n := sort.Search(len(s), func(x int) bool {
+		return s[x].GetTime().Add(i.Duration()).After(closed)
+	})
+	return s[:n], nil
+}
+
 // Latest will return latest data event
 func (b *Base) Latest() common.DataEventHandler {
 	return b.latest
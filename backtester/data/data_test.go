@@ -1,10 +1,12 @@
 package data
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
@@ -55,6 +57,37 @@ func TestBaseDataFunctions(t *testing.T) {
 	d.SortStream()
 }
 
+func TestGetHistoryFor(t *testing.T) {
+	t.Parallel()
+	var d Base
+	_, err := d.GetHistoryFor(kline.OneHour)
+	if !errors.Is(err, ErrIntervalNotLoaded) {
+		t.Errorf("received: %v, expected: %v", err, ErrIntervalNotLoaded)
+	}
+	d.SetIntervalStream(kline.OneHour, []common.DataEventHandler{fakeDataHandler{time: 2}, fakeDataHandler{time: -5}})
+	history, err := d.GetHistoryFor(kline.OneHour)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if history != nil {
+		t.Error("expected no history before any data is processed")
+	}
+	d.AppendStream(fakeDataHandler{time: 1})
+	d.Next()
+	history, err = d.GetHistoryFor(kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].(fakeDataHandler).time != -5 {
+		t.Errorf("received: %v, expected only the closed interval", history)
+	}
+	d.Reset()
+	_, err = d.GetHistoryFor(kline.OneHour)
+	if !errors.Is(err, ErrIntervalNotLoaded) {
+		t.Errorf("received: %v, expected: %v", err, ErrIntervalNotLoaded)
+	}
+}
+
 func TestSetup(t *testing.T) {
 	t.Parallel()
 	d := HandlerPerCurrency{}
@@ -1,14 +1,20 @@
 package data
 
 import (
+	"errors"
 	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 )
 
+// ErrIntervalNotLoaded occurs when history is requested for an interval
+// which was not set in the config's additional intervals
+var ErrIntervalNotLoaded = errors.New("interval not loaded")
+
 // HandlerPerCurrency stores an event handler per exchange asset pair
 type HandlerPerCurrency struct {
 	data map[string]map[asset.Item]map[currency.Pair]Handler
@@ -29,6 +35,8 @@ type Base struct {
 	latest common.DataEventHandler
 	stream []common.DataEventHandler
 	offset int
+	// intervals holds the streams of any additional intervals
+	intervals map[kline.Interval][]common.DataEventHandler
 }
 
 // Handler interface for Loading and Streaming data
@@ -48,6 +56,7 @@ type Streamer interface {
 	Next() common.DataEventHandler
 	GetStream() []common.DataEventHandler
 	History() []common.DataEventHandler
+	GetHistoryFor(kline.Interval) ([]common.DataEventHandler, error)
 	Latest() common.DataEventHandler
 	List() []common.DataEventHandler
 	Offset() int
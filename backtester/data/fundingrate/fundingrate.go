@@ -0,0 +1,73 @@
+package fundingrate
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// LoadCSV reads funding rates from a CSV file in the format of
+// unix timestamp,rate and returns them sorted by time
+func LoadCSV(filepath string) ([]Rate, error) {
+	csvFile, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = csvFile.Close()
+		if err != nil {
+			log.Errorln(log.BackTester, err)
+		}
+	}()
+	return parse(csvFile)
+}
+
+func parse(r io.Reader) ([]Rate, error) {
+	csvData := csv.NewReader(r)
+	csvData.FieldsPerRecord = -1
+	var resp []Rate
+	for line := 1; ; line++ {
+		row, err := csvData.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("could not read funding rate csv data, %v", err)
+		}
+		if len(row) != 2 {
+			return nil, fmt.Errorf("%w line %v, expected 2 fields, received %v", errInvalidRow, line, len(row))
+		}
+		ts, err := strconv.ParseInt(strings.TrimSpace(row[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w line %v, could not process timestamp %v %v", errInvalidRow, line, row[0], err)
+		}
+		rate, err := decimal.NewFromString(strings.TrimSpace(row[1]))
+		if err != nil {
+			return nil, fmt.Errorf("%w line %v, could not process rate %v %v", errInvalidRow, line, row[1], err)
+		}
+		resp = append(resp, Rate{
+			Time: time.Unix(ts, 0).UTC(),
+			Rate: rate,
+		})
+	}
+	if len(resp) == 0 {
+		return nil, errNoRates
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Time.Before(resp[j].Time)
+	})
+	for i := 1; i < len(resp); i++ {
+		if resp[i].Time.Equal(resp[i-1].Time) {
+			return nil, fmt.Errorf("%w %v", errDuplicateRowTime, resp[i].Time)
+		}
+	}
+	return resp, nil
+}
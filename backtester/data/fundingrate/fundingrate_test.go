@@ -0,0 +1,63 @@
+package fundingrate
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestLoadCSV(t *testing.T) {
+	t.Parallel()
+	_, err := LoadCSV(filepath.Join("..", "..", "..", "testdata", "does-not-exist.csv"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	rates, err := LoadCSV(filepath.Join("..", "..", "..", "testdata", "binance_BTCUSDT_funding_rates_2019_01_01.csv"))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(rates) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(rates), 3)
+	}
+	if !rates[0].Time.Equal(time.Unix(1546300800, 0)) {
+		t.Errorf("received '%v' expected '%v'", rates[0].Time, time.Unix(1546300800, 0))
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	_, err := parse(strings.NewReader(""))
+	if !errors.Is(err, errNoRates) {
+		t.Errorf("received '%v' expected '%v'", err, errNoRates)
+	}
+	_, err = parse(strings.NewReader("1546300800"))
+	if !errors.Is(err, errInvalidRow) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRow)
+	}
+	_, err = parse(strings.NewReader("bad,0.0001"))
+	if !errors.Is(err, errInvalidRow) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRow)
+	}
+	_, err = parse(strings.NewReader("1546300800,bad"))
+	if !errors.Is(err, errInvalidRow) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRow)
+	}
+	_, err = parse(strings.NewReader("1546300800,0.0001\n1546300800,0.0002"))
+	if !errors.Is(err, errDuplicateRowTime) {
+		t.Errorf("received '%v' expected '%v'", err, errDuplicateRowTime)
+	}
+	rates, err := parse(strings.NewReader("1546329600,-0.0001\n1546300800, 0.0001"))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !rates[0].Rate.Equal(decimal.NewFromFloat(0.0001)) {
+		t.Errorf("received '%v' expected '%v'", rates[0].Rate, 0.0001)
+	}
+	if !rates[1].Rate.Equal(decimal.NewFromFloat(-0.0001)) {
+		t.Errorf("received '%v' expected '%v'", rates[1].Rate, -0.0001)
+	}
+}
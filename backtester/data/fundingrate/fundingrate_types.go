@@ -0,0 +1,21 @@
+package fundingrate
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	errNoRates          = errors.New("no funding rates found")
+	errInvalidRow       = errors.New("invalid funding rate row")
+	errDuplicateRowTime = errors.New("duplicate funding rate time")
+)
+
+// Rate is a periodic funding rate for a perpetual contract.
+// A positive rate means long positions pay short positions
+type Rate struct {
+	Time time.Time
+	Rate decimal.Decimal
+}
@@ -51,3 +51,16 @@ func LoadData(ctx context.Context, dataType int64, startDate, endDate time.Time,
 
 	return &candles, nil
 }
+
+// LoadTrades retrieves trades from a GoCryptoTrader exchange wrapper so that they can be replayed individually
+func LoadTrades(ctx context.Context, startDate, endDate time.Time, exch exchange.IBotExchange, fPair currency.Pair, a asset.Item) ([]trade.Data, error) {
+	trades, err := exch.GetHistoricTrades(ctx,
+		fPair,
+		a,
+		startDate,
+		endDate)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve trade data for %v %v %v, %v", exch.GetName(), a, fPair, err)
+	}
+	return trades, nil
+}
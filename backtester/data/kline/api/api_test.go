@@ -84,4 +84,12 @@ func TestLoadTrades(t *testing.T) {
 	if len(data.Candles) == 0 {
 		t.Error("expected candles")
 	}
+
+	trades, err := LoadTrades(context.Background(), tt1, tt2, exch, cp, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) == 0 {
+		t.Error("expected trades")
+	}
 }
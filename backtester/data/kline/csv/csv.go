@@ -104,45 +104,9 @@ func LoadData(dataType int64, filepath, exchangeName string, interval time.Durat
 		resp.Item = candles
 	case common.DataTrade:
 		var trades []trade.Data
-		for {
-			row, errCSV := csvData.Read()
-			if errCSV != nil {
-				if errCSV == io.EOF {
-					break
-				}
-				return nil, errCSV
-			}
-
-			t := trade.Data{}
-			v, errParse := strconv.ParseInt(row[0], 10, 32)
-			if errParse != nil {
-				return nil, errParse
-			}
-			t.Timestamp = time.Unix(v, 0).UTC()
-			if t.Timestamp.IsZero() {
-				err = fmt.Errorf("invalid timestamp received on row %v", row)
-				break
-			}
-
-			t.Price, err = strconv.ParseFloat(row[1], 64)
-			if err != nil {
-				err = fmt.Errorf("could not process trade price %v, %v", row[1], err)
-				break
-			}
-
-			t.Amount, err = strconv.ParseFloat(row[2], 64)
-			if err != nil {
-				err = fmt.Errorf("could not process trade amount %v, %v", row[2], err)
-				break
-			}
-
-			t.Side, err = order.StringToOrderSide(row[3])
-			if err != nil {
-				err = fmt.Errorf("could not process trade side %v, %v", row[3], err)
-				break
-			}
-
-			trades = append(trades, t)
+		trades, err = readTrades(csvData)
+		if err != nil {
+			return nil, fmt.Errorf("could not read csv trade data for %v %v %v, %v", exchangeName, a, fPair, err)
 		}
 		resp.Item, err = trade.ConvertTradesToCandles(kline.Interval(interval), trades...)
 		if err != nil {
@@ -158,3 +122,73 @@ func LoadData(dataType int64, filepath, exchangeName string, interval time.Durat
 
 	return resp, nil
 }
+
+// LoadTrades is a basic csv reader which returns the trades found in the CSV file
+// so that they can be replayed individually
+func LoadTrades(filepath, exchangeName string, fPair currency.Pair, a asset.Item) ([]trade.Data, error) {
+	csvFile, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		err = csvFile.Close()
+		if err != nil {
+			log.Errorln(log.BackTester, err)
+		}
+	}()
+
+	trades, err := readTrades(csv.NewReader(csvFile))
+	if err != nil {
+		return nil, fmt.Errorf("could not read csv trade data for %v %v %v, %v", exchangeName, a, fPair, err)
+	}
+	for i := range trades {
+		trades[i].Exchange = strings.ToLower(exchangeName)
+		trades[i].CurrencyPair = fPair
+		trades[i].AssetType = a
+	}
+	return trades, nil
+}
+
+// readTrades parses trades in the format of
+// unix timestamp,price,amount,side
+func readTrades(csvData *csv.Reader) ([]trade.Data, error) {
+	var trades []trade.Data
+	for {
+		row, err := csvData.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		t := trade.Data{}
+		v, err := strconv.ParseInt(row[0], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		t.Timestamp = time.Unix(v, 0).UTC()
+		if t.Timestamp.IsZero() {
+			return nil, fmt.Errorf("invalid timestamp received on row %v", row)
+		}
+
+		t.Price, err = strconv.ParseFloat(row[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not process trade price %v, %v", row[1], err)
+		}
+
+		t.Amount, err = strconv.ParseFloat(row[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not process trade amount %v, %v", row[2], err)
+		}
+
+		t.Side, err = order.StringToOrderSide(row[3])
+		if err != nil {
+			return nil, fmt.Errorf("could not process trade side %v, %v", row[3], err)
+		}
+
+		trades = append(trades, t)
+	}
+	return trades, nil
+}
@@ -60,3 +60,28 @@ func TestLoadDataInvalid(t *testing.T) {
 		t.Errorf("received: %v, expected: %v", err, common.ErrInvalidDataType)
 	}
 }
+
+func TestLoadTrades(t *testing.T) {
+	exch := testExchange
+	a := asset.Spot
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	trades, err := LoadTrades(
+		filepath.Join("..", "..", "..", "..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv"),
+		exch,
+		p,
+		a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) == 0 {
+		t.Fatal("expected trades")
+	}
+	if !trades[0].CurrencyPair.Equal(p) || trades[0].AssetType != a {
+		t.Errorf("received '%v %v' expected '%v %v'", trades[0].CurrencyPair, trades[0].AssetType, p, a)
+	}
+
+	_, err = LoadTrades("hello.csv", exch, p, a)
+	if err == nil {
+		t.Error("expected error loading missing file")
+	}
+}
@@ -70,3 +70,18 @@ func getCandleDatabaseData(startDate, endDate time.Time, interval time.Duration,
 		startDate,
 		endDate)
 }
+
+// LoadTrades retrieves trades from an existing database so that they can be replayed individually
+func LoadTrades(startDate, endDate time.Time, exchangeName string, fPair currency.Pair, a asset.Item) ([]trade.Data, error) {
+	trades, err := trade.GetTradesInRange(
+		exchangeName,
+		a.String(),
+		fPair.Base.String(),
+		fPair.Quote.String(),
+		startDate,
+		endDate)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve database trade data for %v %v %v, %v", exchangeName, a, fPair, err)
+	}
+	return trades, nil
+}
@@ -202,6 +202,14 @@ func TestLoadDataTrades(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+
+	trades, err := LoadTrades(dStart, dEnd, exch, p, a)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(trades) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(trades), 1)
+	}
 }
 
 func TestLoadDataInvalid(t *testing.T) {
@@ -1,6 +1,7 @@
 package kline
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -52,6 +53,70 @@ func (d *DataFromKline) Load() error {
 	return nil
 }
 
+// LoadInterval converts the loaded candles to a larger interval and sets them
+// as an additional stream which strategies can access via GetHistoryFor.
+// Candles are grouped by the start time of the larger interval, so any group
+// with missing data, along with partial groups at the start and end of the
+// data, are excluded rather than being misaligned
+func (d *DataFromKline) LoadInterval(i gctkline.Interval) error {
+	if len(d.Item.Candles) == 0 {
+		return errNoCandleData
+	}
+	if i <= d.Item.Interval || i.Duration()%d.Item.Interval.Duration() != 0 {
+		return fmt.Errorf("%w, received %v for %v data", errIntervalNotMultiple, i, d.Item.Interval)
+	}
+	candlesPerInterval := int(i.Duration() / d.Item.Interval.Duration())
+	var converted []gctkline.Candle
+	var bundle []gctkline.Candle
+	var bundleStart time.Time
+	for j := range d.Item.Candles {
+		start := d.Item.Candles[j].Time.Truncate(i.Duration())
+		if !start.Equal(bundleStart) {
+			bundle = nil
+			bundleStart = start
+		}
+		bundle = append(bundle, d.Item.Candles[j])
+		if len(bundle) != candlesPerInterval {
+			continue
+		}
+		c, err := gctkline.ConvertToNewInterval(&gctkline.Item{
+			Exchange: d.Item.Exchange,
+			Pair:     d.Item.Pair,
+			Asset:    d.Item.Asset,
+			Interval: d.Item.Interval,
+			Candles:  bundle,
+		}, i)
+		if err != nil {
+			return err
+		}
+		converted = append(converted, c.Candles...)
+	}
+	if len(converted) == 0 {
+		return fmt.Errorf("%w %v", errNoCompleteCandles, i)
+	}
+
+	klineData := make([]common.DataEventHandler, len(converted))
+	for j := range converted {
+		klineData[j] = &kline.Kline{
+			Base: event.Base{
+				Offset:       int64(j + 1),
+				Exchange:     d.Item.Exchange,
+				Time:         converted[j].Time,
+				Interval:     i,
+				CurrencyPair: d.Item.Pair,
+				AssetType:    d.Item.Asset,
+			},
+			Open:   decimal.NewFromFloat(converted[j].Open),
+			High:   decimal.NewFromFloat(converted[j].High),
+			Low:    decimal.NewFromFloat(converted[j].Low),
+			Close:  decimal.NewFromFloat(converted[j].Close),
+			Volume: decimal.NewFromFloat(converted[j].Volume),
+		}
+	}
+	d.SetIntervalStream(i, klineData)
+	return nil
+}
+
 // AppendResults adds a candle item to the data stream and sorts it to ensure it is all in order
 func (d *DataFromKline) AppendResults(ki *gctkline.Item) {
 	if d.addedTimes == nil {
@@ -296,3 +296,102 @@ func TestStreamLow(t *testing.T) {
 		t.Error("expected low")
 	}
 }
+
+func TestLoadInterval(t *testing.T) {
+	t.Parallel()
+	d := DataFromKline{}
+	err := d.LoadInterval(gctkline.FourHour)
+	if !errors.Is(err, errNoCandleData) {
+		t.Errorf("received: %v, expected: %v", err, errNoCandleData)
+	}
+	start := time.Date(2020, 1, 1, 2, 0, 0, 0, time.UTC)
+	d.Item = gctkline.Item{
+		Exchange: testExchange,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		Interval: gctkline.OneHour,
+	}
+	for i := 0; i < 12; i++ {
+		d.Item.Candles = append(d.Item.Candles, gctkline.Candle{
+			Time:   start.Add(time.Hour * time.Duration(i)),
+			Open:   float64(i),
+			High:   float64(i + 10),
+			Low:    float64(i),
+			Close:  float64(i + 1),
+			Volume: 1,
+		})
+	}
+	err = d.LoadInterval(gctkline.OneHour)
+	if !errors.Is(err, errIntervalNotMultiple) {
+		t.Errorf("received: %v, expected: %v", err, errIntervalNotMultiple)
+	}
+	err = d.LoadInterval(gctkline.OneDay)
+	if !errors.Is(err, errNoCompleteCandles) {
+		t.Errorf("received: %v, expected: %v", err, errNoCompleteCandles)
+	}
+	err = d.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.LoadInterval(gctkline.FourHour)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+
+	// the partial intervals at 00:00 and 12:00 are excluded
+	d.Next()
+	for d.Latest().GetTime().Before(start.Add(time.Hour * 4)) {
+		d.Next()
+	}
+	history, err := d.GetHistoryFor(gctkline.FourHour)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(history) != 0 {
+		t.Errorf("received: %v, expected: %v, the 04:00 candle has not closed", len(history), 0)
+	}
+	d.Next()
+	history, err = d.GetHistoryFor(gctkline.FourHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("received: %v, expected: %v", len(history), 1)
+	}
+	k, ok := history[0].(*kline.Kline)
+	if !ok {
+		t.Fatal("expected kline event")
+	}
+	if !k.GetTime().Equal(start.Add(time.Hour*2)) || k.GetInterval() != gctkline.FourHour {
+		t.Errorf("received: %v %v, expected: %v %v", k.GetTime(), k.GetInterval(), start.Add(time.Hour*2), gctkline.FourHour)
+	}
+	if !k.Open.Equal(decimal.NewFromInt(2)) || !k.High.Equal(decimal.NewFromInt(15)) ||
+		!k.Low.Equal(decimal.NewFromInt(2)) || !k.Close.Equal(decimal.NewFromInt(6)) ||
+		!k.Volume.Equal(decimal.NewFromInt(4)) {
+		t.Errorf("received: %v %v %v %v %v, expected: 2 15 2 6 4", k.Open, k.High, k.Low, k.Close, k.Volume)
+	}
+	for i := 0; i < len(d.Item.Candles); i++ {
+		d.Next()
+	}
+	history, err = d.GetHistoryFor(gctkline.FourHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 {
+		t.Errorf("received: %v, expected: %v", len(history), 2)
+	}
+
+	// missing data excludes the interval rather than misaligning it
+	d.Item.Candles = append(d.Item.Candles[:7], d.Item.Candles[8:]...)
+	err = d.LoadInterval(gctkline.FourHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	history, err = d.GetHistoryFor(gctkline.FourHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 {
+		t.Errorf("received: %v, expected: %v", len(history), 1)
+	}
+}
@@ -8,7 +8,11 @@ import (
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 )
 
-var errNoCandleData = errors.New("no candle data provided")
+var (
+	errNoCandleData        = errors.New("no candle data provided")
+	errNoCompleteCandles   = errors.New("no complete candles for interval")
+	errIntervalNotMultiple = errors.New("interval must be a multiple of the data's interval")
+)
 
 // DataFromKline is a struct which implements the data.Streamer interface
 // It holds candle data for a specified range with helper functions
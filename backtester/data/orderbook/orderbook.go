@@ -0,0 +1,135 @@
+package orderbook
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// LoadCSV reads orderbook snapshots from a CSV file in the format of
+// unix timestamp,side,price,amount. Rows sharing a timestamp form one snapshot
+// and the snapshots are returned sorted by time
+func LoadCSV(filepath string) ([]Snapshot, error) {
+	csvFile, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = csvFile.Close()
+		if err != nil {
+			log.Errorln(log.BackTester, err)
+		}
+	}()
+	return parse(csvFile)
+}
+
+func parse(r io.Reader) ([]Snapshot, error) {
+	csvData := csv.NewReader(r)
+	csvData.FieldsPerRecord = -1
+	snapshots := make(map[int64]*Snapshot)
+	for line := 1; ; line++ {
+		row, err := csvData.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("could not read orderbook csv data, %v", err)
+		}
+		if len(row) != 4 {
+			return nil, fmt.Errorf("%w line %v, expected 4 fields, received %v", errInvalidRow, line, len(row))
+		}
+		ts, err := strconv.ParseInt(strings.TrimSpace(row[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w line %v, could not process timestamp %v %v", errInvalidRow, line, row[0], err)
+		}
+		price, err := decimal.NewFromString(strings.TrimSpace(row[2]))
+		if err != nil {
+			return nil, fmt.Errorf("%w line %v, could not process price %v %v", errInvalidRow, line, row[2], err)
+		}
+		amount, err := decimal.NewFromString(strings.TrimSpace(row[3]))
+		if err != nil {
+			return nil, fmt.Errorf("%w line %v, could not process amount %v %v", errInvalidRow, line, row[3], err)
+		}
+		s, ok := snapshots[ts]
+		if !ok {
+			s = &Snapshot{Time: time.Unix(ts, 0).UTC()}
+			snapshots[ts] = s
+		}
+		level := Level{Price: price, Amount: amount}
+		switch strings.ToLower(strings.TrimSpace(row[1])) {
+		case bidSide:
+			s.Bids = append(s.Bids, level)
+		case askSide:
+			s.Asks = append(s.Asks, level)
+		default:
+			return nil, fmt.Errorf("%w line %v, %v", errInvalidSide, line, row[1])
+		}
+	}
+	if len(snapshots) == 0 {
+		return nil, errNoSnapshots
+	}
+	resp := make([]Snapshot, 0, len(snapshots))
+	for _, s := range snapshots {
+		sort.Slice(s.Bids, func(i, j int) bool {
+			return s.Bids[i].Price.GreaterThan(s.Bids[j].Price)
+		})
+		sort.Slice(s.Asks, func(i, j int) bool {
+			return s.Asks[i].Price.LessThan(s.Asks[j].Price)
+		})
+		resp = append(resp, *s)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Time.Before(resp[j].Time)
+	})
+	return resp, nil
+}
+
+// GetSnapshotAtTime returns the latest snapshot recorded at or before the time
+func GetSnapshotAtTime(snapshots []Snapshot, t time.Time) *Snapshot {
+	i := sort.Search(len(snapshots), func(i int) bool {
+		return snapshots[i].Time.After(t)
+	})
+	if i == 0 {
+		return nil
+	}
+	return &snapshots[i-1]
+}
+
+// ToOrderbook converts the snapshot into an orderbook which can
+// be used to simulate an order
+func (s *Snapshot) ToOrderbook(exch string, cp currency.Pair, a asset.Item) (*gctorderbook.Base, error) {
+	if s == nil {
+		return nil, errNilSnapshot
+	}
+	if len(s.Bids) == 0 && len(s.Asks) == 0 {
+		return nil, fmt.Errorf("%w %v", errEmptySnapshot, s.Time)
+	}
+	ob := &gctorderbook.Base{
+		Exchange:    exch,
+		Pair:        cp,
+		Asset:       a,
+		LastUpdated: s.Time,
+		Bids:        make(gctorderbook.Items, len(s.Bids)),
+		Asks:        make(gctorderbook.Items, len(s.Asks)),
+	}
+	for i := range s.Bids {
+		ob.Bids[i].Price, _ = s.Bids[i].Price.Float64()
+		ob.Bids[i].Amount, _ = s.Bids[i].Amount.Float64()
+	}
+	for i := range s.Asks {
+		ob.Asks[i].Price, _ = s.Asks[i].Price.Float64()
+		ob.Asks[i].Amount, _ = s.Asks[i].Amount.Float64()
+	}
+	return ob, nil
+}
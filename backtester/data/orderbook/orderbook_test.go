@@ -0,0 +1,118 @@
+package orderbook
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestLoadCSV(t *testing.T) {
+	t.Parallel()
+	_, err := LoadCSV(filepath.Join("..", "..", "..", "testdata", "does-not-exist.csv"))
+	if err == nil {
+		t.Error("expected error")
+	}
+	snapshots, err := LoadCSV(filepath.Join("..", "..", "..", "testdata", "binance_BTCUSDT_orderbook_2020_11_16.csv"))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(snapshots) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(snapshots), 4)
+	}
+	if !snapshots[0].Time.Equal(time.Unix(1605499846, 0)) {
+		t.Errorf("received '%v' expected '%v'", snapshots[0].Time, time.Unix(1605499846, 0))
+	}
+	if len(snapshots[0].Bids) != 3 || len(snapshots[0].Asks) != 3 {
+		t.Errorf("received '%v' bids '%v' asks expected '%v'", len(snapshots[0].Bids), len(snapshots[0].Asks), 3)
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	_, err := parse(strings.NewReader(""))
+	if !errors.Is(err, errNoSnapshots) {
+		t.Errorf("received '%v' expected '%v'", err, errNoSnapshots)
+	}
+	_, err = parse(strings.NewReader("1605499846,bid,1337"))
+	if !errors.Is(err, errInvalidRow) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRow)
+	}
+	_, err = parse(strings.NewReader("bad,bid,1337,1"))
+	if !errors.Is(err, errInvalidRow) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRow)
+	}
+	_, err = parse(strings.NewReader("1605499846,bid,bad,1"))
+	if !errors.Is(err, errInvalidRow) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRow)
+	}
+	_, err = parse(strings.NewReader("1605499846,bid,1337,bad"))
+	if !errors.Is(err, errInvalidRow) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRow)
+	}
+	_, err = parse(strings.NewReader("1605499846,buy,1337,1"))
+	if !errors.Is(err, errInvalidSide) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSide)
+	}
+	snapshots, err := parse(strings.NewReader("1605499906,ask,1338,1\n1605499846,bid,1336,1\n1605499846,BID,1337,1\n1605499846,ask,1339,1\n1605499846,ask,1338,1"))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(snapshots) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(snapshots), 2)
+	}
+	if !snapshots[0].Bids[0].Price.Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", snapshots[0].Bids[0].Price, 1337)
+	}
+	if !snapshots[0].Asks[0].Price.Equal(decimal.NewFromInt(1338)) {
+		t.Errorf("received '%v' expected '%v'", snapshots[0].Asks[0].Price, 1338)
+	}
+}
+
+func TestGetSnapshotAtTime(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := []Snapshot{{Time: tt}, {Time: tt.Add(time.Minute)}}
+	if GetSnapshotAtTime(snapshots, tt.Add(-time.Second)) != nil {
+		t.Error("expected no snapshot before the first snapshot")
+	}
+	s := GetSnapshotAtTime(snapshots, tt)
+	if s == nil || !s.Time.Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", s, tt)
+	}
+	s = GetSnapshotAtTime(snapshots, tt.Add(time.Hour))
+	if s == nil || !s.Time.Equal(tt.Add(time.Minute)) {
+		t.Errorf("received '%v' expected '%v'", s, tt.Add(time.Minute))
+	}
+}
+
+func TestToOrderbook(t *testing.T) {
+	t.Parallel()
+	var s *Snapshot
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := s.ToOrderbook("binance", cp, asset.Spot)
+	if !errors.Is(err, errNilSnapshot) {
+		t.Errorf("received '%v' expected '%v'", err, errNilSnapshot)
+	}
+	s = &Snapshot{}
+	_, err = s.ToOrderbook("binance", cp, asset.Spot)
+	if !errors.Is(err, errEmptySnapshot) {
+		t.Errorf("received '%v' expected '%v'", err, errEmptySnapshot)
+	}
+	s.Bids = []Level{{Price: decimal.NewFromInt(1337), Amount: decimal.NewFromInt(2)}}
+	ob, err := s.ToOrderbook("binance", cp, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(ob.Bids) != 1 || ob.Bids[0].Price != 1337 || ob.Bids[0].Amount != 2 {
+		t.Errorf("received '%v' expected a bid of 2 at 1337", ob.Bids)
+	}
+	if !ob.Pair.Equal(cp) {
+		t.Errorf("received '%v' expected '%v'", ob.Pair, cp)
+	}
+}
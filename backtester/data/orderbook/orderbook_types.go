@@ -0,0 +1,34 @@
+package orderbook
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	errNoSnapshots   = errors.New("no orderbook snapshots found")
+	errInvalidRow    = errors.New("invalid orderbook row")
+	errInvalidSide   = errors.New("invalid orderbook side")
+	errNilSnapshot   = errors.New("nil orderbook snapshot received")
+	errEmptySnapshot = errors.New("orderbook snapshot has no bids or asks")
+)
+
+const (
+	bidSide = "bid"
+	askSide = "ask"
+)
+
+// Snapshot is a recorded orderbook at a point in time
+type Snapshot struct {
+	Time time.Time
+	Bids []Level
+	Asks []Level
+}
+
+// Level is a price and the amount available at that price
+type Level struct {
+	Price  decimal.Decimal
+	Amount decimal.Decimal
+}
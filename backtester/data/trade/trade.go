@@ -0,0 +1,119 @@
+package trade
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/trade"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gcttrade "github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// HasDataAtTime verifies whether there is a trade
+// at the time provided
+func (d *DataFromTrade) HasDataAtTime(t time.Time) bool {
+	return d.addedTimes[t]
+}
+
+// Load sets the trade data to the stream for processing.
+// Trades which occur at the same time retain their original order
+func (d *DataFromTrade) Load() error {
+	d.addedTimes = make(map[time.Time]bool)
+	if len(d.Trades) == 0 {
+		return errNoTradeData
+	}
+	sort.SliceStable(d.Trades, func(i, j int) bool {
+		return d.Trades[i].Timestamp.Before(d.Trades[j].Timestamp)
+	})
+	tradeData := make([]common.DataEventHandler, len(d.Trades))
+	for i := range d.Trades {
+		tradeData[i] = &trade.Trade{
+			Base: event.Base{
+				Offset:       int64(i + 1),
+				Exchange:     d.Exchange,
+				Time:         d.Trades[i].Timestamp,
+				Interval:     d.Interval,
+				CurrencyPair: d.Pair,
+				AssetType:    d.Asset,
+			},
+			TID:    d.Trades[i].TID,
+			Side:   d.Trades[i].Side,
+			Price:  decimal.NewFromFloat(d.Trades[i].Price),
+			Amount: decimal.NewFromFloat(d.Trades[i].Amount),
+		}
+		d.addedTimes[d.Trades[i].Timestamp] = true
+	}
+	d.SetStream(tradeData)
+	return nil
+}
+
+// ConvertToCandles converts the trades into candles of the data's
+// interval so that they can be charted in the report
+func (d *DataFromTrade) ConvertToCandles() (*gctkline.Item, error) {
+	if len(d.Trades) == 0 {
+		return nil, errNoTradeData
+	}
+	item, err := gcttrade.ConvertTradesToCandles(d.Interval, d.Trades...)
+	if err != nil {
+		return nil, err
+	}
+	item.Exchange = d.Exchange
+	item.Pair = d.Pair
+	item.Asset = d.Asset
+	return &item, nil
+}
+
+// StreamOpen returns the price of all trades from the beginning until the current iteration
+func (d *DataFromTrade) StreamOpen() []decimal.Decimal {
+	return d.streamPrices()
+}
+
+// StreamHigh returns the price of all trades from the beginning until the current iteration
+func (d *DataFromTrade) StreamHigh() []decimal.Decimal {
+	return d.streamPrices()
+}
+
+// StreamLow returns the price of all trades from the beginning until the current iteration
+func (d *DataFromTrade) StreamLow() []decimal.Decimal {
+	return d.streamPrices()
+}
+
+// StreamClose returns the price of all trades from the beginning until the current iteration
+func (d *DataFromTrade) StreamClose() []decimal.Decimal {
+	return d.streamPrices()
+}
+
+// StreamVol returns the amount of all trades from the beginning until the current iteration
+func (d *DataFromTrade) StreamVol() []decimal.Decimal {
+	s := d.GetStream()
+	o := d.Offset()
+
+	ret := make([]decimal.Decimal, o)
+	for x := range s[:o] {
+		if val, ok := s[x].(*trade.Trade); ok {
+			ret[x] = val.Amount
+		} else {
+			log.Errorf(log.BackTester, "incorrect data loaded into stream")
+		}
+	}
+	return ret
+}
+
+func (d *DataFromTrade) streamPrices() []decimal.Decimal {
+	s := d.GetStream()
+	o := d.Offset()
+
+	ret := make([]decimal.Decimal, o)
+	for x := range s[:o] {
+		if val, ok := s[x].(*trade.Trade); ok {
+			ret[x] = val.Price
+		} else {
+			log.Errorf(log.BackTester, "incorrect data loaded into stream")
+		}
+	}
+	return ret
+}
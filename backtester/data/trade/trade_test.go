@@ -0,0 +1,126 @@
+package trade
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gcttrade "github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+const testExchange = "binance"
+
+var tt = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestData() *DataFromTrade {
+	return &DataFromTrade{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Interval: gctkline.OneMin,
+		Trades: []gcttrade.Data{
+			{TID: "3", Timestamp: tt.Add(time.Second), Price: 1338, Amount: 3, Side: gctorder.Sell},
+			{TID: "1", Timestamp: tt, Price: 1337, Amount: 1, Side: gctorder.Buy},
+			{TID: "2", Timestamp: tt, Price: 1336, Amount: 2, Side: gctorder.Buy},
+		},
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	d := DataFromTrade{}
+	err := d.Load()
+	if !errors.Is(err, errNoTradeData) {
+		t.Errorf("received: %v, expected: %v", err, errNoTradeData)
+	}
+	d = *newTestData()
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(d.List()) != 3 {
+		t.Fatalf("received: %v, expected: %v", len(d.List()), 3)
+	}
+	// trades at the same time keep their original order
+	for i, tid := range []string{"1", "2", "3"} {
+		ev := d.Next()
+		if ev.GetOffset() != int64(i+1) {
+			t.Errorf("received: %v, expected: %v", ev.GetOffset(), i+1)
+		}
+		if d.Trades[i].TID != tid {
+			t.Errorf("received: %v, expected: %v", d.Trades[i].TID, tid)
+		}
+	}
+	if !d.Latest().ClosePrice().Equal(decimal.NewFromInt(1338)) {
+		t.Errorf("received: %v, expected: %v", d.Latest().ClosePrice(), 1338)
+	}
+}
+
+func TestHasDataAtTime(t *testing.T) {
+	t.Parallel()
+	d := newTestData()
+	if d.HasDataAtTime(tt) {
+		t.Error("expected no data before loading")
+	}
+	err := d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !d.HasDataAtTime(tt) {
+		t.Error("expected data")
+	}
+	if d.HasDataAtTime(tt.Add(time.Minute)) {
+		t.Error("expected no data")
+	}
+}
+
+func TestConvertToCandles(t *testing.T) {
+	t.Parallel()
+	d := DataFromTrade{}
+	_, err := d.ConvertToCandles()
+	if !errors.Is(err, errNoTradeData) {
+		t.Errorf("received: %v, expected: %v", err, errNoTradeData)
+	}
+	d = *newTestData()
+	item, err := d.ConvertToCandles()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(item.Candles) != 1 {
+		t.Fatalf("received: %v, expected: %v", len(item.Candles), 1)
+	}
+	if item.Candles[0].High != 1338 || item.Candles[0].Low != 1336 || item.Candles[0].Volume != 6 {
+		t.Errorf("received: %+v, expected high 1338 low 1336 volume 6", item.Candles[0])
+	}
+	if item.Exchange != testExchange || item.Asset != asset.Spot || !item.Pair.Equal(d.Pair) {
+		t.Errorf("received: %v %v %v, expected: %v %v %v", item.Exchange, item.Asset, item.Pair, testExchange, asset.Spot, d.Pair)
+	}
+}
+
+func TestStreams(t *testing.T) {
+	t.Parallel()
+	d := newTestData()
+	err := d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	d.Next()
+	d.Next()
+	for _, stream := range [][]decimal.Decimal{d.StreamOpen(), d.StreamHigh(), d.StreamLow(), d.StreamClose()} {
+		if len(stream) != 2 {
+			t.Fatalf("received: %v, expected: %v", len(stream), 2)
+		}
+		if !stream[1].Equal(decimal.NewFromInt(1336)) {
+			t.Errorf("received: %v, expected: %v", stream[1], 1336)
+		}
+	}
+	vol := d.StreamVol()
+	if len(vol) != 2 || !vol[0].Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v, expected: [1 2]", vol)
+	}
+}
@@ -0,0 +1,27 @@
+package trade
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gcttrade "github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+var errNoTradeData = errors.New("no trade data provided")
+
+// DataFromTrade is a struct which implements the data.Streamer interface
+// It holds trade data for a specified range which is replayed one trade at a time
+type DataFromTrade struct {
+	data.Base
+	addedTimes map[time.Time]bool
+	Exchange   string
+	Asset      asset.Item
+	Pair       currency.Pair
+	// Interval is used to convert the trades into candles for reporting
+	Interval gctkline.Interval
+	Trades   []gcttrade.Data
+}
@@ -3,12 +3,14 @@ package exchange
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
@@ -18,7 +20,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 // Reset returns the exchange to initial settings
@@ -42,6 +44,7 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 		Direction:  o.GetDirection(),
 		Amount:     o.GetAmount(),
 		ClosePrice: data.Latest().ClosePrice(),
+		ExitRule:   o.GetExitRule(),
 	}
 	eventFunds := o.GetAllocatedFunds()
 	cs, err := e.GetCurrencySettings(o.GetExchange(), o.GetAssetType(), o.Pair())
@@ -50,9 +53,21 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 	}
 	f.ExchangeFee = cs.ExchangeFee // defaulting to just using taker fee right now without orderbook
 	f.Direction = o.GetDirection()
+	switch o.GetDirection() {
+	case common.CancelOrder:
+		return f, e.cancelOrder(o, f, funds)
+	case common.AmendOrder:
+		return f, e.amendOrder(o, f, funds)
+	}
 	if o.GetDirection() != gctorder.Buy && o.GetDirection() != gctorder.Sell {
 		return f, nil
 	}
+	if cs.UseRealOrders && funds.IsFutures() {
+		return f, e.rejectOrder(f, eventFunds, funds, errFuturesRealOrders)
+	}
+	if IsRestingOrderType(o.GetOrderType()) {
+		return f, e.addRestingOrder(o, f, &cs, funds)
+	}
 	highStr := data.StreamHigh()
 	high := highStr[len(highStr)-1]
 
@@ -63,10 +78,16 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 	volume := volStr[len(volStr)-1]
 	var adjustedPrice, amount decimal.Decimal
 
+	price := f.ClosePrice
+	if f.ExitRule != "" {
+		// exits are filled at the price which triggered them rather than the close
+		price = o.GetPrice()
+	}
+
 	if cs.UseRealOrders {
 		// get current orderbook
-		var ob *orderbook.Base
-		ob, err = orderbook.Get(f.Exchange, f.CurrencyPair, f.AssetType)
+		var ob *gctorderbook.Base
+		ob, err = gctorderbook.Get(f.Exchange, f.CurrencyPair, f.AssetType)
 		if err != nil {
 			return f, err
 		}
@@ -74,7 +95,11 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 		adjustedPrice, amount = slippage.CalculateSlippageByOrderbook(ob, o.GetDirection(), eventFunds, f.ExchangeFee)
 		f.Slippage = adjustedPrice.Sub(f.ClosePrice).Div(f.ClosePrice).Mul(decimal.NewFromInt(100))
 	} else {
-		adjustedPrice, amount, err = e.sizeOfflineOrder(high, low, volume, &cs, f)
+		if len(cs.Orderbooks) > 0 {
+			adjustedPrice, amount, err = sizeOrderbookOrder(eventFunds, &cs, f)
+		} else {
+			adjustedPrice, amount, err = e.sizeOfflineOrder(price, high, low, volume, &cs, f)
+		}
 		if err != nil {
 			switch f.GetDirection() {
 			case gctorder.Buy:
@@ -89,7 +114,13 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 		}
 	}
 
-	portfolioLimitedAmount := reduceAmountToFitPortfolioLimit(adjustedPrice, amount, eventFunds, f.GetDirection())
+	var portfolioLimitedAmount decimal.Decimal
+	if funds.IsFutures() {
+		pos := funds.GetPosition()
+		portfolioLimitedAmount = reduceAmountToFitMargin(adjustedPrice, amount, eventFunds, &pos, f.GetDirection())
+	} else {
+		portfolioLimitedAmount = reduceAmountToFitPortfolioLimit(adjustedPrice, amount, eventFunds, f.GetDirection())
+	}
 	if !portfolioLimitedAmount.Equal(amount) {
 		f.AppendReason(fmt.Sprintf("Order size shrunk from %v to %v to remain within portfolio limits", amount, portfolioLimitedAmount))
 	}
@@ -105,58 +136,81 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 				limitReducedAmount))
 		}
 	}
-	err = verifyOrderWithinLimits(f, limitReducedAmount, &cs)
-	if err != nil {
-		return f, err
+	if f.ExitRule == "" {
+		// exits close the entire position, so are not bound by the sizing rules
+		err = verifyOrderWithinLimits(f, limitReducedAmount, &cs)
+		if err != nil {
+			return f, err
+		}
 	}
 	f.ExchangeFee = calculateExchangeFee(adjustedPrice, limitReducedAmount, cs.ExchangeFee)
 
-	orderID, err := e.placeOrder(context.TODO(), adjustedPrice, limitReducedAmount, cs.UseRealOrders, cs.CanUseExchangeLimits, f, bot)
+	orderID, err := e.placeOrder(context.TODO(), adjustedPrice, limitReducedAmount, cs.UseRealOrders, cs.CanUseExchangeLimits, gctorder.Market, f, bot)
 	if err != nil {
-		fundErr := funds.Release(eventFunds, eventFunds, f.GetDirection())
-		if fundErr != nil {
-			f.AppendReason(fundErr.Error())
-		}
-		if f.GetDirection() == gctorder.Buy {
-			f.SetDirection(common.CouldNotBuy)
-		} else if f.GetDirection() == gctorder.Sell {
-			f.SetDirection(common.CouldNotSell)
-		}
-		return f, err
+		return f, e.rejectOrder(f, eventFunds, funds, err)
 	}
-	switch f.GetDirection() {
-	case gctorder.Buy:
-		err = funds.Release(eventFunds, eventFunds.Sub(limitReducedAmount.Mul(adjustedPrice)), f.GetDirection())
+	if funds.IsFutures() {
+		var realised decimal.Decimal
+		realised, err = settleFuturesFill(funds, eventFunds, limitReducedAmount, adjustedPrice, f.ExchangeFee, f.GetDirection())
 		if err != nil {
 			return f, err
 		}
-		funds.IncreaseAvailable(limitReducedAmount, f.GetDirection())
-	case gctorder.Sell:
-		err = funds.Release(eventFunds, eventFunds.Sub(limitReducedAmount), f.GetDirection())
-		if err != nil {
-			return f, err
+		if !realised.IsZero() {
+			f.AppendReason(fmt.Sprintf("Realised PNL %v", realised))
+		}
+	} else {
+		switch f.GetDirection() {
+		case gctorder.Buy:
+			err = funds.Release(eventFunds, eventFunds.Sub(limitReducedAmount.Mul(adjustedPrice)), f.GetDirection())
+			if err != nil {
+				return f, err
+			}
+			funds.IncreaseAvailable(limitReducedAmount, f.GetDirection())
+		case gctorder.Sell:
+			err = funds.Release(eventFunds, eventFunds.Sub(limitReducedAmount), f.GetDirection())
+			if err != nil {
+				return f, err
+			}
+			funds.IncreaseAvailable(limitReducedAmount.Mul(adjustedPrice), f.GetDirection())
 		}
-		funds.IncreaseAvailable(limitReducedAmount.Mul(adjustedPrice), f.GetDirection())
 	}
 
-	ords, _ := bot.OrderManager.GetOrdersSnapshot("")
-	for i := range ords {
-		if ords[i].ID != orderID {
-			continue
-		}
-		ords[i].Date = o.GetTime()
-		ords[i].LastUpdated = o.GetTime()
-		ords[i].CloseTime = o.GetTime()
-		f.Order = &ords[i]
-		f.PurchasePrice = decimal.NewFromFloat(ords[i].Price)
-		f.Total = f.PurchasePrice.Mul(limitReducedAmount).Add(f.ExchangeFee)
+	err = setFillOrder(f, orderID, limitReducedAmount, o.GetTime(), bot)
+	if err != nil {
+		return nil, err
 	}
+	return f, nil
+}
 
-	if f.Order == nil {
-		return nil, fmt.Errorf("placed order %v not found in order manager", orderID)
+// rejectOrder releases the funds reserved for an order which could not be placed
+func (e *Exchange) rejectOrder(f *fill.Fill, reserved decimal.Decimal, funds funding.IPairReleaser, err error) error {
+	if reserved.GreaterThan(decimal.Zero) {
+		fundErr := funds.Release(reserved, reserved, f.GetDirection())
+		if fundErr != nil {
+			f.AppendReason(fundErr.Error())
+		}
+	}
+	if f.GetDirection() == gctorder.Buy {
+		f.SetDirection(common.CouldNotBuy)
+	} else if f.GetDirection() == gctorder.Sell {
+		f.SetDirection(common.CouldNotSell)
 	}
+	return err
+}
 
-	return f, nil
+// setFillOrder attaches the order placed with the order manager to the fill event
+func setFillOrder(f *fill.Fill, orderID string, amount decimal.Decimal, t time.Time, bot *engine.Engine) error {
+	ord, err := bot.OrderManager.GetByExchangeAndID(f.Exchange, orderID)
+	if err != nil {
+		return fmt.Errorf("placed order %v not found in order manager: %w", orderID, err)
+	}
+	ord.Date = t
+	ord.LastUpdated = t
+	ord.CloseTime = t
+	f.Order = ord
+	f.PurchasePrice = decimal.NewFromFloat(ord.Price)
+	f.Total = f.PurchasePrice.Mul(amount).Add(f.ExchangeFee)
+	return nil
 }
 
 // verifyOrderWithinLimits conforms the amount to fall into the minimum size and maximum size limit after reduced
@@ -221,7 +275,7 @@ func reduceAmountToFitPortfolioLimit(adjustedPrice, amount, sizedPortfolioTotal
 	return amount
 }
 
-func (e *Exchange) placeOrder(ctx context.Context, price, amount decimal.Decimal, useRealOrders, useExchangeLimits bool, f *fill.Fill, bot *engine.Engine) (string, error) {
+func (e *Exchange) placeOrder(ctx context.Context, price, amount decimal.Decimal, useRealOrders, useExchangeLimits bool, orderType gctorder.Type, f *fill.Fill, bot *engine.Engine) (string, error) {
 	if f == nil {
 		return "", common.ErrNilEvent
 	}
@@ -244,7 +298,7 @@ func (e *Exchange) placeOrder(ctx context.Context, price, amount decimal.Decimal
 		Date:        f.GetTime(),
 		LastUpdated: f.GetTime(),
 		Pair:        f.Pair(),
-		Type:        gctorder.Market,
+		Type:        orderType,
 	}
 
 	if useRealOrders {
@@ -276,17 +330,17 @@ func (e *Exchange) placeOrder(ctx context.Context, price, amount decimal.Decimal
 	return orderID, nil
 }
 
-func (e *Exchange) sizeOfflineOrder(high, low, volume decimal.Decimal, cs *Settings, f *fill.Fill) (adjustedPrice, adjustedAmount decimal.Decimal, err error) {
+func (e *Exchange) sizeOfflineOrder(price, high, low, volume decimal.Decimal, cs *Settings, f *fill.Fill) (adjustedPrice, adjustedAmount decimal.Decimal, err error) {
 	if cs == nil || f == nil {
 		return decimal.Zero, decimal.Zero, common.ErrNilArguments
 	}
 	// provide history and estimate volatility
 	slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
 	if cs.SkipCandleVolumeFitting {
-		f.VolumeAdjustedPrice = f.ClosePrice
+		f.VolumeAdjustedPrice = price
 		adjustedAmount = f.Amount
 	} else {
-		f.VolumeAdjustedPrice, adjustedAmount = ensureOrderFitsWithinHLV(f.ClosePrice, f.Amount, high, low, volume)
+		f.VolumeAdjustedPrice, adjustedAmount = ensureOrderFitsWithinHLV(price, f.Amount, high, low, volume)
 		if !adjustedAmount.Equal(f.Amount) {
 			f.AppendReason(fmt.Sprintf("Order size shrunk from %v to %v to fit candle", f.Amount, adjustedAmount))
 		}
@@ -302,6 +356,59 @@ func (e *Exchange) sizeOfflineOrder(high, low, volume decimal.Decimal, cs *Setti
 	return adjustedPrice, adjustedAmount, nil
 }
 
+// sizeOrderbookOrder fills an order against the latest recorded orderbook snapshot
+// at or before the order's time, walking the levels to determine the price and amount
+func sizeOrderbookOrder(funds decimal.Decimal, cs *Settings, f *fill.Fill) (adjustedPrice, adjustedAmount decimal.Decimal, err error) {
+	if cs == nil || f == nil {
+		return decimal.Zero, decimal.Zero, common.ErrNilArguments
+	}
+	snapshot := orderbook.GetSnapshotAtTime(cs.Orderbooks, f.GetTime())
+	if snapshot == nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%w %v", errNoOrderbookSnapshot, f.GetTime())
+	}
+	ob, err := snapshot.ToOrderbook(f.Exchange, f.CurrencyPair, f.AssetType)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	// buy orders consume the quote currency, sell orders consume the base currency
+	orderFunds := f.Amount
+	if f.GetDirection() == gctorder.Buy {
+		orderFunds = f.Amount.Mul(f.ClosePrice)
+	}
+	if orderFunds.GreaterThan(funds) {
+		orderFunds = funds
+	}
+	adjustedPrice, adjustedAmount = slippage.CalculateSlippageByOrderbook(ob, f.GetDirection(), orderFunds, decimal.Zero)
+	if adjustedAmount.LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%w at %v", errNoOrderbookLiquidity, snapshot.Time)
+	}
+	// the orderbook is walked using floats, so ensure that rounding
+	// cannot take the order beyond the funds allocated to it
+	switch f.GetDirection() {
+	case gctorder.Buy:
+		if adjustedAmount.Mul(adjustedPrice).GreaterThan(funds) {
+			adjustedAmount = funds.Div(adjustedPrice).Truncate(8)
+		}
+	case gctorder.Sell:
+		if adjustedAmount.GreaterThan(funds) {
+			adjustedAmount = funds
+		}
+	}
+	if adjustedAmount.GreaterThan(f.Amount) {
+		adjustedAmount = f.Amount
+	} else if adjustedAmount.LessThan(f.Amount) {
+		f.AppendReason(fmt.Sprintf("Order size shrunk from %v to %v to fit orderbook", f.Amount, adjustedAmount))
+	}
+	// the orderbook determines the amount, so the difference between the
+	// close price and the price walked through the orderbook is slippage
+	f.VolumeAdjustedPrice = f.ClosePrice
+	if !f.ClosePrice.IsZero() {
+		f.Slippage = adjustedPrice.Sub(f.ClosePrice).Div(f.ClosePrice).Mul(decimal.NewFromInt(100))
+	}
+	f.ExchangeFee = calculateExchangeFee(adjustedPrice, adjustedAmount, cs.TakerFee)
+	return adjustedPrice, adjustedAmount, nil
+}
+
 func applySlippageToPrice(direction gctorder.Side, price, slippageRate decimal.Decimal) decimal.Decimal {
 	adjustedPrice := price
 	if direction == gctorder.Buy {
@@ -11,9 +11,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -30,6 +32,25 @@ func (f *fakeFund) IncreaseAvailable(decimal.Decimal, gctorder.Side) {}
 func (f *fakeFund) Release(decimal.Decimal, decimal.Decimal, gctorder.Side) error {
 	return nil
 }
+func (f *fakeFund) Reserve(decimal.Decimal, gctorder.Side) error {
+	return nil
+}
+func (f *fakeFund) IsFutures() bool {
+	return false
+}
+func (f *fakeFund) GetPosition() funding.Position {
+	return funding.Position{}
+}
+func (f *fakeFund) UpdatePosition(decimal.Decimal, decimal.Decimal, decimal.Decimal, gctorder.Side) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
+func (f *fakeFund) UpdateMarkPrice(decimal.Decimal) {}
+func (f *fakeFund) ApplyFundingRate(decimal.Decimal, time.Time) decimal.Decimal {
+	return decimal.Zero
+}
+func (f *fakeFund) Liquidate(decimal.Decimal) (amount, fee decimal.Decimal, err error) {
+	return decimal.Zero, decimal.Zero, nil
+}
 
 func TestReset(t *testing.T) {
 	t.Parallel()
@@ -111,7 +132,7 @@ func TestCalculateExchangeFee(t *testing.T) {
 func TestSizeOrder(t *testing.T) {
 	t.Parallel()
 	e := Exchange{}
-	_, _, err := e.sizeOfflineOrder(decimal.Zero, decimal.Zero, decimal.Zero, nil, nil)
+	_, _, err := e.sizeOfflineOrder(decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero, nil, nil)
 	if !errors.Is(err, common.ErrNilArguments) {
 		t.Error(err)
 	}
@@ -120,12 +141,12 @@ func TestSizeOrder(t *testing.T) {
 		ClosePrice: decimal.NewFromInt(1337),
 		Amount:     decimal.NewFromInt(1),
 	}
-	_, _, err = e.sizeOfflineOrder(decimal.Zero, decimal.Zero, decimal.Zero, cs, f)
+	_, _, err = e.sizeOfflineOrder(f.ClosePrice, decimal.Zero, decimal.Zero, decimal.Zero, cs, f)
 	if !errors.Is(err, errDataMayBeIncorrect) {
 		t.Errorf("received: %v, expected: %v", err, errDataMayBeIncorrect)
 	}
 	var p, a decimal.Decimal
-	p, a, err = e.sizeOfflineOrder(decimal.NewFromInt(10), decimal.NewFromInt(2), decimal.NewFromInt(10), cs, f)
+	p, a, err = e.sizeOfflineOrder(f.ClosePrice, decimal.NewFromInt(10), decimal.NewFromInt(2), decimal.NewFromInt(10), cs, f)
 	if err != nil {
 		t.Error(err)
 	}
@@ -137,6 +158,81 @@ func TestSizeOrder(t *testing.T) {
 	}
 }
 
+func TestSizeOrderbookOrder(t *testing.T) {
+	t.Parallel()
+	_, _, err := sizeOrderbookOrder(decimal.Zero, nil, nil)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilArguments)
+	}
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cs := &Settings{
+		TakerFee: decimal.NewFromFloat(0.001),
+		Orderbooks: []orderbook.Snapshot{
+			{
+				Time: tt,
+				Bids: []orderbook.Level{
+					{Price: decimal.NewFromInt(99), Amount: decimal.NewFromInt(1)},
+					{Price: decimal.NewFromInt(98), Amount: decimal.NewFromInt(1)},
+				},
+				Asks: []orderbook.Level{
+					{Price: decimal.NewFromInt(101), Amount: decimal.NewFromInt(1)},
+					{Price: decimal.NewFromInt(103), Amount: decimal.NewFromInt(1)},
+				},
+			},
+			{
+				Time: tt.Add(time.Minute),
+			},
+		},
+	}
+	f := &fill.Fill{
+		Base: event.Base{
+			Time: tt.Add(-time.Second),
+		},
+		Direction:  gctorder.Buy,
+		ClosePrice: decimal.NewFromInt(100),
+		Amount:     decimal.NewFromInt(2),
+	}
+	_, _, err = sizeOrderbookOrder(decimal.NewFromInt(1337), cs, f)
+	if !errors.Is(err, errNoOrderbookSnapshot) {
+		t.Errorf("received: %v, expected: %v", err, errNoOrderbookSnapshot)
+	}
+
+	f.Time = tt
+	p, a, err := sizeOrderbookOrder(decimal.NewFromInt(1337), cs, f)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	// 200 quote consumes 1 at 101 and 99 at 103
+	if !a.Round(8).Equal(decimal.NewFromFloat(1.96116505)) {
+		t.Errorf("received: %v, expected: %v", a, 1.96116505)
+	}
+	if !p.Round(8).Equal(decimal.NewFromFloat(101.98019802)) {
+		t.Errorf("received: %v, expected: %v", p, 101.98019802)
+	}
+	if !f.Slippage.Round(8).Equal(decimal.NewFromFloat(1.98019802)) {
+		t.Errorf("received: %v, expected: %v", f.Slippage, 1.98019802)
+	}
+	if !f.ExchangeFee.Equal(p.Mul(a).Mul(cs.TakerFee)) {
+		t.Errorf("received: %v, expected: %v", f.ExchangeFee, p.Mul(a).Mul(cs.TakerFee))
+	}
+
+	f.Direction = gctorder.Sell
+	f.Amount = decimal.NewFromInt(1)
+	p, a, err = sizeOrderbookOrder(decimal.NewFromInt(1337), cs, f)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !p.Equal(decimal.NewFromInt(99)) || !a.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v %v, expected: %v %v", p, a, 99, 1)
+	}
+
+	f.Time = tt.Add(time.Hour)
+	_, _, err = sizeOrderbookOrder(decimal.NewFromInt(1337), cs, f)
+	if err == nil {
+		t.Error("expected error filling against an empty snapshot")
+	}
+}
+
 func TestPlaceOrder(t *testing.T) {
 	t.Parallel()
 	bot := &engine.Engine{}
@@ -166,30 +262,30 @@ func TestPlaceOrder(t *testing.T) {
 		t.Error(err)
 	}
 	e := Exchange{}
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, nil, nil)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, gctorder.Market, nil, nil)
 	if !errors.Is(err, common.ErrNilEvent) {
 		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
 	}
 	f := &fill.Fill{}
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, f, bot)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, gctorder.Market, f, bot)
 	if err != nil && err.Error() != "order exchange name must be specified" {
 		t.Error(err)
 	}
 
 	f.Exchange = testExchange
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, f, bot)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, gctorder.Market, f, bot)
 	if !errors.Is(err, gctorder.ErrPairIsEmpty) {
 		t.Errorf("received: %v, expected: %v", err, gctorder.ErrPairIsEmpty)
 	}
 	f.CurrencyPair = currency.NewPair(currency.BTC, currency.USDT)
 	f.AssetType = asset.Spot
 	f.Direction = gctorder.Buy
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, f, bot)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, gctorder.Market, f, bot)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), true, true, f, bot)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), true, true, gctorder.Market, f, bot)
 	if err != nil && !strings.Contains(err.Error(), "unset/default API keys") {
 		t.Error(err)
 	}
@@ -2,16 +2,21 @@ package exchange
 
 import (
 	"errors"
+	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -20,6 +25,14 @@ var (
 	errExceededPortfolioLimit = errors.New("exceeded portfolio limit")
 	errNilCurrencySettings    = errors.New("received nil currency settings")
 	errInvalidDirection       = errors.New("received invalid order direction")
+	errRestingOrderNotFound   = errors.New("resting order not found")
+	errRestingOrderExists     = errors.New("resting order already exists")
+	errRealOrdersUnsupported  = errors.New("resting orders are unsupported when using real orders")
+	errInvalidOrderType       = errors.New("invalid order type")
+	errInvalidOrderPrice      = errors.New("invalid order price")
+	errFuturesRealOrders      = errors.New("futures are unsupported when using real orders")
+	errNoOrderbookSnapshot    = errors.New("no orderbook snapshot recorded at or before order time")
+	errNoOrderbookLiquidity   = errors.New("orderbook snapshot has no liquidity to fill order")
 )
 
 // ExecutionHandler interface dictates what functions are required to submit an order
@@ -27,12 +40,47 @@ type ExecutionHandler interface {
 	SetExchangeAssetCurrencySettings(string, asset.Item, currency.Pair, *Settings)
 	GetCurrencySettings(string, asset.Item, currency.Pair) (Settings, error)
 	ExecuteOrder(order.Event, data.Handler, *engine.Engine, funding.IPairReleaser) (*fill.Fill, error)
+	ProcessOpenOrders(data.Handler, *engine.Engine, funding.IPairReleaser) ([]*fill.Fill, error)
+	GetOpenOrders(string, asset.Item, currency.Pair) []RestingOrder
+	UpdateFuturesPosition(common.DataEventHandler, *engine.Engine, funding.IPairReleaser) (*fill.Fill, error)
 	Reset()
 }
 
 // Exchange contains all the currency settings
 type Exchange struct {
 	CurrencySettings []Settings
+	OrderBook        OrderBook
+}
+
+// OrderBook holds every order resting on the simulated exchange.
+// Orders remain in the book across data events until they are filled,
+// cancelled or expire
+type OrderBook struct {
+	Orders []*RestingOrder
+}
+
+// RestingOrder is a limit, stop, stop-limit or take-profit order which waits
+// for a candle's price range to cross its limit or trigger price before it is filled
+type RestingOrder struct {
+	ID           string
+	Exchange     string
+	Asset        asset.Item
+	Pair         currency.Pair
+	Interval     gctkline.Interval
+	Direction    gctorder.Side
+	OrderType    gctorder.Type
+	Amount       decimal.Decimal
+	LimitPrice   decimal.Decimal
+	TriggerPrice decimal.Decimal
+	// Triggered is set when a stop-limit order's trigger price
+	// has been crossed and it now rests as a limit order
+	Triggered bool
+	// Reserved is the funding held for the order. It is the quote currency
+	// for buy orders and the base currency for sell orders.
+	// Futures orders reserve quote currency margin for either side
+	Reserved decimal.Decimal
+	PlacedAt time.Time
+	Expiry   time.Time
 }
 
 // Settings allow the eventhandler to size an order within the limitations set by the config file
@@ -58,4 +106,13 @@ type Settings struct {
 	Limits                  *gctorder.Limits
 	CanUseExchangeLimits    bool
 	SkipCandleVolumeFitting bool
+
+	// FundingRates are applied to open perpetual futures positions
+	FundingRates []fundingrate.Rate
+	// Orderbooks are recorded orderbook snapshots which market orders
+	// are filled against instead of estimating slippage
+	Orderbooks []orderbook.Snapshot
+	// ExitRules are the stop-loss, take-profit and trailing-stop
+	// rules the portfolio manager attaches to the currency's positions
+	ExitRules *config.ExitRules
 }
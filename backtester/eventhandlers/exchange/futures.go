@@ -0,0 +1,156 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// UpdateFuturesPosition marks a futures position to the data event's candle.
+// A position whose liquidation price is crossed by the candle is closed at the
+// liquidation price, or the open price should the candle gap beyond it, and a fill
+// event is returned. Any funding rates which occurred since the last funding payment
+// up until the data event's time are then applied to the position.
+// Nil is returned when the funding pair is not a futures contract or no liquidation occurred
+func (e *Exchange) UpdateFuturesPosition(ev common.DataEventHandler, bot *engine.Engine, funds funding.IPairReleaser) (*fill.Fill, error) {
+	if ev == nil || funds == nil {
+		return nil, common.ErrNilArguments
+	}
+	if !funds.IsFutures() {
+		return nil, nil
+	}
+	cs, err := e.GetCurrencySettings(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		return nil, err
+	}
+	var f *fill.Fill
+	pos := funds.GetPosition()
+	if price, ok := liquidationFillPrice(&pos, ev.OpenPrice(), ev.HighPrice(), ev.LowPrice()); ok {
+		f, err = e.liquidate(ev, price, &pos, bot, funds)
+		if err != nil {
+			return nil, err
+		}
+	}
+	funds.UpdateMarkPrice(ev.ClosePrice())
+	e.applyFundingRates(ev, &cs, funds)
+	return f, nil
+}
+
+// liquidationFillPrice returns the price a position is liquidated at when the
+// candle's range crosses the position's liquidation price
+func liquidationFillPrice(pos *funding.Position, open, high, low decimal.Decimal) (decimal.Decimal, bool) {
+	if pos.Size.IsZero() || high.IsZero() || low.IsZero() {
+		return decimal.Zero, false
+	}
+	liq := pos.LiquidationPrice()
+	if liq.LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero, false
+	}
+	return Crossed(open, high, low, liq, pos.Size.IsPositive())
+}
+
+// liquidate closes the entire position and places the closing order with the order manager
+func (e *Exchange) liquidate(ev common.DataEventHandler, price decimal.Decimal, pos *funding.Position, bot *engine.Engine, funds funding.IPairReleaser) (*fill.Fill, error) {
+	side := gctorder.Sell
+	if pos.Size.IsNegative() {
+		side = gctorder.Buy
+	}
+	liquidationPrice := pos.LiquidationPrice()
+	f := &fill.Fill{
+		Base: event.Base{
+			Offset:       ev.GetOffset(),
+			Exchange:     ev.GetExchange(),
+			Time:         ev.GetTime(),
+			CurrencyPair: ev.Pair(),
+			AssetType:    ev.GetAssetType(),
+			Interval:     ev.GetInterval(),
+		},
+		Direction:           side,
+		Amount:              pos.Size.Abs(),
+		ClosePrice:          ev.ClosePrice(),
+		VolumeAdjustedPrice: price,
+		ExchangeFee:         pos.Size.Abs().Mul(price).Mul(pos.LiquidationFeeRate),
+	}
+	// liquidations are forced by the exchange and are not subject to order limits
+	orderID, err := e.placeOrder(context.TODO(), price, f.Amount, false, false, gctorder.Market, f, bot)
+	if err != nil {
+		return nil, err
+	}
+	amount, fee, err := funds.Liquidate(price)
+	if err != nil {
+		return nil, err
+	}
+	f.Amount = amount
+	f.ExchangeFee = fee
+	err = setFillOrder(f, orderID, amount, ev.GetTime(), bot)
+	if err != nil {
+		return nil, err
+	}
+	f.SetDirection(common.Liquidated)
+	f.AppendReason(fmt.Sprintf("%v position of %v with entry price %v liquidated at %v, liquidation price %v, fee %v",
+		side,
+		amount,
+		pos.EntryPrice,
+		price,
+		liquidationPrice,
+		fee))
+	return f, nil
+}
+
+// applyFundingRates applies every funding rate after the position's last
+// funding time up to and including the data event's time
+func (e *Exchange) applyFundingRates(ev common.DataEventHandler, cs *Settings, funds funding.IPairReleaser) {
+	if len(cs.FundingRates) == 0 {
+		return
+	}
+	lastFunding := funds.GetPosition().LastFundingTime
+	start := sort.Search(len(cs.FundingRates), func(i int) bool {
+		return cs.FundingRates[i].Time.After(lastFunding)
+	})
+	for i := start; i < len(cs.FundingRates); i++ {
+		if cs.FundingRates[i].Time.After(ev.GetTime()) {
+			break
+		}
+		funds.ApplyFundingRate(cs.FundingRates[i].Rate, cs.FundingRates[i].Time)
+	}
+}
+
+// reduceAmountToFitMargin reduces the amount of a futures order to the amount
+// which closes the current position plus the amount the allocated margin can open
+func reduceAmountToFitMargin(price, amount, allocatedMargin decimal.Decimal, pos *funding.Position, side gctorder.Side) decimal.Decimal {
+	var closable decimal.Decimal
+	if (side == gctorder.Buy && pos.Size.IsNegative()) ||
+		(side == gctorder.Sell && pos.Size.IsPositive()) {
+		closable = pos.Size.Abs()
+	}
+	maximum := closable
+	if allocatedMargin.GreaterThan(decimal.Zero) &&
+		price.GreaterThan(decimal.Zero) &&
+		pos.InitialMarginRatio.GreaterThan(decimal.Zero) {
+		maximum = maximum.Add(allocatedMargin.Div(price.Mul(pos.InitialMarginRatio)))
+	}
+	if amount.GreaterThan(maximum) {
+		return maximum
+	}
+	return amount
+}
+
+// settleFuturesFill releases the margin reserved for an order and applies the
+// fill to the futures position, which posts the margin required. It returns the realised PNL
+func settleFuturesFill(funds funding.IPairReleaser, reserved, amount, price, fee decimal.Decimal, side gctorder.Side) (decimal.Decimal, error) {
+	if reserved.GreaterThan(decimal.Zero) {
+		err := funds.Release(reserved, reserved, side)
+		if err != nil {
+			return decimal.Zero, err
+		}
+	}
+	return funds.UpdatePosition(amount, price, fee, side)
+}
@@ -0,0 +1,259 @@
+package exchange
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var futuresStart = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func setupFuturesTest(t *testing.T, m funding.MarginRequirements) (*engine.Engine, *Exchange, *funding.Pair, *kline.DataFromKline) {
+	t.Helper()
+	bot, _, _, _ := setupRestingOrderTest(t)
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	e := &Exchange{
+		CurrencySettings: []Settings{
+			{
+				ExchangeName:            testExchange,
+				CurrencyPair:            p,
+				AssetType:               asset.Futures,
+				ExchangeFee:             decimal.NewFromFloat(0.001),
+				TakerFee:                decimal.NewFromFloat(0.001),
+				SkipCandleVolumeFitting: true,
+			},
+		},
+	}
+	b, err := funding.CreateItem(testExchange, asset.Futures, p.Base, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Futures, p.Quote, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pair.SetupFutures(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: testExchange,
+			Pair:     p,
+			Asset:    asset.Futures,
+			Interval: gctkline.OneDay,
+			Candles: []gctkline.Candle{
+				{Time: futuresStart, Open: 100, High: 110, Low: 95, Close: 100, Volume: 1000},
+				{Time: futuresStart.Add(gctkline.OneDay.Duration()), Open: 100, High: 105, Low: 85, Close: 90, Volume: 1000},
+				{Time: futuresStart.Add(gctkline.OneDay.Duration() * 2), Open: 90, High: 130, Low: 88, Close: 125, Volume: 1000},
+			},
+		},
+	}
+	err = d.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Next()
+	return bot, e, pair, d
+}
+
+func TestFuturesMarketOrderLiquidation(t *testing.T) {
+	t.Parallel()
+	bot, e, funds, d := setupFuturesTest(t, funding.MarginRequirements{
+		InitialMarginRatio:     decimal.NewFromFloat(0.1),
+		MaintenanceMarginRatio: decimal.NewFromFloat(0.05),
+		LiquidationFeeRate:     decimal.NewFromFloat(0.01),
+	})
+	hundred := decimal.NewFromInt(100)
+	o := restingOrderEvent(d, gctorder.Buy, gctorder.Market, decimal.NewFromInt(50), hundred, decimal.Zero)
+	o.AllocatedFunds = decimal.NewFromInt(500)
+	err := funds.Reserve(o.AllocatedFunds, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := e.ExecuteOrder(o, d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.GetDirection() != gctorder.Buy {
+		t.Errorf("received '%v' expected '%v'", f.GetDirection(), gctorder.Buy)
+	}
+	pos := funds.GetPosition()
+	if !pos.Size.Equal(decimal.NewFromInt(50)) || !pos.Margin.Equal(decimal.NewFromInt(500)) {
+		t.Errorf("unexpected position %+v", pos)
+	}
+	// 1000 - 500 margin - 5 fee
+	if !funds.QuoteAvailable().Equal(decimal.NewFromInt(495)) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 495)
+	}
+
+	// the first candle's low does not reach the liquidation price
+	f, err = e.UpdateFuturesPosition(d.Latest(), bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f != nil {
+		t.Error("expected no liquidation")
+	}
+
+	d.Next()
+	liq := pos.LiquidationPrice()
+	f, err = e.UpdateFuturesPosition(d.Latest(), bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f == nil {
+		t.Fatal("expected liquidation")
+	}
+	if f.GetDirection() != common.Liquidated {
+		t.Errorf("received '%v' expected '%v'", f.GetDirection(), common.Liquidated)
+	}
+	if f.GetOrder() == nil || f.GetOrder().Side != gctorder.Sell {
+		t.Error("expected sell order to be set")
+	}
+	pos = funds.GetPosition()
+	if !pos.Size.IsZero() || pos.Liquidations != 1 {
+		t.Errorf("unexpected position %+v", pos)
+	}
+	expected := decimal.NewFromInt(995).
+		Add(decimal.NewFromInt(50).Mul(liq.Sub(hundred))).
+		Sub(decimal.NewFromInt(50).Mul(liq).Mul(decimal.NewFromFloat(0.01)))
+	if !funds.QuoteAvailable().Equal(expected) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), expected)
+	}
+
+	_, err = e.UpdateFuturesPosition(nil, bot, funds)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+}
+
+func TestFuturesFundingRates(t *testing.T) {
+	t.Parallel()
+	bot, e, funds, d := setupFuturesTest(t, funding.MarginRequirements{
+		InitialMarginRatio:     decimal.NewFromFloat(0.5),
+		MaintenanceMarginRatio: decimal.NewFromFloat(0.05),
+	})
+	e.CurrencySettings[0].FundingRates = []fundingrate.Rate{
+		{Time: futuresStart.Add(time.Hour * 8), Rate: decimal.NewFromFloat(0.001)},
+		{Time: futuresStart.Add(time.Hour * 16), Rate: decimal.NewFromFloat(-0.002)},
+		{Time: futuresStart.Add(time.Hour * 49), Rate: decimal.NewFromFloat(0.5)},
+	}
+	o := restingOrderEvent(d, gctorder.Buy, gctorder.Market, decimal.NewFromInt(10), decimal.NewFromInt(100), decimal.Zero)
+	o.AllocatedFunds = decimal.NewFromInt(500)
+	err := funds.Reserve(o.AllocatedFunds, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.ExecuteOrder(o, d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Next()
+	f, err := e.UpdateFuturesPosition(d.Latest(), bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f != nil {
+		t.Error("expected no liquidation")
+	}
+	pos := funds.GetPosition()
+	if !pos.MarkPrice.Equal(decimal.NewFromInt(90)) {
+		t.Errorf("received '%v' expected '%v'", pos.MarkPrice, 90)
+	}
+	if !pos.UnrealisedPNL.Equal(decimal.NewFromInt(-100)) {
+		t.Errorf("received '%v' expected '%v'", pos.UnrealisedPNL, -100)
+	}
+	// 10 * 90 * (0.001 - 0.002)
+	if !pos.FundingPayments.Equal(decimal.NewFromFloat(-0.9)) {
+		t.Errorf("received '%v' expected '%v'", pos.FundingPayments, -0.9)
+	}
+	if !pos.LastFundingTime.Equal(futuresStart.Add(time.Hour * 16)) {
+		t.Errorf("received '%v' expected '%v'", pos.LastFundingTime, futuresStart.Add(time.Hour*16))
+	}
+	// 1000 - 500 margin - 1 fee + 0.9 funding
+	if !funds.QuoteAvailable().Equal(decimal.NewFromFloat(499.9)) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 499.9)
+	}
+
+	// closing the position requires no margin and realises the loss
+	o = restingOrderEvent(d, gctorder.Sell, gctorder.Market, decimal.NewFromInt(10), decimal.NewFromInt(90), decimal.Zero)
+	o.AllocatedFunds = decimal.Zero
+	f, err = e.ExecuteOrder(o, d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.GetAmount().Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", f.GetAmount(), 10)
+	}
+	pos = funds.GetPosition()
+	if !pos.Size.IsZero() || !pos.RealisedPNL.Equal(decimal.NewFromInt(-100)) {
+		t.Errorf("unexpected position %+v", pos)
+	}
+	// 499.9 + 500 margin - 100 loss - 0.9 fee
+	if !funds.QuoteAvailable().Equal(decimal.NewFromInt(899)) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 899)
+	}
+}
+
+func TestFuturesRealOrdersUnsupported(t *testing.T) {
+	t.Parallel()
+	bot, e, funds, d := setupFuturesTest(t, funding.MarginRequirements{
+		InitialMarginRatio:     decimal.NewFromFloat(0.1),
+		MaintenanceMarginRatio: decimal.NewFromFloat(0.05),
+	})
+	e.CurrencySettings[0].UseRealOrders = true
+	o := restingOrderEvent(d, gctorder.Sell, gctorder.Market, decimal.NewFromInt(1), decimal.NewFromInt(100), decimal.Zero)
+	o.AllocatedFunds = decimal.NewFromInt(10)
+	err := funds.Reserve(o.AllocatedFunds, gctorder.Sell)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := e.ExecuteOrder(o, d, bot, funds)
+	if !errors.Is(err, errFuturesRealOrders) {
+		t.Errorf("received '%v' expected '%v'", err, errFuturesRealOrders)
+	}
+	if f.GetDirection() != common.CouldNotSell {
+		t.Errorf("received '%v' expected '%v'", f.GetDirection(), common.CouldNotSell)
+	}
+	if !funds.QuoteAvailable().Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 1000)
+	}
+}
+
+func TestReduceAmountToFitMargin(t *testing.T) {
+	t.Parallel()
+	pos := &funding.Position{
+		MarginRequirements: funding.MarginRequirements{InitialMarginRatio: decimal.NewFromFloat(0.1)},
+		Size:               decimal.NewFromInt(-5),
+	}
+	hundred := decimal.NewFromInt(100)
+	// 5 closes the short and 100 margin opens a further 10
+	resp := reduceAmountToFitMargin(hundred, hundred, hundred, pos, gctorder.Buy)
+	if !resp.Equal(decimal.NewFromInt(15)) {
+		t.Errorf("received '%v' expected '%v'", resp, 15)
+	}
+	resp = reduceAmountToFitMargin(hundred, hundred, hundred, pos, gctorder.Sell)
+	if !resp.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", resp, 10)
+	}
+	resp = reduceAmountToFitMargin(hundred, decimal.NewFromInt(3), decimal.Zero, pos, gctorder.Buy)
+	if !resp.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("received '%v' expected '%v'", resp, 3)
+	}
+}
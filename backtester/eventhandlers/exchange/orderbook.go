@@ -0,0 +1,516 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// IsRestingOrderType returns whether the order type will rest on the
+// simulated exchange's order book rather than being filled immediately
+func IsRestingOrderType(t gctorder.Type) bool {
+	switch t {
+	case gctorder.Limit, gctorder.Stop, gctorder.StopLimit, gctorder.TakeProfit:
+		return true
+	}
+	return false
+}
+
+// GetOpenOrders returns a copy of all resting orders for an exchange, asset, currency pair
+func (e *Exchange) GetOpenOrders(exch string, a asset.Item, cp currency.Pair) []RestingOrder {
+	var resp []RestingOrder
+	for i := range e.OrderBook.Orders {
+		if e.OrderBook.Orders[i].matches(exch, a, cp) {
+			resp = append(resp, *e.OrderBook.Orders[i])
+		}
+	}
+	return resp
+}
+
+// ProcessOpenOrders assesses all resting orders for the latest data event's exchange,
+// asset and currency pair. Orders which have expired will release their reserved funds,
+// orders whose limit or trigger price has been crossed by the candle will be filled.
+// A fill event is returned for every order that has changed
+func (e *Exchange) ProcessOpenOrders(d data.Handler, bot *engine.Engine, funds funding.IPairReleaser) ([]*fill.Fill, error) {
+	if d == nil || funds == nil {
+		return nil, common.ErrNilArguments
+	}
+	ev := d.Latest()
+	if ev == nil {
+		return nil, common.ErrNilEvent
+	}
+	if len(e.OrderBook.Orders) == 0 {
+		return nil, nil
+	}
+	cs, err := e.GetCurrencySettings(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		return nil, err
+	}
+	var volume decimal.Decimal
+	volumes := d.StreamVol()
+	if len(volumes) > 0 {
+		volume = volumes[len(volumes)-1]
+	}
+
+	var resp []*fill.Fill
+	var errs gctcommon.Errors
+	remaining := e.OrderBook.Orders[:0]
+	for i := range e.OrderBook.Orders {
+		ro := e.OrderBook.Orders[i]
+		if !ro.matches(ev.GetExchange(), ev.GetAssetType(), ev.Pair()) ||
+			!ev.GetTime().After(ro.PlacedAt) {
+			// orders can only be filled by candles after they were placed
+			remaining = append(remaining, ro)
+			continue
+		}
+		if !ro.Expiry.IsZero() && !ev.GetTime().Before(ro.Expiry) {
+			err = releaseReserved(funds, ro.Reserved, ro.Direction)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			f := ro.createFill(ev)
+			f.SetDirection(common.OrderExpired)
+			f.AppendReason(fmt.Sprintf("%v order %v expired at %v, released %v reserved funds", ro.OrderType, ro.ID, ro.Expiry, ro.Reserved))
+			resp = append(resp, f)
+			continue
+		}
+		price, ok := ro.evaluate(ev.OpenPrice(), ev.HighPrice(), ev.LowPrice())
+		if !ok {
+			remaining = append(remaining, ro)
+			continue
+		}
+		var f *fill.Fill
+		var complete bool
+		f, complete, err = e.fillRestingOrder(ro, ev, price, volume, &cs, bot, funds)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v order %v %w", ro.OrderType, ro.ID, err))
+		}
+		if f != nil {
+			resp = append(resp, f)
+		}
+		if !complete {
+			remaining = append(remaining, ro)
+		}
+	}
+	for i := len(remaining); i < len(e.OrderBook.Orders); i++ {
+		e.OrderBook.Orders[i] = nil
+	}
+	e.OrderBook.Orders = remaining
+	if len(errs) > 0 {
+		return resp, errs
+	}
+	return resp, nil
+}
+
+// addRestingOrder places an order event onto the order book. The funds reserved
+// by the portfolio manager remain reserved until the order is filled, cancelled or expires
+func (e *Exchange) addRestingOrder(o order.Event, f *fill.Fill, cs *Settings, funds funding.IPairReleaser) error {
+	err := validateRestingOrder(o, cs)
+	if err == nil {
+		for i := range e.OrderBook.Orders {
+			if o.GetID() != "" && e.OrderBook.Orders[i].ID == o.GetID() {
+				err = fmt.Errorf("%w %v", errRestingOrderExists, o.GetID())
+				break
+			}
+		}
+	}
+	if err != nil {
+		if o.GetAllocatedFunds().GreaterThan(decimal.Zero) {
+			fundErr := funds.Release(o.GetAllocatedFunds(), o.GetAllocatedFunds(), o.GetDirection())
+			if fundErr != nil {
+				f.AppendReason(fundErr.Error())
+			}
+		}
+		if o.GetDirection() == gctorder.Buy {
+			f.SetDirection(common.CouldNotBuy)
+		} else {
+			f.SetDirection(common.CouldNotSell)
+		}
+		f.AppendReason(err.Error())
+		return err
+	}
+
+	id := o.GetID()
+	if id == "" {
+		var u uuid.UUID
+		u, err = uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		id = u.String()
+	}
+	ro := &RestingOrder{
+		ID:           id,
+		Exchange:     o.GetExchange(),
+		Asset:        o.GetAssetType(),
+		Pair:         o.Pair(),
+		Interval:     o.GetInterval(),
+		Direction:    o.GetDirection(),
+		OrderType:    o.GetOrderType(),
+		Amount:       o.GetAmount(),
+		TriggerPrice: o.GetTriggerPrice(),
+		Reserved:     o.GetAllocatedFunds(),
+		PlacedAt:     o.GetTime(),
+		Expiry:       o.GetExpiry(),
+	}
+	if ro.usesLimitPrice() {
+		ro.LimitPrice = o.GetPrice()
+	}
+	e.OrderBook.Orders = append(e.OrderBook.Orders, ro)
+
+	f.Amount = decimal.Zero
+	f.ExchangeFee = decimal.Zero
+	f.SetDirection(common.OrderPlaced)
+	f.AppendReason(fmt.Sprintf("%v %v order %v for %v resting with limit price %v and trigger price %v",
+		ro.Direction,
+		ro.OrderType,
+		ro.ID,
+		ro.Amount,
+		ro.LimitPrice,
+		ro.TriggerPrice))
+	return nil
+}
+
+// cancelOrder removes a resting order from the order book and releases its reserved funds
+func (e *Exchange) cancelOrder(o order.Event, f *fill.Fill, funds funding.IPairReleaser) error {
+	f.Amount = decimal.Zero
+	f.ExchangeFee = decimal.Zero
+	for i := range e.OrderBook.Orders {
+		ro := e.OrderBook.Orders[i]
+		if ro.ID != o.GetID() || !ro.matches(o.GetExchange(), o.GetAssetType(), o.Pair()) {
+			continue
+		}
+		err := releaseReserved(funds, ro.Reserved, ro.Direction)
+		if err != nil {
+			f.SetDirection(common.DoNothing)
+			f.AppendReason(err.Error())
+			return err
+		}
+		e.OrderBook.Orders = append(e.OrderBook.Orders[:i], e.OrderBook.Orders[i+1:]...)
+		f.AppendReason(fmt.Sprintf("cancelled %v order %v, released %v reserved funds", ro.OrderType, ro.ID, ro.Reserved))
+		return nil
+	}
+	err := fmt.Errorf("%w %v", errRestingOrderNotFound, o.GetID())
+	f.SetDirection(common.DoNothing)
+	f.AppendReason(err.Error())
+	return err
+}
+
+// amendOrder changes the limit price, trigger price or expiry of a resting order.
+// Buy orders will reserve or release quote funds to match the new price.
+// Futures orders keep the margin reserved when they were placed
+func (e *Exchange) amendOrder(o order.Event, f *fill.Fill, funds funding.IPairReleaser) error {
+	f.Amount = decimal.Zero
+	f.ExchangeFee = decimal.Zero
+	var ro *RestingOrder
+	for i := range e.OrderBook.Orders {
+		if e.OrderBook.Orders[i].ID == o.GetID() &&
+			e.OrderBook.Orders[i].matches(o.GetExchange(), o.GetAssetType(), o.Pair()) {
+			ro = e.OrderBook.Orders[i]
+			break
+		}
+	}
+	if ro == nil {
+		err := fmt.Errorf("%w %v", errRestingOrderNotFound, o.GetID())
+		f.SetDirection(common.DoNothing)
+		f.AppendReason(err.Error())
+		return err
+	}
+	amended := *ro
+	if ro.usesLimitPrice() && o.GetPrice().GreaterThan(decimal.Zero) {
+		amended.LimitPrice = o.GetPrice()
+	}
+	if o.GetTriggerPrice().GreaterThan(decimal.Zero) {
+		amended.TriggerPrice = o.GetTriggerPrice()
+	}
+	if !o.GetExpiry().IsZero() {
+		amended.Expiry = o.GetExpiry()
+	}
+	if amended.Direction == gctorder.Buy && !funds.IsFutures() {
+		required := amended.Amount.Mul(amended.sizingPrice())
+		diff := required.Sub(amended.Reserved)
+		var err error
+		switch {
+		case diff.GreaterThan(decimal.Zero):
+			err = funds.Reserve(diff, gctorder.Buy)
+		case diff.LessThan(decimal.Zero):
+			err = funds.Release(diff.Abs(), diff.Abs(), gctorder.Buy)
+		}
+		if err != nil {
+			f.SetDirection(common.DoNothing)
+			f.AppendReason(err.Error())
+			return err
+		}
+		amended.Reserved = required
+	}
+	*ro = amended
+	f.AppendReason(fmt.Sprintf("amended %v order %v to limit price %v, trigger price %v",
+		ro.OrderType,
+		ro.ID,
+		ro.LimitPrice,
+		ro.TriggerPrice))
+	return nil
+}
+
+// fillRestingOrder fills a resting order whose price has been crossed. The order is
+// shrunk to fit the candle's volume when required, leaving the remainder resting.
+// It returns whether the order has been completed and can leave the order book
+func (e *Exchange) fillRestingOrder(ro *RestingOrder, ev common.DataEventHandler, price, volume decimal.Decimal, cs *Settings, bot *engine.Engine, funds funding.IPairReleaser) (*fill.Fill, bool, error) {
+	f := ro.createFill(ev)
+	f.Direction = ro.Direction
+	f.VolumeAdjustedPrice = price
+	amount := ro.Amount
+	if !cs.SkipCandleVolumeFitting {
+		_, amount = ensureOrderFitsWithinHLV(price, ro.Amount, ev.HighPrice(), ev.LowPrice(), volume)
+		if volume.LessThanOrEqual(decimal.Zero) {
+			amount = ro.Amount
+		}
+		if amount.LessThanOrEqual(decimal.Zero) {
+			return nil, false, nil
+		}
+		if !amount.Equal(ro.Amount) {
+			f.AppendReason(fmt.Sprintf("Order partially filled %v of %v to fit candle", amount, ro.Amount))
+		}
+	}
+
+	adjustedPrice := price
+	fee := cs.MakerFee
+	if !ro.usesLimitPrice() {
+		// once triggered, stop and take profit orders are executed as market orders
+		slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
+		adjustedPrice = applySlippageToPrice(ro.Direction, price, slippageRate)
+		f.Slippage = slippageRate.Mul(decimal.NewFromInt(100)).Sub(decimal.NewFromInt(100))
+		fee = cs.TakerFee
+	}
+
+	reserved := ro.Reserved
+	if amount.LessThan(ro.Amount) {
+		reserved = ro.Reserved.Mul(amount).Div(ro.Amount)
+	}
+	var fittedAmount decimal.Decimal
+	if funds.IsFutures() {
+		pos := funds.GetPosition()
+		fittedAmount = reduceAmountToFitMargin(adjustedPrice, amount, reserved, &pos, ro.Direction)
+	} else {
+		fittedAmount = reduceAmountToFitPortfolioLimit(adjustedPrice, amount, reserved, ro.Direction)
+	}
+	if !fittedAmount.Equal(amount) {
+		f.AppendReason(fmt.Sprintf("Order size shrunk from %v to %v to remain within reserved funds", amount, fittedAmount))
+	}
+	if cs.CanUseExchangeLimits {
+		conformed := cs.Limits.ConformToDecimalAmount(fittedAmount)
+		if !conformed.Equal(fittedAmount) {
+			f.AppendReason(fmt.Sprintf("Order size shrunk from %v to %v to remain within exchange step amount limits", fittedAmount, conformed))
+		}
+		fittedAmount = conformed
+	}
+	// any reduction beyond volume fitting leaves an unfillable remainder,
+	// so the whole order is considered complete
+	complete := amount.Equal(ro.Amount) || !fittedAmount.Equal(amount)
+	if complete {
+		reserved = ro.Reserved
+	}
+	if fittedAmount.LessThanOrEqual(decimal.Zero) {
+		if !complete {
+			return nil, false, nil
+		}
+		err := releaseReserved(funds, reserved, ro.Direction)
+		if err != nil {
+			return nil, false, err
+		}
+		f.Amount = decimal.Zero
+		f.SetDirection(common.OrderExpired)
+		f.AppendReason(fmt.Sprintf("%v order %v could not be filled within limits, released %v reserved funds", ro.OrderType, ro.ID, reserved))
+		return f, true, nil
+	}
+
+	f.Amount = fittedAmount
+	f.ExchangeFee = calculateExchangeFee(adjustedPrice, fittedAmount, fee)
+	orderType := gctorder.Market
+	if ro.usesLimitPrice() {
+		orderType = gctorder.Limit
+	}
+	orderID, err := e.placeOrder(context.TODO(), adjustedPrice, fittedAmount, false, cs.CanUseExchangeLimits, orderType, f, bot)
+	if err != nil {
+		return nil, false, err
+	}
+	if funds.IsFutures() {
+		var realised decimal.Decimal
+		realised, err = settleFuturesFill(funds, reserved, fittedAmount, adjustedPrice, f.ExchangeFee, ro.Direction)
+		if err != nil {
+			return nil, false, err
+		}
+		if !realised.IsZero() {
+			f.AppendReason(fmt.Sprintf("Realised PNL %v", realised))
+		}
+	} else {
+		switch ro.Direction {
+		case gctorder.Buy:
+			err = funds.Release(reserved, reserved.Sub(fittedAmount.Mul(adjustedPrice)), ro.Direction)
+			if err != nil {
+				return nil, false, err
+			}
+			funds.IncreaseAvailable(fittedAmount, ro.Direction)
+		case gctorder.Sell:
+			err = funds.Release(reserved, reserved.Sub(fittedAmount), ro.Direction)
+			if err != nil {
+				return nil, false, err
+			}
+			funds.IncreaseAvailable(fittedAmount.Mul(adjustedPrice), ro.Direction)
+		}
+	}
+	ro.Amount = ro.Amount.Sub(fittedAmount)
+	ro.Reserved = ro.Reserved.Sub(reserved)
+	f.AppendReason(fmt.Sprintf("%v order %v filled", ro.OrderType, ro.ID))
+	err = setFillOrder(f, orderID, fittedAmount, ev.GetTime(), bot)
+	if err != nil {
+		return nil, complete, err
+	}
+	return f, complete, nil
+}
+
+// releaseReserved releases an order's reserved funds. Orders which reduce a
+// futures position may not have reserved any funds
+func releaseReserved(funds funding.IPairReleaser, reserved decimal.Decimal, side gctorder.Side) error {
+	if reserved.LessThanOrEqual(decimal.Zero) {
+		return nil
+	}
+	return funds.Release(reserved, reserved, side)
+}
+
+func validateRestingOrder(o order.Event, cs *Settings) error {
+	if cs.UseRealOrders {
+		return errRealOrdersUnsupported
+	}
+	if o.GetDirection() != gctorder.Buy && o.GetDirection() != gctorder.Sell {
+		return fmt.Errorf("%w %v", errInvalidDirection, o.GetDirection())
+	}
+	if o.GetAmount().LessThanOrEqual(decimal.Zero) {
+		return fmt.Errorf("%w amount %v", errExceededPortfolioLimit, o.GetAmount())
+	}
+	switch o.GetOrderType() {
+	case gctorder.Limit:
+		if o.GetPrice().LessThanOrEqual(decimal.Zero) {
+			return fmt.Errorf("%w limit price %v", errInvalidOrderPrice, o.GetPrice())
+		}
+	case gctorder.Stop, gctorder.TakeProfit:
+		if o.GetTriggerPrice().LessThanOrEqual(decimal.Zero) {
+			return fmt.Errorf("%w trigger price %v", errInvalidOrderPrice, o.GetTriggerPrice())
+		}
+	case gctorder.StopLimit:
+		if o.GetPrice().LessThanOrEqual(decimal.Zero) {
+			return fmt.Errorf("%w limit price %v", errInvalidOrderPrice, o.GetPrice())
+		}
+		if o.GetTriggerPrice().LessThanOrEqual(decimal.Zero) {
+			return fmt.Errorf("%w trigger price %v", errInvalidOrderPrice, o.GetTriggerPrice())
+		}
+	default:
+		return fmt.Errorf("%w %v", errInvalidOrderType, o.GetOrderType())
+	}
+	return nil
+}
+
+func (r *RestingOrder) matches(exch string, a asset.Item, cp currency.Pair) bool {
+	return r.Exchange == exch && r.Asset == a && r.Pair.Equal(cp)
+}
+
+// usesLimitPrice returns whether the order will be filled at its limit price
+// rather than executing as a market order once triggered
+func (r *RestingOrder) usesLimitPrice() bool {
+	return r.OrderType == gctorder.Limit || r.OrderType == gctorder.StopLimit
+}
+
+// sizingPrice returns the price used to calculate the funds an order requires
+func (r *RestingOrder) sizingPrice() decimal.Decimal {
+	if r.usesLimitPrice() {
+		return r.LimitPrice
+	}
+	return r.TriggerPrice
+}
+
+// evaluate determines whether a candle's price range crosses the order's
+// limit or trigger price, returning the price the order would be filled at.
+// Candles which open beyond the order's price are filled at the open price
+func (r *RestingOrder) evaluate(open, high, low decimal.Decimal) (decimal.Decimal, bool) {
+	if high.IsZero() || low.IsZero() {
+		// missing data cannot fill an order
+		return decimal.Zero, false
+	}
+	isBuy := r.Direction == gctorder.Buy
+	switch r.OrderType {
+	case gctorder.Limit:
+		return Crossed(open, high, low, r.LimitPrice, isBuy)
+	case gctorder.Stop:
+		return Crossed(open, high, low, r.TriggerPrice, !isBuy)
+	case gctorder.TakeProfit:
+		return Crossed(open, high, low, r.TriggerPrice, isBuy)
+	case gctorder.StopLimit:
+		if r.Triggered {
+			return Crossed(open, high, low, r.LimitPrice, isBuy)
+		}
+		entry, triggered := Crossed(open, high, low, r.TriggerPrice, !isBuy)
+		if !triggered {
+			return decimal.Zero, false
+		}
+		r.Triggered = true
+		// the order only fills on the candle it was triggered
+		// when the trigger price is within the limit price
+		if (isBuy && entry.LessThanOrEqual(r.LimitPrice)) ||
+			(!isBuy && entry.GreaterThanOrEqual(r.LimitPrice)) {
+			return entry, true
+		}
+	}
+	return decimal.Zero, false
+}
+
+// Crossed checks whether a candle's price range has fallen to, or risen to the price.
+// When falling, the candle must trade at or below the price and will
+// fill at the lower of the open and the price. When rising, the candle must
+// trade at or above the price and will fill at the higher of the open and the price
+func Crossed(open, high, low, price decimal.Decimal, falling bool) (decimal.Decimal, bool) {
+	if falling {
+		if low.GreaterThan(price) {
+			return decimal.Zero, false
+		}
+		if open.GreaterThan(decimal.Zero) && open.LessThan(price) {
+			return open, true
+		}
+		return price, true
+	}
+	if high.LessThan(price) {
+		return decimal.Zero, false
+	}
+	if open.GreaterThan(price) {
+		return open, true
+	}
+	return price, true
+}
+
+func (r *RestingOrder) createFill(ev common.DataEventHandler) *fill.Fill {
+	return &fill.Fill{
+		Base: event.Base{
+			Offset:       ev.GetOffset(),
+			Exchange:     ev.GetExchange(),
+			Time:         ev.GetTime(),
+			CurrencyPair: ev.Pair(),
+			AssetType:    ev.GetAssetType(),
+			Interval:     ev.GetInterval(),
+		},
+		Direction:  r.Direction,
+		ClosePrice: ev.ClosePrice(),
+	}
+}
@@ -0,0 +1,448 @@
+package exchange
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func setupRestingOrderTest(t *testing.T) (*engine.Engine, *Exchange, *funding.Pair, *kline.DataFromKline) {
+	t.Helper()
+	bot := &engine.Engine{}
+	em := engine.SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(exch)
+	bot.ExchangeManager = em
+	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, &bot.ServicesWG, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = bot.OrderManager.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	e := &Exchange{
+		CurrencySettings: []Settings{
+			{
+				ExchangeName:            testExchange,
+				CurrencyPair:            p,
+				AssetType:               asset.Spot,
+				MakerFee:                decimal.NewFromFloat(0.001),
+				TakerFee:                decimal.NewFromFloat(0.002),
+				SkipCandleVolumeFitting: true,
+			},
+		},
+	}
+	b, err := funding.CreateItem(testExchange, asset.Spot, p.Base, decimal.NewFromInt(10), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, p.Quote, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: testExchange,
+			Pair:     p,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneDay,
+			Candles: []gctkline.Candle{
+				{Time: tt, Open: 100, High: 110, Low: 95, Close: 100, Volume: 1000},
+				{Time: tt.Add(gctkline.OneDay.Duration()), Open: 100, High: 105, Low: 85, Close: 90, Volume: 1000},
+				{Time: tt.Add(gctkline.OneDay.Duration() * 2), Open: 90, High: 130, Low: 88, Close: 125, Volume: 1000},
+			},
+		},
+	}
+	err = d.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Next()
+	return bot, e, pair, d
+}
+
+func restingOrderEvent(d *kline.DataFromKline, side gctorder.Side, orderType gctorder.Type, amount, price, trigger decimal.Decimal) *order.Order {
+	latest := d.Latest()
+	o := &order.Order{
+		Base: event.Base{
+			Offset:       latest.GetOffset(),
+			Exchange:     latest.GetExchange(),
+			Time:         latest.GetTime(),
+			CurrencyPair: latest.Pair(),
+			AssetType:    latest.GetAssetType(),
+			Interval:     latest.GetInterval(),
+		},
+		Direction:    side,
+		OrderType:    orderType,
+		Amount:       amount,
+		Price:        price,
+		TriggerPrice: trigger,
+	}
+	if side == gctorder.Buy {
+		o.AllocatedFunds = amount.Mul(price)
+		if orderType == gctorder.Stop || orderType == gctorder.TakeProfit {
+			o.AllocatedFunds = amount.Mul(trigger)
+		}
+	} else {
+		o.AllocatedFunds = amount
+	}
+	return o
+}
+
+func TestIsRestingOrderType(t *testing.T) {
+	t.Parallel()
+	for _, ot := range []gctorder.Type{gctorder.Limit, gctorder.Stop, gctorder.StopLimit, gctorder.TakeProfit} {
+		if !IsRestingOrderType(ot) {
+			t.Errorf("expected %v to rest", ot)
+		}
+	}
+	if IsRestingOrderType(gctorder.Market) {
+		t.Error("expected market orders to not rest")
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+	open, high, low := decimal.NewFromInt(100), decimal.NewFromInt(110), decimal.NewFromInt(90)
+	tests := []struct {
+		name      string
+		order     RestingOrder
+		expected  decimal.Decimal
+		crossed   bool
+		triggered bool
+	}{
+		{"buy limit crossed", RestingOrder{Direction: gctorder.Buy, OrderType: gctorder.Limit, LimitPrice: decimal.NewFromInt(95)}, decimal.NewFromInt(95), true, false},
+		{"buy limit not crossed", RestingOrder{Direction: gctorder.Buy, OrderType: gctorder.Limit, LimitPrice: decimal.NewFromInt(85)}, decimal.Zero, false, false},
+		{"buy limit gapped", RestingOrder{Direction: gctorder.Buy, OrderType: gctorder.Limit, LimitPrice: decimal.NewFromInt(105)}, decimal.NewFromInt(100), true, false},
+		{"sell limit crossed", RestingOrder{Direction: gctorder.Sell, OrderType: gctorder.Limit, LimitPrice: decimal.NewFromInt(108)}, decimal.NewFromInt(108), true, false},
+		{"sell limit gapped", RestingOrder{Direction: gctorder.Sell, OrderType: gctorder.Limit, LimitPrice: decimal.NewFromInt(95)}, decimal.NewFromInt(100), true, false},
+		{"buy stop crossed", RestingOrder{Direction: gctorder.Buy, OrderType: gctorder.Stop, TriggerPrice: decimal.NewFromInt(105)}, decimal.NewFromInt(105), true, false},
+		{"buy stop not crossed", RestingOrder{Direction: gctorder.Buy, OrderType: gctorder.Stop, TriggerPrice: decimal.NewFromInt(115)}, decimal.Zero, false, false},
+		{"sell stop crossed", RestingOrder{Direction: gctorder.Sell, OrderType: gctorder.Stop, TriggerPrice: decimal.NewFromInt(95)}, decimal.NewFromInt(95), true, false},
+		{"sell take profit crossed", RestingOrder{Direction: gctorder.Sell, OrderType: gctorder.TakeProfit, TriggerPrice: decimal.NewFromInt(109)}, decimal.NewFromInt(109), true, false},
+		{"buy take profit crossed", RestingOrder{Direction: gctorder.Buy, OrderType: gctorder.TakeProfit, TriggerPrice: decimal.NewFromInt(91)}, decimal.NewFromInt(91), true, false},
+		{"buy stop limit within limit", RestingOrder{Direction: gctorder.Buy, OrderType: gctorder.StopLimit, TriggerPrice: decimal.NewFromInt(105), LimitPrice: decimal.NewFromInt(106)}, decimal.NewFromInt(105), true, true},
+		{"buy stop limit beyond limit", RestingOrder{Direction: gctorder.Buy, OrderType: gctorder.StopLimit, TriggerPrice: decimal.NewFromInt(105), LimitPrice: decimal.NewFromInt(104)}, decimal.Zero, false, true},
+		{"sell stop limit not triggered", RestingOrder{Direction: gctorder.Sell, OrderType: gctorder.StopLimit, TriggerPrice: decimal.NewFromInt(85), LimitPrice: decimal.NewFromInt(84)}, decimal.Zero, false, false},
+		{"sell stop limit triggered", RestingOrder{Direction: gctorder.Sell, OrderType: gctorder.StopLimit, TriggerPrice: decimal.NewFromInt(50), LimitPrice: decimal.NewFromInt(105), Triggered: true}, decimal.NewFromInt(105), true, true},
+	}
+	for i := range tests {
+		tt := tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			price, crossed := tt.order.evaluate(open, high, low)
+			if crossed != tt.crossed {
+				t.Errorf("received '%v' expected '%v'", crossed, tt.crossed)
+			}
+			if !price.Equal(tt.expected) {
+				t.Errorf("received '%v' expected '%v'", price, tt.expected)
+			}
+			if tt.order.Triggered != tt.triggered {
+				t.Errorf("received '%v' expected '%v'", tt.order.Triggered, tt.triggered)
+			}
+		})
+	}
+
+	r := RestingOrder{Direction: gctorder.Buy, OrderType: gctorder.Limit, LimitPrice: decimal.NewFromInt(95)}
+	if _, crossed := r.evaluate(decimal.Zero, decimal.Zero, decimal.Zero); crossed {
+		t.Error("expected missing data to not fill an order")
+	}
+}
+
+func TestRestingLimitOrderFill(t *testing.T) {
+	t.Parallel()
+	bot, e, funds, d := setupRestingOrderTest(t)
+
+	o := restingOrderEvent(d, gctorder.Buy, gctorder.Limit, decimal.NewFromInt(2), decimal.NewFromInt(90), decimal.Zero)
+	err := funds.Reserve(o.AllocatedFunds, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := e.ExecuteOrder(o, d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.GetDirection() != common.OrderPlaced {
+		t.Errorf("received '%v' expected '%v'", f.GetDirection(), common.OrderPlaced)
+	}
+	if len(e.GetOpenOrders(testExchange, asset.Spot, o.Pair())) != 1 {
+		t.Fatal("expected one resting order")
+	}
+	if !funds.QuoteAvailable().Equal(decimal.NewFromInt(820)) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 820)
+	}
+
+	// the order cannot be filled by the candle it was placed on
+	fills, err := e.ProcessOpenOrders(d, bot, funds)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(fills) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(fills), 0)
+	}
+
+	d.Next()
+	fills, err = e.ProcessOpenOrders(d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	if fills[0].GetDirection() != gctorder.Buy {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetDirection(), gctorder.Buy)
+	}
+	if !fills[0].GetPurchasePrice().Equal(decimal.NewFromInt(90)) {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetPurchasePrice(), 90)
+	}
+	if !fills[0].GetExchangeFee().Equal(decimal.NewFromFloat(0.18)) {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetExchangeFee(), 0.18)
+	}
+	if fills[0].GetOrder() == nil {
+		t.Error("expected order to be set")
+	}
+	if len(e.GetOpenOrders(testExchange, asset.Spot, o.Pair())) != 0 {
+		t.Error("expected filled order to be removed")
+	}
+	if !funds.BaseAvailable().Equal(decimal.NewFromInt(12)) {
+		t.Errorf("received '%v' expected '%v'", funds.BaseAvailable(), 12)
+	}
+	if !funds.QuoteAvailable().Equal(decimal.NewFromInt(820)) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 820)
+	}
+
+	_, err = e.ProcessOpenOrders(nil, bot, funds)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+}
+
+func TestRestingOrderPartialFill(t *testing.T) {
+	t.Parallel()
+	bot, e, funds, d := setupRestingOrderTest(t)
+	e.CurrencySettings[0].SkipCandleVolumeFitting = false
+
+	o := restingOrderEvent(d, gctorder.Sell, gctorder.Limit, decimal.NewFromInt(10), decimal.NewFromInt(120), decimal.Zero)
+	err := funds.Reserve(o.AllocatedFunds, gctorder.Sell)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.ExecuteOrder(o, d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Next()
+	d.Next()
+	fills, err := e.ProcessOpenOrders(d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	if !fills[0].GetAmount().LessThan(decimal.NewFromInt(10)) {
+		t.Errorf("expected partial fill, received '%v'", fills[0].GetAmount())
+	}
+	open := e.GetOpenOrders(testExchange, asset.Spot, o.Pair())
+	if len(open) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(open), 1)
+	}
+	if !open[0].Amount.Add(fills[0].GetAmount()).Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected remaining amount", open[0].Amount)
+	}
+	if !open[0].Reserved.Equal(open[0].Amount) {
+		t.Errorf("received '%v' expected '%v'", open[0].Reserved, open[0].Amount)
+	}
+}
+
+func TestRestingStopOrderFill(t *testing.T) {
+	t.Parallel()
+	bot, e, funds, d := setupRestingOrderTest(t)
+
+	o := restingOrderEvent(d, gctorder.Sell, gctorder.Stop, decimal.NewFromInt(1), decimal.Zero, decimal.NewFromInt(87))
+	err := funds.Reserve(o.AllocatedFunds, gctorder.Sell)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.ExecuteOrder(o, d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Next()
+	fills, err := e.ProcessOpenOrders(d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	if !fills[0].GetVolumeAdjustedPrice().Equal(decimal.NewFromInt(87)) {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetVolumeAdjustedPrice(), 87)
+	}
+	if !fills[0].GetExchangeFee().Equal(fills[0].GetPurchasePrice().Mul(decimal.NewFromFloat(0.002))) {
+		t.Errorf("expected taker fee, received '%v'", fills[0].GetExchangeFee())
+	}
+	if !funds.BaseAvailable().Equal(decimal.NewFromInt(9)) {
+		t.Errorf("received '%v' expected '%v'", funds.BaseAvailable(), 9)
+	}
+}
+
+func TestRestingOrderExpiry(t *testing.T) {
+	t.Parallel()
+	bot, e, funds, d := setupRestingOrderTest(t)
+
+	o := restingOrderEvent(d, gctorder.Buy, gctorder.Limit, decimal.NewFromInt(1), decimal.NewFromInt(50), decimal.Zero)
+	o.Expiry = d.Latest().GetTime().Add(time.Hour)
+	err := funds.Reserve(o.AllocatedFunds, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.ExecuteOrder(o, d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Next()
+	fills, err := e.ProcessOpenOrders(d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	if fills[0].GetDirection() != common.OrderExpired {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetDirection(), common.OrderExpired)
+	}
+	if !funds.QuoteAvailable().Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 1000)
+	}
+	if len(e.GetOpenOrders(testExchange, asset.Spot, o.Pair())) != 0 {
+		t.Error("expected expired order to be removed")
+	}
+}
+
+func TestCancelAndAmendRestingOrder(t *testing.T) {
+	t.Parallel()
+	bot, e, funds, d := setupRestingOrderTest(t)
+
+	o := restingOrderEvent(d, gctorder.Buy, gctorder.Limit, decimal.NewFromInt(2), decimal.NewFromInt(50), decimal.Zero)
+	o.ID = "test"
+	err := funds.Reserve(o.AllocatedFunds, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.ExecuteOrder(o, d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = funds.Reserve(o.AllocatedFunds, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.ExecuteOrder(o, d, bot, funds)
+	if !errors.Is(err, errRestingOrderExists) {
+		t.Errorf("received '%v' expected '%v'", err, errRestingOrderExists)
+	}
+	if !funds.QuoteAvailable().Equal(decimal.NewFromInt(900)) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 900)
+	}
+
+	amend := restingOrderEvent(d, common.AmendOrder, "", decimal.Zero, decimal.NewFromInt(60), decimal.Zero)
+	amend.ID = "test"
+	f, err := e.ExecuteOrder(amend, d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.GetDirection() != common.AmendOrder {
+		t.Errorf("received '%v' expected '%v'", f.GetDirection(), common.AmendOrder)
+	}
+	open := e.GetOpenOrders(testExchange, asset.Spot, o.Pair())
+	if len(open) != 1 || !open[0].LimitPrice.Equal(decimal.NewFromInt(60)) {
+		t.Fatalf("expected amended limit price, received '%+v'", open)
+	}
+	if !funds.QuoteAvailable().Equal(decimal.NewFromInt(880)) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 880)
+	}
+
+	amend.Price = decimal.NewFromInt(1000)
+	_, err = e.ExecuteOrder(amend, d, bot, funds)
+	if err == nil {
+		t.Error("expected error amending beyond available funds")
+	}
+
+	cancel := restingOrderEvent(d, common.CancelOrder, "", decimal.Zero, decimal.Zero, decimal.Zero)
+	cancel.ID = "test"
+	f, err = e.ExecuteOrder(cancel, d, bot, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.GetDirection() != common.CancelOrder {
+		t.Errorf("received '%v' expected '%v'", f.GetDirection(), common.CancelOrder)
+	}
+	if !funds.QuoteAvailable().Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 1000)
+	}
+
+	_, err = e.ExecuteOrder(cancel, d, bot, funds)
+	if !errors.Is(err, errRestingOrderNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errRestingOrderNotFound)
+	}
+}
+
+func TestAddRestingOrderValidation(t *testing.T) {
+	t.Parallel()
+	bot, e, funds, d := setupRestingOrderTest(t)
+
+	o := restingOrderEvent(d, gctorder.Buy, gctorder.Stop, decimal.NewFromInt(1), decimal.Zero, decimal.Zero)
+	o.AllocatedFunds = decimal.NewFromInt(10)
+	err := funds.Reserve(o.AllocatedFunds, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := e.ExecuteOrder(o, d, bot, funds)
+	if !errors.Is(err, errInvalidOrderPrice) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidOrderPrice)
+	}
+	if f.GetDirection() != common.CouldNotBuy {
+		t.Errorf("received '%v' expected '%v'", f.GetDirection(), common.CouldNotBuy)
+	}
+	if !funds.QuoteAvailable().Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 1000)
+	}
+
+	e.CurrencySettings[0].UseRealOrders = true
+	o.TriggerPrice = decimal.NewFromInt(10)
+	err = funds.Reserve(o.AllocatedFunds, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.ExecuteOrder(o, d, bot, funds)
+	if !errors.Is(err, errRealOrdersUnsupported) {
+		t.Errorf("received '%v' expected '%v'", err, errRealOrdersUnsupported)
+	}
+}
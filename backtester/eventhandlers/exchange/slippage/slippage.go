@@ -30,13 +30,22 @@ func EstimateSlippagePercentage(maximumSlippageRate, minimumSlippageRate decimal
 }
 
 // CalculateSlippageByOrderbook will analyse a provided orderbook and return the result of attempting to
-// place the order on there
+// place the order on there. For buy orders the amount of funds is in the quote currency,
+// for sell orders it is in the base currency. The price returned is the volume weighted average
+// price of the orderbook levels consumed and the amount returned is in the base currency
 func CalculateSlippageByOrderbook(ob *orderbook.Base, side gctorder.Side, amountOfFunds, feeRate decimal.Decimal) (price, amount decimal.Decimal) {
 	funds, _ := amountOfFunds.Float64()
-	fee, _ := feeRate.Float64()
 	result := ob.SimulateOrder(funds, side == gctorder.Buy)
-	rate := (result.MinimumPrice - result.MaximumPrice) / result.MaximumPrice
-	price = decimal.NewFromFloat(result.MinimumPrice * (rate + 1))
-	amount = decimal.NewFromFloat(result.Amount * (1 - fee))
-	return
+	var total decimal.Decimal
+	for i := range result.Orders {
+		levelAmount := decimal.NewFromFloat(result.Orders[i].Amount)
+		amount = amount.Add(levelAmount)
+		total = total.Add(levelAmount.Mul(decimal.NewFromFloat(result.Orders[i].Price)))
+	}
+	if amount.IsZero() {
+		return decimal.Zero, decimal.Zero
+	}
+	price = total.Div(amount)
+	amount = amount.Mul(decimal.NewFromInt(1).Sub(feeRate))
+	return price, amount
 }
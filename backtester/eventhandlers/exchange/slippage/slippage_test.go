@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 func TestRandomSlippage(t *testing.T) {
@@ -35,3 +36,37 @@ func TestCalculateSlippageByOrderbook(t *testing.T) {
 		t.Error("order size must be less than funds")
 	}
 }
+
+func TestCalculateSlippageByRecordedOrderbook(t *testing.T) {
+	t.Parallel()
+	ob := &orderbook.Base{
+		Bids: orderbook.Items{
+			{Price: 99, Amount: 1},
+			{Price: 98, Amount: 1},
+		},
+		Asks: orderbook.Items{
+			{Price: 101, Amount: 1},
+			{Price: 102, Amount: 1},
+		},
+	}
+	price, amount := CalculateSlippageByOrderbook(ob, gctorder.Buy, decimal.NewFromInt(152), decimal.Zero)
+	if !price.Round(8).Equal(decimal.NewFromFloat(101.33333333)) {
+		t.Errorf("received '%v' expected '%v'", price, 101.33333333)
+	}
+	if !amount.Round(8).Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("received '%v' expected '%v'", amount, 1.5)
+	}
+
+	price, amount = CalculateSlippageByOrderbook(ob, gctorder.Sell, decimal.NewFromInt(2), decimal.NewFromFloat(0.1))
+	if !price.Equal(decimal.NewFromFloat(98.5)) {
+		t.Errorf("received '%v' expected '%v'", price, 98.5)
+	}
+	if !amount.Equal(decimal.NewFromFloat(1.8)) {
+		t.Errorf("received '%v' expected '%v'", amount, 1.8)
+	}
+
+	price, amount = CalculateSlippageByOrderbook(&orderbook.Base{}, gctorder.Sell, decimal.NewFromInt(2), decimal.Zero)
+	if !price.IsZero() || !amount.IsZero() {
+		t.Errorf("received '%v' '%v' expected zero values", price, amount)
+	}
+}
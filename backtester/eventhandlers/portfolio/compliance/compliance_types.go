@@ -33,5 +33,6 @@ type SnapshotOrder struct {
 	VolumeAdjustedPrice decimal.Decimal `json:"volume-adjusted-price"`
 	SlippageRate        decimal.Decimal `json:"slippage-rate"`
 	CostBasis           decimal.Decimal `json:"cost-basis"`
+	ExitRule            string          `json:"exit-rule,omitempty"`
 	*order.Detail       `json:"order-detail"`
 }
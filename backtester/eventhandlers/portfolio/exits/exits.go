@@ -0,0 +1,143 @@
+package exits
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// NewManager sets up an exit manager for the rules
+func NewManager(rules *config.ExitRules) (*Manager, error) {
+	if rules == nil {
+		return nil, errNoExitRules
+	}
+	return &Manager{rules: *rules}, nil
+}
+
+// SetPosition sets the position the exit rules are evaluated against.
+// Futures positions are tracked by the funding manager, so they are set
+// after every fill. Opening or flipping a position restarts the trailing stop
+func (m *Manager) SetPosition(size, entryPrice decimal.Decimal) {
+	if size.IsZero() {
+		m.reset()
+		return
+	}
+	if m.size.IsZero() || m.size.IsPositive() != size.IsPositive() {
+		m.bestPrice = entryPrice
+	}
+	m.size = size
+	m.entryPrice = entryPrice
+}
+
+// OnFill updates a spot position from a fill. Buys increase the position
+// and average the entry price, sells reduce it
+func (m *Manager) OnFill(side gctorder.Side, amount, price decimal.Decimal) {
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return
+	}
+	switch side {
+	case gctorder.Buy:
+		if m.size.IsZero() {
+			m.bestPrice = price
+		}
+		total := m.size.Add(amount)
+		m.entryPrice = m.size.Mul(m.entryPrice).Add(amount.Mul(price)).Div(total)
+		m.size = total
+	case gctorder.Sell:
+		m.size = m.size.Sub(amount)
+		if m.size.LessThanOrEqual(decimal.Zero) {
+			m.reset()
+		}
+	}
+}
+
+// Size returns the position size, which is negative for short positions
+func (m *Manager) Size() decimal.Decimal {
+	return m.size
+}
+
+// Evaluate checks whether a data event's price range crosses any exit rule.
+// Stops are checked first, so should a candle cross both a stop and a
+// take-profit, the stop is assumed to have been hit first.
+// The trailing stop is evaluated against the best price before the data event,
+// then moved with the data event's high or low
+func (m *Manager) Evaluate(open, high, low decimal.Decimal) (*Trigger, bool) {
+	if m.size.IsZero() || high.IsZero() || low.IsZero() {
+		return nil, false
+	}
+	isLong := m.size.IsPositive()
+	t, ok := m.evaluate(open, high, low, isLong)
+	if isLong && high.GreaterThan(m.bestPrice) {
+		m.bestPrice = high
+	} else if !isLong && low.LessThan(m.bestPrice) {
+		m.bestPrice = low
+	}
+	return t, ok
+}
+
+func (m *Manager) evaluate(open, high, low decimal.Decimal, isLong bool) (*Trigger, bool) {
+	var stopRule string
+	var stopPrice decimal.Decimal
+	if m.rules.StopLoss != nil {
+		stopRule = StopLoss
+		stopPrice = offset(m.entryPrice, m.rules.StopLoss, !isLong)
+	}
+	if m.rules.TrailingStop != nil {
+		trailing := offset(m.bestPrice, m.rules.TrailingStop, !isLong)
+		// the tightest stop is the one which is hit first
+		if stopRule == "" ||
+			(isLong && trailing.GreaterThan(stopPrice)) ||
+			(!isLong && trailing.LessThan(stopPrice)) {
+			stopRule = TrailingStop
+			stopPrice = trailing
+		}
+	}
+	if stopRule != "" && stopPrice.GreaterThan(decimal.Zero) {
+		if price, ok := exchange.Crossed(open, high, low, stopPrice, isLong); ok {
+			return m.trigger(stopRule, stopPrice, price, isLong), true
+		}
+	}
+	if m.rules.TakeProfit != nil {
+		target := offset(m.entryPrice, m.rules.TakeProfit, isLong)
+		if target.GreaterThan(decimal.Zero) {
+			if price, ok := exchange.Crossed(open, high, low, target, !isLong); ok {
+				return m.trigger(TakeProfit, target, price, isLong), true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (m *Manager) trigger(rule string, triggerPrice, price decimal.Decimal, isLong bool) *Trigger {
+	side := gctorder.Buy
+	if isLong {
+		side = gctorder.Sell
+	}
+	return &Trigger{
+		Rule:         rule,
+		Side:         side,
+		Amount:       m.size.Abs(),
+		EntryPrice:   m.entryPrice,
+		TriggerPrice: triggerPrice,
+		Price:        price,
+	}
+}
+
+func (m *Manager) reset() {
+	m.size = decimal.Zero
+	m.entryPrice = decimal.Zero
+	m.bestPrice = decimal.Zero
+}
+
+// offset returns the price moved by the rule's distance, above it when up is set
+func offset(price decimal.Decimal, rule *config.ExitRule, up bool) decimal.Decimal {
+	distance := rule.Absolute
+	if !rule.Percent.IsZero() {
+		distance = price.Mul(rule.Percent).Div(decimal.NewFromInt(100))
+	}
+	if up {
+		return price.Add(distance)
+	}
+	return price.Sub(distance)
+}
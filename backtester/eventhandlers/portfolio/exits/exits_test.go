@@ -0,0 +1,180 @@
+package exits
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestNewManager(t *testing.T) {
+	t.Parallel()
+	_, err := NewManager(nil)
+	if !errors.Is(err, errNoExitRules) {
+		t.Errorf("received: %v, expected: %v", err, errNoExitRules)
+	}
+	m, err := NewManager(&config.ExitRules{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if m == nil {
+		t.Error("expected manager")
+	}
+}
+
+func TestOnFill(t *testing.T) {
+	t.Parallel()
+	m := &Manager{}
+	m.OnFill(gctorder.Buy, decimal.Zero, decimal.NewFromInt(100))
+	if !m.Size().IsZero() {
+		t.Errorf("received: %v, expected: %v", m.Size(), 0)
+	}
+	m.OnFill(gctorder.Buy, decimal.NewFromInt(1), decimal.NewFromInt(100))
+	m.OnFill(gctorder.Buy, decimal.NewFromInt(1), decimal.NewFromInt(200))
+	if !m.Size().Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received: %v, expected: %v", m.Size(), 2)
+	}
+	if !m.entryPrice.Equal(decimal.NewFromInt(150)) {
+		t.Errorf("received: %v, expected: %v", m.entryPrice, 150)
+	}
+	if !m.bestPrice.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received: %v, expected: %v", m.bestPrice, 100)
+	}
+	m.OnFill(gctorder.Sell, decimal.NewFromInt(1), decimal.NewFromInt(300))
+	if !m.Size().Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v, expected: %v", m.Size(), 1)
+	}
+	if !m.entryPrice.Equal(decimal.NewFromInt(150)) {
+		t.Errorf("received: %v, expected: %v", m.entryPrice, 150)
+	}
+	m.OnFill(gctorder.Sell, decimal.NewFromInt(5), decimal.NewFromInt(300))
+	if !m.Size().IsZero() || !m.entryPrice.IsZero() || !m.bestPrice.IsZero() {
+		t.Errorf("received: %+v, expected a reset manager", m)
+	}
+}
+
+func TestSetPosition(t *testing.T) {
+	t.Parallel()
+	m := &Manager{}
+	m.SetPosition(decimal.NewFromInt(1), decimal.NewFromInt(100))
+	if !m.bestPrice.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received: %v, expected: %v", m.bestPrice, 100)
+	}
+	m.bestPrice = decimal.NewFromInt(120)
+	m.SetPosition(decimal.NewFromInt(2), decimal.NewFromInt(110))
+	if !m.bestPrice.Equal(decimal.NewFromInt(120)) {
+		t.Errorf("received: %v, expected: %v", m.bestPrice, 120)
+	}
+	// flipping the position restarts the trailing stop
+	m.SetPosition(decimal.NewFromInt(-1), decimal.NewFromInt(130))
+	if !m.bestPrice.Equal(decimal.NewFromInt(130)) {
+		t.Errorf("received: %v, expected: %v", m.bestPrice, 130)
+	}
+	m.SetPosition(decimal.Zero, decimal.Zero)
+	if !m.Size().IsZero() || !m.bestPrice.IsZero() {
+		t.Errorf("received: %+v, expected a reset manager", m)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+	m, err := NewManager(&config.ExitRules{
+		StopLoss:   &config.ExitRule{Percent: decimal.NewFromInt(10)},
+		TakeProfit: &config.ExitRule{Absolute: decimal.NewFromInt(50)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ok := m.Evaluate(decimal.NewFromInt(100), decimal.NewFromInt(100), decimal.NewFromInt(1))
+	if ok {
+		t.Error("expected no trigger without a position")
+	}
+
+	m.SetPosition(decimal.NewFromInt(2), decimal.NewFromInt(100))
+	_, ok = m.Evaluate(decimal.NewFromInt(100), decimal.NewFromInt(110), decimal.NewFromInt(95))
+	if ok {
+		t.Error("expected no trigger within range")
+	}
+	_, ok = m.Evaluate(decimal.Zero, decimal.Zero, decimal.Zero)
+	if ok {
+		t.Error("expected no trigger with missing data")
+	}
+
+	tr, ok := m.Evaluate(decimal.NewFromInt(100), decimal.NewFromInt(101), decimal.NewFromInt(85))
+	if !ok {
+		t.Fatal("expected stop loss to trigger")
+	}
+	if tr.Rule != StopLoss || tr.Side != gctorder.Sell ||
+		!tr.Amount.Equal(decimal.NewFromInt(2)) ||
+		!tr.TriggerPrice.Equal(decimal.NewFromInt(90)) ||
+		!tr.Price.Equal(decimal.NewFromInt(90)) {
+		t.Errorf("received: %+v, expected a stop loss sell of 2 at 90", tr)
+	}
+
+	// gapping below the stop fills at the open
+	tr, ok = m.Evaluate(decimal.NewFromInt(80), decimal.NewFromInt(81), decimal.NewFromInt(79))
+	if !ok || !tr.Price.Equal(decimal.NewFromInt(80)) {
+		t.Errorf("received: %+v, expected a fill at the open of 80", tr)
+	}
+
+	// the stop is assumed to be hit first when both exits are crossed
+	tr, ok = m.Evaluate(decimal.NewFromInt(100), decimal.NewFromInt(160), decimal.NewFromInt(80))
+	if !ok || tr.Rule != StopLoss {
+		t.Errorf("received: %+v, expected: %v", tr, StopLoss)
+	}
+
+	tr, ok = m.Evaluate(decimal.NewFromInt(100), decimal.NewFromInt(160), decimal.NewFromInt(95))
+	if !ok || tr.Rule != TakeProfit || !tr.Price.Equal(decimal.NewFromInt(150)) {
+		t.Errorf("received: %+v, expected a take profit at 150", tr)
+	}
+
+	// short positions mirror the exits
+	m.SetPosition(decimal.NewFromInt(-1), decimal.NewFromInt(100))
+	tr, ok = m.Evaluate(decimal.NewFromInt(100), decimal.NewFromInt(111), decimal.NewFromInt(99))
+	if !ok || tr.Rule != StopLoss || tr.Side != gctorder.Buy || !tr.Price.Equal(decimal.NewFromInt(110)) {
+		t.Errorf("received: %+v, expected a stop loss buy at 110", tr)
+	}
+	tr, ok = m.Evaluate(decimal.NewFromInt(100), decimal.NewFromInt(101), decimal.NewFromInt(40))
+	if !ok || tr.Rule != TakeProfit || !tr.Price.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("received: %+v, expected a take profit buy at 50", tr)
+	}
+}
+
+func TestEvaluateTrailingStop(t *testing.T) {
+	t.Parallel()
+	m, err := NewManager(&config.ExitRules{
+		StopLoss:     &config.ExitRule{Absolute: decimal.NewFromInt(20)},
+		TrailingStop: &config.ExitRule{Absolute: decimal.NewFromInt(10)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SetPosition(decimal.NewFromInt(1), decimal.NewFromInt(100))
+	// the trailing stop at 90 is tighter than the stop loss at 80
+	tr, ok := m.Evaluate(decimal.NewFromInt(100), decimal.NewFromInt(100), decimal.NewFromInt(89))
+	if !ok || tr.Rule != TrailingStop || !tr.TriggerPrice.Equal(decimal.NewFromInt(90)) {
+		t.Errorf("received: %+v, expected a trailing stop at 90", tr)
+	}
+
+	// the best price only moves the stop for the following data event
+	_, ok = m.Evaluate(decimal.NewFromInt(100), decimal.NewFromInt(130), decimal.NewFromInt(91))
+	if ok {
+		t.Error("expected no trigger")
+	}
+	tr, ok = m.Evaluate(decimal.NewFromInt(125), decimal.NewFromInt(126), decimal.NewFromInt(110))
+	if !ok || tr.Rule != TrailingStop || !tr.TriggerPrice.Equal(decimal.NewFromInt(120)) {
+		t.Errorf("received: %+v, expected a trailing stop at 120", tr)
+	}
+
+	m.SetPosition(decimal.NewFromInt(-1), decimal.NewFromInt(100))
+	_, ok = m.Evaluate(decimal.NewFromInt(100), decimal.NewFromInt(105), decimal.NewFromInt(70))
+	if ok {
+		t.Error("expected no trigger")
+	}
+	tr, ok = m.Evaluate(decimal.NewFromInt(75), decimal.NewFromInt(85), decimal.NewFromInt(74))
+	if !ok || tr.Rule != TrailingStop || tr.Side != gctorder.Buy || !tr.TriggerPrice.Equal(decimal.NewFromInt(80)) {
+		t.Errorf("received: %+v, expected a trailing stop buy at 80", tr)
+	}
+}
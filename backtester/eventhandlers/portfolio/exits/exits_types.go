@@ -0,0 +1,44 @@
+package exits
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Exit rule names attached to the orders they create
+const (
+	StopLoss     = "stop-loss"
+	TakeProfit   = "take-profit"
+	TrailingStop = "trailing-stop"
+)
+
+var errNoExitRules = errors.New("no exit rules received")
+
+// Manager tracks a currency's position and evaluates its exit rules
+// against each data event's price range
+type Manager struct {
+	rules config.ExitRules
+	// size is negative for short positions
+	size       decimal.Decimal
+	entryPrice decimal.Decimal
+	// bestPrice is the highest price since a long position was opened
+	// or the lowest price since a short position was opened
+	bestPrice decimal.Decimal
+}
+
+// Trigger details an exit rule crossed by a data event
+type Trigger struct {
+	Rule   string
+	Side   gctorder.Side
+	Amount decimal.Decimal
+	// EntryPrice is the position's average entry price
+	EntryPrice decimal.Decimal
+	// TriggerPrice is the exit rule's price when it was crossed
+	TriggerPrice decimal.Decimal
+	// Price is the price the exit fills at. It is the trigger price unless
+	// the data event opened beyond it
+	Price decimal.Decimal
+}
@@ -0,0 +1,81 @@
+package portfolio
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// closePosition creates a market order which closes the entire futures position.
+// Reducing a position requires no margin, so no funds are reserved and
+// the order is not subject to sizing or risk evaluation
+func closePosition(ev signal.Event, o *order.Order, funds funding.IPairReserver) *order.Order {
+	if !funds.IsFutures() {
+		o.AppendReason("cannot close position, not a futures contract")
+		o.SetDirection(common.DoNothing)
+		ev.SetDirection(common.DoNothing)
+		return o
+	}
+	pos := funds.GetPosition()
+	if pos.Size.IsZero() {
+		o.AppendReason("no position to close")
+		o.SetDirection(common.DoNothing)
+		ev.SetDirection(common.DoNothing)
+		return o
+	}
+	o.SetDirection(gctorder.Sell)
+	if pos.Size.IsNegative() {
+		o.SetDirection(gctorder.Buy)
+	}
+	ev.SetDirection(o.GetDirection())
+	o.Amount = pos.Size.Abs()
+	o.Price = ev.GetPrice()
+	o.OrderType = gctorder.Market
+	return o
+}
+
+// futuresSizingFunds returns the funds the size manager uses to size a futures order.
+// Buy orders are sized in quote currency and sell orders in contracts, both of which
+// include the margin's buying power and the amount required to close the current position
+func futuresSizingFunds(side gctorder.Side, price decimal.Decimal, funds funding.IPairReserver) decimal.Decimal {
+	pos := funds.GetPosition()
+	if pos.InitialMarginRatio.LessThanOrEqual(decimal.Zero) || price.LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero
+	}
+	buyingPower := funds.QuoteAvailable().Div(pos.InitialMarginRatio)
+	closable := closableAmount(&pos, side)
+	if side == gctorder.Sell {
+		return buyingPower.Div(price).Add(closable)
+	}
+	return buyingPower.Add(closable.Mul(price))
+}
+
+// reserveFuturesMargin reserves the initial margin for the portion of
+// the order which opens or increases a position
+func reserveFuturesMargin(o *order.Order, funds funding.IPairReserver) error {
+	pos := funds.GetPosition()
+	opening := o.Amount.Sub(closableAmount(&pos, o.GetDirection()))
+	margin := opening.Mul(o.Price).Mul(pos.InitialMarginRatio)
+	o.AllocatedFunds = decimal.Zero
+	if margin.LessThanOrEqual(decimal.Zero) {
+		return nil
+	}
+	err := funds.Reserve(margin, o.GetDirection())
+	if err != nil {
+		return err
+	}
+	o.AllocatedFunds = margin
+	return nil
+}
+
+// closableAmount returns the amount of the position an order on the side would close
+func closableAmount(pos *funding.Position, side gctorder.Side) decimal.Decimal {
+	if (side == gctorder.Buy && pos.Size.IsNegative()) ||
+		(side == gctorder.Sell && pos.Size.IsPositive()) {
+		return pos.Size.Abs()
+	}
+	return decimal.Zero
+}
@@ -0,0 +1,187 @@
+package portfolio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/size"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func futuresPair(t *testing.T) *funding.Pair {
+	t.Helper()
+	b, err := funding.CreateItem(testExchange, asset.Futures, currency.BTC, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Futures, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pair.SetupFutures(funding.MarginRequirements{
+		InitialMarginRatio:     decimal.NewFromFloat(0.1),
+		MaintenanceMarginRatio: decimal.NewFromFloat(0.05),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pair
+}
+
+func TestOnSignalFutures(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	p := Portfolio{
+		sizeManager: &size.Size{},
+		riskManager: &risk.Risk{
+			CanUseLeverage: true,
+			CurrencySettings: map[string]map[asset.Item]map[currency.Pair]*risk.CurrencySettings{
+				testExchange: {asset.Futures: {cp: &risk.CurrencySettings{}}},
+			},
+		},
+	}
+	_, err := p.SetupCurrencySettingsMap(testExchange, asset.Futures, cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.setHoldingsForOffset(&holdings.Holding{
+		Exchange:  testExchange,
+		Asset:     asset.Futures,
+		Pair:      cp,
+		Timestamp: time.Now(),
+		QuoteSize: decimal.NewFromInt(1000)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	funds := futuresPair(t)
+	s := &signal.Signal{
+		Base: event.Base{
+			Exchange:     testExchange,
+			CurrencyPair: cp,
+			AssetType:    asset.Futures,
+		},
+		ClosePrice: decimal.NewFromInt(100),
+		Direction:  common.ClosePosition,
+	}
+	resp, err := p.OnSignal(s, &exchange.Settings{}, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetDirection() != common.DoNothing {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), common.DoNothing)
+	}
+
+	// a short can be opened without holding any base currency
+	s.Direction = gctorder.Sell
+	resp, err = p.OnSignal(s, &exchange.Settings{}, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetDirection() != gctorder.Sell {
+		t.Fatalf("received '%v' expected '%v' %v", resp.GetDirection(), gctorder.Sell, resp.GetReason())
+	}
+	if !resp.Leverage.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", resp.Leverage, 10)
+	}
+	// 1000 / 0.1 buying power at a price of 100
+	if !resp.Amount.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", resp.Amount, 100)
+	}
+	if !resp.AllocatedFunds.Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received '%v' expected '%v'", resp.AllocatedFunds, 1000)
+	}
+	if !funds.QuoteAvailable().IsZero() {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), 0)
+	}
+	err = funds.Release(resp.AllocatedFunds, resp.AllocatedFunds, gctorder.Sell)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = funds.UpdatePosition(decimal.NewFromInt(5), decimal.NewFromInt(100), decimal.Zero, gctorder.Sell)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.Direction = common.ClosePosition
+	resp, err = p.OnSignal(s, &exchange.Settings{}, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetDirection() != gctorder.Buy {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), gctorder.Buy)
+	}
+	if !resp.Amount.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received '%v' expected '%v'", resp.Amount, 5)
+	}
+	if !resp.AllocatedFunds.IsZero() {
+		t.Errorf("received '%v' expected '%v'", resp.AllocatedFunds, 0)
+	}
+}
+
+func TestFuturesSizingFunds(t *testing.T) {
+	t.Parallel()
+	funds := futuresPair(t)
+	hundred := decimal.NewFromInt(100)
+	resp := futuresSizingFunds(gctorder.Buy, decimal.Zero, funds)
+	if !resp.IsZero() {
+		t.Errorf("received '%v' expected '%v'", resp, 0)
+	}
+	_, err := funds.UpdatePosition(decimal.NewFromInt(10), hundred, decimal.Zero, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 900 available / 0.1 margin ratio + 1000 to close the position
+	resp = futuresSizingFunds(gctorder.Sell, hundred, funds)
+	if !resp.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", resp, 100)
+	}
+	resp = futuresSizingFunds(gctorder.Buy, hundred, funds)
+	if !resp.Equal(decimal.NewFromInt(9000)) {
+		t.Errorf("received '%v' expected '%v'", resp, 9000)
+	}
+}
+
+func TestReserveFuturesMargin(t *testing.T) {
+	t.Parallel()
+	funds := futuresPair(t)
+	hundred := decimal.NewFromInt(100)
+	_, err := funds.UpdatePosition(decimal.NewFromInt(10), hundred, decimal.Zero, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := &order.Order{
+		Direction: gctorder.Sell,
+		Amount:    decimal.NewFromInt(5),
+		Price:     hundred,
+	}
+	err = reserveFuturesMargin(o, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !o.AllocatedFunds.IsZero() {
+		t.Errorf("received '%v' expected '%v'", o.AllocatedFunds, 0)
+	}
+	o.Amount = decimal.NewFromInt(15)
+	err = reserveFuturesMargin(o, funds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !o.AllocatedFunds.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("received '%v' expected '%v'", o.AllocatedFunds, 50)
+	}
+}
@@ -28,6 +28,7 @@ func Create(ev common.EventHandler, funding funding.IPairReader, riskFreeRate de
 		BaseSize:          funding.BaseInitialFunds(),
 		RiskFreeRate:      riskFreeRate,
 		TotalInitialValue: funding.BaseInitialFunds().Mul(funding.QuoteInitialFunds()).Add(funding.QuoteInitialFunds()),
+		IsFutures:         funding.IsFutures(),
 	}, nil
 }
 
@@ -46,8 +47,31 @@ func (h *Holding) UpdateValue(d common.DataEventHandler) {
 	h.updateValue(latest)
 }
 
+// UpdatePosition syncs the holding with the funding pair's futures position.
+// Futures funding can change outside of fills via funding payments, so the
+// quote size is synced as well
+func (h *Holding) UpdatePosition(f funding.IPairReader) {
+	if f == nil || !f.IsFutures() {
+		return
+	}
+	pos := f.GetPosition()
+	h.IsFutures = true
+	h.QuoteSize = f.QuoteAvailable()
+	h.BaseSize = pos.Size
+	h.EntryPrice = pos.EntryPrice
+	h.MarginPosted = pos.Margin
+	h.UnrealisedPNL = pos.UnrealisedPNL
+	h.RealisedPNL = pos.RealisedPNL
+	h.FundingPayments = pos.FundingPayments
+	h.Liquidations = pos.Liquidations
+}
+
 // HasInvestments determines whether there are any holdings in the base funds
+// or an open futures position
 func (h *Holding) HasInvestments() bool {
+	if h.IsFutures {
+		return !h.BaseSize.IsZero()
+	}
 	return h.BaseSize.GreaterThan(decimal.Zero)
 }
 
@@ -65,8 +89,13 @@ func (h *Holding) update(e fill.Event, f funding.IPairReader) {
 		price := decimal.NewFromFloat(o.Price)
 		h.BaseSize = f.BaseAvailable()
 		h.QuoteSize = f.QuoteAvailable()
+		h.UpdatePosition(f)
 		h.BaseValue = h.BaseSize.Mul(price)
 		h.TotalFees = h.TotalFees.Add(fee)
+		if direction == common.Liquidated {
+			// liquidations are trades on the opposite side of the position
+			direction = o.Side
+		}
 		switch direction {
 		case order.Buy:
 			h.BoughtAmount = h.BoughtAmount.Add(amount)
@@ -74,7 +103,8 @@ func (h *Holding) update(e fill.Event, f funding.IPairReader) {
 		case order.Sell:
 			h.SoldAmount = h.SoldAmount.Add(amount)
 			h.SoldValue = h.SoldAmount.Mul(price)
-		case common.DoNothing, common.CouldNotSell, common.CouldNotBuy, common.MissingData, common.TransferredFunds, "":
+		case common.DoNothing, common.CouldNotSell, common.CouldNotBuy, common.MissingData, common.TransferredFunds,
+			common.OrderPlaced, common.OrderExpired, common.CancelOrder, common.AmendOrder, "":
 		}
 	}
 	h.TotalValueLostToVolumeSizing = h.TotalValueLostToVolumeSizing.Add(e.GetClosePrice().Sub(e.GetVolumeAdjustedPrice()).Mul(e.GetAmount()))
@@ -90,7 +120,16 @@ func (h *Holding) updateValue(latestPrice decimal.Decimal) {
 	h.BaseValue = h.BaseSize.Mul(latestPrice)
 	h.BoughtValue = h.BoughtAmount.Mul(latestPrice)
 	h.SoldValue = h.SoldAmount.Mul(latestPrice)
-	h.TotalValue = h.BaseValue.Add(h.QuoteSize)
+	if h.IsFutures {
+		// the position itself is not owned, its value is the
+		// posted margin and any unrealised profit or loss
+		if !h.BaseSize.IsZero() {
+			h.UnrealisedPNL = h.BaseSize.Mul(latestPrice.Sub(h.EntryPrice))
+		}
+		h.TotalValue = h.QuoteSize.Add(h.MarginPosted).Add(h.UnrealisedPNL)
+	} else {
+		h.TotalValue = h.BaseValue.Add(h.QuoteSize)
+	}
 
 	h.TotalValueDifference = h.TotalValue.Sub(origTotalValue)
 	h.BoughtValueDifference = h.BoughtValue.Sub(origBoughtValue)
@@ -343,3 +343,54 @@ func TestUpdateSellStats(t *testing.T) {
 		t.Errorf("expected '%v' received '%v'", 2, h.TotalFees)
 	}
 }
+
+func TestUpdatePositionFutures(t *testing.T) {
+	t.Parallel()
+	b, err := funding.CreateItem(testExchange, asset.Futures, currency.BTC, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Futures, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.SetupFutures(funding.MarginRequirements{
+		InitialMarginRatio:     decimal.NewFromFloat(0.1),
+		MaintenanceMarginRatio: decimal.NewFromFloat(0.05),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := Create(&fill.Fill{}, p, riskFreeRate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !h.IsFutures {
+		t.Error("expected futures holdings")
+	}
+	_, err = p.UpdatePosition(decimal.NewFromInt(10), decimal.NewFromInt(100), decimal.Zero, order.Sell)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.UpdatePosition(p)
+	if !h.BaseSize.Equal(decimal.NewFromInt(-10)) {
+		t.Errorf("received '%v' expected '%v'", h.BaseSize, -10)
+	}
+	if !h.HasInvestments() {
+		t.Error("expected short position to be an investment")
+	}
+	h.UpdateValue(&kline.Kline{
+		Close: decimal.NewFromInt(90),
+	})
+	if !h.UnrealisedPNL.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", h.UnrealisedPNL, 100)
+	}
+	// 900 available + 100 margin + 100 unrealised profit
+	if !h.TotalValue.Equal(decimal.NewFromInt(1100)) {
+		t.Errorf("received '%v' expected '%v'", h.TotalValue, 1100)
+	}
+}
@@ -45,4 +45,14 @@ type Holding struct {
 	TotalValueLost               decimal.Decimal `json:"total-value-lost"`
 
 	RiskFreeRate decimal.Decimal `json:"risk-free-rate"`
+
+	// IsFutures holdings track a futures position where BaseSize is the
+	// position size in contracts, which is negative for short positions
+	IsFutures       bool            `json:"is-futures"`
+	EntryPrice      decimal.Decimal `json:"entry-price"`
+	MarginPosted    decimal.Decimal `json:"margin-posted"`
+	UnrealisedPNL   decimal.Decimal `json:"unrealised-pnl"`
+	RealisedPNL     decimal.Decimal `json:"realised-pnl"`
+	FundingPayments decimal.Decimal `json:"funding-payments"`
+	Liquidations    int64           `json:"liquidations"`
 }
@@ -89,6 +89,17 @@ func (p *Portfolio) OnSignal(ev signal.Event, cs *exchange.Settings, funds fundi
 			ev.Pair())
 	}
 
+	if ev.GetDirection() == common.CancelOrder ||
+		ev.GetDirection() == common.AmendOrder {
+		// no funds are reserved, the exchange manages resting order funding
+		o.ID = ev.GetOrderID()
+		o.OrderType = ev.GetOrderType()
+		o.Price = ev.GetLimitPrice()
+		o.TriggerPrice = ev.GetTriggerPrice()
+		o.Expiry = ev.GetExpiry()
+		return o, nil
+	}
+
 	if ev.GetDirection() == common.DoNothing ||
 		ev.GetDirection() == common.MissingData ||
 		ev.GetDirection() == common.TransferredFunds ||
@@ -96,6 +107,10 @@ func (p *Portfolio) OnSignal(ev signal.Event, cs *exchange.Settings, funds fundi
 		return o, nil
 	}
 
+	if ev.GetDirection() == common.ClosePosition {
+		return closePosition(ev, o, funds), nil
+	}
+
 	if !funds.CanPlaceOrder(ev.GetDirection()) {
 		if ev.GetDirection() == gctorder.Sell {
 			o.AppendReason("no holdings to sell")
@@ -110,12 +125,39 @@ func (p *Portfolio) OnSignal(ev signal.Event, cs *exchange.Settings, funds fundi
 
 	o.Price = ev.GetPrice()
 	o.OrderType = gctorder.Market
+	if ev.GetOrderType() != "" {
+		o.OrderType = ev.GetOrderType()
+	}
+	o.ID = ev.GetOrderID()
+	o.TriggerPrice = ev.GetTriggerPrice()
+	o.Expiry = ev.GetExpiry()
+	switch o.OrderType {
+	case gctorder.Limit, gctorder.StopLimit:
+		// resting orders are sized against the price they will be filled at
+		o.Price = ev.GetLimitPrice()
+	case gctorder.Stop, gctorder.TakeProfit:
+		o.Price = ev.GetTriggerPrice()
+	}
+	if exchange.IsRestingOrderType(o.OrderType) && o.Price.LessThanOrEqual(decimal.Zero) {
+		o.AppendReason(fmt.Sprintf("%v %v for %v order", errInvalidOrderPrice, o.Price, o.OrderType))
+		if ev.GetDirection() == gctorder.Sell {
+			o.SetDirection(common.CouldNotSell)
+		} else {
+			o.SetDirection(common.CouldNotBuy)
+		}
+		ev.SetDirection(o.Direction)
+		return o, nil
+	}
 	o.BuyLimit = ev.GetBuyLimit()
 	o.SellLimit = ev.GetSellLimit()
 	var sizingFunds decimal.Decimal
-	if ev.GetDirection() == gctorder.Sell {
+	switch {
+	case funds.IsFutures():
+		sizingFunds = futuresSizingFunds(ev.GetDirection(), o.Price, funds)
+		o.Leverage = decimal.NewFromInt(1).Div(funds.GetPosition().InitialMarginRatio)
+	case ev.GetDirection() == gctorder.Sell:
 		sizingFunds = funds.BaseAvailable()
-	} else {
+	default:
 		sizingFunds = funds.QuoteAvailable()
 	}
 	sizedOrder := p.sizeOrder(ev, cs, o, sizingFunds, funds)
@@ -177,10 +219,13 @@ func (p *Portfolio) sizeOrder(d common.Directioner, cs *exchange.Settings, origi
 		d.SetDirection(originalOrderSignal.Direction)
 		originalOrderSignal.AppendReason("sized order to 0")
 	}
-	if d.GetDirection() == gctorder.Sell {
+	switch {
+	case funds.IsFutures():
+		err = reserveFuturesMargin(sizedOrder, funds)
+	case d.GetDirection() == gctorder.Sell:
 		err = funds.Reserve(sizedOrder.Amount, gctorder.Sell)
 		sizedOrder.AllocatedFunds = sizedOrder.Amount
-	} else {
+	default:
 		err = funds.Reserve(sizedOrder.Amount.Mul(sizedOrder.Price), gctorder.Buy)
 		sizedOrder.AllocatedFunds = sizedOrder.Amount.Mul(sizedOrder.Price)
 	}
@@ -231,10 +276,22 @@ func (p *Portfolio) OnFill(ev fill.Event, funding funding.IPairReader) (*fill.Fi
 	}
 
 	direction := ev.GetDirection()
+	if lookup.ExitManager != nil && (direction == gctorder.Buy || direction == gctorder.Sell) {
+		if funding.IsFutures() {
+			pos := funding.GetPosition()
+			lookup.ExitManager.SetPosition(pos.Size, pos.EntryPrice)
+		} else if fo := ev.GetOrder(); fo != nil {
+			lookup.ExitManager.OnFill(direction, decimal.NewFromFloat(fo.Amount), ev.GetPurchasePrice())
+		}
+	}
 	if direction == common.DoNothing ||
 		direction == common.CouldNotBuy ||
 		direction == common.CouldNotSell ||
 		direction == common.MissingData ||
+		direction == common.OrderPlaced ||
+		direction == common.OrderExpired ||
+		direction == common.CancelOrder ||
+		direction == common.AmendOrder ||
 		direction == "" {
 		fe, ok := ev.(*fill.Fill)
 		if !ok {
@@ -251,6 +308,61 @@ func (p *Portfolio) OnFill(ev fill.Event, funding funding.IPairReader) (*fill.Fi
 	return fe, nil
 }
 
+// CheckExits evaluates the currency's exit rules against the data event's price range.
+// When an exit is crossed, a market order closing the entire position is returned.
+// Like closing a position, the order is not subject to sizing or risk evaluation.
+// Nil is returned when the currency has no exit rules or no exit was crossed
+func (p *Portfolio) CheckExits(ev common.DataEventHandler, funds funding.IPairReserver) (*order.Order, error) {
+	if ev == nil || funds == nil {
+		return nil, common.ErrNilArguments
+	}
+	lookup := p.exchangeAssetPairSettings[ev.GetExchange()][ev.GetAssetType()][ev.Pair()]
+	if lookup == nil {
+		return nil, fmt.Errorf("%w for %v %v %v", errNoPortfolioSettings, ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	}
+	if lookup.ExitManager == nil {
+		return nil, nil
+	}
+	t, ok := lookup.ExitManager.Evaluate(ev.OpenPrice(), ev.HighPrice(), ev.LowPrice())
+	if !ok {
+		return nil, nil
+	}
+	amount := t.Amount
+	if !funds.IsFutures() {
+		// holdings can be reserved by resting sell orders, only what is available can be sold
+		if available := funds.BaseAvailable(); amount.GreaterThan(available) {
+			amount = available
+		}
+		if amount.LessThanOrEqual(decimal.Zero) {
+			return nil, nil
+		}
+	}
+	o := &order.Order{
+		Base: event.Base{
+			Offset:       ev.GetOffset(),
+			Exchange:     ev.GetExchange(),
+			Time:         ev.GetTime(),
+			CurrencyPair: ev.Pair(),
+			AssetType:    ev.GetAssetType(),
+			Interval:     ev.GetInterval(),
+			Reason:       fmt.Sprintf("%v triggered at %v for position entered at %v", t.Rule, t.TriggerPrice, t.EntryPrice),
+		},
+		Direction: t.Side,
+		Price:     t.Price,
+		Amount:    amount,
+		OrderType: gctorder.Market,
+		ExitRule:  t.Rule,
+	}
+	if !funds.IsFutures() {
+		err := funds.Reserve(amount, gctorder.Sell)
+		if err != nil {
+			return nil, err
+		}
+		o.AllocatedFunds = amount
+	}
+	return o, nil
+}
+
 // addComplianceSnapshot gets the previous snapshot of compliance events, updates with the latest fillevent
 // then saves the snapshot to the c
 func (p *Portfolio) addComplianceSnapshot(fillEvent fill.Event) error {
@@ -273,6 +385,7 @@ func (p *Portfolio) addComplianceSnapshot(fillEvent fill.Event) error {
 			SlippageRate:        fillEvent.GetSlippageRate(),
 			Detail:              fo,
 			CostBasis:           price.Mul(amount).Add(fee),
+			ExitRule:            fillEvent.GetExitRule(),
 		}
 		prevSnap.Orders = append(prevSnap.Orders, snapOrder)
 	}
@@ -330,6 +443,7 @@ func (p *Portfolio) UpdateHoldings(ev common.DataEventHandler, funds funding.IPa
 			return err
 		}
 	}
+	h.UpdatePosition(funds)
 	h.UpdateValue(ev)
 	err := p.setHoldingsForOffset(&h, true)
 	if errors.Is(err, errNoHoldings) {
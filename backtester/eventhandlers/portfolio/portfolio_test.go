@@ -7,8 +7,10 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/exits"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/settings"
@@ -518,3 +520,88 @@ func TestOnSignal(t *testing.T) {
 		t.Error("expected an amount to be sized")
 	}
 }
+
+func TestCheckExits(t *testing.T) {
+	t.Parallel()
+	p := Portfolio{}
+	_, err := p.CheckExits(nil, nil)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilArguments)
+	}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	k := &kline.Kline{
+		Base: event.Base{
+			Exchange:     testExchange,
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+		},
+		Open:  decimal.NewFromInt(100),
+		High:  decimal.NewFromInt(101),
+		Low:   decimal.NewFromInt(85),
+		Close: decimal.NewFromInt(95),
+	}
+	b, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.NewFromInt(1), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, currency.USDT, decimal.NewFromInt(100), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = p.CheckExits(k, pair)
+	if !errors.Is(err, errNoPortfolioSettings) {
+		t.Errorf("received: %v, expected: %v", err, errNoPortfolioSettings)
+	}
+
+	s, err := p.SetupCurrencySettingsMap(testExchange, asset.Spot, cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, err := p.CheckExits(k, pair)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if o != nil {
+		t.Error("expected no order without exit rules")
+	}
+
+	s.ExitManager, err = exits.NewManager(&config.ExitRules{
+		StopLoss: &config.ExitRule{Percent: decimal.NewFromInt(10)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the position is larger than the available holdings
+	s.ExitManager.OnFill(gctorder.Buy, decimal.NewFromInt(2), decimal.NewFromInt(100))
+	o, err = p.CheckExits(k, pair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if o == nil {
+		t.Fatal("expected stop loss order")
+	}
+	if o.ExitRule != exits.StopLoss ||
+		o.Direction != gctorder.Sell ||
+		o.OrderType != gctorder.Market ||
+		!o.Price.Equal(decimal.NewFromInt(90)) ||
+		!o.Amount.Equal(decimal.NewFromInt(1)) ||
+		!o.AllocatedFunds.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %+v, expected a stop loss market sell of 1 at 90", o)
+	}
+	if !pair.BaseAvailable().IsZero() {
+		t.Errorf("received: %v, expected: %v", pair.BaseAvailable(), 0)
+	}
+
+	// nothing is left to sell
+	o, err = p.CheckExits(k, pair)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if o != nil {
+		t.Error("expected no order without holdings")
+	}
+}
@@ -30,6 +30,7 @@ var (
 	errNoHoldings           = errors.New("no holdings found")
 	errHoldingsNoTimestamp  = errors.New("holding with unset timestamp received")
 	errHoldingsAlreadySet   = errors.New("holding already set")
+	errInvalidOrderPrice    = errors.New("invalid order price")
 )
 
 // Portfolio stores all holdings and rules to assess orders, allowing the portfolio manager to
@@ -45,6 +46,7 @@ type Portfolio struct {
 type Handler interface {
 	OnSignal(signal.Event, *exchange.Settings, funding.IPairReserver) (*order.Order, error)
 	OnFill(fill.Event, funding.IPairReader) (*fill.Fill, error)
+	CheckExits(common.DataEventHandler, funding.IPairReserver) (*order.Order, error)
 
 	ViewHoldingAtTimePeriod(common.EventHandler) (*holdings.Holding, error)
 	setHoldingsForOffset(*holdings.Holding, bool) error
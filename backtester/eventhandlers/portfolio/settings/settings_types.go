@@ -4,6 +4,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/exits"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 )
 
@@ -16,4 +17,6 @@ type Settings struct {
 	Leverage          config.Leverage
 	HoldingsSnapshots []holdings.Holding
 	ComplianceManager compliance.Manager
+	// ExitManager is set when the currency has exit rules
+	ExitManager *exits.Manager
 }
@@ -8,6 +8,10 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/robustness"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
@@ -34,6 +38,9 @@ func (c *CurrencyStatistic) CalculateResults(f funding.IPairReader) error {
 		} else if last.Transactions.Orders[i].Side == gctorder.Sell {
 			c.SellOrders++
 		}
+		if last.Transactions.Orders[i].ExitRule != "" {
+			c.addExit(&last.Transactions.Orders[i], lastPrice)
+		}
 	}
 	for i := range c.Events {
 		price := c.Events[i].DataEvent.ClosePrice()
@@ -51,6 +58,13 @@ func (c *CurrencyStatistic) CalculateResults(f funding.IPairReader) error {
 		c.StrategyMovement = last.Holdings.TotalValue.Sub(first.Holdings.TotalValue).Div(first.Holdings.TotalValue).Mul(oneHundred)
 	}
 	c.calculateHighestCommittedFunds()
+	if last.Holdings.IsFutures {
+		c.IsFutures = true
+		c.RealisedPNL = last.Holdings.RealisedPNL
+		c.UnrealisedPNL = last.Holdings.UnrealisedPNL
+		c.FundingPayments = last.Holdings.FundingPayments
+		c.Liquidations = last.Holdings.Liquidations
+	}
 	c.RiskFreeRate = last.Holdings.RiskFreeRate.Mul(oneHundred)
 	returnPerCandle := make([]decimal.Decimal, len(c.Events))
 	benchmarkRates := make([]decimal.Decimal, len(c.Events))
@@ -224,6 +238,41 @@ func (c *CurrencyStatistic) PrintResults(e string, a asset.Item, p currency.Pair
 	log.Infof(log.BackTester, "%s Sell amount: %v %v", sep, last.Holdings.SoldAmount.Round(8), last.Holdings.Pair.Base)
 	log.Infof(log.BackTester, "%s Total orders: %d\n\n", sep, c.TotalOrders)
 
+	if c.IsFutures {
+		log.Info(log.BackTester, "------------------Futures-----------------------------------------------")
+		log.Infof(log.BackTester, "%s Realised PNL: %v", sep, c.RealisedPNL.Round(8))
+		log.Infof(log.BackTester, "%s Unrealised PNL: %v", sep, c.UnrealisedPNL.Round(8))
+		log.Infof(log.BackTester, "%s Funding payments: %v", sep, c.FundingPayments.Round(8))
+		log.Infof(log.BackTester, "%s Liquidations: %d", sep, c.Liquidations)
+		log.Infof(log.BackTester, "%s Final position entry price: %v", sep, last.Holdings.EntryPrice.Round(8))
+		log.Infof(log.BackTester, "%s Final margin posted: %v\n\n", sep, last.Holdings.MarginPosted.Round(8))
+	}
+
+	if c.Robustness != nil {
+		log.Info(log.BackTester, "------------------Robustness--------------------------------------------")
+		log.Infof(log.BackTester, "%s Method: %v over %v iterations", sep, c.Robustness.Method, c.Robustness.Iterations)
+		log.Infof(log.BackTester, "%s Final equity mean: %v median: %v", sep, c.Robustness.FinalEquity.Mean.Round(8), c.Robustness.FinalEquity.Median.Round(8))
+		log.Infof(log.BackTester, "%s Final equity %v%% confidence interval: %v to %v", sep, c.Robustness.ConfidenceLevel.Mul(decimal.NewFromInt(100)), c.Robustness.FinalEquity.Lower.Round(8), c.Robustness.FinalEquity.Upper.Round(8))
+		log.Infof(log.BackTester, "%s Max drawdown mean: %v%% median: %v%%", sep, c.Robustness.MaxDrawdown.Mean.Round(2), c.Robustness.MaxDrawdown.Median.Round(2))
+		log.Infof(log.BackTester, "%s Max drawdown %v%% confidence interval: %v%% to %v%%", sep, c.Robustness.ConfidenceLevel.Mul(decimal.NewFromInt(100)), c.Robustness.MaxDrawdown.Lower.Round(2), c.Robustness.MaxDrawdown.Upper.Round(2))
+		log.Infof(log.BackTester, "%s Probability of losing %v%%: %v%%\n\n", sep, c.Robustness.RuinThreshold, c.Robustness.RuinProbability.Round(2))
+	}
+
+	if len(c.Exits) > 0 {
+		log.Info(log.BackTester, "------------------Exits-------------------------------------------------")
+		for i := range c.Exits {
+			log.Infof(log.BackTester, "%s %v %v %v at %v on %v, difference to holding: %v",
+				sep,
+				c.Exits[i].Rule,
+				c.Exits[i].Side,
+				c.Exits[i].Amount.Round(8),
+				c.Exits[i].Price.Round(8),
+				c.Exits[i].Time,
+				c.Exits[i].Difference.Round(8))
+		}
+		log.Infof(log.BackTester, "%s Total difference to holding: %v\n\n", sep, c.ExitDifference.Round(8))
+	}
+
 	log.Info(log.BackTester, "------------------Max Drawdown-------------------------------")
 	log.Infof(log.BackTester, "%s Highest Price of drawdown: %v", sep, c.MaxDrawdown.Highest.Price.Round(8))
 	log.Infof(log.BackTester, "%s Time of highest price of drawdown: %v", sep, c.MaxDrawdown.Highest.Time)
@@ -294,6 +343,113 @@ func (c *CurrencyStatistic) PrintResults(e string, a asset.Item, p currency.Pair
 	}
 }
 
+// AnalyseRobustness resamples the return of each trade to determine whether
+// the strategy's results depend on the order its trades occurred in
+func (c *CurrencyStatistic) AnalyseRobustness(settings *config.RobustnessSettings) error {
+	if len(c.Events) == 0 {
+		return errNoEvents
+	}
+	var err error
+	c.Robustness, err = robustness.Analyse(c.Events[0].Holdings.TotalValue, c.returnPerTrade(), settings)
+	return err
+}
+
+// returnPerTrade replays the fill events to find each round trip. A trade opens
+// when a fill moves the position away from flat and closes when a fill returns
+// it to flat, so candles without a position are not sampled. A fill which flips
+// the position closes one trade and opens the next. Holdings not bought by a
+// fill open a trade at the first close price and a trade still open at the end
+// is valued at the final close price
+func (c *CurrencyStatistic) returnPerTrade() []decimal.Decimal {
+	var (
+		returns  []decimal.Decimal
+		basis    decimal.Decimal
+		cashFlow decimal.Decimal
+	)
+	closeTrade := func() {
+		if !basis.IsZero() {
+			returns = append(returns, cashFlow.Div(basis))
+		}
+		basis = decimal.Zero
+		cashFlow = decimal.Zero
+	}
+	first := c.Events[0]
+	position := first.Holdings.BaseSize
+	for i := range first.FillEvents {
+		position = position.Sub(signedFillAmount(first.FillEvents[i]))
+	}
+	if !position.IsZero() {
+		basis = position.Abs().Mul(first.DataEvent.ClosePrice())
+		cashFlow = position.Neg().Mul(first.DataEvent.ClosePrice())
+	}
+	for i := range c.Events {
+		for j := range c.Events[i].FillEvents {
+			f := c.Events[i].FillEvents[j]
+			amount := signedFillAmount(f)
+			if amount.IsZero() {
+				continue
+			}
+			price := f.GetPurchasePrice()
+			cashFlow = cashFlow.Sub(f.GetExchangeFee())
+			if !position.IsZero() && position.Sign() != amount.Sign() {
+				closing := amount
+				if amount.Abs().GreaterThan(position.Abs()) {
+					closing = position.Neg()
+				}
+				cashFlow = cashFlow.Sub(closing.Mul(price))
+				position = position.Add(closing)
+				amount = amount.Sub(closing)
+				if position.IsZero() {
+					closeTrade()
+				}
+			}
+			if !amount.IsZero() {
+				basis = basis.Add(amount.Abs().Mul(price))
+				cashFlow = cashFlow.Sub(amount.Mul(price))
+				position = position.Add(amount)
+			}
+		}
+	}
+	last := c.Events[len(c.Events)-1]
+	if !position.IsZero() {
+		cashFlow = cashFlow.Add(position.Mul(last.DataEvent.ClosePrice()))
+		closeTrade()
+	}
+	return returns
+}
+
+// signedFillAmount returns a fill's amount as a change in position,
+// or zero when the fill did not buy or sell
+func signedFillAmount(f fill.Event) decimal.Decimal {
+	switch f.GetDirection() {
+	case gctorder.Buy:
+		return f.GetAmount()
+	case gctorder.Sell:
+		return f.GetAmount().Neg()
+	}
+	return decimal.Zero
+}
+
+// addExit records an order placed by an exit rule along with what it saved
+// compared with holding the position until the final close price
+func (c *CurrencyStatistic) addExit(o *compliance.SnapshotOrder, lastPrice decimal.Decimal) {
+	price := decimal.NewFromFloat(o.Price)
+	amount := decimal.NewFromFloat(o.Amount)
+	difference := price.Sub(lastPrice).Mul(amount)
+	if o.Side == gctorder.Buy {
+		difference = difference.Neg()
+	}
+	c.Exits = append(c.Exits, Exit{
+		Time:       o.Date,
+		Rule:       o.ExitRule,
+		Side:       o.Side,
+		Amount:     amount,
+		Price:      price,
+		Difference: difference,
+	})
+	c.ExitDifference = c.ExitDifference.Add(difference)
+}
+
 func calculateMaxDrawdown(closePrices []common.DataEventHandler) Swing {
 	var lowestPrice, highestPrice decimal.Decimal
 	var lowestTime, highestTime time.Time
@@ -387,10 +543,16 @@ func calculateMaxDrawdown(closePrices []common.DataEventHandler) Swing {
 	return maxDrawdown
 }
 
+// calculateHighestCommittedFunds determines the highest value of holdings.
+// Futures positions commit their posted margin rather than their notional value
 func (c *CurrencyStatistic) calculateHighestCommittedFunds() {
 	for i := range c.Events {
-		if c.Events[i].Holdings.BaseSize.Mul(c.Events[i].DataEvent.ClosePrice()).GreaterThan(c.HighestCommittedFunds.Value) {
-			c.HighestCommittedFunds.Value = c.Events[i].Holdings.BaseSize.Mul(c.Events[i].DataEvent.ClosePrice())
+		committed := c.Events[i].Holdings.BaseSize.Mul(c.Events[i].DataEvent.ClosePrice())
+		if c.Events[i].Holdings.IsFutures {
+			committed = c.Events[i].Holdings.MarginPosted
+		}
+		if committed.GreaterThan(c.HighestCommittedFunds.Value) {
+			c.HighestCommittedFunds.Value = committed
 			c.HighestCommittedFunds.Time = c.Events[i].Holdings.Timestamp
 		}
 	}
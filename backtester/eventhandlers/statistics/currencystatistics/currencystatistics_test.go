@@ -1,14 +1,17 @@
 package currencystatistics
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
@@ -350,4 +353,116 @@ func TestCalculateHighestCommittedFunds(t *testing.T) {
 	if c.HighestCommittedFunds.Time != tt2 {
 		t.Errorf("expected %v, received %v", tt2, c.HighestCommittedFunds.Time)
 	}
+
+	c = CurrencyStatistic{}
+	c.Events = append(c.Events,
+		EventStore{DataEvent: &kline.Kline{Close: decimal.NewFromInt(1337)}, Holdings: holdings.Holding{Timestamp: tt1, IsFutures: true, BaseSize: decimal.NewFromInt(-1337), MarginPosted: decimal.NewFromInt(10)}},
+		EventStore{DataEvent: &kline.Kline{Close: decimal.NewFromInt(1338)}, Holdings: holdings.Holding{Timestamp: tt2, IsFutures: true, BaseSize: decimal.NewFromInt(1), MarginPosted: decimal.NewFromInt(11)}},
+	)
+	c.calculateHighestCommittedFunds()
+	if c.HighestCommittedFunds.Time != tt2 {
+		t.Errorf("expected %v, received %v", tt2, c.HighestCommittedFunds.Time)
+	}
+	if !c.HighestCommittedFunds.Value.Equal(decimal.NewFromInt(11)) {
+		t.Errorf("expected %v, received %v", 11, c.HighestCommittedFunds.Value)
+	}
+}
+
+func TestAddExit(t *testing.T) {
+	t.Parallel()
+	c := CurrencyStatistic{}
+	tt := time.Now()
+	c.addExit(&compliance.SnapshotOrder{
+		ExitRule: "stop-loss",
+		Detail: &order.Detail{
+			Side:   order.Sell,
+			Price:  90,
+			Amount: 2,
+			Date:   tt,
+		},
+	}, decimal.NewFromInt(80))
+	if len(c.Exits) != 1 {
+		t.Fatalf("received: %v, expected: %v", len(c.Exits), 1)
+	}
+	if !c.Exits[0].Difference.Equal(decimal.NewFromInt(20)) || !c.Exits[0].Time.Equal(tt) {
+		t.Errorf("received: %+v, expected a difference of 20", c.Exits[0])
+	}
+
+	// closing a short which the price then fell below cost money
+	c.addExit(&compliance.SnapshotOrder{
+		ExitRule: "take-profit",
+		Detail: &order.Detail{
+			Side:   order.Buy,
+			Price:  90,
+			Amount: 1,
+		},
+	}, decimal.NewFromInt(80))
+	if !c.Exits[1].Difference.Equal(decimal.NewFromInt(-10)) {
+		t.Errorf("received: %v, expected: %v", c.Exits[1].Difference, -10)
+	}
+	if !c.ExitDifference.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received: %v, expected: %v", c.ExitDifference, 10)
+	}
+}
+
+func TestAnalyseRobustness(t *testing.T) {
+	t.Parallel()
+	c := CurrencyStatistic{}
+	s := &config.RobustnessSettings{
+		Method:          config.ShuffleMethod,
+		Iterations:      10,
+		ConfidenceLevel: decimal.NewFromFloat(0.95),
+		RuinThreshold:   decimal.NewFromInt(50),
+		Seed:            1,
+	}
+	err := c.AnalyseRobustness(s)
+	if !errors.Is(err, errNoEvents) {
+		t.Errorf("received: %v, expected: %v", err, errNoEvents)
+	}
+	buy := func(amount, price int64) fill.Event {
+		return &fill.Fill{Direction: order.Buy, Amount: decimal.NewFromInt(amount), PurchasePrice: decimal.NewFromInt(price)}
+	}
+	sell := func(amount, price int64) fill.Event {
+		return &fill.Fill{Direction: order.Sell, Amount: decimal.NewFromInt(amount), PurchasePrice: decimal.NewFromInt(price)}
+	}
+	candle := func(price int64) common.DataEventHandler {
+		return &kline.Kline{Close: decimal.NewFromInt(price)}
+	}
+	c.Events = []EventStore{
+		{DataEvent: candle(100), Holdings: holdings.Holding{TotalValue: decimal.NewFromInt(100)}},
+		{DataEvent: candle(100), FillEvents: []fill.Event{buy(1, 100)}},
+		{DataEvent: candle(120)},
+	}
+	err = c.AnalyseRobustness(s)
+	if err == nil {
+		t.Error("expected an error when only one trade has been made")
+	}
+
+	// an exit and a new entry on the same candle are separate trades, the
+	// flat candle is not sampled and the final short is valued at the close
+	c.Events = append(c.Events,
+		EventStore{DataEvent: candle(110), FillEvents: []fill.Event{sell(1, 110), buy(1, 110)}},
+		EventStore{DataEvent: candle(99), FillEvents: []fill.Event{sell(2, 99)}},
+		EventStore{DataEvent: candle(99)},
+	)
+	returns := c.returnPerTrade()
+	expected := []decimal.Decimal{decimal.NewFromFloat(0.1), decimal.NewFromFloat(-0.1), decimal.Zero}
+	if len(returns) != len(expected) {
+		t.Fatalf("received: %v, expected: %v", returns, expected)
+	}
+	for i := range expected {
+		if !returns[i].Equal(expected[i]) {
+			t.Errorf("received: %v, expected: %v", returns[i], expected[i])
+		}
+	}
+	err = c.AnalyseRobustness(s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if c.Robustness == nil || c.Robustness.Iterations != 10 {
+		t.Fatalf("received: %+v, expected robustness results", c.Robustness)
+	}
+	if !c.Robustness.FinalEquity.Median.Round(8).Equal(decimal.NewFromInt(99)) {
+		t.Errorf("received: %v, expected: %v", c.Robustness.FinalEquity.Median, 99)
+	}
 }
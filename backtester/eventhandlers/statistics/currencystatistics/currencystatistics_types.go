@@ -1,17 +1,22 @@
 package currencystatistics
 
 import (
+	"errors"
 	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/robustness"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
+var errNoEvents = errors.New("no events to analyse")
+
 // CurrencyStats defines what is expected in order to
 // calculate statistics based on an exchange, asset type and currency pair
 type CurrencyStats interface {
@@ -23,14 +28,15 @@ type CurrencyStats interface {
 }
 
 // EventStore is used to hold all event information
-// at a time interval
+// at a time interval. A resting order can fill on the same
+// interval as an order placed by the strategy, so every fill is kept
 type EventStore struct {
 	Holdings     holdings.Holding
 	Transactions compliance.Snapshot
 	DataEvent    common.DataEventHandler
 	SignalEvent  signal.Event
 	OrderEvent   order.Event
-	FillEvent    fill.Event
+	FillEvents   []fill.Event
 }
 
 // CurrencyStatistic Holds all events and statistics relevant to an exchange, asset type and currency pair
@@ -57,6 +63,26 @@ type CurrencyStatistic struct {
 	ShowMissingDataWarning       bool                  `json:"-"`
 	IsStrategyProfitable         bool                  `json:"is-strategy-profitable"`
 	DoesPerformanceBeatTheMarket bool                  `json:"does-performance-beat-the-market"`
+	IsFutures                    bool                  `json:"is-futures"`
+	RealisedPNL                  decimal.Decimal       `json:"realised-pnl"`
+	UnrealisedPNL                decimal.Decimal       `json:"unrealised-pnl"`
+	FundingPayments              decimal.Decimal       `json:"funding-payments"`
+	Liquidations                 int64                 `json:"liquidations"`
+	Exits                        []Exit                `json:"exits,omitempty"`
+	ExitDifference               decimal.Decimal       `json:"exit-difference"`
+	Robustness                   *robustness.Result    `json:"robustness,omitempty"`
+}
+
+// Exit is an order placed by the portfolio manager's exit rules
+type Exit struct {
+	Time   time.Time       `json:"time"`
+	Rule   string          `json:"rule"`
+	Side   gctorder.Side   `json:"side"`
+	Amount decimal.Decimal `json:"amount"`
+	Price  decimal.Decimal `json:"price"`
+	// Difference is what the exit saved compared with holding the position
+	// until the final close price. It is negative when the exit cost money
+	Difference decimal.Decimal `json:"difference"`
 }
 
 // Ratios stores all the ratios used for statistics
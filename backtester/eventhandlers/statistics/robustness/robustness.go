@@ -0,0 +1,139 @@
+package robustness
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+)
+
+// Analyse resamples the returns of a run over the configured iterations
+// and reports the distribution of final equity, drawdown and the
+// probability of ruin. Returns are fractional changes in equity,
+// eg 0.01 for a 1% increase
+func Analyse(initialEquity decimal.Decimal, returns []decimal.Decimal, settings *config.RobustnessSettings) (*Result, error) {
+	if settings == nil {
+		return nil, errNilSettings
+	}
+	if initialEquity.LessThanOrEqual(decimal.Zero) {
+		return nil, fmt.Errorf("%w, received %v", errInvalidInitialEquity, initialEquity)
+	}
+	if len(returns) < 2 {
+		return nil, fmt.Errorf("%w, received %v", errNotEnoughReturns, len(returns))
+	}
+	if settings.Iterations <= 0 {
+		return nil, fmt.Errorf("%w, received %v", errInvalidIterations, settings.Iterations)
+	}
+
+	r := make([]float64, len(returns))
+	for i := range returns {
+		r[i], _ = returns[i].Float64()
+	}
+	start, _ := initialEquity.Float64()
+	ruinPercent, _ := settings.RuinThreshold.Float64()
+	ruinLevel := start * (1 - ruinPercent/100)
+
+	seed := settings.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(seed)) // nolint:gosec // basic number generation required, no need for crypto/rand
+
+	finalEquity := make([]float64, settings.Iterations)
+	maxDrawdown := make([]float64, settings.Iterations)
+	path := make([]float64, len(r))
+	var ruined int64
+	for i := int64(0); i < settings.Iterations; i++ {
+		switch settings.Method {
+		case config.ShuffleMethod:
+			copy(path, r)
+			rng.Shuffle(len(path), func(x, y int) {
+				path[x], path[y] = path[y], path[x]
+			})
+		default:
+			for j := range path {
+				path[j] = r[rng.Intn(len(r))]
+			}
+		}
+		var isRuined bool
+		finalEquity[i], maxDrawdown[i], isRuined = walk(start, ruinLevel, path)
+		if isRuined {
+			ruined++
+		}
+	}
+
+	return &Result{
+		Method:          settings.Method,
+		Iterations:      settings.Iterations,
+		ConfidenceLevel: settings.ConfidenceLevel,
+		RuinThreshold:   settings.RuinThreshold,
+		FinalEquity:     distribute(finalEquity, settings.ConfidenceLevel),
+		MaxDrawdown:     distribute(maxDrawdown, settings.ConfidenceLevel),
+		RuinProbability: decimal.NewFromInt(ruined).Div(decimal.NewFromInt(settings.Iterations)).Mul(decimal.NewFromInt(100)),
+	}, nil
+}
+
+// walk applies the returns to the starting equity in order, returning the
+// final equity, the maximum drawdown percentage and whether equity ever
+// fell to the ruin level
+func walk(start, ruinLevel float64, returns []float64) (finalEquity, maxDrawdown float64, ruined bool) {
+	equity, peak := start, start
+	for i := range returns {
+		equity *= 1 + returns[i]
+		if equity > peak {
+			peak = equity
+		}
+		if peak > 0 {
+			if drawdown := (peak - equity) / peak * 100; drawdown > maxDrawdown {
+				maxDrawdown = drawdown
+			}
+		}
+		if equity <= ruinLevel {
+			ruined = true
+		}
+	}
+	return equity, maxDrawdown, ruined
+}
+
+// distribute summarises the values with a confidence interval centred on
+// the median
+func distribute(values []float64, confidenceLevel decimal.Decimal) Distribution {
+	if len(values) == 0 {
+		return Distribution{}
+	}
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	var sum float64
+	for i := range sorted {
+		sum += sorted[i]
+	}
+	cl, _ := confidenceLevel.Float64()
+	tail := (1 - cl) / 2
+	return Distribution{
+		Mean:   decimal.NewFromFloat(sum / float64(len(sorted))),
+		Median: decimal.NewFromFloat(percentile(sorted, 0.5)),
+		Lower:  decimal.NewFromFloat(percentile(sorted, tail)),
+		Upper:  decimal.NewFromFloat(percentile(sorted, 1-tail)),
+		Min:    decimal.NewFromFloat(sorted[0]),
+		Max:    decimal.NewFromFloat(sorted[len(sorted)-1]),
+	}
+}
+
+// percentile linearly interpolates the pth percentile of sorted values
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	rank := p * float64(len(sorted)-1)
+	lower := math.Floor(rank)
+	upper := math.Ceil(rank)
+	if lower == upper {
+		return sorted[int(rank)]
+	}
+	return sorted[int(lower)] + (rank-lower)*(sorted[int(upper)]-sorted[int(lower)])
+}
@@ -0,0 +1,113 @@
+package robustness
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+)
+
+func TestAnalyse(t *testing.T) {
+	t.Parallel()
+	returns := []decimal.Decimal{
+		decimal.NewFromFloat(0.1),
+		decimal.NewFromFloat(-0.2),
+		decimal.NewFromFloat(0.05),
+		decimal.NewFromFloat(0.3),
+	}
+	_, err := Analyse(decimal.NewFromInt(100), returns, nil)
+	if !errors.Is(err, errNilSettings) {
+		t.Errorf("received: %v, expected: %v", err, errNilSettings)
+	}
+	s := &config.RobustnessSettings{
+		Method:          config.ShuffleMethod,
+		ConfidenceLevel: decimal.NewFromFloat(0.9),
+		RuinThreshold:   decimal.NewFromInt(15),
+		Seed:            1337,
+	}
+	_, err = Analyse(decimal.Zero, returns, s)
+	if !errors.Is(err, errInvalidInitialEquity) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidInitialEquity)
+	}
+	_, err = Analyse(decimal.NewFromInt(100), returns[:1], s)
+	if !errors.Is(err, errNotEnoughReturns) {
+		t.Errorf("received: %v, expected: %v", err, errNotEnoughReturns)
+	}
+	_, err = Analyse(decimal.NewFromInt(100), returns, s)
+	if !errors.Is(err, errInvalidIterations) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidIterations)
+	}
+
+	s.Iterations = 500
+	r, err := Analyse(decimal.NewFromInt(100), returns, s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	// shuffling keeps every return so the final equity never changes
+	expected := decimal.NewFromFloat(120.12)
+	if !r.FinalEquity.Min.Round(8).Equal(expected) || !r.FinalEquity.Max.Round(8).Equal(expected) {
+		t.Errorf("received: %v %v, expected: %v", r.FinalEquity.Min, r.FinalEquity.Max, expected)
+	}
+	// only orderings without enough gains before the 20% loss are ruined
+	if r.RuinProbability.LessThanOrEqual(decimal.Zero) || r.RuinProbability.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+		t.Errorf("received: %v, expected a ruin probability between 0 and 100", r.RuinProbability)
+	}
+	if !r.MaxDrawdown.Min.Round(8).Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received: %v, expected: %v", r.MaxDrawdown.Min, 20)
+	}
+
+	s.Method = config.BootstrapMethod
+	r, err = Analyse(decimal.NewFromInt(100), returns, s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if r.FinalEquity.Min.Equal(r.FinalEquity.Max) {
+		t.Error("expected bootstrapped final equity to vary")
+	}
+	if r.FinalEquity.Lower.GreaterThan(r.FinalEquity.Median) || r.FinalEquity.Upper.LessThan(r.FinalEquity.Median) {
+		t.Errorf("received: %+v, expected the median within the confidence interval", r.FinalEquity)
+	}
+	r2, err := Analyse(decimal.NewFromInt(100), returns, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.FinalEquity.Mean.Equal(r2.FinalEquity.Mean) {
+		t.Errorf("received: %v, expected: %v, the same seed should reproduce results", r2.FinalEquity.Mean, r.FinalEquity.Mean)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+	equity, drawdown, ruined := walk(100, 80, []float64{0.5, -0.5, 0.1})
+	if equity < 82.49 || equity > 82.51 {
+		t.Errorf("received: %v, expected: %v", equity, 82.5)
+	}
+	if drawdown != 50 {
+		t.Errorf("received: %v, expected: %v", drawdown, 50)
+	}
+	if !ruined {
+		t.Error("expected equity of 75 to be ruined")
+	}
+}
+
+func TestDistribute(t *testing.T) {
+	t.Parallel()
+	d := distribute(nil, decimal.NewFromFloat(0.9))
+	if !d.Mean.IsZero() {
+		t.Errorf("received: %v, expected: %v", d.Mean, 0)
+	}
+	d = distribute([]float64{5, 1, 4, 2, 3}, decimal.NewFromFloat(0.5))
+	if !d.Median.Equal(decimal.NewFromInt(3)) || !d.Mean.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("received: %v %v, expected: %v", d.Median, d.Mean, 3)
+	}
+	if !d.Lower.Equal(decimal.NewFromInt(2)) || !d.Upper.Equal(decimal.NewFromInt(4)) {
+		t.Errorf("received: %v %v, expected: %v %v", d.Lower, d.Upper, 2, 4)
+	}
+	if !d.Min.Equal(decimal.NewFromInt(1)) || !d.Max.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received: %v %v, expected: %v %v", d.Min, d.Max, 1, 5)
+	}
+	if percentile([]float64{7}, 0.3) != 7 {
+		t.Error("expected a single value to be every percentile")
+	}
+}
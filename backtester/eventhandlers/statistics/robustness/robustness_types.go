@@ -0,0 +1,41 @@
+package robustness
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	errNilSettings          = errors.New("nil robustness settings received")
+	errNotEnoughReturns     = errors.New("at least two returns are required to analyse robustness")
+	errInvalidInitialEquity = errors.New("initial equity must be greater than zero")
+	errInvalidIterations    = errors.New("iterations must be greater than zero")
+)
+
+// Result holds the distribution of outcomes from resampling a run's returns
+type Result struct {
+	Method          string          `json:"method"`
+	Iterations      int64           `json:"iterations"`
+	ConfidenceLevel decimal.Decimal `json:"confidence-level"`
+	RuinThreshold   decimal.Decimal `json:"ruin-threshold"`
+	// FinalEquity is the distribution of equity at the end of each iteration
+	FinalEquity Distribution `json:"final-equity"`
+	// MaxDrawdown is the distribution of each iteration's largest
+	// peak to trough decline as a percentage
+	MaxDrawdown Distribution `json:"max-drawdown"`
+	// RuinProbability is the percentage of iterations where equity
+	// fell below the ruin threshold at any point
+	RuinProbability decimal.Decimal `json:"ruin-probability"`
+}
+
+// Distribution summarises a set of simulated outcomes. Lower and Upper
+// are the bounds of the confidence interval
+type Distribution struct {
+	Mean   decimal.Decimal `json:"mean"`
+	Median decimal.Decimal `json:"median"`
+	Lower  decimal.Decimal `json:"lower"`
+	Upper  decimal.Decimal `json:"upper"`
+	Min    decimal.Decimal `json:"min"`
+	Max    decimal.Decimal `json:"max"`
+}
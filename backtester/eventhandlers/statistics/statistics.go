@@ -39,7 +39,12 @@ func (s *Statistic) SetupEventForTime(ev common.DataEventHandler) error {
 	if lookup == nil {
 		lookup = &currencystatistics.CurrencyStatistic{}
 	}
-	for i := range lookup.Events {
+	// events are stored in time order, so only the events at the end can
+	// match. This keeps tick data, which has many events, from being rescanned
+	for i := len(lookup.Events) - 1; i >= 0; i-- {
+		if lookup.Events[i].DataEvent.GetTime().Before(ev.GetTime()) {
+			break
+		}
 		if lookup.Events[i].DataEvent.GetTime().Equal(ev.GetTime()) &&
 			lookup.Events[i].DataEvent.GetExchange() == ev.GetExchange() &&
 			lookup.Events[i].DataEvent.GetAssetType() == ev.GetAssetType() &&
@@ -104,7 +109,13 @@ func applyEventAtOffset(ev common.EventHandler, lookup *currencystatistics.Curre
 	case order.Event:
 		lookup.Events[i].OrderEvent = t
 	case fill.Event:
-		lookup.Events[i].FillEvent = t
+		for j := range lookup.Events[i].FillEvents {
+			if lookup.Events[i].FillEvents[j] == t {
+				// the same fill is set again once processed by the portfolio
+				return nil
+			}
+		}
+		lookup.Events[i].FillEvents = append(lookup.Events[i].FillEvents, t)
 	default:
 		return fmt.Errorf("unknown event type received: %v", ev)
 	}
@@ -173,8 +184,8 @@ func (s *Statistic) CalculateAllResults(funds funding.IFundingManager) error {
 				endDate = last.DataEvent.GetTime()
 				var event common.EventHandler
 				switch {
-				case last.FillEvent != nil:
-					event = last.FillEvent
+				case len(last.FillEvents) > 0:
+					event = last.FillEvents[len(last.FillEvents)-1]
 				case last.SignalEvent != nil:
 					event = last.SignalEvent
 				default:
@@ -188,6 +199,12 @@ func (s *Statistic) CalculateAllResults(funds funding.IFundingManager) error {
 				if err != nil {
 					log.Error(log.BackTester, err)
 				}
+				if s.RobustnessSettings != nil {
+					err = stats.AnalyseRobustness(s.RobustnessSettings)
+					if err != nil {
+						log.Errorf(log.BackTester, "%v %v %v robustness analysis failed: %v", exchangeName, assetItem, pair, err)
+					}
+				}
 				stats.PrintResults(exchangeName, assetItem, pair, f, funds.IsUsingExchangeLevelFunding())
 				stats.FinalHoldings = last.Holdings
 				stats.InitialHoldings = stats.Events[0].Holdings
@@ -201,6 +218,9 @@ func (s *Statistic) CalculateAllResults(funds funding.IFundingManager) error {
 					MaxDrawdown:      stats.MaxDrawdown,
 					MarketMovement:   stats.MarketMovement,
 					StrategyMovement: stats.StrategyMovement,
+					SharpeRatio:      stats.ArithmeticRatios.SharpeRatio,
+					SortinoRatio:     stats.ArithmeticRatios.SortinoRatio,
+					CalmarRatio:      stats.ArithmeticRatios.CalmarRatio,
 				})
 				s.TotalBuyOrders += stats.BuyOrders
 				s.TotalSellOrders += stats.SellOrders
@@ -212,6 +232,7 @@ func (s *Statistic) CalculateAllResults(funds funding.IFundingManager) error {
 	}
 	s.Funding = funds.GenerateReport(startDate, endDate)
 	s.TotalOrders = s.TotalBuyOrders + s.TotalSellOrders
+	s.FinalResults = finalResults
 	if currCount > 1 {
 		s.BiggestDrawdown = s.GetTheBiggestDrawdownAcrossCurrencies(finalResults)
 		s.BestMarketMovement = s.GetBestMarketPerformer(finalResults)
@@ -222,6 +243,12 @@ func (s *Statistic) CalculateAllResults(funds funding.IFundingManager) error {
 	return nil
 }
 
+// GetFinalResults returns the results of each exchange, asset and currency pair
+// once CalculateAllResults has been run
+func (s *Statistic) GetFinalResults() []FinalResultsHolder {
+	return s.FinalResults
+}
+
 // PrintTotalResults outputs all results to the CMD
 func (s *Statistic) PrintTotalResults(isUsingExchangeLevelFunding bool) {
 	log.Info(log.BackTester, "------------------Strategy-----------------------------------")
@@ -326,6 +353,42 @@ func addEventOutputToTime(events []eventOutputHolder, t time.Time, message strin
 	return events
 }
 
+// fillEventOutput returns the details of a fill event for output. Fills which
+// did not place an order only output their price and reason
+func fillEventOutput(f fill.Event) string {
+	direction := f.GetDirection()
+	if direction == common.CouldNotBuy ||
+		direction == common.CouldNotSell ||
+		direction == common.DoNothing ||
+		direction == common.MissingData ||
+		direction == common.TransferredFunds ||
+		direction == common.OrderPlaced ||
+		direction == common.OrderExpired ||
+		direction == common.CancelOrder ||
+		direction == common.AmendOrder ||
+		direction == "" {
+		return fmt.Sprintf("%v %v %v %v | Price: $%v - Direction: %v - Reason: %s",
+			f.GetTime().Format(gctcommon.SimpleTimeFormat),
+			f.GetExchange(),
+			f.GetAssetType(),
+			f.Pair(),
+			f.GetClosePrice().Round(8),
+			f.GetDirection(),
+			f.GetReason())
+	}
+	return fmt.Sprintf("%v %v %v %v | Price: $%v - Amount: %v - Fee: $%v - Total: $%v - Direction %v - Reason: %s",
+		f.GetTime().Format(gctcommon.SimpleTimeFormat),
+		f.GetExchange(),
+		f.GetAssetType(),
+		f.Pair(),
+		f.GetPurchasePrice().Round(8),
+		f.GetAmount().Round(8),
+		f.GetExchangeFee().Round(8),
+		f.GetTotal().Round(8),
+		f.GetDirection(),
+		f.GetReason())
+}
+
 // PrintAllEventsChronologically outputs all event details in the CMD
 // rather than separated by exchange, asset and currency pair, it's
 // grouped by time to allow a clearer picture of events
@@ -338,37 +401,10 @@ func (s *Statistic) PrintAllEventsChronologically() {
 			for pair, currencyStatistic := range y {
 				for i := range currencyStatistic.Events {
 					switch {
-					case currencyStatistic.Events[i].FillEvent != nil:
-						direction := currencyStatistic.Events[i].FillEvent.GetDirection()
-						if direction == common.CouldNotBuy ||
-							direction == common.CouldNotSell ||
-							direction == common.DoNothing ||
-							direction == common.MissingData ||
-							direction == common.TransferredFunds ||
-							direction == "" {
-							results = addEventOutputToTime(results, currencyStatistic.Events[i].FillEvent.GetTime(),
-								fmt.Sprintf("%v %v %v %v | Price: $%v - Direction: %v - Reason: %s",
-									currencyStatistic.Events[i].FillEvent.GetTime().Format(gctcommon.SimpleTimeFormat),
-									currencyStatistic.Events[i].FillEvent.GetExchange(),
-									currencyStatistic.Events[i].FillEvent.GetAssetType(),
-									currencyStatistic.Events[i].FillEvent.Pair(),
-									currencyStatistic.Events[i].FillEvent.GetClosePrice().Round(8),
-									currencyStatistic.Events[i].FillEvent.GetDirection(),
-									currencyStatistic.Events[i].FillEvent.GetReason()))
-						} else {
-							results = addEventOutputToTime(results, currencyStatistic.Events[i].FillEvent.GetTime(),
-								fmt.Sprintf("%v %v %v %v | Price: $%v - Amount: %v - Fee: $%v - Total: $%v - Direction %v - Reason: %s",
-									currencyStatistic.Events[i].FillEvent.GetTime().Format(gctcommon.SimpleTimeFormat),
-									currencyStatistic.Events[i].FillEvent.GetExchange(),
-									currencyStatistic.Events[i].FillEvent.GetAssetType(),
-									currencyStatistic.Events[i].FillEvent.Pair(),
-									currencyStatistic.Events[i].FillEvent.GetPurchasePrice().Round(8),
-									currencyStatistic.Events[i].FillEvent.GetAmount().Round(8),
-									currencyStatistic.Events[i].FillEvent.GetExchangeFee().Round(8),
-									currencyStatistic.Events[i].FillEvent.GetTotal().Round(8),
-									currencyStatistic.Events[i].FillEvent.GetDirection(),
-									currencyStatistic.Events[i].FillEvent.GetReason(),
-								))
+					case len(currencyStatistic.Events[i].FillEvents) > 0:
+						for j := range currencyStatistic.Events[i].FillEvents {
+							results = addEventOutputToTime(results, currencyStatistic.Events[i].FillEvents[j].GetTime(),
+								fillEventOutput(currencyStatistic.Events[i].FillEvents[j]))
 						}
 					case currencyStatistic.Events[i].SignalEvent != nil:
 						results = addEventOutputToTime(results, currencyStatistic.Events[i].SignalEvent.GetTime(),
@@ -253,6 +253,55 @@ func TestAddFillEventForTime(t *testing.T) {
 	}
 }
 
+func TestSetEventForOffsetRestingAndStrategyFills(t *testing.T) {
+	t.Parallel()
+	b := event.Base{
+		Offset:       1,
+		Exchange:     testExchange,
+		Time:         time.Now(),
+		Interval:     gctkline.OneDay,
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+		AssetType:    asset.Spot,
+	}
+	s := Statistic{}
+	s.ExchangeAssetPairStatistics = make(map[string]map[asset.Item]map[currency.Pair]*currencystatistics.CurrencyStatistic)
+	err := s.SetupEventForTime(&kline.Kline{Base: b, Close: eleet})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	resting := &fill.Fill{
+		Base:          b,
+		Direction:     gctorder.Buy,
+		Amount:        eleet,
+		PurchasePrice: eleeg,
+		Order:         &gctorder.Detail{ID: "resting"},
+	}
+	strategy := &fill.Fill{
+		Base:          b,
+		Direction:     gctorder.Sell,
+		Amount:        eleet,
+		PurchasePrice: eleet,
+		Order:         &gctorder.Detail{ID: "strategy"},
+	}
+	// each fill is set when executed and again once processed by the portfolio
+	for _, f := range []*fill.Fill{resting, strategy, resting, strategy} {
+		err = s.SetEventForOffset(f)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received: %v, expected: %v", err, nil)
+		}
+	}
+	fills := s.ExchangeAssetPairStatistics[testExchange][asset.Spot][b.CurrencyPair].Events[0].FillEvents
+	if len(fills) != 2 {
+		t.Fatalf("received: %v, expected: %v", len(fills), 2)
+	}
+	if fills[0] != resting || fills[1] != strategy {
+		t.Errorf("received: %v, expected the resting fill followed by the strategy fill", fills)
+	}
+	if output := fillEventOutput(fills[0]); output == fillEventOutput(fills[1]) {
+		t.Errorf("expected distinct output for each fill, received: %v", output)
+	}
+}
+
 func TestAddHoldingsForTime(t *testing.T) {
 	t.Parallel()
 	tt := time.Now()
@@ -780,4 +829,7 @@ func TestCalculateTheResults(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Errorf("received '%v' expected '%v'", err, nil)
 	}
+	if len(s.GetFinalResults()) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(s.GetFinalResults()), 2)
+	}
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/currencystatistics"
@@ -32,6 +33,7 @@ type Statistic struct {
 	StrategyGoal                string                                                                            `json:"strategy-goal"`
 	ExchangeAssetPairStatistics map[string]map[asset.Item]map[currency.Pair]*currencystatistics.CurrencyStatistic `json:"-"`
 	RiskFreeRate                decimal.Decimal                                                                   `json:"risk-free-rate"`
+	RobustnessSettings          *config.RobustnessSettings                                                        `json:"robustness-settings,omitempty"`
 	TotalBuyOrders              int64                                                                             `json:"total-buy-orders"`
 	TotalSellOrders             int64                                                                             `json:"total-sell-orders"`
 	TotalOrders                 int64                                                                             `json:"total-orders"`
@@ -41,6 +43,7 @@ type Statistic struct {
 	AllStats                    []currencystatistics.CurrencyStatistic                                            `json:"results"` // as ExchangeAssetPairStatistics cannot be rendered via json.Marshall, we append all result to this slice instead
 	WasAnyDataMissing           bool                                                                              `json:"was-any-data-missing"`
 	Funding                     *funding.Report                                                                   `json:"funding"`
+	FinalResults                []FinalResultsHolder                                                              `json:"final-results,omitempty"`
 }
 
 // FinalResultsHolder holds important stats about a currency's performance
@@ -51,6 +54,9 @@ type FinalResultsHolder struct {
 	MaxDrawdown      currencystatistics.Swing `json:"max-drawdown"`
 	MarketMovement   decimal.Decimal          `json:"market-movement"`
 	StrategyMovement decimal.Decimal          `json:"strategy-movement"`
+	SharpeRatio      decimal.Decimal          `json:"sharpe-ratio"`
+	SortinoRatio     decimal.Decimal          `json:"sortino-ratio"`
+	CalmarRatio      decimal.Decimal          `json:"calmar-ratio"`
 }
 
 // Handler interface details what a statistic is expected to do
@@ -61,6 +67,7 @@ type Handler interface {
 	AddHoldingsForTime(*holdings.Holding) error
 	AddComplianceSnapshotForTime(compliance.Snapshot, fill.Event) error
 	CalculateAllResults(funding.IFundingManager) error
+	GetFinalResults() []FinalResultsHolder
 	Reset()
 	Serialise() (string, error)
 }
@@ -0,0 +1,245 @@
+package gctscript
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+)
+
+// IsScript returns whether the strategy name refers to a gctscript file
+func IsScript(name string) bool {
+	return strings.EqualFold(filepath.Ext(name), gctcommon.GctExt)
+}
+
+// New loads and compiles the gctscript at the path
+// so that it can be run against data events
+func New(path string) (*Strategy, error) {
+	if !IsScript(path) {
+		return nil, fmt.Errorf("%v %w", path, errNotScript)
+	}
+	v, err := vm.NewStandalone(&vm.Config{
+		Enabled:       true,
+		ScriptTimeout: vm.DefaultTimeoutValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = v.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	// the script's inputs and outputs must be declared before compilation
+	// so that they can be set and read on every run
+	for _, variable := range []string{dataKey, settingsKey, signalsKey} {
+		err = v.Script.Add(variable, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = v.Compile()
+	if err != nil {
+		return nil, err
+	}
+	return &Strategy{
+		path: path,
+		vm:   v,
+	}, nil
+}
+
+// Name returns the name of the strategy
+func (s *Strategy) Name() string {
+	return filepath.Base(s.path)
+}
+
+// Description provides a nice overview of the strategy
+// be it definition of terms or to highlight its purpose
+func (s *Strategy) Description() string {
+	return fmt.Sprintf("%v Script: %v", description, s.path)
+}
+
+// OnSignal handles a data event and returns what action the script believes should occur
+func (s *Strategy) OnSignal(d data.Handler, f funding.IFundTransferer) (signal.Event, error) {
+	if d == nil {
+		return nil, common.ErrNilEvent
+	}
+	resp, err := s.run([]data.Handler{d}, f)
+	if err != nil {
+		return nil, err
+	}
+	return resp[0], nil
+}
+
+// SupportsSimultaneousProcessing highlights whether the strategy can handle multiple currency calculation
+// Scripts receive every data event at once when simultaneous processing is enabled
+func (s *Strategy) SupportsSimultaneousProcessing() bool {
+	return true
+}
+
+// OnSimultaneousSignals analyses multiple data points simultaneously, allowing flexibility
+// in allowing a strategy to only place an order for X currency if Y currency's price is Z
+func (s *Strategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundTransferer) ([]signal.Event, error) {
+	for i := range d {
+		if d[i] == nil {
+			return nil, common.ErrNilEvent
+		}
+	}
+	return s.run(d, f)
+}
+
+// SetCustomSettings stores the custom settings which are passed to the script
+// it is up to the script to validate them
+func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) error {
+	s.customSettings = customSettings
+	return nil
+}
+
+// SetDefaults removes any custom settings
+func (s *Strategy) SetDefaults() {
+	s.customSettings = nil
+}
+
+// run passes every data event with data at its latest time to the script and
+// converts the script's signals into signal events
+func (s *Strategy) run(d []data.Handler, f funding.IFundTransferer) ([]signal.Event, error) {
+	if s.vm == nil || s.vm.Compiled == nil {
+		return nil, errScriptNotLoaded
+	}
+	resp := make([]signal.Event, len(d))
+	var scriptData []interface{}
+	var scriptIndexes []int
+	for i := range d {
+		es, err := s.GetBaseData(d[i])
+		if err != nil {
+			return nil, err
+		}
+		es.SetPrice(d[i].Latest().ClosePrice())
+		resp[i] = &es
+		if !d[i].HasDataAtTime(d[i].Latest().GetTime()) {
+			es.SetDirection(common.MissingData)
+			es.AppendReason(fmt.Sprintf("missing data at %v, cannot perform any actions", d[i].Latest().GetTime()))
+			continue
+		}
+		var item map[string]interface{}
+		item, err = convertData(d[i], f)
+		if err != nil {
+			return nil, err
+		}
+		scriptData = append(scriptData, item)
+		scriptIndexes = append(scriptIndexes, i)
+	}
+	if len(scriptData) == 0 {
+		return resp, nil
+	}
+
+	err := s.vm.Compiled.Set(dataKey, scriptData)
+	if err != nil {
+		return nil, err
+	}
+	err = s.vm.Compiled.Set(settingsKey, s.customSettings)
+	if err != nil {
+		return nil, err
+	}
+	err = s.vm.Compiled.Set(signalsKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = s.vm.RunCtx()
+	if err != nil {
+		return nil, err
+	}
+
+	signals, ok := s.vm.Compiled.Get(signalsKey).Value().([]interface{})
+	if !ok || len(signals) != len(scriptData) {
+		return nil, fmt.Errorf("%w. Expected %v signals, received '%v'", errInvalidSignals, len(scriptData), s.vm.Compiled.Get(signalsKey).Value())
+	}
+	for i := range signals {
+		var direction order.Side
+		var reason string
+		direction, reason, err = parseSignal(signals[i])
+		if err != nil {
+			return nil, err
+		}
+		resp[scriptIndexes[i]].SetDirection(direction)
+		if reason != "" {
+			resp[scriptIndexes[i]].AppendReason(reason)
+		}
+	}
+	return resp, nil
+}
+
+// convertData converts a data handler's history and the available funds
+// into a map which can be read by the script
+func convertData(d data.Handler, f funding.IFundTransferer) (map[string]interface{}, error) {
+	latest := d.Latest()
+	history := d.History()
+	opens, highs, lows, closes, volumes := d.StreamOpen(), d.StreamHigh(), d.StreamLow(), d.StreamClose(), d.StreamVol()
+	ohlcv := make([]interface{}, len(history))
+	for i := range history {
+		o, _ := opens[i].Float64()
+		h, _ := highs[i].Float64()
+		l, _ := lows[i].Float64()
+		c, _ := closes[i].Float64()
+		v, _ := volumes[i].Float64()
+		ohlcv[i] = []interface{}{history[i].GetTime().Unix(), o, h, l, c, v}
+	}
+	var baseAvailable, quoteAvailable float64
+	if f != nil {
+		funds, err := f.GetFundingForEAP(latest.GetExchange(), latest.GetAssetType(), latest.Pair())
+		if err != nil {
+			return nil, err
+		}
+		baseAvailable, _ = funds.BaseAvailable().Float64()
+		quoteAvailable, _ = funds.QuoteAvailable().Float64()
+	}
+	return map[string]interface{}{
+		"exchange":        latest.GetExchange(),
+		"asset":           latest.GetAssetType().String(),
+		"pair":            latest.Pair().String(),
+		"offset":          int64(d.Offset()),
+		"ohlcv":           ohlcv,
+		"base_available":  baseAvailable,
+		"quote_available": quoteAvailable,
+	}, nil
+}
+
+// parseSignal converts a script signal into a direction and optional reason
+// a signal can be either a direction string or a map containing a direction and reason
+func parseSignal(sig interface{}) (order.Side, string, error) {
+	var direction, reason string
+	switch v := sig.(type) {
+	case string:
+		direction = v
+	case map[string]interface{}:
+		var ok bool
+		direction, ok = v[directionKey].(string)
+		if !ok {
+			return "", "", fmt.Errorf("%w. '%v' does not contain a direction", errInvalidSignals, v)
+		}
+		if r, ok := v[reasonKey]; ok {
+			reason, ok = r.(string)
+			if !ok {
+				return "", "", fmt.Errorf("%w. reason '%v' is not a string", errInvalidSignals, r)
+			}
+		}
+	default:
+		return "", "", fmt.Errorf("%w. '%v' is not a string or map", errInvalidSignals, sig)
+	}
+	switch {
+	case strings.EqualFold(direction, order.Buy.String()):
+		return order.Buy, reason, nil
+	case strings.EqualFold(direction, order.Sell.String()):
+		return order.Sell, reason, nil
+	case strings.EqualFold(direction, common.DoNothing.String()),
+		strings.EqualFold(direction, doNothingAlias):
+		return common.DoNothing, reason, nil
+	}
+	return "", "", fmt.Errorf("%w. Unrecognised direction '%v'", errInvalidSignals, direction)
+}
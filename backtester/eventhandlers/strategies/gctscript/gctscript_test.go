@@ -0,0 +1,248 @@
+package gctscript
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	exampleScript          = filepath.Join("examples", "rsi.gct")
+	brokenScript           = filepath.Join("..", "..", "..", "..", "testdata", "gctscript", "broken.gct")
+	invalidSignalsScript   = filepath.Join("..", "..", "..", "..", "testdata", "gctscript", "backtester_invalid_signals.gct")
+	invalidDirectionScript = filepath.Join("..", "..", "..", "..", "testdata", "gctscript", "backtester_invalid_direction.gct")
+)
+
+// newTestData returns data with candles moving by the change each interval
+// which has been streamed up to the latest candle
+func newTestData(t *testing.T, candles int, change float64) *kline.DataFromKline {
+	t.Helper()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(gctkline.OneDay.Duration() * time.Duration(candles))
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: "binance",
+			Pair:     currency.NewPair(currency.BTC, currency.USDT),
+			Asset:    asset.Spot,
+			Interval: gctkline.OneDay,
+		},
+	}
+	price := 1000.0
+	for i := 0; i < candles; i++ {
+		d.Item.Candles = append(d.Item.Candles, gctkline.Candle{
+			Time:   start.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+			Open:   price,
+			High:   price + 1,
+			Low:    price - 1,
+			Close:  price + change,
+			Volume: 1337,
+		})
+		price += change
+	}
+	err := d.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.RangeHolder, err = gctkline.CalculateCandleDateRanges(start, end, gctkline.OneDay, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.RangeHolder.SetHasDataFromCandles(d.Item.Candles)
+	for d.Next() != nil {
+	}
+	return d
+}
+
+func TestIsScript(t *testing.T) {
+	t.Parallel()
+	if IsScript(Name) {
+		t.Errorf("expected %v to not be a script", Name)
+	}
+	if !IsScript(exampleScript) {
+		t.Errorf("expected %v to be a script", exampleScript)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New("rsi")
+	if !errors.Is(err, errNotScript) {
+		t.Errorf("received: %v, expected: %v", err, errNotScript)
+	}
+	_, err = New("hello.gct")
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("received: %v, expected: %v", err, os.ErrNotExist)
+	}
+	_, err = New(brokenScript)
+	if err == nil {
+		t.Error("expected compilation error")
+	}
+	s, err := New(exampleScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Name() != "rsi.gct" {
+		t.Errorf("received: %v, expected: %v", s.Name(), "rsi.gct")
+	}
+	if !s.SupportsSimultaneousProcessing() {
+		t.Error("expected true")
+	}
+}
+
+func TestOnSignal(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	_, err := s.OnSignal(nil, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
+	}
+	_, err = s.OnSignal(newTestData(t, 5, 1), nil)
+	if !errors.Is(err, errScriptNotLoaded) {
+		t.Errorf("received: %v, expected: %v", err, errScriptNotLoaded)
+	}
+
+	st, err := New(exampleScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := st.OnSignal(newTestData(t, 5, 1), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetDirection() != common.DoNothing {
+		t.Errorf("received: %v, expected: %v", resp.GetDirection(), common.DoNothing)
+	}
+
+	resp, err = st.OnSignal(newTestData(t, 30, 1), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetDirection() != order.Sell {
+		t.Errorf("received: %v, expected: %v", resp.GetDirection(), order.Sell)
+	}
+
+	err = st.SetCustomSettings(map[string]interface{}{"rsi-period": 5.0, "rsi-low": 1.0, "rsi-high": 101.0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = st.OnSignal(newTestData(t, 30, 1), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetDirection() != common.DoNothing {
+		t.Errorf("received: %v, expected: %v", resp.GetDirection(), common.DoNothing)
+	}
+}
+
+func TestOnSimultaneousSignals(t *testing.T) {
+	t.Parallel()
+	s, err := New(exampleScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.SetSimultaneousProcessing(true)
+	_, err = s.OnSimultaneousSignals([]data.Handler{nil}, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
+	}
+	resp, err := s.OnSimultaneousSignals([]data.Handler{newTestData(t, 30, 1), newTestData(t, 30, -1)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("received: %v, expected: %v", len(resp), 2)
+	}
+	if resp[0].GetDirection() != order.Sell {
+		t.Errorf("received: %v, expected: %v", resp[0].GetDirection(), order.Sell)
+	}
+	if resp[1].GetDirection() != order.Buy {
+		t.Errorf("received: %v, expected: %v", resp[1].GetDirection(), order.Buy)
+	}
+
+	missing := newTestData(t, 30, 1)
+	missing.RangeHolder.Ranges[0].Intervals[len(missing.RangeHolder.Ranges[0].Intervals)-1].HasData = false
+	resp, err = s.OnSimultaneousSignals([]data.Handler{missing, newTestData(t, 30, -1)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp[0].GetDirection() != common.MissingData {
+		t.Errorf("received: %v, expected: %v", resp[0].GetDirection(), common.MissingData)
+	}
+	if resp[1].GetDirection() != order.Buy {
+		t.Errorf("received: %v, expected: %v", resp[1].GetDirection(), order.Buy)
+	}
+}
+
+func TestInvalidSignals(t *testing.T) {
+	t.Parallel()
+	s, err := New(invalidSignalsScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.OnSignal(newTestData(t, 5, 1), nil)
+	if !errors.Is(err, errInvalidSignals) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidSignals)
+	}
+
+	s, err = New(invalidDirectionScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.OnSignal(newTestData(t, 5, 1), nil)
+	if !errors.Is(err, errInvalidSignals) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidSignals)
+	}
+}
+
+func TestParseSignal(t *testing.T) {
+	t.Parallel()
+	direction, reason, err := parseSignal("BUY")
+	if err != nil {
+		t.Error(err)
+	}
+	if direction != order.Buy || reason != "" {
+		t.Errorf("received: %v %v, expected: %v", direction, reason, order.Buy)
+	}
+	direction, reason, err = parseSignal(map[string]interface{}{directionKey: doNothingAlias, reasonKey: "because"})
+	if err != nil {
+		t.Error(err)
+	}
+	if direction != common.DoNothing || reason != "because" {
+		t.Errorf("received: %v %v, expected: %v because", direction, reason, common.DoNothing)
+	}
+	_, _, err = parseSignal(map[string]interface{}{reasonKey: "because"})
+	if !errors.Is(err, errInvalidSignals) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidSignals)
+	}
+	_, _, err = parseSignal(map[string]interface{}{directionKey: "sell", reasonKey: 1337})
+	if !errors.Is(err, errInvalidSignals) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidSignals)
+	}
+	_, _, err = parseSignal(1337)
+	if !errors.Is(err, errInvalidSignals) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidSignals)
+	}
+}
+
+func TestSetDefaults(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	err := s.SetCustomSettings(map[string]interface{}{"hello": "moto"})
+	if err != nil {
+		t.Error(err)
+	}
+	s.SetDefaults()
+	if s.customSettings != nil {
+		t.Error("expected custom settings to be removed")
+	}
+}
@@ -0,0 +1,36 @@
+package gctscript
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+)
+
+const (
+	// Name is the strategy name
+	Name        = "gctscript"
+	description = `Runs a gctscript against data events. The script receives the data history and available funds for each data event and returns a buy, sell or do nothing signal for each`
+
+	dataKey        = "data"
+	settingsKey    = "settings"
+	signalsKey     = "signals"
+	directionKey   = "direction"
+	reasonKey      = "reason"
+	doNothingAlias = "do-nothing"
+)
+
+var (
+	errNotScript       = errors.New("is not a gctscript file")
+	errScriptNotLoaded = errors.New("gctscript strategy has not been loaded")
+	errInvalidSignals  = errors.New("invalid signals returned from script")
+)
+
+// Strategy is an implementation of the Handler interface
+// which runs a gctscript to generate signals
+type Strategy struct {
+	base.Strategy
+	path           string
+	vm             *vm.VM
+	customSettings map[string]interface{}
+}
@@ -6,12 +6,22 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dollarcostaverage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/gctscript"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/rsi"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/top2bottom2"
 )
 
 // LoadStrategyByName returns the strategy by its name
+// if the name is the path to a gctscript file, the script is loaded as the strategy
 func LoadStrategyByName(name string, useSimultaneousProcessing bool) (Handler, error) {
+	if gctscript.IsScript(name) {
+		s, err := gctscript.New(name)
+		if err != nil {
+			return nil, fmt.Errorf("strategy '%v' %w", name, err)
+		}
+		s.SetSimultaneousProcessing(useSimultaneousProcessing)
+		return s, nil
+	}
 	strats := GetStrategies()
 	for i := range strats {
 		if !strings.EqualFold(name, strats[i].Name()) {
@@ -2,6 +2,8 @@ package strategies
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
@@ -55,4 +57,17 @@ func TestLoadStrategyByName(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Errorf("received: %v, expected: %v", err, nil)
 	}
+
+	script := filepath.Join("gctscript", "examples", "rsi.gct")
+	resp, err = LoadStrategyByName(script, true)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if !resp.UsingSimultaneousProcessing() {
+		t.Error("expected true")
+	}
+	_, err = LoadStrategyByName("hello.gct", false)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("received: %v, expected: %v", err, os.ErrNotExist)
+	}
 }
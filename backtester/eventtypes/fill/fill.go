@@ -64,3 +64,8 @@ func (f *Fill) GetOrder() *order.Detail {
 func (f *Fill) GetSlippageRate() decimal.Decimal {
 	return f.Slippage
 }
+
+// GetExitRule returns the exit rule which closed the position, if any
+func (f *Fill) GetExitRule() string {
+	return f.ExitRule
+}
@@ -19,6 +19,7 @@ type Fill struct {
 	ExchangeFee         decimal.Decimal `json:"exchange-fee"`
 	Slippage            decimal.Decimal `json:"slippage"`
 	Order               *order.Detail   `json:"-"`
+	ExitRule            string          `json:"exit-rule,omitempty"`
 }
 
 // Event holds all functions required to handle a fill event
@@ -36,4 +37,5 @@ type Event interface {
 	GetExchangeFee() decimal.Decimal
 	SetExchangeFee(decimal.Decimal)
 	GetOrder() *order.Detail
+	GetExitRule() string
 }
@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -81,3 +83,30 @@ func (o *Order) SetLeverage(l decimal.Decimal) {
 func (o *Order) GetAllocatedFunds() decimal.Decimal {
 	return o.AllocatedFunds
 }
+
+// GetOrderType returns the order type, eg market or limit
+func (o *Order) GetOrderType() order.Type {
+	return o.OrderType
+}
+
+// GetPrice returns the price the order was sized against.
+// For limit orders this is the limit price
+func (o *Order) GetPrice() decimal.Decimal {
+	return o.Price
+}
+
+// GetTriggerPrice returns the price which triggers
+// a stop, stop-limit or take-profit order
+func (o *Order) GetTriggerPrice() decimal.Decimal {
+	return o.TriggerPrice
+}
+
+// GetExpiry returns when a resting order will expire
+func (o *Order) GetExpiry() time.Time {
+	return o.Expiry
+}
+
+// GetExitRule returns the exit rule which created the order
+func (o *Order) GetExitRule() string {
+	return o.ExitRule
+}
@@ -2,6 +2,7 @@ package order
 
 import (
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -84,3 +85,26 @@ func TestGetFunds(t *testing.T) {
 		t.Error("expected decimal.NewFromInt(1337)")
 	}
 }
+
+func TestGetRestingOrderDetails(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	o := Order{
+		OrderType:    gctorder.StopLimit,
+		Price:        decimal.NewFromInt(1337),
+		TriggerPrice: decimal.NewFromInt(1338),
+		Expiry:       tt,
+	}
+	if o.GetOrderType() != gctorder.StopLimit {
+		t.Errorf("expected %v, received %v", gctorder.StopLimit, o.GetOrderType())
+	}
+	if !o.GetPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("expected 1337, received %v", o.GetPrice())
+	}
+	if !o.GetTriggerPrice().Equal(decimal.NewFromInt(1338)) {
+		t.Errorf("expected 1338, received %v", o.GetTriggerPrice())
+	}
+	if !o.GetExpiry().Equal(tt) {
+		t.Errorf("expected %v, received %v", tt, o.GetExpiry())
+	}
+}
@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -16,10 +18,15 @@ type Order struct {
 	Price          decimal.Decimal
 	Amount         decimal.Decimal
 	OrderType      order.Type
+	TriggerPrice   decimal.Decimal
+	Expiry         time.Time
 	Leverage       decimal.Decimal
 	AllocatedFunds decimal.Decimal
 	BuyLimit       decimal.Decimal
 	SellLimit      decimal.Decimal
+	// ExitRule is set when the portfolio manager created the
+	// order to close a position via a stop-loss, take-profit or trailing-stop
+	ExitRule string
 }
 
 // Event inherits common event interfaces along with extra functions related to handling orders
@@ -36,4 +43,9 @@ type Event interface {
 	GetID() string
 	IsLeveraged() bool
 	GetAllocatedFunds() decimal.Decimal
+	GetOrderType() order.Type
+	GetPrice() decimal.Decimal
+	GetTriggerPrice() decimal.Decimal
+	GetExpiry() time.Time
+	GetExitRule() string
 }
@@ -1,6 +1,8 @@
 package signal
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -55,3 +57,53 @@ func (s *Signal) GetPrice() decimal.Decimal {
 func (s *Signal) SetPrice(f decimal.Decimal) {
 	s.ClosePrice = f
 }
+
+// GetOrderType returns the type of order the signal requests
+func (s *Signal) GetOrderType() order.Type {
+	return s.OrderType
+}
+
+// SetOrderType sets the type of order the signal requests
+func (s *Signal) SetOrderType(t order.Type) {
+	s.OrderType = t
+}
+
+// GetLimitPrice returns the limit price
+func (s *Signal) GetLimitPrice() decimal.Decimal {
+	return s.LimitPrice
+}
+
+// SetLimitPrice sets the limit price
+func (s *Signal) SetLimitPrice(f decimal.Decimal) {
+	s.LimitPrice = f
+}
+
+// GetTriggerPrice returns the trigger price
+func (s *Signal) GetTriggerPrice() decimal.Decimal {
+	return s.TriggerPrice
+}
+
+// SetTriggerPrice sets the trigger price
+func (s *Signal) SetTriggerPrice(f decimal.Decimal) {
+	s.TriggerPrice = f
+}
+
+// GetExpiry returns when a resting order expires
+func (s *Signal) GetExpiry() time.Time {
+	return s.Expiry
+}
+
+// SetExpiry sets when a resting order expires
+func (s *Signal) SetExpiry(t time.Time) {
+	s.Expiry = t
+}
+
+// GetOrderID returns the strategy defined order ID
+func (s *Signal) GetOrderID() string {
+	return s.OrderID
+}
+
+// SetOrderID sets the strategy defined order ID
+func (s *Signal) SetOrderID(id string) {
+	s.OrderID = id
+}
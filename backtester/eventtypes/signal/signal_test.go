@@ -2,6 +2,7 @@ package signal
 
 import (
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -56,3 +57,49 @@ func TestSetSellLimit(t *testing.T) {
 		t.Errorf("expected 20, received %v", s.GetSellLimit())
 	}
 }
+
+func TestSetOrderType(t *testing.T) {
+	t.Parallel()
+	s := Signal{}
+	s.SetOrderType(gctorder.Limit)
+	if s.GetOrderType() != gctorder.Limit {
+		t.Errorf("expected %v, received %v", gctorder.Limit, s.GetOrderType())
+	}
+}
+
+func TestSetLimitPrice(t *testing.T) {
+	t.Parallel()
+	s := Signal{}
+	s.SetLimitPrice(decimal.NewFromInt(1337))
+	if !s.GetLimitPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("expected 1337, received %v", s.GetLimitPrice())
+	}
+}
+
+func TestSetTriggerPrice(t *testing.T) {
+	t.Parallel()
+	s := Signal{}
+	s.SetTriggerPrice(decimal.NewFromInt(1337))
+	if !s.GetTriggerPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("expected 1337, received %v", s.GetTriggerPrice())
+	}
+}
+
+func TestSetExpiry(t *testing.T) {
+	t.Parallel()
+	s := Signal{}
+	tt := time.Now()
+	s.SetExpiry(tt)
+	if !s.GetExpiry().Equal(tt) {
+		t.Errorf("expected %v, received %v", tt, s.GetExpiry())
+	}
+}
+
+func TestSetOrderID(t *testing.T) {
+	t.Parallel()
+	s := Signal{}
+	s.SetOrderID("1337")
+	if s.GetOrderID() != "1337" {
+		t.Errorf("expected 1337, received %v", s.GetOrderID())
+	}
+}
@@ -1,6 +1,8 @@
 package signal
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -17,6 +19,11 @@ type Event interface {
 	IsSignal() bool
 	GetSellLimit() decimal.Decimal
 	GetBuyLimit() decimal.Decimal
+	GetOrderType() order.Type
+	GetLimitPrice() decimal.Decimal
+	GetTriggerPrice() decimal.Decimal
+	GetExpiry() time.Time
+	GetOrderID() string
 }
 
 // Signal contains everything needed for a strategy to raise a signal event
@@ -30,4 +37,16 @@ type Signal struct {
 	BuyLimit   decimal.Decimal
 	SellLimit  decimal.Decimal
 	Direction  order.Side
+	// OrderType defaults to a market order when unset. Limit, Stop, StopLimit
+	// and TakeProfit orders will rest on the simulated exchange until
+	// the price crosses their LimitPrice or TriggerPrice
+	OrderType    order.Type
+	LimitPrice   decimal.Decimal
+	TriggerPrice decimal.Decimal
+	// Expiry is when a resting order will be removed from the simulated
+	// exchange. A zero value will keep the order until it is filled or cancelled
+	Expiry time.Time
+	// OrderID is a strategy defined identifier for a resting order which
+	// is used to cancel or amend it
+	OrderID string
 }
@@ -0,0 +1,23 @@
+package trade
+
+import "github.com/shopspring/decimal"
+
+// ClosePrice returns the price of the trade
+func (t *Trade) ClosePrice() decimal.Decimal {
+	return t.Price
+}
+
+// HighPrice returns the price of the trade
+func (t *Trade) HighPrice() decimal.Decimal {
+	return t.Price
+}
+
+// LowPrice returns the price of the trade
+func (t *Trade) LowPrice() decimal.Decimal {
+	return t.Price
+}
+
+// OpenPrice returns the price of the trade
+func (t *Trade) OpenPrice() decimal.Decimal {
+	return t.Price
+}
@@ -0,0 +1,47 @@
+package trade
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestClose(t *testing.T) {
+	t.Parallel()
+	tr := Trade{
+		Price: decimal.NewFromInt(1337),
+	}
+	if !tr.ClosePrice().Equal(decimal.NewFromInt(1337)) {
+		t.Error("expected decimal.NewFromInt(1337)")
+	}
+}
+
+func TestHigh(t *testing.T) {
+	t.Parallel()
+	tr := Trade{
+		Price: decimal.NewFromInt(1337),
+	}
+	if !tr.HighPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Error("expected decimal.NewFromInt(1337)")
+	}
+}
+
+func TestLow(t *testing.T) {
+	t.Parallel()
+	tr := Trade{
+		Price: decimal.NewFromInt(1337),
+	}
+	if !tr.LowPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Error("expected decimal.NewFromInt(1337)")
+	}
+}
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+	tr := Trade{
+		Price: decimal.NewFromInt(1337),
+	}
+	if !tr.OpenPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Error("expected decimal.NewFromInt(1337)")
+	}
+}
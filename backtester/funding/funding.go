@@ -299,8 +299,12 @@ func (p *Pair) QuoteAvailable() decimal.Decimal {
 
 // Reserve allocates an amount of funds to be used at a later time
 // it prevents multiple events from claiming the same resource
-// changes which currency to affect based on the order side
+// changes which currency to affect based on the order side.
+// Futures pairs always reserve collateral from the quote item
 func (p *Pair) Reserve(amount decimal.Decimal, side order.Side) error {
+	if p.IsFutures() && (side == order.Buy || side == order.Sell) {
+		return p.Quote.Reserve(amount)
+	}
 	switch side {
 	case order.Buy:
 		return p.Quote.Reserve(amount)
@@ -318,8 +322,12 @@ func (p *Pair) Reserve(amount decimal.Decimal, side order.Side) error {
 
 // Release reduces the amount of funding reserved and adds any difference
 // back to the available amount
-// changes which currency to affect based on the order side
+// changes which currency to affect based on the order side.
+// Futures pairs always release collateral to the quote item
 func (p *Pair) Release(amount, diff decimal.Decimal, side order.Side) error {
+	if p.IsFutures() && (side == order.Buy || side == order.Sell) {
+		return p.Quote.Release(amount, diff)
+	}
 	switch side {
 	case order.Buy:
 		return p.Quote.Release(amount, diff)
@@ -336,8 +344,13 @@ func (p *Pair) Release(amount, diff decimal.Decimal, side order.Side) error {
 }
 
 // IncreaseAvailable adds funding to the available amount
-// changes which currency to affect based on the order side
+// changes which currency to affect based on the order side.
+// Futures contracts are not held as funds, so futures pairs are
+// unaffected, see UpdatePosition instead
 func (p *Pair) IncreaseAvailable(amount decimal.Decimal, side order.Side) {
+	if p.IsFutures() {
+		return
+	}
 	switch side {
 	case order.Buy:
 		p.Base.IncreaseAvailable(amount)
@@ -348,8 +361,12 @@ func (p *Pair) IncreaseAvailable(amount decimal.Decimal, side order.Side) {
 
 // CanPlaceOrder does a > 0 check to see if there are any funds
 // to place an order with
-// changes which currency to affect based on the order side
+// changes which currency to affect based on the order side.
+// Futures pairs can always place an order which reduces the open position
 func (p *Pair) CanPlaceOrder(side order.Side) bool {
+	if p.IsFutures() {
+		return p.Quote.CanPlaceOrder() || p.Base.position.reducedBy(side)
+	}
 	switch side {
 	case order.Buy:
 		return p.Quote.CanPlaceOrder()
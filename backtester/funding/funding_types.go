@@ -68,6 +68,8 @@ type IPairReader interface {
 	QuoteInitialFunds() decimal.Decimal
 	BaseAvailable() decimal.Decimal
 	QuoteAvailable() decimal.Decimal
+	IsFutures() bool
+	GetPosition() Position
 }
 
 // IPairReserver limits funding usage for portfolio event handling
@@ -77,10 +79,24 @@ type IPairReserver interface {
 	Reserve(decimal.Decimal, order.Side) error
 }
 
-// IPairReleaser limits funding usage for exchange event handling
+// IPairReleaser limits funding usage for exchange event handling.
+// Reserve is used when amending resting orders which require more funding
 type IPairReleaser interface {
+	IFuturesPair
 	IncreaseAvailable(decimal.Decimal, order.Side)
 	Release(decimal.Decimal, decimal.Decimal, order.Side) error
+	Reserve(decimal.Decimal, order.Side) error
+}
+
+// IFuturesPair is used by the exchange to manage futures positions
+// along with the margin, funding payments and liquidation associated with them
+type IFuturesPair interface {
+	IsFutures() bool
+	GetPosition() Position
+	UpdatePosition(amount, price, fee decimal.Decimal, side order.Side) (decimal.Decimal, error)
+	UpdateMarkPrice(decimal.Decimal)
+	ApplyFundingRate(decimal.Decimal, time.Time) decimal.Decimal
+	Liquidate(decimal.Decimal) (amount, fee decimal.Decimal, err error)
 }
 
 // Item holds funding data per currency item
@@ -93,6 +109,32 @@ type Item struct {
 	reserved     decimal.Decimal
 	transferFee  decimal.Decimal
 	pairedWith   *Item
+	// position is only set on the base item of a futures pair
+	position *Position
+}
+
+// MarginRequirements define the collateral required to open and
+// maintain a futures position as ratios of the position's notional value
+type MarginRequirements struct {
+	InitialMarginRatio     decimal.Decimal
+	MaintenanceMarginRatio decimal.Decimal
+	LiquidationFeeRate     decimal.Decimal
+}
+
+// Position is a futures position held against a funding pair. A positive Size
+// is a long position and a negative Size is a short position. Margin is the
+// collateral posted from the pair's quote item to keep the position open
+type Position struct {
+	MarginRequirements
+	Size            decimal.Decimal
+	EntryPrice      decimal.Decimal
+	MarkPrice       decimal.Decimal
+	Margin          decimal.Decimal
+	UnrealisedPNL   decimal.Decimal
+	RealisedPNL     decimal.Decimal
+	FundingPayments decimal.Decimal
+	LastFundingTime time.Time
+	Liquidations    int64
 }
 
 // Pair holds two currencies that are associated with each other
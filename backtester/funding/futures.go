@@ -0,0 +1,264 @@
+package funding
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	errNotFutures         = errors.New("funding pair is not a futures contract")
+	errInvalidMarginRatio = errors.New("invalid margin ratio")
+	errNoPosition         = errors.New("no open position")
+	errInvalidPrice       = errors.New("received invalid price")
+)
+
+// SetupFutures enables position tracking for a futures funding pair.
+// The base item represents the contract and the quote item is used as collateral
+func (p *Pair) SetupFutures(m MarginRequirements) error {
+	if p.Base == nil || p.Quote == nil {
+		return common.ErrNilArguments
+	}
+	if !common.IsFuturesAsset(p.Base.asset) {
+		return fmt.Errorf("%v %v %v %w", p.Base.exchange, p.Base.asset, p.Base.currency, errNotFutures)
+	}
+	one := decimal.NewFromInt(1)
+	if m.InitialMarginRatio.LessThanOrEqual(decimal.Zero) ||
+		m.InitialMarginRatio.GreaterThan(one) ||
+		m.MaintenanceMarginRatio.LessThanOrEqual(decimal.Zero) ||
+		m.MaintenanceMarginRatio.GreaterThanOrEqual(m.InitialMarginRatio) {
+		return fmt.Errorf("%w initial: %v maintenance: %v", errInvalidMarginRatio, m.InitialMarginRatio, m.MaintenanceMarginRatio)
+	}
+	if m.LiquidationFeeRate.IsNegative() {
+		return fmt.Errorf("%w liquidation fee rate", errNegativeAmountReceived)
+	}
+	p.Base.position = &Position{MarginRequirements: m}
+	return nil
+}
+
+// IsFutures returns whether the pair tracks a futures position
+func (p *Pair) IsFutures() bool {
+	return p.Base != nil && p.Base.position != nil
+}
+
+// GetPosition returns a copy of the pair's futures position
+func (p *Pair) GetPosition() Position {
+	if !p.IsFutures() {
+		return Position{}
+	}
+	return *p.Base.position
+}
+
+// UpdatePosition applies a futures fill to the pair's position. Any amount which
+// reduces the position realises PNL and releases a proportional share of the posted
+// margin, any remaining amount increases the position and posts initial margin from
+// the quote item's available funds. Fees and realised losses are paid from the
+// quote item's available funds and then from the position's margin.
+// The realised PNL is returned
+func (p *Pair) UpdatePosition(amount, price, fee decimal.Decimal, side order.Side) (decimal.Decimal, error) {
+	if !p.IsFutures() {
+		return decimal.Zero, errNotFutures
+	}
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero, errZeroAmountReceived
+	}
+	if price.LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero, fmt.Errorf("%w %v", errInvalidPrice, price)
+	}
+	if fee.IsNegative() {
+		return decimal.Zero, fmt.Errorf("%w fee", errNegativeAmountReceived)
+	}
+	var direction decimal.Decimal
+	switch side {
+	case order.Buy:
+		direction = decimal.NewFromInt(1)
+	case order.Sell:
+		direction = decimal.NewFromInt(-1)
+	default:
+		return decimal.Zero, fmt.Errorf("%w for %v %v %v. Unknown side %v",
+			errCannotAllocate,
+			p.Base.exchange,
+			p.Base.asset,
+			p.Base.currency,
+			side)
+	}
+	pos := p.Base.position
+	var realised decimal.Decimal
+	if pos.reducedBy(side) {
+		currentSize := pos.Size.Abs()
+		closing := decimal.Min(amount, currentSize)
+		realised = closing.Mul(price.Sub(pos.EntryPrice))
+		if pos.Size.IsNegative() {
+			realised = realised.Neg()
+		}
+		releasedMargin := pos.Margin.Mul(closing).Div(currentSize)
+		if releasedMargin.GreaterThan(decimal.Zero) {
+			err := p.Quote.Release(releasedMargin, releasedMargin)
+			if err != nil {
+				return decimal.Zero, err
+			}
+		}
+		pos.Margin = pos.Margin.Sub(releasedMargin)
+		pos.Size = pos.Size.Add(closing.Mul(direction))
+		if pos.Size.IsZero() {
+			pos.EntryPrice = decimal.Zero
+			pos.Margin = decimal.Zero
+		}
+		pos.RealisedPNL = pos.RealisedPNL.Add(realised)
+		p.settle(realised)
+		amount = amount.Sub(closing)
+	}
+	if amount.GreaterThan(decimal.Zero) {
+		margin := amount.Mul(price).Mul(pos.InitialMarginRatio)
+		err := p.Quote.Reserve(margin)
+		if err != nil {
+			return realised, err
+		}
+		currentSize := pos.Size.Abs()
+		pos.EntryPrice = currentSize.Mul(pos.EntryPrice).Add(amount.Mul(price)).Div(currentSize.Add(amount))
+		pos.Size = pos.Size.Add(amount.Mul(direction))
+		pos.Margin = pos.Margin.Add(margin)
+	}
+	p.payFromCollateral(fee)
+	pos.MarkPrice = price
+	pos.updateUnrealisedPNL()
+	return realised, nil
+}
+
+// UpdateMarkPrice revalues the position's unrealised PNL against the mark price
+func (p *Pair) UpdateMarkPrice(price decimal.Decimal) {
+	if !p.IsFutures() || price.LessThanOrEqual(decimal.Zero) {
+		return
+	}
+	p.Base.position.MarkPrice = price
+	p.Base.position.updateUnrealisedPNL()
+}
+
+// ApplyFundingRate settles a periodic funding payment at the current mark price.
+// Long positions pay short positions when the rate is positive and receive payment
+// when it is negative. Each funding time is only applied once and no payment is
+// made when there is no position. It returns the amount paid, where a negative
+// amount is a payment received. Payments are taken from the quote item's
+// available funds and then from the position's margin
+func (p *Pair) ApplyFundingRate(rate decimal.Decimal, t time.Time) decimal.Decimal {
+	if !p.IsFutures() {
+		return decimal.Zero
+	}
+	pos := p.Base.position
+	if !t.After(pos.LastFundingTime) {
+		return decimal.Zero
+	}
+	pos.LastFundingTime = t
+	if pos.Size.IsZero() || pos.MarkPrice.IsZero() {
+		return decimal.Zero
+	}
+	payment := pos.Size.Mul(pos.MarkPrice).Mul(rate)
+	p.settle(payment.Neg())
+	pos.FundingPayments = pos.FundingPayments.Add(payment)
+	return payment
+}
+
+// Liquidate closes the entire position at the liquidation price and charges
+// the liquidation fee. It returns the amount closed and the fee charged
+func (p *Pair) Liquidate(price decimal.Decimal) (amount, fee decimal.Decimal, err error) {
+	if !p.IsFutures() {
+		return decimal.Zero, decimal.Zero, errNotFutures
+	}
+	pos := p.Base.position
+	if pos.Size.IsZero() {
+		return decimal.Zero, decimal.Zero, errNoPosition
+	}
+	side := order.Sell
+	if pos.Size.IsNegative() {
+		side = order.Buy
+	}
+	amount = pos.Size.Abs()
+	fee = amount.Mul(price).Mul(pos.LiquidationFeeRate)
+	_, err = p.UpdatePosition(amount, price, fee, side)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	pos.Liquidations++
+	return amount, fee, nil
+}
+
+// settle adds a profit to the quote item's available funds or pays a loss
+// from the position's collateral
+func (p *Pair) settle(amount decimal.Decimal) {
+	if amount.IsNegative() {
+		p.payFromCollateral(amount.Neg())
+		return
+	}
+	p.Quote.adjustAvailable(amount)
+}
+
+// payFromCollateral deducts an amount from the quote item's available funds.
+// When available funds are insufficient, the remainder is taken from the
+// margin posted for the position
+func (p *Pair) payFromCollateral(amount decimal.Decimal) {
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return
+	}
+	shortfall := p.Quote.adjustAvailable(amount.Neg())
+	if shortfall.IsZero() {
+		return
+	}
+	pos := p.Base.position
+	taken := decimal.Min(shortfall, pos.Margin, p.Quote.reserved)
+	pos.Margin = pos.Margin.Sub(taken)
+	p.Quote.reserved = p.Quote.reserved.Sub(taken)
+}
+
+// adjustAvailable adds a positive or negative amount to the available funds.
+// Available funds cannot fall below zero, any shortfall is returned
+func (i *Item) adjustAvailable(amount decimal.Decimal) decimal.Decimal {
+	i.available = i.available.Add(amount)
+	if i.available.IsNegative() {
+		shortfall := i.available.Neg()
+		i.available = decimal.Zero
+		return shortfall
+	}
+	return decimal.Zero
+}
+
+// LiquidationPrice returns the price at which the position's margin plus
+// unrealised PNL equals the maintenance margin requirement.
+// Zero is returned when there is no position or it cannot be liquidated
+func (p Position) LiquidationPrice() decimal.Decimal {
+	if p.Size.IsZero() {
+		return decimal.Zero
+	}
+	one := decimal.NewFromInt(1)
+	size := p.Size.Abs()
+	if p.Size.IsPositive() {
+		// margin + size * (price - entry) = size * price * maintenance
+		liq := size.Mul(p.EntryPrice).Sub(p.Margin).Div(size.Mul(one.Sub(p.MaintenanceMarginRatio)))
+		if liq.IsNegative() {
+			return decimal.Zero
+		}
+		return liq
+	}
+	// margin + size * (entry - price) = size * price * maintenance
+	return p.Margin.Add(size.Mul(p.EntryPrice)).Div(size.Mul(one.Add(p.MaintenanceMarginRatio)))
+}
+
+// reducedBy returns whether an order on the side would reduce the position
+func (p *Position) reducedBy(side order.Side) bool {
+	if p == nil {
+		return false
+	}
+	return (side == order.Buy && p.Size.IsNegative()) ||
+		(side == order.Sell && p.Size.IsPositive())
+}
+
+func (p *Position) updateUnrealisedPNL() {
+	if p.Size.IsZero() {
+		p.UnrealisedPNL = decimal.Zero
+		return
+	}
+	p.UnrealisedPNL = p.Size.Mul(p.MarkPrice.Sub(p.EntryPrice))
+}
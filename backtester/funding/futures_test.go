@@ -0,0 +1,339 @@
+package funding
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	tenth          = decimal.NewFromFloat(0.1)
+	fiftieth       = decimal.NewFromFloat(0.02)
+	defaultMargins = MarginRequirements{
+		InitialMarginRatio:     tenth,
+		MaintenanceMarginRatio: fiftieth,
+	}
+)
+
+func setupFuturesPair(t *testing.T, quoteFunds decimal.Decimal) *Pair {
+	t.Helper()
+	baseItem, err := CreateItem(exch, asset.Futures, base, decimal.Zero, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	quoteItem, err := CreateItem(exch, asset.Futures, quote, quoteFunds, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	p, err := CreatePair(baseItem, quoteItem)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = p.SetupFutures(defaultMargins)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	return p
+}
+
+func TestSetupFutures(t *testing.T) {
+	t.Parallel()
+	p := &Pair{}
+	err := p.SetupFutures(defaultMargins)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+	baseItem, err := CreateItem(exch, a, base, decimal.Zero, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	quoteItem, err := CreateItem(exch, a, quote, elite, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	p, err = CreatePair(baseItem, quoteItem)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = p.SetupFutures(defaultMargins)
+	if !errors.Is(err, errNotFutures) {
+		t.Errorf("received '%v' expected '%v'", err, errNotFutures)
+	}
+
+	p.Base.asset = asset.PerpetualSwap
+	err = p.SetupFutures(MarginRequirements{InitialMarginRatio: tenth, MaintenanceMarginRatio: tenth})
+	if !errors.Is(err, errInvalidMarginRatio) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMarginRatio)
+	}
+	err = p.SetupFutures(MarginRequirements{InitialMarginRatio: tenth, MaintenanceMarginRatio: fiftieth, LiquidationFeeRate: neg})
+	if !errors.Is(err, errNegativeAmountReceived) {
+		t.Errorf("received '%v' expected '%v'", err, errNegativeAmountReceived)
+	}
+	if p.IsFutures() {
+		t.Error("expected pair to not be futures")
+	}
+	err = p.SetupFutures(defaultMargins)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !p.IsFutures() {
+		t.Error("expected pair to be futures")
+	}
+	if !p.GetPosition().InitialMarginRatio.Equal(tenth) {
+		t.Errorf("received '%v' expected '%v'", p.GetPosition().InitialMarginRatio, tenth)
+	}
+}
+
+func TestFuturesPairFunding(t *testing.T) {
+	t.Parallel()
+	p := setupFuturesPair(t, elite)
+	if !p.CanPlaceOrder(gctorder.Sell) {
+		t.Error("expected to be able to sell using quote collateral")
+	}
+	err := p.Reserve(elite, gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !p.Quote.reserved.Equal(elite) {
+		t.Errorf("received '%v' expected '%v'", p.Quote.reserved, elite)
+	}
+	if p.CanPlaceOrder(gctorder.Buy) {
+		t.Error("expected no funds available")
+	}
+	err = p.Release(elite, elite, gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !p.Quote.available.Equal(elite) {
+		t.Errorf("received '%v' expected '%v'", p.Quote.available, elite)
+	}
+	p.IncreaseAvailable(elite, gctorder.Buy)
+	if !p.Base.available.IsZero() {
+		t.Errorf("received '%v' expected '%v'", p.Base.available, decimal.Zero)
+	}
+}
+
+func TestUpdatePosition(t *testing.T) {
+	t.Parallel()
+	p := &Pair{}
+	_, err := p.UpdatePosition(one, one, decimal.Zero, gctorder.Buy)
+	if !errors.Is(err, errNotFutures) {
+		t.Errorf("received '%v' expected '%v'", err, errNotFutures)
+	}
+	p = setupFuturesPair(t, decimal.NewFromInt(1000))
+	_, err = p.UpdatePosition(decimal.Zero, one, decimal.Zero, gctorder.Buy)
+	if !errors.Is(err, errZeroAmountReceived) {
+		t.Errorf("received '%v' expected '%v'", err, errZeroAmountReceived)
+	}
+	_, err = p.UpdatePosition(one, decimal.Zero, decimal.Zero, gctorder.Buy)
+	if !errors.Is(err, errInvalidPrice) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPrice)
+	}
+	_, err = p.UpdatePosition(one, one, decimal.Zero, common.DoNothing)
+	if !errors.Is(err, errCannotAllocate) {
+		t.Errorf("received '%v' expected '%v'", err, errCannotAllocate)
+	}
+
+	// open a 10 contract long at 100, posting 100 margin and paying a 1 fee
+	hundred := decimal.NewFromInt(100)
+	ten := decimal.NewFromInt(10)
+	realised, err := p.UpdatePosition(ten, hundred, one, gctorder.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !realised.IsZero() {
+		t.Errorf("received '%v' expected '%v'", realised, decimal.Zero)
+	}
+	pos := p.GetPosition()
+	if !pos.Size.Equal(ten) || !pos.EntryPrice.Equal(hundred) || !pos.Margin.Equal(hundred) {
+		t.Errorf("unexpected position %+v", pos)
+	}
+	if !p.QuoteAvailable().Equal(decimal.NewFromInt(899)) {
+		t.Errorf("received '%v' expected '%v'", p.QuoteAvailable(), 899)
+	}
+
+	p.UpdateMarkPrice(decimal.NewFromInt(110))
+	if !p.GetPosition().UnrealisedPNL.Equal(hundred) {
+		t.Errorf("received '%v' expected '%v'", p.GetPosition().UnrealisedPNL, hundred)
+	}
+
+	// sell 15 at 110, closing the long for 100 profit and opening a 5 contract short
+	realised, err = p.UpdatePosition(decimal.NewFromInt(15), decimal.NewFromInt(110), decimal.Zero, gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !realised.Equal(hundred) {
+		t.Errorf("received '%v' expected '%v'", realised, hundred)
+	}
+	pos = p.GetPosition()
+	if !pos.Size.Equal(decimal.NewFromInt(-5)) || !pos.EntryPrice.Equal(decimal.NewFromInt(110)) {
+		t.Errorf("unexpected position %+v", pos)
+	}
+	if !pos.Margin.Equal(decimal.NewFromInt(55)) {
+		t.Errorf("received '%v' expected '%v'", pos.Margin, 55)
+	}
+	// 899 + 100 margin released + 100 profit - 55 margin posted
+	if !p.QuoteAvailable().Equal(decimal.NewFromInt(1044)) {
+		t.Errorf("received '%v' expected '%v'", p.QuoteAvailable(), 1044)
+	}
+	if !p.Quote.reserved.Equal(decimal.NewFromInt(55)) {
+		t.Errorf("received '%v' expected '%v'", p.Quote.reserved, 55)
+	}
+	if !p.CanPlaceOrder(gctorder.Buy) {
+		t.Error("expected to be able to reduce position")
+	}
+}
+
+func TestUpdatePositionLossExceedsAvailable(t *testing.T) {
+	t.Parallel()
+	p := setupFuturesPair(t, decimal.NewFromInt(1000))
+	// open a 90 contract long at 100, posting 900 margin
+	_, err := p.UpdatePosition(decimal.NewFromInt(90), decimal.NewFromInt(100), decimal.Zero, gctorder.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	liquidationPrice := p.GetPosition().LiquidationPrice()
+
+	// close 10 at 50, releasing 100 margin for a 500 loss. The 300 not covered
+	// by available funds is taken from the margin of the remaining position
+	realised, err := p.UpdatePosition(decimal.NewFromInt(10), decimal.NewFromInt(50), decimal.Zero, gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !realised.Equal(decimal.NewFromInt(-500)) {
+		t.Errorf("received '%v' expected '%v'", realised, -500)
+	}
+	if !p.QuoteAvailable().IsZero() {
+		t.Errorf("received '%v' expected '%v'", p.QuoteAvailable(), decimal.Zero)
+	}
+	pos := p.GetPosition()
+	if !pos.Margin.Equal(decimal.NewFromInt(500)) || !p.Quote.reserved.Equal(decimal.NewFromInt(500)) {
+		t.Errorf("received margin '%v' reserved '%v' expected '%v'", pos.Margin, p.Quote.reserved, 500)
+	}
+	if !pos.LiquidationPrice().GreaterThan(liquidationPrice) {
+		t.Errorf("received '%v' expected liquidation price above '%v'", pos.LiquidationPrice(), liquidationPrice)
+	}
+
+	// a funding payment with no available funds is also taken from margin
+	payment := p.ApplyFundingRate(decimal.NewFromFloat(0.01), time.Now())
+	if !payment.Equal(decimal.NewFromInt(40)) {
+		t.Errorf("received '%v' expected '%v'", payment, 40)
+	}
+	if !p.QuoteAvailable().IsZero() || !p.GetPosition().Margin.Equal(decimal.NewFromInt(460)) {
+		t.Errorf("unexpected position %+v available %v", p.GetPosition(), p.QuoteAvailable())
+	}
+}
+
+func TestApplyFundingRate(t *testing.T) {
+	t.Parallel()
+	p := &Pair{}
+	if !p.ApplyFundingRate(tenth, time.Now()).IsZero() {
+		t.Error("expected no payment for non-futures pair")
+	}
+	p = setupFuturesPair(t, decimal.NewFromInt(1000))
+	tt := time.Now()
+	if !p.ApplyFundingRate(tenth, tt).IsZero() {
+		t.Error("expected no payment without a position")
+	}
+	_, err := p.UpdatePosition(decimal.NewFromInt(10), decimal.NewFromInt(100), decimal.Zero, gctorder.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !p.ApplyFundingRate(tenth, tt).IsZero() {
+		t.Error("expected funding time to only be applied once")
+	}
+	payment := p.ApplyFundingRate(decimal.NewFromFloat(0.01), tt.Add(time.Hour))
+	if !payment.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", payment, 10)
+	}
+	if !p.QuoteAvailable().Equal(decimal.NewFromInt(890)) {
+		t.Errorf("received '%v' expected '%v'", p.QuoteAvailable(), 890)
+	}
+	payment = p.ApplyFundingRate(decimal.NewFromFloat(-0.01), tt.Add(time.Hour*2))
+	if !payment.Equal(decimal.NewFromInt(-10)) {
+		t.Errorf("received '%v' expected '%v'", payment, -10)
+	}
+	if !p.QuoteAvailable().Equal(decimal.NewFromInt(900)) {
+		t.Errorf("received '%v' expected '%v'", p.QuoteAvailable(), 900)
+	}
+	if !p.GetPosition().FundingPayments.IsZero() {
+		t.Errorf("received '%v' expected '%v'", p.GetPosition().FundingPayments, decimal.Zero)
+	}
+
+	// payments exceeding available funds are taken from posted margin
+	payment = p.ApplyFundingRate(one, tt.Add(time.Hour*3))
+	if !payment.Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received '%v' expected '%v'", payment, 1000)
+	}
+	if !p.QuoteAvailable().IsZero() || !p.GetPosition().Margin.IsZero() {
+		t.Errorf("unexpected position %+v available %v", p.GetPosition(), p.QuoteAvailable())
+	}
+}
+
+func TestLiquidate(t *testing.T) {
+	t.Parallel()
+	p := &Pair{}
+	_, _, err := p.Liquidate(one)
+	if !errors.Is(err, errNotFutures) {
+		t.Errorf("received '%v' expected '%v'", err, errNotFutures)
+	}
+	p = setupFuturesPair(t, decimal.NewFromInt(100))
+	p.Base.position.LiquidationFeeRate = decimal.NewFromFloat(0.01)
+	_, _, err = p.Liquidate(one)
+	if !errors.Is(err, errNoPosition) {
+		t.Errorf("received '%v' expected '%v'", err, errNoPosition)
+	}
+	_, err = p.UpdatePosition(decimal.NewFromInt(10), decimal.NewFromInt(100), decimal.Zero, gctorder.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	liq := p.GetPosition().LiquidationPrice()
+	// (10 * 100 - 100) / (10 * 0.98)
+	expected := decimal.NewFromInt(900).Div(decimal.NewFromFloat(9.8))
+	if !liq.Equal(expected) {
+		t.Errorf("received '%v' expected '%v'", liq, expected)
+	}
+	amount, fee, err := p.Liquidate(liq)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !amount.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", amount, 10)
+	}
+	if !fee.Equal(amount.Mul(liq).Mul(decimal.NewFromFloat(0.01))) {
+		t.Errorf("unexpected fee %v", fee)
+	}
+	pos := p.GetPosition()
+	if !pos.Size.IsZero() || pos.Liquidations != 1 || !pos.Margin.IsZero() {
+		t.Errorf("unexpected position %+v", pos)
+	}
+}
+
+func TestLiquidationPrice(t *testing.T) {
+	t.Parallel()
+	var pos Position
+	if !pos.LiquidationPrice().IsZero() {
+		t.Error("expected zero")
+	}
+	pos = Position{
+		MarginRequirements: defaultMargins,
+		Size:               decimal.NewFromInt(-10),
+		EntryPrice:         decimal.NewFromInt(100),
+		Margin:             decimal.NewFromInt(100),
+	}
+	// (100 + 10 * 100) / (10 * 1.02)
+	expected := decimal.NewFromInt(1100).Div(decimal.NewFromFloat(10.2))
+	if !pos.LiquidationPrice().Equal(expected) {
+		t.Errorf("received '%v' expected '%v'", pos.LiquidationPrice(), expected)
+	}
+	pos.Size = decimal.NewFromInt(1)
+	pos.Margin = decimal.NewFromInt(1000)
+	if !pos.LiquidationPrice().IsZero() {
+		t.Errorf("received '%v' expected '%v'", pos.LiquidationPrice(), decimal.Zero)
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/backtest"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/rpcserver"
 	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	gctlog "github.com/thrasher-corp/gocryptotrader/log"
@@ -16,8 +18,8 @@ import (
 )
 
 func main() {
-	var configPath, templatePath, reportOutput string
-	var printLogo, generateReport, darkReport bool
+	var configPath, templatePath, reportOutput, exportFormats, rpcListenAddress string
+	var printLogo, generateReport, darkReport, rpcServer bool
 	wd, err := os.Getwd()
 	if err != nil {
 		fmt.Printf("Could not get working directory. Error: %v.\n", err)
@@ -52,6 +54,11 @@ func main() {
 			wd,
 			"results"),
 		"the path where to output results")
+	flag.StringVar(
+		&exportFormats,
+		"exportformats",
+		"",
+		"comma separated list of formats to export results in, overriding the config's export settings. Supported: json, csv")
 	flag.BoolVar(
 		&printLogo,
 		"printlogo",
@@ -62,15 +69,27 @@ func main() {
 		"darkreport",
 		false,
 		"sets the initial rerport to use a dark theme")
+	flag.BoolVar(
+		&rpcServer,
+		"rpcserver",
+		false,
+		"runs a gRPC server which queues backtesting runs submitted remotely instead of running the config. Uses the GoCryptoTrader config's gRPC credentials and TLS certificate")
+	flag.StringVar(
+		&rpcListenAddress,
+		"rpclistenaddress",
+		"localhost:9054",
+		"the address the backtester gRPC server listens on")
 	flag.Parse()
 
 	var bt *backtest.BackTest
-	var cfg *config.Config
-	fmt.Println("reading config...")
-	cfg, err = config.ReadConfigFromFile(configPath)
-	if err != nil {
-		fmt.Printf("Could not read config. Error: %v.\n", err)
-		os.Exit(1)
+	cfg := &config.Config{}
+	if !rpcServer {
+		fmt.Println("reading config...")
+		cfg, err = config.ReadConfigFromFile(configPath)
+		if err != nil {
+			fmt.Printf("Could not read config. Error: %v.\n", err)
+			os.Exit(1)
+		}
 	}
 	if printLogo {
 		fmt.Print(common.ASCIILogo)
@@ -100,6 +119,20 @@ func main() {
 		os.Exit(-1)
 	}
 
+	if rpcServer {
+		err = runRPCServer(bot, templatePath, reportOutput, rpcListenAddress)
+		if err != nil {
+			fmt.Printf("Could not run backtester gRPC server. Error: %v.\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if exportFormats != "" {
+		cfg.ExportSettings = &config.ExportSettings{
+			Formats: strings.Split(exportFormats, ","),
+		}
+	}
 	err = cfg.Validate()
 	if err != nil {
 		fmt.Printf("Could not read config. Error: %v.\n", err)
@@ -110,6 +143,16 @@ func main() {
 		fmt.Printf("Could not setup backtester from config. Error: %v.\n", err)
 		os.Exit(1)
 	}
+	if cfg.OptimisationSettings != nil {
+		var results *backtest.OptimisationResult
+		results, err = bt.Optimise(cfg)
+		if err != nil {
+			fmt.Printf("Could not complete optimisation. Error: %v.\n", err)
+			os.Exit(1)
+		}
+		results.PrintResults()
+		return
+	}
 	if cfg.DataSettings.LiveData != nil {
 		go func() {
 			err = bt.RunLive()
@@ -135,6 +178,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	err = bt.Reports.GenerateExport()
+	if err != nil {
+		gctlog.Error(gctlog.BackTester, err)
+	}
+
 	if generateReport {
 		bt.Reports.UseDarkMode(darkReport)
 		err = bt.Reports.GenerateReport()
@@ -143,3 +191,23 @@ func main() {
 		}
 	}
 }
+
+// runRPCServer processes backtesting runs submitted via gRPC until interrupted
+func runRPCServer(bot *engine.Engine, templatePath, reportOutput, listenAddress string) error {
+	manager, err := rpcserver.NewRunManager(bot, templatePath, reportOutput)
+	if err != nil {
+		return err
+	}
+	err = manager.Start()
+	if err != nil {
+		return err
+	}
+	server, err := rpcserver.StartRPCServer(bot, manager, listenAddress)
+	if err != nil {
+		return err
+	}
+	interrupt := signaler.WaitForInterrupt()
+	gctlog.Infof(gctlog.Global, "Captured %v, shutdown requested.\n", interrupt)
+	server.Stop()
+	return manager.Stop()
+}
@@ -0,0 +1,510 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/currencystatistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// GenerateExport outputs the results of the run in every export format
+// set in the config. JSON results are output to a single file while CSV
+// results are output to one file per table
+func (d *Data) GenerateExport() error {
+	if d.Config == nil || d.Config.ExportSettings == nil {
+		return nil
+	}
+	if d.Statistics == nil {
+		return errStatisticsUnset
+	}
+	e := d.buildExport()
+	for i := range d.Config.ExportSettings.Formats {
+		var err error
+		switch d.Config.ExportSettings.Formats[i] {
+		case config.JSONExportFormat:
+			err = d.exportJSON(e)
+		case config.CSVExportFormat:
+			err = d.exportCSV(e)
+		default:
+			err = fmt.Errorf("%w '%v'", errUnsupportedExportFormat, d.Config.ExportSettings.Formats[i])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildExport converts statistics into flat tables which can be
+// compared across runs
+func (d *Data) buildExport() *Export {
+	e := &Export{
+		Run: RunSummary{
+			Version:     ExportVersion,
+			GeneratedAt: d.getGeneratedAt().UTC(),
+			Nickname:    d.Config.Nickname,
+			Strategy:    d.Statistics.StrategyName,
+			Goal:        d.Config.Goal,
+			TotalOrders: d.Statistics.TotalOrders,
+		},
+	}
+	if d.Statistics.Funding != nil {
+		e.Run.InitialTotalUSD = d.Statistics.Funding.InitialTotalUSD
+		e.Run.FinalTotalUSD = d.Statistics.Funding.FinalTotalUSD
+		e.Run.Difference = d.Statistics.Funding.Difference
+		for i := range d.Statistics.Funding.Items {
+			item := &d.Statistics.Funding.Items[i]
+			e.Funding = append(e.Funding, FundingRow{
+				Exchange:        item.Exchange,
+				Asset:           item.Asset,
+				Currency:        item.Currency,
+				PairedWith:      item.PairedWith,
+				InitialFunds:    item.InitialFunds,
+				InitialFundsUSD: item.InitialFundsUSD,
+				TransferFee:     item.TransferFee,
+				FinalFunds:      item.FinalFunds,
+				FinalFundsUSD:   item.FinalFundsUSD,
+				Difference:      item.Difference,
+			})
+		}
+	}
+
+	for exch, assetMap := range d.Statistics.ExchangeAssetPairStatistics {
+		for a, pairMap := range assetMap {
+			for p, stats := range pairMap {
+				if stats == nil {
+					continue
+				}
+				e.Holdings = append(e.Holdings, holdingRows(exch, a, p, stats)...)
+				e.Orders = append(e.Orders, orderRows(exch, a, p, stats)...)
+				e.Metrics = append(e.Metrics, metricRow(exch, a, p, stats))
+				if stats.Robustness != nil {
+					e.Robustness = append(e.Robustness, robustnessRow(exch, a, p, stats))
+				}
+			}
+		}
+	}
+	// map iteration is random, sorting keeps exports of the same run identical
+	sort.SliceStable(e.Holdings, func(i, j int) bool {
+		if !e.Holdings[i].Time.Equal(e.Holdings[j].Time) {
+			return e.Holdings[i].Time.Before(e.Holdings[j].Time)
+		}
+		return lessEAP(e.Holdings[i].Exchange, e.Holdings[i].Asset, e.Holdings[i].Pair,
+			e.Holdings[j].Exchange, e.Holdings[j].Asset, e.Holdings[j].Pair)
+	})
+	sort.SliceStable(e.Orders, func(i, j int) bool {
+		if !e.Orders[i].Time.Equal(e.Orders[j].Time) {
+			return e.Orders[i].Time.Before(e.Orders[j].Time)
+		}
+		return lessEAP(e.Orders[i].Exchange, e.Orders[i].Asset, e.Orders[i].Pair,
+			e.Orders[j].Exchange, e.Orders[j].Asset, e.Orders[j].Pair)
+	})
+	sort.Slice(e.Metrics, func(i, j int) bool {
+		return lessEAP(e.Metrics[i].Exchange, e.Metrics[i].Asset, e.Metrics[i].Pair,
+			e.Metrics[j].Exchange, e.Metrics[j].Asset, e.Metrics[j].Pair)
+	})
+	sort.Slice(e.Robustness, func(i, j int) bool {
+		return lessEAP(e.Robustness[i].Exchange, e.Robustness[i].Asset, e.Robustness[i].Pair,
+			e.Robustness[j].Exchange, e.Robustness[j].Asset, e.Robustness[j].Pair)
+	})
+	return e
+}
+
+func lessEAP(exch1 string, a1 asset.Item, p1 currency.Pair, exch2 string, a2 asset.Item, p2 currency.Pair) bool {
+	if exch1 != exch2 {
+		return exch1 < exch2
+	}
+	if a1 != a2 {
+		return a1 < a2
+	}
+	return p1.String() < p2.String()
+}
+
+// holdingRows returns the holdings for every candle processed
+func holdingRows(exch string, a asset.Item, p currency.Pair, stats *currencystatistics.CurrencyStatistic) []HoldingRow {
+	resp := make([]HoldingRow, 0, len(stats.Events))
+	for i := range stats.Events {
+		if stats.Events[i].DataEvent == nil {
+			continue
+		}
+		h := &stats.Events[i].Holdings
+		resp = append(resp, HoldingRow{
+			Time:                         stats.Events[i].DataEvent.GetTime().UTC(),
+			Offset:                       stats.Events[i].DataEvent.GetOffset(),
+			Exchange:                     exch,
+			Asset:                        a,
+			Pair:                         p,
+			ClosePrice:                   stats.Events[i].DataEvent.ClosePrice(),
+			BaseSize:                     h.BaseSize,
+			BaseValue:                    h.BaseValue,
+			QuoteSize:                    h.QuoteSize,
+			TotalValue:                   h.TotalValue,
+			ChangeInTotalValuePercent:    h.ChangeInTotalValuePercent,
+			TotalFees:                    h.TotalFees,
+			TotalValueLostToSlippage:     h.TotalValueLostToSlippage,
+			TotalValueLostToVolumeSizing: h.TotalValueLostToVolumeSizing,
+			UnrealisedPNL:                h.UnrealisedPNL,
+			RealisedPNL:                  h.RealisedPNL,
+		})
+	}
+	return resp
+}
+
+// orderRows returns every fill event, including those which could not be placed
+func orderRows(exch string, a asset.Item, p currency.Pair, stats *currencystatistics.CurrencyStatistic) []OrderRow {
+	var resp []OrderRow
+	for i := range stats.Events {
+		for j := range stats.Events[i].FillEvents {
+			resp = append(resp, orderRow(exch, a, p, stats.Events[i].FillEvents[j]))
+		}
+	}
+	return resp
+}
+
+// orderRow returns the details of a fill event
+func orderRow(exch string, a asset.Item, p currency.Pair, f fill.Event) OrderRow {
+	var orderID string
+	if o := f.GetOrder(); o != nil {
+		orderID = o.ID
+	}
+	return OrderRow{
+		Time:                f.GetTime().UTC(),
+		Offset:              f.GetOffset(),
+		Exchange:            exch,
+		Asset:               a,
+		Pair:                p,
+		OrderID:             orderID,
+		Direction:           f.GetDirection(),
+		Amount:              f.GetAmount(),
+		ClosePrice:          f.GetClosePrice(),
+		VolumeAdjustedPrice: f.GetVolumeAdjustedPrice(),
+		PurchasePrice:       f.GetPurchasePrice(),
+		Total:               f.GetTotal(),
+		ExchangeFee:         f.GetExchangeFee(),
+		SlippageRate:        f.GetSlippageRate(),
+		SlippageCost:        f.GetVolumeAdjustedPrice().Sub(f.GetPurchasePrice()).Abs().Mul(f.GetAmount()),
+		Reason:              f.GetReason(),
+		ExitRule:            f.GetExitRule(),
+	}
+}
+
+// metricRow returns the final results calculated for the exchange asset pair
+func metricRow(exch string, a asset.Item, p currency.Pair, stats *currencystatistics.CurrencyStatistic) MetricRow {
+	return MetricRow{
+		Exchange:                     exch,
+		Asset:                        a,
+		Pair:                         p,
+		StartingClosePrice:           stats.StartingClosePrice,
+		EndingClosePrice:             stats.EndingClosePrice,
+		LowestClosePrice:             stats.LowestClosePrice,
+		HighestClosePrice:            stats.HighestClosePrice,
+		MarketMovement:               stats.MarketMovement,
+		StrategyMovement:             stats.StrategyMovement,
+		BuyOrders:                    stats.BuyOrders,
+		SellOrders:                   stats.SellOrders,
+		TotalOrders:                  stats.TotalOrders,
+		MaxDrawdownPercent:           stats.MaxDrawdown.DrawdownPercent,
+		HighestCommittedFunds:        stats.HighestCommittedFunds.Value,
+		ArithmeticSharpeRatio:        stats.ArithmeticRatios.SharpeRatio,
+		ArithmeticSortinoRatio:       stats.ArithmeticRatios.SortinoRatio,
+		ArithmeticInformationRatio:   stats.ArithmeticRatios.InformationRatio,
+		ArithmeticCalmarRatio:        stats.ArithmeticRatios.CalmarRatio,
+		GeometricSharpeRatio:         stats.GeometricRatios.SharpeRatio,
+		GeometricSortinoRatio:        stats.GeometricRatios.SortinoRatio,
+		GeometricInformationRatio:    stats.GeometricRatios.InformationRatio,
+		GeometricCalmarRatio:         stats.GeometricRatios.CalmarRatio,
+		CompoundAnnualGrowthRate:     stats.CompoundAnnualGrowthRate,
+		TotalFees:                    stats.FinalHoldings.TotalFees,
+		TotalValueLostToSlippage:     stats.FinalHoldings.TotalValueLostToSlippage,
+		TotalValueLostToVolumeSizing: stats.FinalHoldings.TotalValueLostToVolumeSizing,
+		RealisedPNL:                  stats.RealisedPNL,
+		UnrealisedPNL:                stats.UnrealisedPNL,
+		FundingPayments:              stats.FundingPayments,
+		Liquidations:                 stats.Liquidations,
+		IsStrategyProfitable:         stats.IsStrategyProfitable,
+		DoesPerformanceBeatTheMarket: stats.DoesPerformanceBeatTheMarket,
+		ExitsTriggered:               int64(len(stats.Exits)),
+		ExitDifference:               stats.ExitDifference,
+	}
+}
+
+// exportJSON outputs all tables to a single JSON file
+// robustnessRow returns the distribution of resampled outcomes
+func robustnessRow(exch string, a asset.Item, p currency.Pair, stats *currencystatistics.CurrencyStatistic) RobustnessRow {
+	r := stats.Robustness
+	return RobustnessRow{
+		Exchange:          exch,
+		Asset:             a,
+		Pair:              p,
+		Method:            r.Method,
+		Iterations:        r.Iterations,
+		ConfidenceLevel:   r.ConfidenceLevel,
+		FinalEquityMean:   r.FinalEquity.Mean,
+		FinalEquityMedian: r.FinalEquity.Median,
+		FinalEquityLower:  r.FinalEquity.Lower,
+		FinalEquityUpper:  r.FinalEquity.Upper,
+		MaxDrawdownMean:   r.MaxDrawdown.Mean,
+		MaxDrawdownMedian: r.MaxDrawdown.Median,
+		MaxDrawdownLower:  r.MaxDrawdown.Lower,
+		MaxDrawdownUpper:  r.MaxDrawdown.Upper,
+		RuinThreshold:     r.RuinThreshold,
+		RuinProbability:   r.RuinProbability,
+	}
+}
+
+func (d *Data) exportJSON(e *Export) error {
+	resp, err := json.MarshalIndent(e, "", " ")
+	if err != nil {
+		return err
+	}
+	fileName := d.fileName("", config.JSONExportFormat)
+	err = writeFile(filepath.Join(d.OutputPath, fileName), func(w io.Writer) error {
+		_, err = w.Write(resp)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof(log.BackTester, "successfully exported results to %v", filepath.Join(d.OutputPath, fileName))
+	return nil
+}
+
+// exportCSV outputs each table to its own CSV file
+func (d *Data) exportCSV(e *Export) error {
+	tables := []csvTable{
+		{runTable, e.runRecords()},
+		{holdingsTable, e.holdingsRecords()},
+		{ordersTable, e.ordersRecords()},
+		{fundingTable, e.fundingRecords()},
+		{metricsTable, e.metricsRecords()},
+	}
+	if len(e.Robustness) > 0 {
+		tables = append(tables, csvTable{robustnessTable, e.robustnessRecords()})
+	}
+	for i := range tables {
+		fileName := d.fileName(tables[i].name, config.CSVExportFormat)
+		records := tables[i].records
+		err := writeFile(filepath.Join(d.OutputPath, fileName), func(w io.Writer) error {
+			return csv.NewWriter(w).WriteAll(records)
+		})
+		if err != nil {
+			return err
+		}
+		log.Infof(log.BackTester, "successfully exported %v results to %v", tables[i].name, filepath.Join(d.OutputPath, fileName))
+	}
+	return nil
+}
+
+func writeFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = write(f)
+	if err != nil {
+		closeErr := f.Close()
+		if closeErr != nil {
+			log.Error(log.BackTester, closeErr)
+		}
+		return err
+	}
+	return f.Close()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func (e *Export) runRecords() [][]string {
+	return [][]string{
+		{"version", "generated-at", "nickname", "strategy", "goal", "total-orders", "initial-total-usd", "final-total-usd", "difference"},
+		{
+			strconv.Itoa(e.Run.Version),
+			formatTime(e.Run.GeneratedAt),
+			e.Run.Nickname,
+			e.Run.Strategy,
+			e.Run.Goal,
+			strconv.FormatInt(e.Run.TotalOrders, 10),
+			e.Run.InitialTotalUSD.String(),
+			e.Run.FinalTotalUSD.String(),
+			e.Run.Difference.String(),
+		},
+	}
+}
+
+func (e *Export) holdingsRecords() [][]string {
+	resp := [][]string{
+		{"time", "offset", "exchange", "asset", "pair", "close-price", "base-size", "base-value", "quote-size", "total-value",
+			"change-in-total-value-percent", "total-fees", "total-value-lost-to-slippage", "total-value-lost-to-volume-sizing",
+			"unrealised-pnl", "realised-pnl"},
+	}
+	for i := range e.Holdings {
+		h := &e.Holdings[i]
+		resp = append(resp, []string{
+			formatTime(h.Time),
+			strconv.FormatInt(h.Offset, 10),
+			h.Exchange,
+			h.Asset.String(),
+			h.Pair.String(),
+			h.ClosePrice.String(),
+			h.BaseSize.String(),
+			h.BaseValue.String(),
+			h.QuoteSize.String(),
+			h.TotalValue.String(),
+			h.ChangeInTotalValuePercent.String(),
+			h.TotalFees.String(),
+			h.TotalValueLostToSlippage.String(),
+			h.TotalValueLostToVolumeSizing.String(),
+			h.UnrealisedPNL.String(),
+			h.RealisedPNL.String(),
+		})
+	}
+	return resp
+}
+
+func (e *Export) ordersRecords() [][]string {
+	resp := [][]string{
+		{"time", "offset", "exchange", "asset", "pair", "order-id", "direction", "amount", "close-price", "volume-adjusted-price",
+			"purchase-price", "total", "exchange-fee", "slippage-rate", "slippage-cost", "reason", "exit-rule"},
+	}
+	for i := range e.Orders {
+		o := &e.Orders[i]
+		resp = append(resp, []string{
+			formatTime(o.Time),
+			strconv.FormatInt(o.Offset, 10),
+			o.Exchange,
+			o.Asset.String(),
+			o.Pair.String(),
+			o.OrderID,
+			o.Direction.String(),
+			o.Amount.String(),
+			o.ClosePrice.String(),
+			o.VolumeAdjustedPrice.String(),
+			o.PurchasePrice.String(),
+			o.Total.String(),
+			o.ExchangeFee.String(),
+			o.SlippageRate.String(),
+			o.SlippageCost.String(),
+			o.Reason,
+			o.ExitRule,
+		})
+	}
+	return resp
+}
+
+func (e *Export) fundingRecords() [][]string {
+	resp := [][]string{
+		{"exchange", "asset", "currency", "paired-with", "initial-funds", "initial-funds-usd", "transfer-fee",
+			"final-funds", "final-funds-usd", "difference"},
+	}
+	for i := range e.Funding {
+		f := &e.Funding[i]
+		resp = append(resp, []string{
+			f.Exchange,
+			f.Asset.String(),
+			f.Currency.String(),
+			f.PairedWith.String(),
+			f.InitialFunds.String(),
+			f.InitialFundsUSD.String(),
+			f.TransferFee.String(),
+			f.FinalFunds.String(),
+			f.FinalFundsUSD.String(),
+			f.Difference.String(),
+		})
+	}
+	return resp
+}
+
+func (e *Export) metricsRecords() [][]string {
+	resp := [][]string{
+		{"exchange", "asset", "pair", "starting-close-price", "ending-close-price", "lowest-close-price", "highest-close-price",
+			"market-movement", "strategy-movement", "buy-orders", "sell-orders", "total-orders", "max-drawdown-percent",
+			"highest-committed-funds", "arithmetic-sharpe-ratio", "arithmetic-sortino-ratio", "arithmetic-information-ratio",
+			"arithmetic-calmar-ratio", "geometric-sharpe-ratio", "geometric-sortino-ratio", "geometric-information-ratio",
+			"geometric-calmar-ratio", "compound-annual-growth-rate", "total-fees", "total-value-lost-to-slippage",
+			"total-value-lost-to-volume-sizing", "realised-pnl", "unrealised-pnl", "funding-payments", "liquidations",
+			"is-strategy-profitable", "does-performance-beat-the-market", "exits-triggered", "exit-difference"},
+	}
+	for i := range e.Metrics {
+		m := &e.Metrics[i]
+		resp = append(resp, []string{
+			m.Exchange,
+			m.Asset.String(),
+			m.Pair.String(),
+			m.StartingClosePrice.String(),
+			m.EndingClosePrice.String(),
+			m.LowestClosePrice.String(),
+			m.HighestClosePrice.String(),
+			m.MarketMovement.String(),
+			m.StrategyMovement.String(),
+			strconv.FormatInt(m.BuyOrders, 10),
+			strconv.FormatInt(m.SellOrders, 10),
+			strconv.FormatInt(m.TotalOrders, 10),
+			m.MaxDrawdownPercent.String(),
+			m.HighestCommittedFunds.String(),
+			m.ArithmeticSharpeRatio.String(),
+			m.ArithmeticSortinoRatio.String(),
+			m.ArithmeticInformationRatio.String(),
+			m.ArithmeticCalmarRatio.String(),
+			m.GeometricSharpeRatio.String(),
+			m.GeometricSortinoRatio.String(),
+			m.GeometricInformationRatio.String(),
+			m.GeometricCalmarRatio.String(),
+			m.CompoundAnnualGrowthRate.String(),
+			m.TotalFees.String(),
+			m.TotalValueLostToSlippage.String(),
+			m.TotalValueLostToVolumeSizing.String(),
+			m.RealisedPNL.String(),
+			m.UnrealisedPNL.String(),
+			m.FundingPayments.String(),
+			strconv.FormatInt(m.Liquidations, 10),
+			strconv.FormatBool(m.IsStrategyProfitable),
+			strconv.FormatBool(m.DoesPerformanceBeatTheMarket),
+			strconv.FormatInt(m.ExitsTriggered, 10),
+			m.ExitDifference.String(),
+		})
+	}
+	return resp
+}
+
+func (e *Export) robustnessRecords() [][]string {
+	resp := [][]string{
+		{"exchange", "asset", "pair", "method", "iterations", "confidence-level", "final-equity-mean",
+			"final-equity-median", "final-equity-lower", "final-equity-upper", "max-drawdown-mean",
+			"max-drawdown-median", "max-drawdown-lower", "max-drawdown-upper", "ruin-threshold", "ruin-probability"},
+	}
+	for i := range e.Robustness {
+		r := &e.Robustness[i]
+		resp = append(resp, []string{
+			r.Exchange,
+			r.Asset.String(),
+			r.Pair.String(),
+			r.Method,
+			strconv.FormatInt(r.Iterations, 10),
+			r.ConfidenceLevel.String(),
+			r.FinalEquityMean.String(),
+			r.FinalEquityMedian.String(),
+			r.FinalEquityLower.String(),
+			r.FinalEquityUpper.String(),
+			r.MaxDrawdownMean.String(),
+			r.MaxDrawdownMedian.String(),
+			r.MaxDrawdownLower.String(),
+			r.MaxDrawdownUpper.String(),
+			r.RuinThreshold.String(),
+			r.RuinProbability.String(),
+		})
+	}
+	return resp
+}
@@ -0,0 +1,240 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/currencystatistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/robustness"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func newExportTestData(outputPath string) *Data {
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := event.Base{
+		Exchange:     testExchange,
+		Time:         tt,
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+		AssetType:    asset.Spot,
+	}
+	b2 := b
+	b2.Time = tt.Add(time.Hour)
+	b2.Offset = 1
+	ethBase := b
+	ethBase.CurrencyPair = currency.NewPair(currency.ETH, currency.USDT)
+	return &Data{
+		Config: &config.Config{
+			Nickname: "test",
+			ExportSettings: &config.ExportSettings{
+				Formats: []string{config.JSONExportFormat, config.CSVExportFormat},
+			},
+		},
+		OutputPath: outputPath,
+		Statistics: &statistics.Statistic{
+			StrategyName: "testStrat",
+			TotalOrders:  1,
+			Funding: &funding.Report{
+				InitialTotalUSD: decimal.NewFromInt(1000),
+				FinalTotalUSD:   decimal.NewFromInt(1100),
+				Difference:      decimal.NewFromInt(10),
+				Items: []funding.ReportItem{
+					{
+						Exchange:     testExchange,
+						Asset:        asset.Spot,
+						Currency:     currency.USDT,
+						PairedWith:   currency.BTC,
+						InitialFunds: decimal.NewFromInt(1000),
+						FinalFunds:   decimal.NewFromInt(900),
+					},
+				},
+			},
+			ExchangeAssetPairStatistics: map[string]map[asset.Item]map[currency.Pair]*currencystatistics.CurrencyStatistic{
+				testExchange: {
+					asset.Spot: {
+						b.CurrencyPair: &currencystatistics.CurrencyStatistic{
+							Events: []currencystatistics.EventStore{
+								{
+									DataEvent: &kline.Kline{Base: b, Close: decimal.NewFromInt(100)},
+									Holdings:  holdings.Holding{QuoteSize: decimal.NewFromInt(1000)},
+								},
+								{
+									DataEvent: &kline.Kline{Base: b2, Close: decimal.NewFromInt(110)},
+									Holdings: holdings.Holding{
+										BaseSize:  decimal.NewFromInt(1),
+										QuoteSize: decimal.NewFromInt(899),
+										TotalFees: decimal.NewFromInt(1),
+									},
+									FillEvents: []fill.Event{
+										&fill.Fill{
+											Base:                b2,
+											Direction:           gctorder.Buy,
+											Amount:              decimal.NewFromInt(1),
+											ClosePrice:          decimal.NewFromInt(110),
+											VolumeAdjustedPrice: decimal.NewFromInt(110),
+											PurchasePrice:       decimal.NewFromInt(100),
+											ExchangeFee:         decimal.NewFromInt(1),
+											Slippage:            decimal.NewFromInt(1),
+											Order:               &gctorder.Detail{ID: "1337"},
+										},
+										// a resting order filled on the same candle
+										&fill.Fill{
+											Base:          b2,
+											Direction:     gctorder.Sell,
+											Amount:        decimal.NewFromFloat(0.5),
+											ClosePrice:    decimal.NewFromInt(110),
+											PurchasePrice: decimal.NewFromInt(109),
+											Order:         &gctorder.Detail{ID: "1338"},
+										},
+									},
+								},
+							},
+							StrategyMovement: decimal.NewFromInt(10),
+							TotalOrders:      1,
+							Robustness: &robustness.Result{
+								Method:          config.BootstrapMethod,
+								Iterations:      100,
+								ConfidenceLevel: decimal.NewFromFloat(0.95),
+								RuinProbability: decimal.NewFromInt(5),
+							},
+						},
+						ethBase.CurrencyPair: &currencystatistics.CurrencyStatistic{
+							Events: []currencystatistics.EventStore{
+								{
+									DataEvent: &kline.Kline{Base: ethBase, Close: decimal.NewFromInt(10)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGenerateExport(t *testing.T) {
+	t.Parallel()
+	var d Data
+	err := d.GenerateExport()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	d.Config = &config.Config{ExportSettings: &config.ExportSettings{}}
+	err = d.GenerateExport()
+	if !errors.Is(err, errStatisticsUnset) {
+		t.Errorf("received: %v, expected: %v", err, errStatisticsUnset)
+	}
+	d.Statistics = &statistics.Statistic{}
+	d.Config.ExportSettings.Formats = []string{"xml"}
+	err = d.GenerateExport()
+	if !errors.Is(err, errUnsupportedExportFormat) {
+		t.Errorf("received: %v, expected: %v", err, errUnsupportedExportFormat)
+	}
+
+	tempDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("Problem creating temp dir at %s: %s\n", tempDir, err)
+	}
+	defer func(path string) {
+		err = os.RemoveAll(path)
+		if err != nil {
+			t.Error(err)
+		}
+	}(tempDir)
+	d2 := newExportTestData(tempDir)
+	err = d2.GenerateExport()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+
+	resp, err := ioutil.ReadFile(filepath.Join(tempDir, d2.fileName("", config.JSONExportFormat)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e Export
+	err = json.Unmarshal(resp, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Run.Version != ExportVersion {
+		t.Errorf("received: %v, expected: %v", e.Run.Version, ExportVersion)
+	}
+	if len(e.Holdings) != 3 || len(e.Orders) != 2 || len(e.Funding) != 1 || len(e.Metrics) != 2 {
+		t.Fatalf("received: %v holdings %v orders %v funding %v metrics, expected: 3 2 1 2",
+			len(e.Holdings), len(e.Orders), len(e.Funding), len(e.Metrics))
+	}
+	if !e.Orders[0].SlippageCost.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received: %v, expected: %v", e.Orders[0].SlippageCost, 10)
+	}
+	if e.Orders[0].OrderID != "1337" {
+		t.Errorf("received: %v, expected: %v", e.Orders[0].OrderID, "1337")
+	}
+	if e.Orders[1].OrderID != "1338" {
+		t.Errorf("received: %v, expected: %v", e.Orders[1].OrderID, "1338")
+	}
+	if len(e.Robustness) != 1 || !e.Robustness[0].RuinProbability.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received: %+v, expected one robustness row", e.Robustness)
+	}
+
+	for _, table := range []string{runTable, holdingsTable, ordersTable, fundingTable, metricsTable, robustnessTable} {
+		var f *os.File
+		f, err = os.Open(filepath.Join(tempDir, d2.fileName(table, config.CSVExportFormat)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var records [][]string
+		records, err = csv.NewReader(f).ReadAll()
+		if err != nil {
+			t.Error(err)
+		}
+		err = f.Close()
+		if err != nil {
+			t.Error(err)
+		}
+		if len(records) < 2 {
+			t.Errorf("received: %v records for %v, expected a header and rows", len(records), table)
+		}
+	}
+}
+
+func TestBuildExport(t *testing.T) {
+	t.Parallel()
+	d := newExportTestData("")
+	e := d.buildExport()
+	// rows are sorted by time, then exchange asset pair so that exports are stable
+	if !e.Holdings[0].Pair.Equal(currency.NewPair(currency.BTC, currency.USDT)) ||
+		!e.Holdings[1].Pair.Equal(currency.NewPair(currency.ETH, currency.USDT)) {
+		t.Errorf("received: %v %v, expected: BTCUSDT ETHUSDT", e.Holdings[0].Pair, e.Holdings[1].Pair)
+	}
+	if !e.Holdings[2].ClosePrice.Equal(decimal.NewFromInt(110)) {
+		t.Errorf("received: %v, expected: %v", e.Holdings[2].ClosePrice, 110)
+	}
+	if !e.Metrics[0].Pair.Equal(currency.NewPair(currency.BTC, currency.USDT)) {
+		t.Errorf("received: %v, expected: %v", e.Metrics[0].Pair, "BTCUSDT")
+	}
+	if !e.Run.FinalTotalUSD.Equal(decimal.NewFromInt(1100)) {
+		t.Errorf("received: %v, expected: %v", e.Run.FinalTotalUSD, 1100)
+	}
+	records := e.holdingsRecords()
+	if len(records) != 4 {
+		t.Fatalf("received: %v, expected: %v", len(records), 4)
+	}
+	if len(records[0]) != len(records[1]) {
+		t.Errorf("received: %v columns, expected: %v", len(records[1]), len(records[0]))
+	}
+}
@@ -0,0 +1,172 @@
+package report
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// ExportVersion is incremented whenever a field is removed, renamed or changes
+// meaning so that consumers of exported results can detect incompatible files
+const ExportVersion = 1
+
+// Export table names, used as CSV file name suffixes
+const (
+	runTable        = "run"
+	holdingsTable   = "holdings"
+	ordersTable     = "orders"
+	fundingTable    = "funding"
+	metricsTable    = "metrics"
+	robustnessTable = "robustness"
+)
+
+var errUnsupportedExportFormat = errors.New("unsupported export format")
+
+// csvTable is a table written to its own CSV file
+type csvTable struct {
+	name    string
+	records [][]string
+}
+
+// Export is the versioned machine-readable representation of a backtesting run.
+// Every table is exported to its own file when using CSV
+type Export struct {
+	Run      RunSummary   `json:"run"`
+	Holdings []HoldingRow `json:"holdings"`
+	Orders   []OrderRow   `json:"orders"`
+	Funding  []FundingRow `json:"funding"`
+	Metrics  []MetricRow  `json:"metrics"`
+	// Robustness is only populated when robustness analysis is enabled
+	Robustness []RobustnessRow `json:"robustness,omitempty"`
+}
+
+// RunSummary identifies the run and holds the funding totals
+type RunSummary struct {
+	Version         int             `json:"version"`
+	GeneratedAt     time.Time       `json:"generated-at"`
+	Nickname        string          `json:"nickname"`
+	Strategy        string          `json:"strategy"`
+	Goal            string          `json:"goal"`
+	TotalOrders     int64           `json:"total-orders"`
+	InitialTotalUSD decimal.Decimal `json:"initial-total-usd"`
+	FinalTotalUSD   decimal.Decimal `json:"final-total-usd"`
+	Difference      decimal.Decimal `json:"difference"`
+}
+
+// HoldingRow holds the holdings of an exchange asset pair for a single candle
+type HoldingRow struct {
+	Time                         time.Time       `json:"time"`
+	Offset                       int64           `json:"offset"`
+	Exchange                     string          `json:"exchange"`
+	Asset                        asset.Item      `json:"asset"`
+	Pair                         currency.Pair   `json:"pair"`
+	ClosePrice                   decimal.Decimal `json:"close-price"`
+	BaseSize                     decimal.Decimal `json:"base-size"`
+	BaseValue                    decimal.Decimal `json:"base-value"`
+	QuoteSize                    decimal.Decimal `json:"quote-size"`
+	TotalValue                   decimal.Decimal `json:"total-value"`
+	ChangeInTotalValuePercent    decimal.Decimal `json:"change-in-total-value-percent"`
+	TotalFees                    decimal.Decimal `json:"total-fees"`
+	TotalValueLostToSlippage     decimal.Decimal `json:"total-value-lost-to-slippage"`
+	TotalValueLostToVolumeSizing decimal.Decimal `json:"total-value-lost-to-volume-sizing"`
+	UnrealisedPNL                decimal.Decimal `json:"unrealised-pnl"`
+	RealisedPNL                  decimal.Decimal `json:"realised-pnl"`
+}
+
+// OrderRow holds every fill event along with its fees and slippage
+type OrderRow struct {
+	Time                time.Time       `json:"time"`
+	Offset              int64           `json:"offset"`
+	Exchange            string          `json:"exchange"`
+	Asset               asset.Item      `json:"asset"`
+	Pair                currency.Pair   `json:"pair"`
+	OrderID             string          `json:"order-id"`
+	Direction           order.Side      `json:"direction"`
+	Amount              decimal.Decimal `json:"amount"`
+	ClosePrice          decimal.Decimal `json:"close-price"`
+	VolumeAdjustedPrice decimal.Decimal `json:"volume-adjusted-price"`
+	PurchasePrice       decimal.Decimal `json:"purchase-price"`
+	Total               decimal.Decimal `json:"total"`
+	ExchangeFee         decimal.Decimal `json:"exchange-fee"`
+	SlippageRate        decimal.Decimal `json:"slippage-rate"`
+	SlippageCost        decimal.Decimal `json:"slippage-cost"`
+	Reason              string          `json:"reason"`
+	ExitRule            string          `json:"exit-rule"`
+}
+
+// FundingRow holds the funding snapshot of a single currency
+type FundingRow struct {
+	Exchange        string          `json:"exchange"`
+	Asset           asset.Item      `json:"asset"`
+	Currency        currency.Code   `json:"currency"`
+	PairedWith      currency.Code   `json:"paired-with"`
+	InitialFunds    decimal.Decimal `json:"initial-funds"`
+	InitialFundsUSD decimal.Decimal `json:"initial-funds-usd"`
+	TransferFee     decimal.Decimal `json:"transfer-fee"`
+	FinalFunds      decimal.Decimal `json:"final-funds"`
+	FinalFundsUSD   decimal.Decimal `json:"final-funds-usd"`
+	Difference      decimal.Decimal `json:"difference"`
+}
+
+// MetricRow holds the final metrics of an exchange asset pair
+type MetricRow struct {
+	Exchange                     string          `json:"exchange"`
+	Asset                        asset.Item      `json:"asset"`
+	Pair                         currency.Pair   `json:"pair"`
+	StartingClosePrice           decimal.Decimal `json:"starting-close-price"`
+	EndingClosePrice             decimal.Decimal `json:"ending-close-price"`
+	LowestClosePrice             decimal.Decimal `json:"lowest-close-price"`
+	HighestClosePrice            decimal.Decimal `json:"highest-close-price"`
+	MarketMovement               decimal.Decimal `json:"market-movement"`
+	StrategyMovement             decimal.Decimal `json:"strategy-movement"`
+	BuyOrders                    int64           `json:"buy-orders"`
+	SellOrders                   int64           `json:"sell-orders"`
+	TotalOrders                  int64           `json:"total-orders"`
+	MaxDrawdownPercent           decimal.Decimal `json:"max-drawdown-percent"`
+	HighestCommittedFunds        decimal.Decimal `json:"highest-committed-funds"`
+	ArithmeticSharpeRatio        decimal.Decimal `json:"arithmetic-sharpe-ratio"`
+	ArithmeticSortinoRatio       decimal.Decimal `json:"arithmetic-sortino-ratio"`
+	ArithmeticInformationRatio   decimal.Decimal `json:"arithmetic-information-ratio"`
+	ArithmeticCalmarRatio        decimal.Decimal `json:"arithmetic-calmar-ratio"`
+	GeometricSharpeRatio         decimal.Decimal `json:"geometric-sharpe-ratio"`
+	GeometricSortinoRatio        decimal.Decimal `json:"geometric-sortino-ratio"`
+	GeometricInformationRatio    decimal.Decimal `json:"geometric-information-ratio"`
+	GeometricCalmarRatio         decimal.Decimal `json:"geometric-calmar-ratio"`
+	CompoundAnnualGrowthRate     decimal.Decimal `json:"compound-annual-growth-rate"`
+	TotalFees                    decimal.Decimal `json:"total-fees"`
+	TotalValueLostToSlippage     decimal.Decimal `json:"total-value-lost-to-slippage"`
+	TotalValueLostToVolumeSizing decimal.Decimal `json:"total-value-lost-to-volume-sizing"`
+	RealisedPNL                  decimal.Decimal `json:"realised-pnl"`
+	UnrealisedPNL                decimal.Decimal `json:"unrealised-pnl"`
+	FundingPayments              decimal.Decimal `json:"funding-payments"`
+	Liquidations                 int64           `json:"liquidations"`
+	IsStrategyProfitable         bool            `json:"is-strategy-profitable"`
+	DoesPerformanceBeatTheMarket bool            `json:"does-performance-beat-the-market"`
+	ExitsTriggered               int64           `json:"exits-triggered"`
+	ExitDifference               decimal.Decimal `json:"exit-difference"`
+}
+
+// RobustnessRow holds the resampled outcomes of an exchange asset pair.
+// Drawdown and ruin probability are percentages
+type RobustnessRow struct {
+	Exchange          string          `json:"exchange"`
+	Asset             asset.Item      `json:"asset"`
+	Pair              currency.Pair   `json:"pair"`
+	Method            string          `json:"method"`
+	Iterations        int64           `json:"iterations"`
+	ConfidenceLevel   decimal.Decimal `json:"confidence-level"`
+	FinalEquityMean   decimal.Decimal `json:"final-equity-mean"`
+	FinalEquityMedian decimal.Decimal `json:"final-equity-median"`
+	FinalEquityLower  decimal.Decimal `json:"final-equity-lower"`
+	FinalEquityUpper  decimal.Decimal `json:"final-equity-upper"`
+	MaxDrawdownMean   decimal.Decimal `json:"max-drawdown-mean"`
+	MaxDrawdownMedian decimal.Decimal `json:"max-drawdown-median"`
+	MaxDrawdownLower  decimal.Decimal `json:"max-drawdown-lower"`
+	MaxDrawdownUpper  decimal.Decimal `json:"max-drawdown-upper"`
+	RuinThreshold     decimal.Decimal `json:"ruin-threshold"`
+	RuinProbability   decimal.Decimal `json:"ruin-probability"`
+}
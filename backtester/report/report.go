@@ -49,15 +49,7 @@ func (d *Data) GenerateReport() error {
 			filepath.Join(d.TemplatePath),
 		),
 	)
-	var nickName string
-	if d.Config.Nickname != "" {
-		nickName = d.Config.Nickname + "-"
-	}
-	fileName := fmt.Sprintf(
-		"%v%v-%v.html",
-		nickName,
-		d.Statistics.StrategyName,
-		time.Now().Format("2006-01-02-15-04-05"))
+	fileName := d.fileName("", "html")
 	var f *os.File
 	f, err = os.Create(
 		filepath.Join(d.OutputPath,
@@ -82,6 +74,33 @@ func (d *Data) GenerateReport() error {
 	return nil
 }
 
+// fileName returns the name shared by all files generated for the run
+// so that the report and any exported results can be matched together
+func (d *Data) fileName(suffix, extension string) string {
+	var nickName string
+	if d.Config.Nickname != "" {
+		nickName = d.Config.Nickname + "-"
+	}
+	if suffix != "" {
+		suffix = "-" + suffix
+	}
+	return fmt.Sprintf(
+		"%v%v-%v%v.%v",
+		nickName,
+		d.Statistics.StrategyName,
+		d.getGeneratedAt().Format("2006-01-02-15-04-05"),
+		suffix,
+		extension)
+}
+
+// getGeneratedAt returns the time the first file was generated for the run
+func (d *Data) getGeneratedAt() time.Time {
+	if d.generatedAt.IsZero() {
+		d.generatedAt = time.Now()
+	}
+	return d.generatedAt
+}
+
 // AddKlineItem appends a SET of candles for the report to enhance upon
 // generation
 func (d *Data) AddKlineItem(k *kline.Item) {
@@ -2,6 +2,7 @@ package report
 
 import (
 	"errors"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
@@ -23,6 +24,7 @@ var (
 // Handler contains all functions required to generate statistical reporting for backtesting results
 type Handler interface {
 	GenerateReport() error
+	GenerateExport() error
 	AddKlineItem(*kline.Item)
 	UpdateItem(*kline.Item)
 	UseDarkMode(bool)
@@ -38,6 +40,7 @@ type Data struct {
 	OutputPath      string
 	Warnings        []Warning
 	UseDarkTheme    bool
+	generatedAt     time.Time
 }
 
 // Warning holds any candle warnings
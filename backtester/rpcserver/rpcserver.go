@@ -0,0 +1,188 @@
+package rpcserver
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"time"
+
+	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StartRPCServer starts the backtester gRPC server on the listen address.
+// It uses the same TLS certificate and basic auth credentials as the
+// GoCryptoTrader gRPC server set in the engine's config
+func StartRPCServer(bot *engine.Engine, manager *RunManager, listenAddress string) (*grpc.Server, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	if manager == nil {
+		return nil, errNilManager
+	}
+	if bot.Config == nil {
+		return nil, errNilConfig
+	}
+	targetDir := utils.GetTLSDir(bot.Config.GetDataPath())
+	err := engine.CheckCerts(targetDir)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := credentials.NewServerTLSFromFile(filepath.Join(targetDir, "cert.pem"), filepath.Join(targetDir, "key.pem"))
+	if err != nil {
+		return nil, err
+	}
+	lis, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	basicAuth := auth.BasicAuth{
+		Username: bot.Config.RemoteControl.Username,
+		Password: bot.Config.RemoteControl.Password,
+	}
+	server := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(basicAuth.Authenticate)),
+		grpc.StreamInterceptor(grpcauth.StreamServerInterceptor(basicAuth.Authenticate)),
+	)
+	btrpc.RegisterBacktesterServiceServer(server, &RPCServer{manager: manager})
+
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			log.Errorf(log.BackTester, "backtester gRPC server failed to serve: %s\n", err)
+		}
+	}()
+	log.Infof(log.BackTester, "backtester gRPC server started on https://%v", listenAddress)
+	return server, nil
+}
+
+// SubmitRun queues a backtesting run from a config
+func (s *RPCServer) SubmitRun(_ context.Context, r *btrpc.SubmitRunRequest) (*btrpc.SubmitRunResponse, error) {
+	cfg, err := config.LoadConfig(r.Config)
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.manager.Submit(cfg, r.GenerateReport)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.SubmitRunResponse{Run: convertRunDetails(details)}, nil
+}
+
+// ListRuns lists all runs, optionally filtered by status
+func (s *RPCServer) ListRuns(_ context.Context, r *btrpc.ListRunsRequest) (*btrpc.ListRunsResponse, error) {
+	runs, err := s.manager.List(r.Status)
+	if err != nil {
+		return nil, err
+	}
+	resp := &btrpc.ListRunsResponse{
+		Runs: make([]*btrpc.Run, len(runs)),
+	}
+	for i := range runs {
+		resp.Runs[i] = convertRunDetails(&runs[i])
+	}
+	return resp, nil
+}
+
+// StreamRunProgress streams progress updates for a run until it completes
+func (s *RPCServer) StreamRunProgress(r *btrpc.StreamRunProgressRequest, stream btrpc.BacktesterService_StreamRunProgressServer) error {
+	progress, unsubscribe, err := s.manager.Subscribe(r.Id)
+	if err != nil {
+		return err
+	}
+	defer unsubscribe()
+	var lastStatus string
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case p, ok := <-progress:
+			if !ok {
+				if isCompleted(lastStatus) {
+					return nil
+				}
+				// updates may be dropped for slow subscribers, so always
+				// finish with the run's final state
+				var details *RunDetails
+				details, err = s.manager.Get(r.Id)
+				if err != nil {
+					return err
+				}
+				return stream.Send(&btrpc.RunProgress{
+					Id:              details.ID,
+					Status:          details.Status,
+					EventsProcessed: details.EventsProcessed,
+					TotalEvents:     details.TotalEvents,
+					DataTime:        convertTime(details.DataTime),
+					Error:           details.Error,
+				})
+			}
+			lastStatus = p.Status
+			err = stream.Send(&btrpc.RunProgress{
+				Id:              p.ID,
+				Status:          p.Status,
+				EventsProcessed: p.EventsProcessed,
+				TotalEvents:     p.TotalEvents,
+				DataTime:        convertTime(p.DataTime),
+				Error:           p.Error,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// CancelRun cancels a queued or running run
+func (s *RPCServer) CancelRun(_ context.Context, r *btrpc.CancelRunRequest) (*btrpc.CancelRunResponse, error) {
+	details, err := s.manager.Cancel(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.CancelRunResponse{Run: convertRunDetails(details)}, nil
+}
+
+// GetRunResult returns the statistics and report of a finished run
+func (s *RPCServer) GetRunResult(_ context.Context, r *btrpc.GetRunResultRequest) (*btrpc.GetRunResultResponse, error) {
+	details, statistics, report, err := s.manager.GetResult(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.GetRunResultResponse{
+		Run:        convertRunDetails(details),
+		Statistics: statistics,
+		Report:     report,
+	}, nil
+}
+
+func convertRunDetails(d *RunDetails) *btrpc.Run {
+	return &btrpc.Run{
+		Id:              d.ID,
+		Nickname:        d.Nickname,
+		Strategy:        d.Strategy,
+		Status:          d.Status,
+		Submitted:       convertTime(d.Submitted),
+		Started:         convertTime(d.Started),
+		Finished:        convertTime(d.Finished),
+		EventsProcessed: d.EventsProcessed,
+		TotalEvents:     d.TotalEvents,
+		Error:           d.Error,
+	}
+}
+
+// convertTime leaves unset times empty rather than sending the zero time
+func convertTime(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,78 @@
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+)
+
+func TestStartRPCServer(t *testing.T) {
+	t.Parallel()
+	_, err := StartRPCServer(nil, nil, "")
+	if !errors.Is(err, errNilBot) {
+		t.Errorf("received: %v, expected: %v", err, errNilBot)
+	}
+	_, err = StartRPCServer(&engine.Engine{}, nil, "")
+	if !errors.Is(err, errNilManager) {
+		t.Errorf("received: %v, expected: %v", err, errNilManager)
+	}
+	_, err = StartRPCServer(&engine.Engine{}, &RunManager{}, "")
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received: %v, expected: %v", err, errNilConfig)
+	}
+}
+
+func TestRPCServerRuns(t *testing.T) {
+	t.Parallel()
+	s := &RPCServer{manager: newTestManager(t)}
+	_, err := s.SubmitRun(context.Background(), &btrpc.SubmitRunRequest{Config: []byte("hello")})
+	if err == nil {
+		t.Error("expected error unmarshalling config")
+	}
+	resp, err := s.SubmitRun(context.Background(), &btrpc.SubmitRunRequest{
+		Config: []byte(`{"nickname":"test","strategy-settings":{"name":"dollarcostaverage"},` +
+			`"currency-settings":[{"exchange-name":"binance","asset":"spot","base":"BTC","quote":"USDT","initial-quote-funds":"1000"}]}`),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if resp.Run.Status != StatusQueued || resp.Run.Started != nil {
+		t.Errorf("received: %+v, expected a queued run", resp.Run)
+	}
+
+	list, err := s.ListRuns(context.Background(), &btrpc.ListRunsRequest{Status: StatusQueued})
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if len(list.Runs) != 1 || list.Runs[0].Id != resp.Run.Id {
+		t.Errorf("received: %+v, expected the submitted run", list.Runs)
+	}
+
+	cancelled, err := s.CancelRun(context.Background(), &btrpc.CancelRunRequest{Id: resp.Run.Id})
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if cancelled.Run.Status != StatusCancelled {
+		t.Errorf("received: %v, expected: %v", cancelled.Run.Status, StatusCancelled)
+	}
+
+	_, err = s.GetRunResult(context.Background(), &btrpc.GetRunResultRequest{Id: resp.Run.Id})
+	if !errors.Is(err, errRunNotFinished) {
+		t.Errorf("received: %v, expected: %v", err, errRunNotFinished)
+	}
+}
+
+func TestConvertTime(t *testing.T) {
+	t.Parallel()
+	if convertTime(time.Time{}) != nil {
+		t.Error("expected nil timestamp for zero time")
+	}
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	if !convertTime(tt).AsTime().Equal(tt) {
+		t.Errorf("received: %v, expected: %v", convertTime(tt).AsTime(), tt)
+	}
+}
@@ -0,0 +1,15 @@
+package rpcserver
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+)
+
+var errNilManager = errors.New("nil run manager received")
+
+// RPCServer serves the backtester gRPC service
+type RPCServer struct {
+	btrpc.UnimplementedBacktesterServiceServer
+	manager *RunManager
+}
@@ -0,0 +1,409 @@
+package rpcserver
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/backtester/backtest"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewRunManager sets up a run manager which outputs the results
+// of each run to its own folder under the output path
+func NewRunManager(bot *engine.Engine, templatePath, outputPath string) (*RunManager, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	m := &RunManager{
+		bot:          bot,
+		templatePath: templatePath,
+		outputPath:   outputPath,
+		runs:         make(map[string]*run),
+		subscribers:  make(map[string][]chan Progress),
+		notify:       make(chan struct{}, 1),
+	}
+	m.execute = m.executeRun
+	return m, nil
+}
+
+// Start begins processing queued runs
+func (m *RunManager) Start() error {
+	m.m.Lock()
+	defer m.m.Unlock()
+	if m.running {
+		return errManagerRunning
+	}
+	m.running = true
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.processRuns()
+	return nil
+}
+
+// Stop cancels any running run and stops processing queued runs
+func (m *RunManager) Stop() error {
+	m.m.Lock()
+	if !m.running {
+		m.m.Unlock()
+		return errManagerNotRunning
+	}
+	m.running = false
+	close(m.shutdown)
+	for _, r := range m.runs {
+		if r.details.Status == StatusRunning {
+			m.cancelRun(r)
+		}
+	}
+	m.m.Unlock()
+	m.wg.Wait()
+	return nil
+}
+
+// Submit validates the config and queues it to be run
+func (m *RunManager) Submit(cfg *config.Config, generateReport bool) (*RunDetails, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.DataSettings.LiveData != nil {
+		return nil, errLiveDataUnsupported
+	}
+	if cfg.OptimisationSettings != nil {
+		return nil, errOptimisationDisabled
+	}
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	r := &run{
+		details: RunDetails{
+			ID:        id.String(),
+			Nickname:  cfg.Nickname,
+			Strategy:  cfg.StrategySettings.Name,
+			Status:    StatusQueued,
+			Submitted: time.Now(),
+		},
+		cfg:            cfg,
+		generateReport: generateReport,
+	}
+	m.m.Lock()
+	m.runs[r.details.ID] = r
+	m.order = append(m.order, r.details.ID)
+	details := r.details
+	m.m.Unlock()
+	select {
+	case m.notify <- struct{}{}:
+	default:
+	}
+	return &details, nil
+}
+
+// List returns the details of every run in submission order
+// filtered by status if one is provided
+func (m *RunManager) List(status string) ([]RunDetails, error) {
+	status = strings.ToLower(status)
+	switch status {
+	case "", StatusQueued, StatusRunning, StatusFinished, StatusFailed, StatusCancelled:
+	default:
+		return nil, fmt.Errorf("%w '%v'", errInvalidStatus, status)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	resp := make([]RunDetails, 0, len(m.order))
+	for i := range m.order {
+		r := m.runs[m.order[i]]
+		if status != "" && r.details.Status != status {
+			continue
+		}
+		resp = append(resp, r.details)
+	}
+	return resp, nil
+}
+
+// Get returns the details of a run
+func (m *RunManager) Get(id string) (*RunDetails, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	r, ok := m.runs[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", errRunNotFound, id)
+	}
+	details := r.details
+	return &details, nil
+}
+
+// Cancel removes a queued run from the queue or stops a running run
+func (m *RunManager) Cancel(id string) (*RunDetails, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	r, ok := m.runs[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", errRunNotFound, id)
+	}
+	switch r.details.Status {
+	case StatusQueued:
+		r.cancelled = true
+		m.setStatus(r, StatusCancelled, nil)
+	case StatusRunning:
+		m.cancelRun(r)
+	default:
+		return nil, fmt.Errorf("%v %w with status %v", id, errRunAlreadyCompleted, r.details.Status)
+	}
+	details := r.details
+	return &details, nil
+}
+
+// GetResult returns the serialised statistics and HTML report of a finished run.
+// The report is empty when the run was submitted without generating one
+func (m *RunManager) GetResult(id string) (details *RunDetails, statistics, report string, err error) {
+	m.m.Lock()
+	r, ok := m.runs[id]
+	if !ok {
+		m.m.Unlock()
+		return nil, "", "", fmt.Errorf("%w %v", errRunNotFound, id)
+	}
+	if r.details.Status != StatusFinished {
+		m.m.Unlock()
+		return nil, "", "", fmt.Errorf("%v %w, status: %v", id, errRunNotFinished, r.details.Status)
+	}
+	d := r.details
+	statistics = r.statistics
+	reportPath := r.reportPath
+	m.m.Unlock()
+	if reportPath != "" {
+		var resp []byte
+		resp, err = ioutil.ReadFile(reportPath)
+		if err != nil {
+			return nil, "", "", err
+		}
+		report = string(resp)
+	}
+	return &d, statistics, report, nil
+}
+
+// Subscribe returns a channel which receives progress updates for a run.
+// The channel is closed once the run has completed. The returned function
+// must be called to unsubscribe before the run has completed
+func (m *RunManager) Subscribe(id string) (<-chan Progress, func(), error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	r, ok := m.runs[id]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w %v", errRunNotFound, id)
+	}
+	ch := make(chan Progress, progressBufferSize)
+	ch <- r.progress()
+	if isCompleted(r.details.Status) {
+		close(ch)
+		return ch, func() {}, nil
+	}
+	m.subscribers[id] = append(m.subscribers[id], ch)
+	return ch, func() {
+		m.m.Lock()
+		defer m.m.Unlock()
+		subs := m.subscribers[id]
+		for i := range subs {
+			if subs[i] == ch {
+				m.subscribers[id] = append(subs[:i], subs[i+1:]...)
+				close(ch)
+				break
+			}
+		}
+	}, nil
+}
+
+// processRuns runs every queued run in submission order until shutdown
+func (m *RunManager) processRuns() {
+	defer m.wg.Done()
+	for {
+		m.m.Lock()
+		var next *run
+		for i := range m.order {
+			if m.runs[m.order[i]].details.Status == StatusQueued {
+				next = m.runs[m.order[i]]
+				break
+			}
+		}
+		if next != nil {
+			next.details.Started = time.Now()
+			m.setStatus(next, StatusRunning, nil)
+		}
+		m.m.Unlock()
+		if next == nil {
+			select {
+			case <-m.shutdown:
+				return
+			case <-m.notify:
+			}
+			continue
+		}
+
+		err := m.execute(next)
+		m.m.Lock()
+		next.details.Finished = time.Now()
+		switch {
+		case next.cancelled:
+			m.setStatus(next, StatusCancelled, nil)
+		case err != nil:
+			log.Errorf(log.BackTester, "run %v failed: %v", next.details.ID, err)
+			m.setStatus(next, StatusFailed, err)
+		default:
+			m.setStatus(next, StatusFinished, nil)
+		}
+		// results are kept, but the backtester is no longer required
+		next.bt = nil
+		m.m.Unlock()
+
+		select {
+		case <-m.shutdown:
+			return
+		default:
+		}
+	}
+}
+
+// executeRun runs the backtester against the run's config and stores the results
+func (m *RunManager) executeRun(r *run) error {
+	outputPath := filepath.Join(m.outputPath, r.details.ID)
+	err := os.MkdirAll(outputPath, 0770)
+	if err != nil {
+		return err
+	}
+	bt, err := backtest.NewFromConfig(r.cfg, m.templatePath, outputPath, m.bot)
+	if err != nil {
+		return err
+	}
+	var totalEvents int64
+	for _, exchangeMap := range bt.Datas.GetAllData() {
+		for _, assetMap := range exchangeMap {
+			for _, dataHandler := range assetMap {
+				// every data handler is advanced in lockstep
+				totalEvents = int64(len(dataHandler.List()))
+			}
+		}
+	}
+	bt.ProgressHandler = func(ev common.DataEventHandler) {
+		m.m.Lock()
+		r.details.EventsProcessed = ev.GetOffset()
+		r.details.DataTime = ev.GetTime()
+		m.publish(r)
+		m.m.Unlock()
+	}
+	m.m.Lock()
+	if r.cancelled {
+		m.m.Unlock()
+		return errRunCancelled
+	}
+	r.bt = bt
+	r.details.TotalEvents = totalEvents
+	m.m.Unlock()
+
+	err = bt.Run()
+	if err != nil {
+		return err
+	}
+	m.m.Lock()
+	cancelled := r.cancelled
+	m.m.Unlock()
+	if cancelled {
+		return errRunCancelled
+	}
+	err = bt.Statistic.CalculateAllResults(bt.Funding)
+	if err != nil {
+		return err
+	}
+	statistics, err := bt.Statistic.Serialise()
+	if err != nil {
+		return err
+	}
+	err = bt.Reports.GenerateExport()
+	if err != nil {
+		return err
+	}
+	var reportPath string
+	if r.generateReport {
+		err = bt.Reports.GenerateReport()
+		if err != nil {
+			return err
+		}
+		var matches []string
+		matches, err = filepath.Glob(filepath.Join(outputPath, "*.html"))
+		if err != nil {
+			return err
+		}
+		if len(matches) > 0 {
+			reportPath = matches[0]
+		}
+	}
+	m.m.Lock()
+	r.statistics = statistics
+	r.reportPath = reportPath
+	m.m.Unlock()
+	return nil
+}
+
+// cancelRun stops a running run. The lock must be held
+func (m *RunManager) cancelRun(r *run) {
+	if r.cancelled {
+		return
+	}
+	r.cancelled = true
+	if r.bt != nil {
+		r.bt.Stop()
+	}
+}
+
+// setStatus updates the run's status and notifies subscribers.
+// Subscriptions are closed once the run has completed. The lock must be held
+func (m *RunManager) setStatus(r *run, status string, err error) {
+	r.details.Status = status
+	if err != nil {
+		r.details.Error = err.Error()
+	}
+	m.publish(r)
+	if !isCompleted(status) {
+		return
+	}
+	for _, ch := range m.subscribers[r.details.ID] {
+		close(ch)
+	}
+	delete(m.subscribers, r.details.ID)
+}
+
+// publish sends the run's progress to all subscribers, dropping the update
+// for any subscriber which has fallen behind. The lock must be held
+func (m *RunManager) publish(r *run) {
+	p := r.progress()
+	for _, ch := range m.subscribers[r.details.ID] {
+		select {
+		case ch <- p:
+		default:
+		}
+	}
+}
+
+func (r *run) progress() Progress {
+	return Progress{
+		ID:              r.details.ID,
+		Status:          r.details.Status,
+		EventsProcessed: r.details.EventsProcessed,
+		TotalEvents:     r.details.TotalEvents,
+		DataTime:        r.details.DataTime,
+		Error:           r.details.Error,
+	}
+}
+
+func isCompleted(status string) bool {
+	return status == StatusFinished || status == StatusFailed || status == StatusCancelled
+}
@@ -0,0 +1,374 @@
+package rpcserver
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dollarcostaverage"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+)
+
+const testExchange = "binance"
+
+func newTestConfig() *config.Config {
+	funds := decimal.NewFromInt(1000)
+	return &config.Config{
+		Nickname: "test",
+		StrategySettings: config.StrategySettings{
+			Name: dollarcostaverage.Name,
+		},
+		CurrencySettings: []config.CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             "spot",
+				Base:              "BTC",
+				Quote:             "USDT",
+				InitialQuoteFunds: &funds,
+			},
+		},
+	}
+}
+
+func newTestManager(t *testing.T) *RunManager {
+	t.Helper()
+	m, err := NewRunManager(&engine.Engine{}, "", "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	return m
+}
+
+// waitForStatus polls until the run reaches the status to avoid racing the worker
+func waitForStatus(t *testing.T, m *RunManager, id, status string) *RunDetails {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		d, err := m.Get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Status == status {
+			return d
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	t.Fatalf("run %v did not reach status %v", id, status)
+	return nil
+}
+
+func TestNewRunManager(t *testing.T) {
+	t.Parallel()
+	_, err := NewRunManager(nil, "", "")
+	if !errors.Is(err, errNilBot) {
+		t.Errorf("received: %v, expected: %v", err, errNilBot)
+	}
+	m := newTestManager(t)
+	if m.execute == nil {
+		t.Error("expected execute to be set")
+	}
+}
+
+func TestStartStop(t *testing.T) {
+	t.Parallel()
+	m := newTestManager(t)
+	err := m.Stop()
+	if !errors.Is(err, errManagerNotRunning) {
+		t.Errorf("received: %v, expected: %v", err, errManagerNotRunning)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, errManagerRunning) {
+		t.Errorf("received: %v, expected: %v", err, errManagerRunning)
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	t.Parallel()
+	m := newTestManager(t)
+	_, err := m.Submit(nil, false)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received: %v, expected: %v", err, errNilConfig)
+	}
+	cfg := newTestConfig()
+	cfg.DataSettings.LiveData = &config.LiveData{}
+	_, err = m.Submit(cfg, false)
+	if !errors.Is(err, errLiveDataUnsupported) {
+		t.Errorf("received: %v, expected: %v", err, errLiveDataUnsupported)
+	}
+	cfg = newTestConfig()
+	cfg.OptimisationSettings = &config.OptimisationSettings{}
+	_, err = m.Submit(cfg, false)
+	if !errors.Is(err, errOptimisationDisabled) {
+		t.Errorf("received: %v, expected: %v", err, errOptimisationDisabled)
+	}
+	cfg = newTestConfig()
+	cfg.CurrencySettings = nil
+	_, err = m.Submit(cfg, false)
+	if err == nil {
+		t.Error("expected config validation error")
+	}
+
+	d, err := m.Submit(newTestConfig(), true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if d.ID == "" || d.Status != StatusQueued || d.Strategy != dollarcostaverage.Name {
+		t.Errorf("received: %+v, expected a queued %v run", d, dollarcostaverage.Name)
+	}
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+	m := newTestManager(t)
+	_, err := m.List("hello")
+	if !errors.Is(err, errInvalidStatus) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidStatus)
+	}
+	d1, err := m.Submit(newTestConfig(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d2, err := m.Submit(newTestConfig(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.Cancel(d2.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runs, err := m.List("")
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if len(runs) != 2 || runs[0].ID != d1.ID || runs[1].ID != d2.ID {
+		t.Errorf("received: %+v, expected runs in submission order", runs)
+	}
+	runs, err = m.List("CANCELLED")
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if len(runs) != 1 || runs[0].ID != d2.ID {
+		t.Errorf("received: %+v, expected only the cancelled run", runs)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	t.Parallel()
+	m := newTestManager(t)
+	_, err := m.Cancel("hello")
+	if !errors.Is(err, errRunNotFound) {
+		t.Errorf("received: %v, expected: %v", err, errRunNotFound)
+	}
+	d, err := m.Submit(newTestConfig(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = m.Cancel(d.ID)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if d.Status != StatusCancelled {
+		t.Errorf("received: %v, expected: %v", d.Status, StatusCancelled)
+	}
+	_, err = m.Cancel(d.ID)
+	if !errors.Is(err, errRunAlreadyCompleted) {
+		t.Errorf("received: %v, expected: %v", err, errRunAlreadyCompleted)
+	}
+
+	// running runs are flagged and stopped by the worker
+	m2 := newTestManager(t)
+	started := make(chan struct{})
+	m2.execute = func(r *run) error {
+		close(started)
+		for i := 0; i < 100; i++ {
+			m2.m.Lock()
+			cancelled := r.cancelled
+			m2.m.Unlock()
+			if cancelled {
+				return errRunCancelled
+			}
+			time.Sleep(time.Millisecond * 10)
+		}
+		return nil
+	}
+	err = m2.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = m2.Submit(newTestConfig(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-started
+	_, err = m2.Cancel(d.ID)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	waitForStatus(t, m2, d.ID, StatusCancelled)
+	err = m2.Stop()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProcessRuns(t *testing.T) {
+	t.Parallel()
+	m := newTestManager(t)
+	errExpected := errors.New("expected error")
+	m.execute = func(r *run) error {
+		if r.details.Nickname == "fail" {
+			return errExpected
+		}
+		return nil
+	}
+	err := m.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := newTestConfig()
+	cfg.Nickname = "fail"
+	failed, err := m.Submit(cfg, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finished, err := m.Submit(newTestConfig(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := waitForStatus(t, m, failed.ID, StatusFailed)
+	if d.Error != errExpected.Error() {
+		t.Errorf("received: %v, expected: %v", d.Error, errExpected)
+	}
+	d = waitForStatus(t, m, finished.ID, StatusFinished)
+	if d.Started.IsZero() || d.Finished.IsZero() {
+		t.Errorf("received: %v %v, expected start and finish times", d.Started, d.Finished)
+	}
+	err = m.Stop()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+	m := newTestManager(t)
+	_, _, err := m.Subscribe("hello")
+	if !errors.Is(err, errRunNotFound) {
+		t.Errorf("received: %v, expected: %v", err, errRunNotFound)
+	}
+	d, err := m.Submit(newTestConfig(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, unsubscribe, err := m.Subscribe(d.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	p := <-ch
+	if p.Status != StatusQueued {
+		t.Errorf("received: %v, expected: %v", p.Status, StatusQueued)
+	}
+	unsubscribe()
+	if _, ok := <-ch; ok {
+		t.Error("expected channel to be closed after unsubscribing")
+	}
+
+	ch, unsubscribe, err = m.Subscribe(d.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unsubscribe()
+	<-ch
+	_, err = m.Cancel(d.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p = <-ch
+	if p.Status != StatusCancelled {
+		t.Errorf("received: %v, expected: %v", p.Status, StatusCancelled)
+	}
+	if _, ok := <-ch; ok {
+		t.Error("expected channel to be closed once the run completed")
+	}
+
+	// completed runs send their final state and close straight away
+	ch, _, err = m.Subscribe(d.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p = <-ch
+	if p.Status != StatusCancelled {
+		t.Errorf("received: %v, expected: %v", p.Status, StatusCancelled)
+	}
+	if _, ok := <-ch; ok {
+		t.Error("expected channel to be closed for a completed run")
+	}
+}
+
+func TestGetResult(t *testing.T) {
+	t.Parallel()
+	tempDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatalf("Problem creating temp dir at %s: %s\n", tempDir, err)
+	}
+	defer func(path string) {
+		err = os.RemoveAll(path)
+		if err != nil {
+			t.Error(err)
+		}
+	}(tempDir)
+	reportPath := filepath.Join(tempDir, "report.html")
+	err = ioutil.WriteFile(reportPath, []byte("<html></html>"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := newTestManager(t)
+	_, _, _, err = m.GetResult("hello")
+	if !errors.Is(err, errRunNotFound) {
+		t.Errorf("received: %v, expected: %v", err, errRunNotFound)
+	}
+	m.execute = func(r *run) error {
+		m.m.Lock()
+		r.statistics = "{}"
+		r.reportPath = reportPath
+		m.m.Unlock()
+		return nil
+	}
+	d, err := m.Submit(newTestConfig(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, _, err = m.GetResult(d.ID)
+	if !errors.Is(err, errRunNotFinished) {
+		t.Errorf("received: %v, expected: %v", err, errRunNotFinished)
+	}
+	err = m.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitForStatus(t, m, d.ID, StatusFinished)
+	_, statistics, report, err := m.GetResult(d.ID)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if statistics != "{}" || report != "<html></html>" {
+		t.Errorf("received: %v %v, expected the run's statistics and report", statistics, report)
+	}
+	err = m.Stop()
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,91 @@
+package rpcserver
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/backtest"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+)
+
+// Run statuses
+const (
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusFinished  = "finished"
+	StatusFailed    = "failed"
+	StatusCancelled = "cancelled"
+)
+
+// progressBufferSize is how many progress updates a subscriber can fall behind
+// before updates are dropped
+const progressBufferSize = 100
+
+var (
+	errNilBot               = errors.New("nil bot received")
+	errNilConfig            = errors.New("nil config received")
+	errRunNotFound          = errors.New("run not found")
+	errRunNotFinished       = errors.New("run has not finished")
+	errRunAlreadyCompleted  = errors.New("run has already completed")
+	errLiveDataUnsupported  = errors.New("live data runs cannot be submitted remotely")
+	errOptimisationDisabled = errors.New("optimisation runs cannot be submitted remotely")
+	errInvalidStatus        = errors.New("invalid run status")
+	errManagerRunning       = errors.New("run manager already running")
+	errManagerNotRunning    = errors.New("run manager not running")
+	errRunCancelled         = errors.New("run cancelled")
+)
+
+// RunManager queues submitted backtesting runs and processes them one at a time
+// as every run shares the same GoCryptoTrader engine
+type RunManager struct {
+	m            sync.Mutex
+	bot          *engine.Engine
+	templatePath string
+	outputPath   string
+	runs         map[string]*run
+	order        []string
+	subscribers  map[string][]chan Progress
+	execute      func(*run) error
+	notify       chan struct{}
+	shutdown     chan struct{}
+	wg           sync.WaitGroup
+	running      bool
+}
+
+// RunDetails holds the state of a backtesting run
+type RunDetails struct {
+	ID              string
+	Nickname        string
+	Strategy        string
+	Status          string
+	Submitted       time.Time
+	Started         time.Time
+	Finished        time.Time
+	EventsProcessed int64
+	TotalEvents     int64
+	DataTime        time.Time
+	Error           string
+}
+
+// Progress is sent to subscribers whenever a run progresses or changes status
+type Progress struct {
+	ID              string
+	Status          string
+	EventsProcessed int64
+	TotalEvents     int64
+	DataTime        time.Time
+	Error           string
+}
+
+// run holds a backtesting run and the results once it has finished
+type run struct {
+	details        RunDetails
+	cfg            *config.Config
+	generateReport bool
+	bt             *backtest.BackTest
+	cancelled      bool
+	statistics     string
+	reportPath     string
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var arbitrageFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "optional asset type to filter opportunities by e.g. spot",
+	},
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "optional currency pair to filter opportunities by e.g. btc-usdt",
+	},
+}
+
+var triangularFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "optional exchange to filter cycles by",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "optional asset type to filter cycles by e.g. spot",
+	},
+}
+
+var arbitrageCommands = &cli.Command{
+	Name:      "arbitrage",
+	Usage:     "execute arbitrage scanner command",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "opportunities",
+			Usage:     "gets the cross exchange arbitrage opportunities found by the most recent scan",
+			ArgsUsage: "<asset> <pair>",
+			Action:    getArbitrageOpportunities,
+			Flags:     arbitrageFlags,
+		},
+		{
+			Name:      "stream",
+			Usage:     "streams cross exchange arbitrage opportunities as they are found",
+			ArgsUsage: "<asset> <pair>",
+			Action:    getArbitrageOpportunityStream,
+			Flags:     arbitrageFlags,
+		},
+		{
+			Name:      "triangular",
+			Usage:     "gets the triangular arbitrage cycles found by the most recent scan",
+			ArgsUsage: "<exchange> <asset>",
+			Action:    getTriangularArbitrageOpportunities,
+			Flags:     triangularFlags,
+		},
+		{
+			Name:      "triangularstream",
+			Usage:     "streams triangular arbitrage cycles as they are found",
+			ArgsUsage: "<exchange> <asset>",
+			Action:    getTriangularArbitrageStream,
+			Flags:     triangularFlags,
+		},
+	},
+}
+
+// arbitrageFilter returns the optional asset and pair filters of an
+// arbitrage command
+func arbitrageFilter(c *cli.Context) (string, *gctrpc.CurrencyPair, error) {
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().First()
+	}
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return "", nil, errInvalidAsset
+	}
+
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+	if pair == "" {
+		return assetType, nil, nil
+	}
+	if !validPair(pair) {
+		return "", nil, errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return "", nil, err
+	}
+	return assetType, &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, nil
+}
+
+func getArbitrageOpportunities(c *cli.Context) error {
+	assetType, pair, err := arbitrageFilter(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetArbitrageOpportunities(c.Context,
+		&gctrpc.GetArbitrageOpportunitiesRequest{
+			Asset: assetType,
+			Pair:  pair,
+		})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getArbitrageOpportunityStream(c *cli.Context) error {
+	assetType, pair, err := arbitrageFilter(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetArbitrageOpportunityStream(c.Context,
+		&gctrpc.GetArbitrageOpportunityStreamRequest{
+			Asset: assetType,
+			Pair:  pair,
+		})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
+// triangularFilter returns the optional exchange and asset filters of a
+// triangular arbitrage command
+func triangularFilter(c *cli.Context) (exchangeName, assetType string, err error) {
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return "", "", errInvalidAsset
+	}
+	return exchangeName, assetType, nil
+}
+
+func getTriangularArbitrageOpportunities(c *cli.Context) error {
+	exchangeName, assetType, err := triangularFilter(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTriangularArbitrageOpportunities(c.Context,
+		&gctrpc.GetTriangularArbitrageOpportunitiesRequest{
+			Exchange: exchangeName,
+			Asset:    assetType,
+		})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getTriangularArbitrageStream(c *cli.Context) error {
+	exchangeName, assetType, err := triangularFilter(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTriangularArbitrageStream(c.Context,
+		&gctrpc.GetTriangularArbitrageStreamRequest{
+			Exchange: exchangeName,
+			Asset:    assetType,
+		})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var backtesterHost string
+
+var errReportNotGenerated = errors.New("run was not submitted with generatereport")
+
+var backtesterCommands = &cli.Command{
+	Name:      "backtester",
+	Usage:     "submit, monitor and fetch backtesting runs from a backtester started with -rpcserver",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "submitrun",
+			Usage:     "submits a backtester strategy config to be run",
+			ArgsUsage: "<path> <generatereport>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "path",
+					Usage: "the path to the backtester strategy config",
+				},
+				&cli.BoolFlag{
+					Name:  "generatereport",
+					Usage: "whether the backtester generates a HTML report for the run",
+				},
+			},
+			Action: submitBacktesterRun,
+		},
+		{
+			Name:      "listruns",
+			Usage:     "lists all runs, optionally filtered by status",
+			ArgsUsage: "<status>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "status",
+					Usage: "queued, running, finished, failed or cancelled",
+				},
+			},
+			Action: listBacktesterRuns,
+		},
+		{
+			Name:      "streamprogress",
+			Usage:     "streams a run's progress until it has completed",
+			ArgsUsage: "<id>",
+			Flags:     []cli.Flag{backtesterRunIDFlag},
+			Action:    streamBacktesterRunProgress,
+		},
+		{
+			Name:      "cancelrun",
+			Usage:     "removes a queued run from the queue or stops a running run",
+			ArgsUsage: "<id>",
+			Flags:     []cli.Flag{backtesterRunIDFlag},
+			Action:    cancelBacktesterRun,
+		},
+		{
+			Name:      "getresult",
+			Usage:     "returns the statistics of a finished run and optionally saves its report",
+			ArgsUsage: "<id> <reportpath>",
+			Flags: []cli.Flag{
+				backtesterRunIDFlag,
+				&cli.StringFlag{
+					Name:  "reportpath",
+					Usage: "if set, saves the run's HTML report to this path",
+				},
+			},
+			Action: getBacktesterRunResult,
+		},
+	},
+}
+
+var backtesterRunIDFlag = &cli.StringFlag{
+	Name:  "id",
+	Usage: "the id of the run",
+}
+
+func setupBacktesterClient(c *cli.Context) (*grpc.ClientConn, context.CancelFunc, error) {
+	creds, err := credentials.NewClientTLSFromFile(certPath, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(auth.BasicAuth{
+			Username: username,
+			Password: password,
+		}),
+	}
+
+	var cancel context.CancelFunc
+	c.Context, cancel = context.WithTimeout(c.Context, timeout)
+	conn, err := grpc.DialContext(c.Context, backtesterHost, opts...)
+	return conn, cancel, err
+}
+
+func submitBacktesterRun(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	var path string
+	if c.IsSet("path") {
+		path = c.String("path")
+	} else {
+		path = c.Args().First()
+	}
+
+	var generateReport bool
+	if c.IsSet("generatereport") {
+		generateReport = c.Bool("generatereport")
+	} else if c.Args().Get(1) != "" {
+		var err error
+		generateReport, err = strconv.ParseBool(c.Args().Get(1))
+		if err != nil {
+			return err
+		}
+	}
+
+	cfg, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupBacktesterClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.SubmitRun(c.Context, &btrpc.SubmitRunRequest{
+		Config:         cfg,
+		GenerateReport: generateReport,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func listBacktesterRuns(c *cli.Context) error {
+	var status string
+	if c.IsSet("status") {
+		status = c.String("status")
+	} else {
+		status = c.Args().First()
+	}
+
+	conn, cancel, err := setupBacktesterClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ListRuns(c.Context, &btrpc.ListRunsRequest{Status: status})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func streamBacktesterRunProgress(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	id := getBacktesterRunID(c)
+
+	conn, cancel, err := setupBacktesterClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.StreamRunProgress(c.Context, &btrpc.StreamRunProgressRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Progress for run %s:\n\n", id)
+		jsonOutput(resp)
+	}
+}
+
+func cancelBacktesterRun(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	id := getBacktesterRunID(c)
+
+	conn, cancel, err := setupBacktesterClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.CancelRun(c.Context, &btrpc.CancelRunRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getBacktesterRunResult(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	id := getBacktesterRunID(c)
+
+	var reportPath string
+	if c.IsSet("reportpath") {
+		reportPath = c.String("reportpath")
+	} else {
+		reportPath = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupBacktesterClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.GetRunResult(c.Context, &btrpc.GetRunResultRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	if reportPath != "" {
+		if result.Report == "" {
+			return fmt.Errorf("cannot save report for %v, %w", id, errReportNotGenerated)
+		}
+		err = file.Write(filepath.Clean(reportPath), []byte(result.Report))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Report saved to %s\n", reportPath)
+	}
+	// the report is only useful when written to disk
+	result.Report = ""
+	jsonOutput(result)
+	return nil
+}
+
+func getBacktesterRunID(c *cli.Context) string {
+	if c.IsSet("id") {
+		return c.String("id")
+	}
+	return c.Args().First()
+}
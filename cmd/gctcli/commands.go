@@ -473,6 +473,52 @@ func getExchangeInfo(c *cli.Context) error {
 	return nil
 }
 
+var getRateLimitBudgetsCommand = &cli.Command{
+	Name:      "getratelimitbudgets",
+	Usage:     "gets the HTTP rate limit budget usage of a specific exchange",
+	ArgsUsage: "<exchange>",
+	Action:    getRateLimitBudgets,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the rate limit budgets for",
+		},
+	},
+}
+
+func getRateLimitBudgets(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getratelimitbudgets")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetRateLimitBudgets(c.Context,
+		&gctrpc.GetRateLimitBudgetsRequest{
+			Exchange: exchangeName,
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var getTickerCommand = &cli.Command{
 	Name:      "getticker",
 	Usage:     "gets the ticker for a specific currency pair and exchange",
@@ -1514,7 +1560,7 @@ var submitOrderCommand = &cli.Command{
 		},
 		&cli.StringFlag{
 			Name:  "type",
-			Usage: "the order type (MARKET OR LIMIT)",
+			Usage: "the order type (MARKET, LIMIT or an order manager emulated STOP, STOP LIMIT, TAKE PROFIT, TRAILING_STOP, OCO, BRACKET)",
 		},
 		&cli.Float64Flag{
 			Name:  "amount",
@@ -1522,7 +1568,7 @@ var submitOrderCommand = &cli.Command{
 		},
 		&cli.Float64Flag{
 			Name:  "price",
-			Usage: "the price for the order",
+			Usage: "the price for the order, or the limit price of a triggered order",
 		},
 		&cli.StringFlag{
 			Name:  "client_id",
@@ -1532,6 +1578,22 @@ var submitOrderCommand = &cli.Command{
 			Name:  "asset",
 			Usage: "required asset type",
 		},
+		&cli.Float64Flag{
+			Name:  "trigger_price",
+			Usage: "the trigger price for STOP, STOP LIMIT and TAKE PROFIT orders",
+		},
+		&cli.Float64Flag{
+			Name:  "trailing_distance",
+			Usage: "the price distance a TRAILING_STOP order follows the market by",
+		},
+		&cli.Float64Flag{
+			Name:  "take_profit_price",
+			Usage: "the take profit trigger price for OCO and BRACKET orders",
+		},
+		&cli.Float64Flag{
+			Name:  "stop_loss_price",
+			Usage: "the stop loss trigger price for OCO and BRACKET orders",
+		},
 	},
 }
 
@@ -1646,12 +1708,68 @@ func submitOrder(c *cli.Context) error {
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
-		Side:      orderSide,
-		OrderType: orderType,
-		Amount:    amount,
-		Price:     price,
-		ClientId:  clientID,
-		AssetType: assetType,
+		Side:             orderSide,
+		OrderType:        orderType,
+		Amount:           amount,
+		Price:            price,
+		ClientId:         clientID,
+		AssetType:        assetType,
+		TriggerPrice:     c.Float64("trigger_price"),
+		TrailingDistance: c.Float64("trailing_distance"),
+		TakeProfitPrice:  c.Float64("take_profit_price"),
+		StopLossPrice:    c.Float64("stop_loss_price"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var killSwitchCommand = &cli.Command{
+	Name:      "killswitch",
+	Usage:     "engages or disengages the order manager kill switch",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "engage",
+			Usage:     "cancels all orders and blocks new orders from being submitted",
+			ArgsUsage: "<reason>",
+			Action:    setKillSwitch,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "reason",
+					Usage: "the reason the kill switch was engaged, sent to communication relayers",
+				},
+			},
+		},
+		{
+			Name:   "disengage",
+			Usage:  "allows new orders to be submitted",
+			Action: setKillSwitch,
+		},
+	},
+}
+
+func setKillSwitch(c *cli.Context) error {
+	var reason string
+	if c.IsSet("reason") {
+		reason = c.String("reason")
+	} else {
+		reason = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetKillSwitch(c.Context, &gctrpc.SetKillSwitchRequest{
+		Engage: c.Command.Name == "engage",
+		Reason: reason,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,275 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errExecutionIDUnset = errors.New("execution order id must be set")
+
+var executionCommands = &cli.Command{
+	Name:      "execution",
+	Usage:     "execute execution manager command",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "submit",
+			Usage:     "submits a parent order to be sliced into child orders by a TWAP, VWAP or ICEBERG algorithm",
+			ArgsUsage: "<exchange> <pair> <asset> <side> <amount> <algorithm>",
+			Action:    submitExecutionOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to submit the order for",
+				},
+				&cli.StringFlag{
+					Name:  "pair",
+					Usage: "the currency pair e.g. btc-usd",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "the asset type",
+				},
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the order side to use (BUY OR SELL)",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the total amount of the parent order",
+				},
+				&cli.StringFlag{
+					Name:  "algorithm",
+					Usage: "the execution algorithm (TWAP, VWAP or ICEBERG)",
+				},
+				&cli.Float64Flag{
+					Name:  "price",
+					Usage: "the limit price of each child order, market orders are used when unset",
+				},
+				&cli.Int64Flag{
+					Name:  "duration",
+					Usage: "the duration in seconds TWAP and VWAP orders are spread across",
+				},
+				&cli.Int64Flag{
+					Name:  "interval",
+					Usage: "the interval in seconds between TWAP and VWAP slices, e.g. 60 for one minute",
+					Value: 60,
+				},
+				&cli.Float64Flag{
+					Name:  "clip_size",
+					Usage: "the visible amount of each ICEBERG child order",
+				},
+				&cli.StringFlag{
+					Name:  "client_order_id",
+					Usage: "the optional client order ID applied to child orders",
+				},
+			},
+		},
+		{
+			Name:      "get",
+			Usage:     "returns the progress of a parent order, or all parent orders when no id is supplied",
+			ArgsUsage: "<id>",
+			Action:    getExecutionOrders,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the parent order id",
+				},
+			},
+		},
+		{
+			Name:      "pause",
+			Usage:     "pauses the submission of child orders for a parent order",
+			ArgsUsage: "<id>",
+			Action:    setExecutionOrderStatus,
+			Flags:     executionIDFlags,
+		},
+		{
+			Name:      "resume",
+			Usage:     "resumes the submission of child orders for a parent order",
+			ArgsUsage: "<id>",
+			Action:    setExecutionOrderStatus,
+			Flags:     executionIDFlags,
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels a parent order and its open child orders",
+			ArgsUsage: "<id>",
+			Action:    setExecutionOrderStatus,
+			Flags:     executionIDFlags,
+		},
+	},
+}
+
+var executionIDFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "id",
+		Usage: "the parent order id",
+	},
+}
+
+func submitExecutionOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(3)
+	}
+	if orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(4) != "" {
+		amount, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	var algorithm string
+	if c.IsSet("algorithm") {
+		algorithm = c.String("algorithm")
+	} else {
+		algorithm = c.Args().Get(5)
+	}
+	if algorithm == "" {
+		return errors.New("execution algorithm must be set")
+	}
+
+	duration := time.Duration(c.Int64("duration")) * time.Second
+	interval := time.Duration(c.Int64("interval")) * time.Second
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SubmitExecutionOrder(c.Context, &gctrpc.SubmitExecutionOrderRequest{
+		Exchange: exchangeName,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType:     assetType,
+		Side:          orderSide,
+		Amount:        amount,
+		Price:         c.Float64("price"),
+		Algorithm:     algorithm,
+		Duration:      int64(duration),
+		Interval:      int64(interval),
+		ClipSize:      c.Float64("clip_size"),
+		ClientOrderId: c.String("client_order_id"),
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getExecutionOrders(c *cli.Context) error {
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExecutionOrders(c.Context, &gctrpc.GetExecutionOrdersRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func setExecutionOrderStatus(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errExecutionIDUnset
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetExecutionOrderStatus(c.Context, &gctrpc.SetExecutionOrderStatusRequest{
+		Id:     id,
+		Status: c.Command.Name,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var futuresFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "the exchange to manage futures for",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "the futures asset type e.g. usdtmarginedfutures",
+	},
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "the currency pair e.g. btc-usdt",
+	},
+}
+
+var futuresCommands = &cli.Command{
+	Name:      "futures",
+	Usage:     "execute futures management command",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "positions",
+			Usage:     "gets the open futures positions held on an exchange, optionally filtered by pair",
+			ArgsUsage: "<exchange> <asset> <pair>",
+			Action:    getFuturesPositions,
+			Flags:     futuresFlags,
+		},
+		{
+			Name:      "fundingrates",
+			Usage:     "gets the current funding rates of perpetual contracts, optionally filtered by pair",
+			ArgsUsage: "<exchange> <asset> <pair>",
+			Action:    getFundingRates,
+			Flags:     futuresFlags,
+		},
+		{
+			Name:      "setleverage",
+			Usage:     "sets the leverage of a futures pair",
+			ArgsUsage: "<exchange> <asset> <pair> <leverage>",
+			Action:    setLeverage,
+			Flags: append([]cli.Flag{
+				&cli.Float64Flag{
+					Name:  "leverage",
+					Usage: "the leverage to use",
+				},
+			}, futuresFlags...),
+		},
+		{
+			Name:      "setmargintype",
+			Usage:     "sets whether a futures pair uses isolated or cross margin",
+			ArgsUsage: "<exchange> <asset> <pair> <margin_type>",
+			Action:    setMarginType,
+			Flags: append([]cli.Flag{
+				&cli.StringFlag{
+					Name:  "margin_type",
+					Usage: "the margin type to use (ISOLATED or CROSS)",
+				},
+			}, futuresFlags...),
+		},
+		{
+			Name:      "collateral",
+			Usage:     "gets the collateral held on a futures account",
+			ArgsUsage: "<exchange> <asset>",
+			Action:    getCollateral,
+			Flags:     futuresFlags[:2],
+		},
+	},
+}
+
+func getFuturesPositions(c *cli.Context) error {
+	exchangeName, assetType, pair, err := ledgerFilter(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFuturesPositions(c.Context, &gctrpc.GetFuturesPositionsRequest{
+		Exchange: exchangeName,
+		Asset:    assetType,
+		Pair:     pair,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getFundingRates(c *cli.Context) error {
+	exchangeName, assetType, pair, err := ledgerFilter(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFundingRates(c.Context, &gctrpc.GetFundingRatesRequest{
+		Exchange: exchangeName,
+		Asset:    assetType,
+		Pair:     pair,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func setLeverage(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	exchangeName, assetType, pair, err := ledgerFilter(c)
+	if err != nil {
+		return err
+	}
+	if pair == nil {
+		return errInvalidPair
+	}
+
+	var leverage float64
+	if c.IsSet("leverage") {
+		leverage = c.Float64("leverage")
+	} else if c.Args().Get(3) != "" {
+		leverage, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if leverage <= 0 {
+		return errors.New("leverage must be greater than zero")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetLeverage(c.Context, &gctrpc.SetLeverageRequest{
+		Exchange: exchangeName,
+		Asset:    assetType,
+		Pair:     pair,
+		Leverage: leverage,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func setMarginType(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	exchangeName, assetType, pair, err := ledgerFilter(c)
+	if err != nil {
+		return err
+	}
+	if pair == nil {
+		return errInvalidPair
+	}
+
+	var marginType string
+	if c.IsSet("margin_type") {
+		marginType = c.String("margin_type")
+	} else {
+		marginType = c.Args().Get(3)
+	}
+	if marginType == "" {
+		return errors.New("margin type must be set")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetMarginType(c.Context, &gctrpc.SetMarginTypeRequest{
+		Exchange:   exchangeName,
+		Asset:      assetType,
+		Pair:       pair,
+		MarginType: marginType,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getCollateral(c *cli.Context) error {
+	exchangeName, assetType, _, err := ledgerFilter(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetCollateral(c.Context, &gctrpc.GetCollateralRequest{
+		Exchange: exchangeName,
+		Asset:    assetType,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var ledgerFilterFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "the optional exchange to filter positions by",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "the optional asset type to filter positions by",
+	},
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "the optional currency pair to filter positions by e.g. btc-usd",
+	},
+}
+
+var getPositionsCommand = &cli.Command{
+	Name:      "getpositions",
+	Usage:     "gets fill ledger positions with their realised and unrealised PnL",
+	ArgsUsage: "<exchange> <asset> <pair>",
+	Action:    getPositions,
+	Flags:     ledgerFilterFlags,
+}
+
+var getPnLCommand = &cli.Command{
+	Name:      "getpnl",
+	Usage:     "gets the realised and unrealised PnL of fill ledger positions, optionally converted to a reporting currency",
+	ArgsUsage: "<exchange> <asset> <pair> <reporting_currency>",
+	Action:    getPnL,
+	Flags: append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  "reporting_currency",
+			Usage: "the currency to convert PnL to, required when positions are quoted in different currencies",
+		},
+	}, ledgerFilterFlags...),
+}
+
+func getPositions(c *cli.Context) error {
+	exchangeName, assetType, pair, err := ledgerFilter(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetPositions(c.Context, &gctrpc.GetPositionsRequest{
+		Exchange: exchangeName,
+		Asset:    assetType,
+		Pair:     pair,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getPnL(c *cli.Context) error {
+	exchangeName, assetType, pair, err := ledgerFilter(c)
+	if err != nil {
+		return err
+	}
+
+	var reportingCurrency string
+	if c.IsSet("reporting_currency") {
+		reportingCurrency = c.String("reporting_currency")
+	} else {
+		reportingCurrency = c.Args().Get(3)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetPnL(c.Context, &gctrpc.GetPnLRequest{
+		Exchange:          exchangeName,
+		Asset:             assetType,
+		Pair:              pair,
+		ReportingCurrency: reportingCurrency,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+// ledgerFilter returns the optional exchange, asset and pair filters shared by
+// the fill ledger commands
+func ledgerFilter(c *cli.Context) (exchangeName, assetType string, pair *gctrpc.CurrencyPair, err error) {
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return "", "", nil, errInvalidAsset
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+	if currencyPair == "" {
+		return exchangeName, assetType, nil, nil
+	}
+	if !validPair(currencyPair) {
+		return "", "", nil, errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return exchangeName, assetType, &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, nil
+}
@@ -70,6 +70,12 @@ func main() {
 			Usage:       "the gRPC host to connect to",
 			Destination: &host,
 		},
+		&cli.StringFlag{
+			Name:        "backtesterrpchost",
+			Value:       "localhost:9054",
+			Usage:       "the backtester gRPC host to connect to",
+			Destination: &backtesterHost,
+		},
 		&cli.StringFlag{
 			Name:        "rpcuser",
 			Value:       "admin",
@@ -114,6 +120,7 @@ func main() {
 		getExchangeOTPCommand,
 		getExchangeOTPsCommand,
 		getExchangeInfoCommand,
+		getRateLimitBudgetsCommand,
 		getTickerCommand,
 		getTickersCommand,
 		getOrderbookCommand,
@@ -138,6 +145,9 @@ func main() {
 		cancelBatchOrdersCommand,
 		cancelAllOrdersCommand,
 		modifyOrderCommand,
+		killSwitchCommand,
+		getPositionsCommand,
+		getPnLCommand,
 		getEventsCommand,
 		addEventCommand,
 		removeEventCommand,
@@ -162,6 +172,10 @@ func main() {
 		tradeCommand,
 		dataHistoryCommands,
 		currencyStateManagementCommand,
+		executionCommands,
+		futuresCommands,
+		arbitrageCommands,
+		backtesterCommands,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
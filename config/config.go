@@ -1435,6 +1435,36 @@ func (c *Config) CheckCurrencyStateManager() {
 	}
 }
 
+// CheckDeadMansSwitchConfig ensures the dead man's switch config is valid, or
+// sets default values
+func (c *Config) CheckDeadMansSwitchConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.DeadMansSwitch.Timeout <= 0 {
+		c.DeadMansSwitch.Timeout = defaultDeadMansSwitchTimeout
+	}
+	if c.DeadMansSwitch.HeartbeatInterval <= 0 ||
+		c.DeadMansSwitch.HeartbeatInterval >= c.DeadMansSwitch.Timeout {
+		c.DeadMansSwitch.HeartbeatInterval = c.DeadMansSwitch.Timeout / 4
+	}
+	if c.DeadMansSwitch.GracePeriod <= 0 {
+		c.DeadMansSwitch.GracePeriod = defaultDeadMansSwitchGracePeriod
+	}
+}
+
+// CheckOrderbookRecorderConfig ensures the orderbook recorder config is
+// valid, or sets default values
+func (c *Config) CheckOrderbookRecorderConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.OrderbookRecorder.SnapshotInterval <= 0 {
+		c.OrderbookRecorder.SnapshotInterval = defaultOrderbookRecorderInterval
+	}
+	if c.OrderbookRecorder.MaxFileSize <= 0 {
+		c.OrderbookRecorder.MaxFileSize = defaultOrderbookRecorderMaxFileSize
+	}
+}
+
 // CheckConnectionMonitorConfig checks and if zero value assigns default values
 func (c *Config) CheckConnectionMonitorConfig() {
 	m.Lock()
@@ -1783,6 +1813,8 @@ func (c *Config) CheckConfig() error {
 	c.CheckConnectionMonitorConfig()
 	c.CheckDataHistoryMonitorConfig()
 	c.CheckCurrencyStateManager()
+	c.CheckDeadMansSwitchConfig()
+	c.CheckOrderbookRecorderConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckBankAccountConfig()
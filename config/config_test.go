@@ -1829,6 +1829,50 @@ func TestCheckConnectionMonitorConfig(t *testing.T) {
 	}
 }
 
+func TestCheckDeadMansSwitchConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.CheckDeadMansSwitchConfig()
+	if c.DeadMansSwitch.Timeout != defaultDeadMansSwitchTimeout {
+		t.Errorf("received '%v', expected '%v'", c.DeadMansSwitch.Timeout, defaultDeadMansSwitchTimeout)
+	}
+	if c.DeadMansSwitch.HeartbeatInterval != defaultDeadMansSwitchTimeout/4 {
+		t.Errorf("received '%v', expected '%v'", c.DeadMansSwitch.HeartbeatInterval, defaultDeadMansSwitchTimeout/4)
+	}
+	if c.DeadMansSwitch.GracePeriod != defaultDeadMansSwitchGracePeriod {
+		t.Errorf("received '%v', expected '%v'", c.DeadMansSwitch.GracePeriod, defaultDeadMansSwitchGracePeriod)
+	}
+
+	c.DeadMansSwitch.Timeout = time.Second * 10
+	c.DeadMansSwitch.HeartbeatInterval = time.Second * 20
+	c.CheckDeadMansSwitchConfig()
+	if c.DeadMansSwitch.HeartbeatInterval != time.Second*10/4 {
+		t.Errorf("received '%v', expected '%v'", c.DeadMansSwitch.HeartbeatInterval, time.Second*10/4)
+	}
+}
+
+func TestCheckOrderbookRecorderConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.CheckOrderbookRecorderConfig()
+	if c.OrderbookRecorder.SnapshotInterval != defaultOrderbookRecorderInterval {
+		t.Errorf("received '%v', expected '%v'", c.OrderbookRecorder.SnapshotInterval, defaultOrderbookRecorderInterval)
+	}
+	if c.OrderbookRecorder.MaxFileSize != defaultOrderbookRecorderMaxFileSize {
+		t.Errorf("received '%v', expected '%v'", c.OrderbookRecorder.MaxFileSize, defaultOrderbookRecorderMaxFileSize)
+	}
+
+	c.OrderbookRecorder.SnapshotInterval = time.Second * 10
+	c.OrderbookRecorder.MaxFileSize = 5
+	c.CheckOrderbookRecorderConfig()
+	if c.OrderbookRecorder.SnapshotInterval != time.Second*10 {
+		t.Errorf("received '%v', expected '%v'", c.OrderbookRecorder.SnapshotInterval, time.Second*10)
+	}
+	if c.OrderbookRecorder.MaxFileSize != 5 {
+		t.Errorf("received '%v', expected '%v'", c.OrderbookRecorder.MaxFileSize, 5)
+	}
+}
+
 func TestDefaultFilePath(t *testing.T) {
 	// This is tricky to test because we're dealing with a config file stored
 	// in a persons default directory and to properly test it, it would
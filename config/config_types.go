@@ -39,6 +39,10 @@ const (
 	defaultDataHistoryMonitorCheckTimer  = time.Minute
 	defaultCurrencyStateManagerDelay     = time.Minute
 	defaultMaxJobsPerCycle               = 5
+	defaultDeadMansSwitchTimeout         = time.Minute
+	defaultDeadMansSwitchGracePeriod     = time.Second * 30
+	defaultOrderbookRecorderInterval     = time.Minute
+	defaultOrderbookRecorderMaxFileSize  = 100
 )
 
 // Constants here hold some messages
@@ -82,6 +86,10 @@ type Config struct {
 	ConnectionMonitor    ConnectionMonitorConfig   `json:"connectionMonitor"`
 	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
 	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
+	OrderManager         OrderManager              `json:"orderManager"`
+	ArbitrageScanner     ArbitrageScanner          `json:"arbitrageScanner"`
+	DeadMansSwitch       DeadMansSwitch            `json:"deadMansSwitch"`
+	OrderbookRecorder    OrderbookRecorder         `json:"orderbookRecorder"`
 	Profiler             Profiler                  `json:"profiler"`
 	NTPClient            NTPClientConfig           `json:"ntpclient"`
 	GCTScript            gctscript.Config          `json:"gctscript"`
@@ -119,6 +127,76 @@ type CurrencyStateManager struct {
 	Delay   time.Duration `json:"delay"`
 }
 
+// DeadMansSwitch defines a set of configuration options for the dead man's
+// switch manager
+type DeadMansSwitch struct {
+	Enabled bool `json:"enabled"`
+	// Timeout is how long exchange native dead man's switches wait for a
+	// heartbeat before cancelling all open orders
+	Timeout time.Duration `json:"timeout"`
+	// HeartbeatInterval is how often exchange native switches are refreshed,
+	// it must be shorter than the timeout
+	HeartbeatInterval time.Duration `json:"heartbeatInterval"`
+	// GracePeriod is how long connectivity can be lost before open orders on
+	// exchanges without a native switch are cancelled once it returns
+	GracePeriod time.Duration `json:"gracePeriod"`
+}
+
+// OrderbookRecorder defines a set of configuration options for the orderbook
+// recorder
+type OrderbookRecorder struct {
+	Enabled bool `json:"enabled"`
+	// Directory is where recordings are written, defaults to the orderbooks
+	// folder in the data directory
+	Directory string `json:"directory"`
+	// SnapshotInterval is how often full snapshots of every book are recorded
+	SnapshotInterval time.Duration `json:"snapshotInterval"`
+	// MaxFileSize is the size in megabytes a recording is rotated and
+	// compressed at
+	MaxFileSize int64 `json:"maxFileSize"`
+}
+
+// OrderManager defines a set of configuration options for the order manager
+type OrderManager struct {
+	RiskControls OrderRiskControls `json:"riskControls"`
+}
+
+// OrderRiskControls defines the pre-trade risk limits the order manager
+// checks before every order submission and modification. A zero value
+// disables the individual check
+type OrderRiskControls struct {
+	Enabled bool `json:"enabled"`
+	// NotionalCurrency is the currency notional limits are expressed in.
+	// When unset, limits are expressed in the quote currency of the order
+	NotionalCurrency string `json:"notionalCurrency"`
+	// MaxOrderNotional is the maximum price * amount of a single order
+	MaxOrderNotional float64 `json:"maxOrderNotional"`
+	// MaxPairNotional is the maximum combined notional of all open orders on
+	// an exchange pair, including the new order
+	MaxPairNotional float64 `json:"maxPairNotional"`
+	// MaxOpenOrdersPerExchange is the maximum number of active orders per
+	// exchange
+	MaxOpenOrdersPerExchange int64 `json:"maxOpenOrdersPerExchange"`
+	// MaxPositions is the maximum holding of a currency code, e.g. "BTC",
+	// after an order fills
+	MaxPositions map[string]float64 `json:"maxPositions"`
+	// PriceBandPercentage is the maximum deviation of a limit price from the
+	// last ticker price
+	PriceBandPercentage float64 `json:"priceBandPercentage"`
+	// MaxOrdersPerMinute is the maximum number of orders submitted per
+	// exchange in a rolling minute
+	MaxOrdersPerMinute int64 `json:"maxOrdersPerMinute"`
+}
+
+// ArbitrageScanner defines the configuration options for the arbitrage
+// scanner
+type ArbitrageScanner struct {
+	// TriangularThresholds is the minimum profit percentage of a triangular
+	// cycle keyed by exchange name. Exchanges without a threshold are not
+	// scanned for triangular cycles
+	TriangularThresholds map[string]float64 `json:"triangularThresholds"`
+}
+
 // ConnectionMonitorConfig defines the connection monitor variables to ensure
 // that there is internet connectivity
 type ConnectionMonitorConfig struct {
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "order"
+(
+    id uuid PRIMARY KEY NOT NULL,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    exchange_order_id varchar NOT NULL,
+    client_order_id varchar,
+    client_id varchar,
+    account_id varchar,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    side varchar NOT NULL,
+    type varchar NOT NULL,
+    status varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    average_executed_price DOUBLE PRECISION NOT NULL,
+    cost DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    fee_asset varchar,
+    leverage DOUBLE PRECISION NOT NULL,
+    trigger_price DOUBLE PRECISION NOT NULL,
+    created TIMESTAMPTZ NOT NULL,
+    last_updated TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniqueexchangeorderid
+        unique(exchange_name_id, exchange_order_id)
+);
+
+CREATE TABLE IF NOT EXISTS order_trade
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id uuid REFERENCES "order"(id) ON DELETE CASCADE NOT NULL,
+    tid varchar,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    fee_asset varchar,
+    side varchar,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniqueordertradeid
+        unique(order_id, tid)
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE order_trade;
+DROP TABLE "order";
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "order"
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    exchange_order_id TEXT NOT NULL,
+    client_order_id TEXT,
+    client_id TEXT,
+    account_id TEXT,
+    base TEXT NOT NULL,
+    quote TEXT NOT NULL,
+    asset TEXT NOT NULL,
+    side TEXT NOT NULL,
+    type TEXT NOT NULL,
+    status TEXT NOT NULL,
+    price REAL NOT NULL,
+    amount REAL NOT NULL,
+    executed_amount REAL NOT NULL,
+    remaining_amount REAL NOT NULL,
+    average_executed_price REAL NOT NULL,
+    cost REAL NOT NULL,
+    fee REAL NOT NULL,
+    fee_asset TEXT,
+    leverage REAL NOT NULL,
+    trigger_price REAL NOT NULL,
+    created TIMESTAMP NOT NULL,
+    last_updated TIMESTAMP NOT NULL,
+    CONSTRAINT uniqueexchangeorderid
+        unique(exchange_name_id, exchange_order_id)
+);
+
+CREATE TABLE IF NOT EXISTS order_trade
+(
+    id text not null primary key,
+    order_id text REFERENCES "order"(id) ON DELETE CASCADE NOT NULL,
+    tid TEXT,
+    price REAL NOT NULL,
+    amount REAL NOT NULL,
+    fee REAL NOT NULL,
+    fee_asset TEXT,
+    side TEXT,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniqueordertradeid
+        unique(order_id, tid) ON CONFLICT IGNORE
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE order_trade;
+DROP TABLE "order";
+-- +goose StatementEnd
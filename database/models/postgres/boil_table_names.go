@@ -10,6 +10,8 @@ var TableNames = struct {
 	Datahistoryjobrelations string
 	Datahistoryjobresult    string
 	Exchange                string
+	Order                   string
+	OrderTrade              string
 	Script                  string
 	ScriptExecution         string
 	Trade                   string
@@ -23,6 +25,8 @@ var TableNames = struct {
 	Datahistoryjobrelations: "datahistoryjobrelations",
 	Datahistoryjobresult:    "datahistoryjobresult",
 	Exchange:                "exchange",
+	Order:                   "order",
+	OrderTrade:              "order_trade",
 	Script:                  "script",
 	ScriptExecution:         "script_execution",
 	Trade:                   "trade",
@@ -0,0 +1,502 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates orders and replaces their trades in the database
+func (db *DBService) Upsert(orders ...*Details) error {
+	for i := range orders {
+		if orders[i] == nil {
+			return errNilOrder
+		}
+		if orders[i].ID == "" {
+			return errOrderIDNotSet
+		}
+		if orders[i].Exchange == "" {
+			return errExchangeNotSet
+		}
+		if orders[i].ExchangeOrderID == "" {
+			return errExchangeOrderIDNotSet
+		}
+	}
+	// The driver is checked before the transaction is started so that it is
+	// never left open
+	var upsert func(context.Context, *sql.Tx, ...*Details) error
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		upsert = upsertSQLite
+	case database.DBPostgreSQL:
+		upsert = upsertPostgres
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	ctx := context.Background()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	err = upsert(ctx, tx, orders...)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByID returns an order and its trades by its internal order ID
+func (db *DBService) GetByID(id string) (*Details, error) {
+	var resp []Details
+	var err error
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		resp, err = db.getSQLite(qm.Where("id = ?", id))
+	case database.DBPostgreSQL:
+		resp, err = db.getPostgres(qm.Where("id = ?", id))
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("order %v %w", id, sql.ErrNoRows)
+	}
+	return &resp[0], nil
+}
+
+// GetInRange returns all orders and their trades for an exchange
+// created between two dates
+func (db *DBService) GetInRange(exchangeName string, startDate, endDate time.Time) ([]Details, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		exch, err := sqlite3.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.Background(), db.sql)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+		}
+		return db.getSQLite(
+			qm.Where("exchange_name_id = ?", exch.ID),
+			qm.Where("created BETWEEN ? AND ?", startDate.UTC().Format(time.RFC3339), endDate.UTC().Format(time.RFC3339)))
+	case database.DBPostgreSQL:
+		exch, err := postgres.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.Background(), db.sql)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+		}
+		return db.getPostgres(
+			qm.Where("exchange_name_id = ?", exch.ID),
+			qm.Where("created BETWEEN ? AND ?", startDate.UTC(), endDate.UTC()))
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetAll returns every stored order and its trades
+func (db *DBService) GetAll() ([]Details, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getSQLite()
+	case database.DBPostgreSQL:
+		return db.getPostgres()
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetOpenOrUpdatedSince returns orders and their trades which do not have one
+// of the supplied closed statuses or which were last updated at or after since
+func (db *DBService) GetOpenOrUpdatedSince(closedStatuses []string, since time.Time) ([]Details, error) {
+	statuses := make([]interface{}, len(closedStatuses))
+	for i := range closedStatuses {
+		statuses[i] = strings.ToUpper(closedStatuses[i])
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		if len(statuses) == 0 {
+			return db.getSQLite()
+		}
+		return db.getSQLite(qm.Expr(
+			qm.WhereIn("status NOT IN ?", statuses...),
+			qm.Or("last_updated >= ?", since.UTC().Format(time.RFC3339))))
+	case database.DBPostgreSQL:
+		if len(statuses) == 0 {
+			return db.getPostgres()
+		}
+		return db.getPostgres(qm.Expr(
+			qm.WhereIn("status NOT IN ?", statuses...),
+			qm.Or("last_updated >= ?", since.UTC())))
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, orders ...*Details) error {
+	for i := range orders {
+		exch, err := sqlite3.Exchanges(
+			qm.Where("name = ?", strings.ToLower(orders[i].Exchange))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].Exchange, err)
+		}
+		var tempEvent = sqlite3.Order{
+			ID:                   orders[i].ID,
+			ExchangeNameID:       exch.ID,
+			ExchangeOrderID:      orders[i].ExchangeOrderID,
+			ClientOrderID:        null.NewString(orders[i].ClientOrderID, orders[i].ClientOrderID != ""),
+			ClientID:             null.NewString(orders[i].ClientID, orders[i].ClientID != ""),
+			AccountID:            null.NewString(orders[i].AccountID, orders[i].AccountID != ""),
+			Base:                 strings.ToUpper(orders[i].Base),
+			Quote:                strings.ToUpper(orders[i].Quote),
+			Asset:                strings.ToLower(orders[i].Asset),
+			Side:                 strings.ToUpper(orders[i].Side),
+			Type:                 strings.ToUpper(orders[i].Type),
+			Status:               strings.ToUpper(orders[i].Status),
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			Cost:                 orders[i].Cost,
+			Fee:                  orders[i].Fee,
+			FeeAsset:             null.NewString(orders[i].FeeAsset, orders[i].FeeAsset != ""),
+			Leverage:             orders[i].Leverage,
+			TriggerPrice:         orders[i].TriggerPrice,
+			Created:              orders[i].Created.UTC().Format(time.RFC3339),
+			LastUpdated:          orders[i].LastUpdated.UTC().Format(time.RFC3339),
+		}
+		var exists bool
+		exists, err = sqlite3.OrderExists(ctx, tx, tempEvent.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			_, err = tempEvent.Update(ctx, tx, boil.Infer())
+		} else {
+			err = tempEvent.Insert(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = sqlite3.OrderTrades(qm.Where("order_id = ?", tempEvent.ID)).DeleteAll(ctx, tx)
+		if err != nil {
+			return err
+		}
+		for j := range orders[i].Trades {
+			if orders[i].Trades[j].ID == "" {
+				var freshUUID uuid.UUID
+				freshUUID, err = uuid.NewV4()
+				if err != nil {
+					return err
+				}
+				orders[i].Trades[j].ID = freshUUID.String()
+			}
+			var tempTrade = sqlite3.OrderTrade{
+				ID:        orders[i].Trades[j].ID,
+				OrderID:   tempEvent.ID,
+				Tid:       null.NewString(orders[i].Trades[j].TID, orders[i].Trades[j].TID != ""),
+				Price:     orders[i].Trades[j].Price,
+				Amount:    orders[i].Trades[j].Amount,
+				Fee:       orders[i].Trades[j].Fee,
+				FeeAsset:  null.NewString(orders[i].Trades[j].FeeAsset, orders[i].Trades[j].FeeAsset != ""),
+				Side:      null.NewString(strings.ToUpper(orders[i].Trades[j].Side), orders[i].Trades[j].Side != ""),
+				Timestamp: orders[i].Trades[j].Timestamp.UTC().Format(time.RFC3339),
+			}
+			err = tempTrade.Insert(ctx, tx, boil.Infer())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, orders ...*Details) error {
+	for i := range orders {
+		exch, err := postgres.Exchanges(
+			qm.Where("name = ?", strings.ToLower(orders[i].Exchange))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].Exchange, err)
+		}
+		var tempEvent = postgres.Order{
+			ID:                   orders[i].ID,
+			ExchangeNameID:       exch.ID,
+			ExchangeOrderID:      orders[i].ExchangeOrderID,
+			ClientOrderID:        null.NewString(orders[i].ClientOrderID, orders[i].ClientOrderID != ""),
+			ClientID:             null.NewString(orders[i].ClientID, orders[i].ClientID != ""),
+			AccountID:            null.NewString(orders[i].AccountID, orders[i].AccountID != ""),
+			Base:                 strings.ToUpper(orders[i].Base),
+			Quote:                strings.ToUpper(orders[i].Quote),
+			Asset:                strings.ToLower(orders[i].Asset),
+			Side:                 strings.ToUpper(orders[i].Side),
+			Type:                 strings.ToUpper(orders[i].Type),
+			Status:               strings.ToUpper(orders[i].Status),
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			Cost:                 orders[i].Cost,
+			Fee:                  orders[i].Fee,
+			FeeAsset:             null.NewString(orders[i].FeeAsset, orders[i].FeeAsset != ""),
+			Leverage:             orders[i].Leverage,
+			TriggerPrice:         orders[i].TriggerPrice,
+			Created:              orders[i].Created.UTC(),
+			LastUpdated:          orders[i].LastUpdated.UTC(),
+		}
+		err = tempEvent.Upsert(ctx, tx, true, []string{"id"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+
+		_, err = postgres.OrderTrades(qm.Where("order_id = ?", tempEvent.ID)).DeleteAll(ctx, tx)
+		if err != nil {
+			return err
+		}
+		for j := range orders[i].Trades {
+			var tempTrade = postgres.OrderTrade{
+				ID:        orders[i].Trades[j].ID,
+				OrderID:   tempEvent.ID,
+				Tid:       null.NewString(orders[i].Trades[j].TID, orders[i].Trades[j].TID != ""),
+				Price:     orders[i].Trades[j].Price,
+				Amount:    orders[i].Trades[j].Amount,
+				Fee:       orders[i].Trades[j].Fee,
+				FeeAsset:  null.NewString(orders[i].Trades[j].FeeAsset, orders[i].Trades[j].FeeAsset != ""),
+				Side:      null.NewString(strings.ToUpper(orders[i].Trades[j].Side), orders[i].Trades[j].Side != ""),
+				Timestamp: orders[i].Trades[j].Timestamp.UTC(),
+			}
+			err = tempTrade.Insert(ctx, tx, boil.Infer())
+			if err != nil {
+				return err
+			}
+			orders[i].Trades[j].ID = tempTrade.ID
+		}
+	}
+
+	return nil
+}
+
+func (db *DBService) getSQLite(mods ...qm.QueryMod) ([]Details, error) {
+	ctx := context.Background()
+	exchanges, err := sqlite3.Exchanges().All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	exchangeNames := make(map[string]string, len(exchanges))
+	for i := range exchanges {
+		exchangeNames[exchanges[i].ID] = exchanges[i].Name
+	}
+
+	mods = append(mods, qm.OrderBy("created"))
+	orders, err := sqlite3.Orders(mods...).All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	if len(orders) == 0 {
+		return nil, nil
+	}
+	var trades sqlite3.OrderTradeSlice
+	for i := 0; i < len(orders); i += maxQueryParameters {
+		end := i + maxQueryParameters
+		if end > len(orders) {
+			end = len(orders)
+		}
+		orderIDs := make([]interface{}, 0, end-i)
+		for j := i; j < end; j++ {
+			orderIDs = append(orderIDs, orders[j].ID)
+		}
+		var batch sqlite3.OrderTradeSlice
+		batch, err = sqlite3.OrderTrades(
+			qm.WhereIn("order_id in ?", orderIDs...),
+			qm.OrderBy("timestamp")).All(ctx, db.sql)
+		if err != nil {
+			return nil, err
+		}
+		trades = append(trades, batch...)
+	}
+	tradesByOrder := make(map[string][]Trade)
+	for i := range trades {
+		var ts time.Time
+		ts, err = time.Parse(time.RFC3339, trades[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		tradesByOrder[trades[i].OrderID] = append(tradesByOrder[trades[i].OrderID], Trade{
+			ID:        trades[i].ID,
+			TID:       trades[i].Tid.String,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Fee:       trades[i].Fee,
+			FeeAsset:  trades[i].FeeAsset.String,
+			Side:      trades[i].Side.String,
+			Timestamp: ts,
+		})
+	}
+
+	resp := make([]Details, len(orders))
+	for i := range orders {
+		var created, lastUpdated time.Time
+		created, err = time.Parse(time.RFC3339, orders[i].Created)
+		if err != nil {
+			return nil, err
+		}
+		lastUpdated, err = time.Parse(time.RFC3339, orders[i].LastUpdated)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Details{
+			ID:                   orders[i].ID,
+			Exchange:             exchangeNames[orders[i].ExchangeNameID],
+			ExchangeOrderID:      orders[i].ExchangeOrderID,
+			ClientOrderID:        orders[i].ClientOrderID.String,
+			ClientID:             orders[i].ClientID.String,
+			AccountID:            orders[i].AccountID.String,
+			Base:                 orders[i].Base,
+			Quote:                orders[i].Quote,
+			Asset:                orders[i].Asset,
+			Side:                 orders[i].Side,
+			Type:                 orders[i].Type,
+			Status:               orders[i].Status,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			Cost:                 orders[i].Cost,
+			Fee:                  orders[i].Fee,
+			FeeAsset:             orders[i].FeeAsset.String,
+			Leverage:             orders[i].Leverage,
+			TriggerPrice:         orders[i].TriggerPrice,
+			Created:              created,
+			LastUpdated:          lastUpdated,
+			Trades:               tradesByOrder[orders[i].ID],
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getPostgres(mods ...qm.QueryMod) ([]Details, error) {
+	ctx := context.Background()
+	exchanges, err := postgres.Exchanges().All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	exchangeNames := make(map[string]string, len(exchanges))
+	for i := range exchanges {
+		exchangeNames[exchanges[i].ID] = exchanges[i].Name
+	}
+
+	mods = append(mods, qm.OrderBy("created"))
+	orders, err := postgres.Orders(mods...).All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	if len(orders) == 0 {
+		return nil, nil
+	}
+	var trades postgres.OrderTradeSlice
+	for i := 0; i < len(orders); i += maxQueryParameters {
+		end := i + maxQueryParameters
+		if end > len(orders) {
+			end = len(orders)
+		}
+		orderIDs := make([]interface{}, 0, end-i)
+		for j := i; j < end; j++ {
+			orderIDs = append(orderIDs, orders[j].ID)
+		}
+		var batch postgres.OrderTradeSlice
+		batch, err = postgres.OrderTrades(
+			qm.WhereIn("order_id in ?", orderIDs...),
+			qm.OrderBy("timestamp")).All(ctx, db.sql)
+		if err != nil {
+			return nil, err
+		}
+		trades = append(trades, batch...)
+	}
+	tradesByOrder := make(map[string][]Trade)
+	for i := range trades {
+		tradesByOrder[trades[i].OrderID] = append(tradesByOrder[trades[i].OrderID], Trade{
+			ID:        trades[i].ID,
+			TID:       trades[i].Tid.String,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Fee:       trades[i].Fee,
+			FeeAsset:  trades[i].FeeAsset.String,
+			Side:      trades[i].Side.String,
+			Timestamp: trades[i].Timestamp.UTC(),
+		})
+	}
+
+	resp := make([]Details, len(orders))
+	for i := range orders {
+		resp[i] = Details{
+			ID:                   orders[i].ID,
+			Exchange:             exchangeNames[orders[i].ExchangeNameID],
+			ExchangeOrderID:      orders[i].ExchangeOrderID,
+			ClientOrderID:        orders[i].ClientOrderID.String,
+			ClientID:             orders[i].ClientID.String,
+			AccountID:            orders[i].AccountID.String,
+			Base:                 orders[i].Base,
+			Quote:                orders[i].Quote,
+			Asset:                orders[i].Asset,
+			Side:                 orders[i].Side,
+			Type:                 orders[i].Type,
+			Status:               orders[i].Status,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			Cost:                 orders[i].Cost,
+			Fee:                  orders[i].Fee,
+			FeeAsset:             orders[i].FeeAsset.String,
+			Leverage:             orders[i].Leverage,
+			TriggerPrice:         orders[i].TriggerPrice,
+			Created:              orders[i].Created.UTC(),
+			LastUpdated:          orders[i].LastUpdated.UTC(),
+			Trades:               tradesByOrder[orders[i].ID],
+		}
+	}
+	return resp, nil
+}
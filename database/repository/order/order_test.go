@@ -0,0 +1,276 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	_, err := Setup(nil)
+	if !errors.Is(err, database.ErrNilInstance) {
+		t.Errorf("received: %v, expected: %v", err, database.ErrNilInstance)
+	}
+
+	_, err = Setup(&database.Instance{})
+	if !errors.Is(err, database.ErrDatabaseNotConnected) {
+		t.Errorf("received: %v, expected: %v", err, database.ErrDatabaseNotConnected)
+	}
+}
+
+func TestUpsertValidation(t *testing.T) {
+	t.Parallel()
+	db := &DBService{}
+	err := db.Upsert(nil)
+	if !errors.Is(err, errNilOrder) {
+		t.Errorf("received: %v, expected: %v", err, errNilOrder)
+	}
+	err = db.Upsert(&Details{})
+	if !errors.Is(err, errOrderIDNotSet) {
+		t.Errorf("received: %v, expected: %v", err, errOrderIDNotSet)
+	}
+	err = db.Upsert(&Details{ID: "1"})
+	if !errors.Is(err, errExchangeNotSet) {
+		t.Errorf("received: %v, expected: %v", err, errExchangeNotSet)
+	}
+	err = db.Upsert(&Details{ID: "1", Exchange: testExchanges[0].Name})
+	if !errors.Is(err, errExchangeOrderIDNotSet) {
+		t.Errorf("received: %v, expected: %v", err, errExchangeOrderIDNotSet)
+	}
+	err = db.Upsert(&Details{ID: "1", Exchange: testExchanges[0].Name, ExchangeOrderID: "1"})
+	if !errors.Is(err, database.ErrNoDatabaseProvided) {
+		t.Errorf("received: %v, expected: %v", err, database.ErrNoDatabaseProvided)
+	}
+}
+
+func TestOrders(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			orderSQLTester(t, db)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func orderSQLTester(t *testing.T, db *DBService) {
+	t.Helper()
+	firstTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var orders []*Details
+	for i := 0; i < 20; i++ {
+		uu, _ := uuid.NewV4()
+		orders = append(orders, &Details{
+			ID:              uu.String(),
+			Exchange:        testExchanges[0].Name,
+			ExchangeOrderID: fmt.Sprintf("%v", i),
+			ClientOrderID:   "strategy",
+			Base:            currency.BTC.String(),
+			Quote:           currency.USD.String(),
+			Asset:           asset.Spot.String(),
+			Side:            "BUY",
+			Type:            "LIMIT",
+			Status:          "NEW",
+			Price:           float64(i + 1),
+			Amount:          1,
+			RemainingAmount: 1,
+			Created:         firstTime.Add(time.Minute * time.Duration(i)),
+			LastUpdated:     firstTime.Add(time.Minute * time.Duration(i)),
+		})
+	}
+	err := db.Upsert(orders...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+
+	// fill the first order and upsert twice to ensure trades are replaced
+	orders[0].Status = "FILLED"
+	orders[0].ExecutedAmount = 1
+	orders[0].RemainingAmount = 0
+	orders[0].LastUpdated = firstTime.Add(time.Hour)
+	orders[0].Trades = []Trade{
+		{
+			TID:       "1337",
+			Price:     1,
+			Amount:    0.5,
+			Fee:       0.01,
+			FeeAsset:  currency.USD.String(),
+			Side:      "BUY",
+			Timestamp: firstTime.Add(time.Hour),
+		},
+		{
+			TID:       "1338",
+			Price:     1,
+			Amount:    0.5,
+			Side:      "BUY",
+			Timestamp: firstTime.Add(time.Hour),
+		},
+	}
+	err = db.Upsert(orders[0])
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	err = db.Upsert(orders[0])
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+
+	resp, err := db.GetByID(orders[0].ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if resp.Status != "FILLED" {
+		t.Errorf("received: %v, expected: %v", resp.Status, "FILLED")
+	}
+	if resp.Exchange != testExchanges[0].Name {
+		t.Errorf("received: %v, expected: %v", resp.Exchange, testExchanges[0].Name)
+	}
+	if resp.ClientOrderID != "strategy" {
+		t.Errorf("received: %v, expected: %v", resp.ClientOrderID, "strategy")
+	}
+	if len(resp.Trades) != 2 {
+		t.Fatalf("received: %v, expected: %v", len(resp.Trades), 2)
+	}
+	if resp.Trades[0].FeeAsset != currency.USD.String() {
+		t.Errorf("received: %v, expected: %v", resp.Trades[0].FeeAsset, currency.USD)
+	}
+	if !resp.LastUpdated.Equal(orders[0].LastUpdated) {
+		t.Errorf("received: %v, expected: %v", resp.LastUpdated, orders[0].LastUpdated)
+	}
+
+	_, err = db.GetByID("fake")
+	if err == nil {
+		t.Error("expected error for missing order")
+	}
+
+	all, err := db.GetAll()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(all) != 20 {
+		t.Errorf("received: %v, expected: %v", len(all), 20)
+	}
+
+	// The filled order is only returned once it has been updated since
+	restorable, err := db.GetOpenOrUpdatedSince([]string{"FILLED", "CANCELLED"}, firstTime.Add(time.Hour*2))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(restorable) != 19 {
+		t.Errorf("received: %v, expected: %v", len(restorable), 19)
+	}
+	restorable, err = db.GetOpenOrUpdatedSince([]string{"FILLED", "CANCELLED"}, firstTime.Add(time.Minute*30))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(restorable) != 20 {
+		t.Errorf("received: %v, expected: %v", len(restorable), 20)
+	}
+
+	inRange, err := db.GetInRange(testExchanges[0].Name, firstTime, firstTime.Add(time.Minute*9))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(inRange) != 10 {
+		t.Errorf("received: %v, expected: %v", len(inRange), 10)
+	}
+
+	inRange, err = db.GetInRange(testExchanges[1].Name, firstTime, firstTime.Add(time.Hour))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(inRange) != 0 {
+		t.Errorf("received: %v, expected: %v", len(inRange), 0)
+	}
+}
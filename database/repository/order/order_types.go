@@ -0,0 +1,78 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// maxQueryParameters limits the amount of order IDs used in a single
+// trade lookup to stay below database bind parameter limits
+const maxQueryParameters = 500
+
+var (
+	errNilOrder              = errors.New("nil order received")
+	errOrderIDNotSet         = errors.New("order ID not set, cannot upsert")
+	errExchangeNotSet        = errors.New("exchange name not set, cannot upsert")
+	errExchangeOrderIDNotSet = errors.New("exchange order ID not set, cannot upsert")
+)
+
+// Details is a DTO for order database data
+type Details struct {
+	// ID is the internal order ID assigned by the order manager
+	ID                   string
+	Exchange             string
+	ExchangeOrderID      string
+	ClientOrderID        string
+	ClientID             string
+	AccountID            string
+	Base                 string
+	Quote                string
+	Asset                string
+	Side                 string
+	Type                 string
+	Status               string
+	Price                float64
+	Amount               float64
+	ExecutedAmount       float64
+	RemainingAmount      float64
+	AverageExecutedPrice float64
+	Cost                 float64
+	Fee                  float64
+	FeeAsset             string
+	Leverage             float64
+	TriggerPrice         float64
+	Created              time.Time
+	LastUpdated          time.Time
+	Trades               []Trade
+}
+
+// Trade is a DTO for a fill belonging to an order
+type Trade struct {
+	ID        string
+	TID       string
+	Price     float64
+	Amount    float64
+	Fee       float64
+	FeeAsset  string
+	Side      string
+	Timestamp time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using order database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(...*Details) error
+	GetByID(string) (*Details, error)
+	GetInRange(string, time.Time, time.Time) ([]Details, error)
+	GetAll() ([]Details, error)
+	GetOpenOrUpdatedSince([]string, time.Time) ([]Details, error)
+}
@@ -0,0 +1,461 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupArbitrageScanner applies configuration parameters before running.
+// The comms manager is optional, when nil opportunities are not pushed to
+// communication relayers
+func SetupArbitrageScanner(em iExchangeManager, comms iCommsManager, assets asset.Items, minimumProfit float64, maxBookAge, interval time.Duration, verbose bool) (*ArbitrageScanner, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if len(assets) == 0 {
+		return nil, errArbitrageAssetsRequired
+	}
+	for i := range assets {
+		if !assets[i].IsValid() {
+			return nil, fmt.Errorf("%s %w", assets[i], asset.ErrNotSupported)
+		}
+	}
+	if minimumProfit < 0 {
+		return nil, errNegativeMinimumProfit
+	}
+	if maxBookAge <= 0 {
+		maxBookAge = DefaultArbitrageMaxBookAge
+	}
+	if interval <= 0 {
+		interval = DefaultArbitrageScannerDelay
+	}
+	mux := dispatch.GetNewMux()
+	id, err := mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	triangularID, err := mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	return &ArbitrageScanner{
+		shutdown:        make(chan struct{}),
+		exchangeManager: em,
+		comms:           comms,
+		assets:          assets,
+		minimumProfit:   minimumProfit,
+		maxBookAge:      maxBookAge,
+		sleep:           interval,
+		verbose:         verbose,
+		mux:             mux,
+		id:              id,
+		triangularID:    triangularID,
+		fees:            make(map[arbitrageFeeKey]*arbitrageFee),
+		notified:        make(map[string]time.Time),
+		thresholds:      make(map[string]float64),
+	}, nil
+}
+
+// Start runs the subsystem
+func (s *ArbitrageScanner) Start() error {
+	if s == nil {
+		return fmt.Errorf("%s %w", ArbitrageScannerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&s.started, 0, 1) {
+		return fmt.Errorf("%s %w", ArbitrageScannerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderBook, "Arbitrage scanner %s", MsgSubSystemStarting)
+	s.wg.Add(1)
+	go s.run()
+	log.Debugf(log.OrderBook, "Arbitrage scanner %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (s *ArbitrageScanner) Stop() error {
+	if s == nil {
+		return fmt.Errorf("%s %w", ArbitrageScannerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&s.started) == 0 {
+		return fmt.Errorf("%s %w", ArbitrageScannerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderBook, "Arbitrage scanner %s", MsgSubSystemShuttingDown)
+	close(s.shutdown)
+	s.wg.Wait()
+	s.shutdown = make(chan struct{})
+	atomic.StoreInt32(&s.started, 0)
+	log.Debugf(log.OrderBook, "Arbitrage scanner %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (s *ArbitrageScanner) IsRunning() bool {
+	if s == nil {
+		return false
+	}
+	return atomic.LoadInt32(&s.started) == 1
+}
+
+// GetOpportunities returns the opportunities found by the most recent scan,
+// ordered by descending profit
+func (s *ArbitrageScanner) GetOpportunities() ([]ArbitrageOpportunity, error) {
+	if s == nil {
+		return nil, fmt.Errorf("%s %w", ArbitrageScannerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&s.started) == 0 {
+		return nil, fmt.Errorf("%s %w", ArbitrageScannerName, ErrSubSystemNotStarted)
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	resp := make([]ArbitrageOpportunity, len(s.opportunities))
+	copy(resp, s.opportunities)
+	return resp, nil
+}
+
+// SubscribeOpportunities returns a pipe which receives every opportunity as
+// it is found. Data received is of type ArbitrageOpportunity
+func (s *ArbitrageScanner) SubscribeOpportunities() (dispatch.Pipe, error) {
+	if s == nil {
+		return dispatch.Pipe{}, fmt.Errorf("%s %w", ArbitrageScannerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&s.started) == 0 {
+		return dispatch.Pipe{}, fmt.Errorf("%s %w", ArbitrageScannerName, ErrSubSystemNotStarted)
+	}
+	return s.mux.Subscribe(s.id)
+}
+
+func (s *ArbitrageScanner) run() {
+	defer s.wg.Done()
+	s.scan()
+	timer := time.NewTimer(s.sleep)
+	for {
+		select {
+		case <-s.shutdown:
+			timer.Stop()
+			return
+		case <-timer.C:
+			s.scan()
+			timer.Reset(s.sleep)
+		}
+	}
+}
+
+// scan compares every enabled pair which is traded on more than one exchange,
+// searches the exchanges with triangular thresholds for profitable cycles
+// and publishes the opportunities found
+func (s *ArbitrageScanner) scan() {
+	books, err := s.getBooks()
+	if err != nil {
+		log.Errorf(log.OrderBook, "%s cannot get orderbooks: %v", ArbitrageScannerName, err)
+		return
+	}
+	markets := make(map[arbitrageMarketKey][]*arbitrageBook)
+	for i := range books {
+		key := arbitrageMarketKey{
+			Asset: books[i].asset,
+			Base:  books[i].pair.Base.Item,
+			Quote: books[i].pair.Quote.Item,
+		}
+		markets[key] = append(markets[key], books[i])
+	}
+	var opportunities []ArbitrageOpportunity
+	for key, market := range markets {
+		for x := range market {
+			for y := range market {
+				if x == y {
+					continue
+				}
+				opp, ok := s.evaluate(key.Asset, market[x], market[y])
+				if !ok {
+					continue
+				}
+				opportunities = append(opportunities, opp)
+			}
+		}
+	}
+	sort.Slice(opportunities, func(i, j int) bool {
+		return opportunities[i].Profit > opportunities[j].Profit
+	})
+	triangular := s.findTriangular(books)
+
+	s.m.Lock()
+	s.opportunities = opportunities
+	s.triangular = triangular
+	s.m.Unlock()
+
+	for i := range opportunities {
+		err = s.mux.Publish([]uuid.UUID{s.id}, &opportunities[i])
+		if err != nil {
+			log.Errorf(log.OrderBook, "%s cannot publish opportunity: %v", ArbitrageScannerName, err)
+		}
+		s.notify(&opportunities[i])
+	}
+	for i := range triangular {
+		err = s.mux.Publish([]uuid.UUID{s.triangularID}, &triangular[i])
+		if err != nil {
+			log.Errorf(log.OrderBook, "%s cannot publish triangular opportunity: %v", ArbitrageScannerName, err)
+		}
+		s.notifyTriangular(&triangular[i])
+	}
+}
+
+// getBooks retrieves the current orderbooks of all enabled exchanges. Stale
+// or empty books and pairs which cannot currently be traded are excluded
+func (s *ArbitrageScanner) getBooks() ([]*arbitrageBook, error) {
+	exchanges, err := s.exchangeManager.GetExchanges()
+	if err != nil {
+		return nil, err
+	}
+	var books []*arbitrageBook
+	now := time.Now()
+	for x := range exchanges {
+		if !exchanges[x].IsEnabled() {
+			continue
+		}
+		enabledAssets := exchanges[x].GetAssetTypes(true)
+		for y := range s.assets {
+			if !enabledAssets.Contains(s.assets[y]) {
+				continue
+			}
+			pairs, err := exchanges[x].GetEnabledPairs(s.assets[y])
+			if err != nil {
+				log.Errorf(log.OrderBook, "%s %s %s cannot get enabled pairs: %v",
+					ArbitrageScannerName, exchanges[x].GetName(), s.assets[y], err)
+				continue
+			}
+			for z := range pairs {
+				book, err := s.getBook(exchanges[x], s.assets[y], pairs[z], now)
+				if err != nil {
+					if s.verbose {
+						log.Debugf(log.OrderBook, "%s %s %s %s skipped: %v",
+							ArbitrageScannerName, exchanges[x].GetName(), s.assets[y], pairs[z], err)
+					}
+					continue
+				}
+				books = append(books, book)
+			}
+		}
+	}
+	return books, nil
+}
+
+// getBook returns a snapshot of the orderbook for a pair along with the fees
+// of its exchange
+func (s *ArbitrageScanner) getBook(exch exchange.IBotExchange, a asset.Item, p currency.Pair, now time.Time) (*arbitrageBook, error) {
+	depth, err := orderbook.GetDepth(exch.GetName(), p, a)
+	if err != nil {
+		return nil, err
+	}
+	ob := depth.Retrieve()
+	if len(ob.Bids) == 0 || len(ob.Asks) == 0 {
+		return nil, errNoOrderbookLiquidity
+	}
+	if now.Sub(ob.LastUpdated) > s.maxBookAge {
+		return nil, errOrderbookStale
+	}
+	err = exch.CanTradePair(p, a)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := s.getFees(exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+	book := &arbitrageBook{
+		exch:  exch,
+		asset: a,
+		pair:  p,
+		bids:  make([]arbitrageLevel, len(ob.Bids)),
+		asks:  make([]arbitrageLevel, len(ob.Asks)),
+		fee:   fee,
+	}
+	for i := range ob.Bids {
+		book.bids[i] = arbitrageLevel{price: ob.Bids[i].Price, amount: ob.Bids[i].Amount}
+	}
+	for i := range ob.Asks {
+		book.asks[i] = arbitrageLevel{price: ob.Asks[i].Price, amount: ob.Asks[i].Amount}
+	}
+	return book, nil
+}
+
+// getFees returns the cached taker and withdrawal fees of a pair on an
+// exchange, fetching them when they have expired
+func (s *ArbitrageScanner) getFees(exch exchange.IBotExchange, a asset.Item, p currency.Pair) (*arbitrageFee, error) {
+	key := arbitrageFeeKey{
+		Exchange: exch.GetName(),
+		Asset:    a,
+		Base:     p.Base.Item,
+		Quote:    p.Quote.Item,
+	}
+	if fee, ok := s.fees[key]; ok && time.Since(fee.updated) < arbitrageFeeRefresh {
+		return fee, nil
+	}
+	// A purchase price and amount of one returns the fee as a rate
+	taker, err := exch.GetFeeByType(context.TODO(), &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          p,
+		PurchasePrice: 1,
+		Amount:        1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if taker < 0 || taker >= 1 {
+		return nil, fmt.Errorf("%w %v", errInvalidFeeRate, taker)
+	}
+	fee := &arbitrageFee{taker: taker, updated: time.Now()}
+	withdrawal, err := exch.GetFeeByType(context.TODO(), &exchange.FeeBuilder{
+		FeeType: exchange.CryptocurrencyWithdrawalFee,
+		Pair:    p,
+		Amount:  1,
+	})
+	if err == nil && withdrawal >= 0 {
+		fee.withdrawal = withdrawal
+		fee.withdrawalKnown = true
+	} else if s.verbose {
+		log.Debugf(log.OrderBook, "%s %s %s withdrawal fee unavailable: %v",
+			ArbitrageScannerName, exch.GetName(), p.Base, err)
+	}
+	s.fees[key] = fee
+	return fee, nil
+}
+
+// evaluate walks the asks of the buying book against the bids of the selling
+// book while the spread covers the taker fees of both exchanges and returns
+// the resulting opportunity when it is profitable after withdrawal fees
+func (s *ArbitrageScanner) evaluate(a asset.Item, buy, sell *arbitrageBook) (ArbitrageOpportunity, bool) {
+	var amount, cost, proceeds float64
+	i, j := 0, 0
+	askRemaining, bidRemaining := buy.asks[0].amount, sell.bids[0].amount
+	for i < len(buy.asks) && j < len(sell.bids) {
+		ask, bid := buy.asks[i].price, sell.bids[j].price
+		if bid*(1-sell.fee.taker) <= ask*(1+buy.fee.taker) {
+			break
+		}
+		qty := math.Min(askRemaining, bidRemaining)
+		amount += qty
+		cost += qty * ask
+		proceeds += qty * bid
+		askRemaining -= qty
+		bidRemaining -= qty
+		if askRemaining <= 0 {
+			i++
+			if i < len(buy.asks) {
+				askRemaining = buy.asks[i].amount
+			}
+		}
+		if bidRemaining <= 0 {
+			j++
+			if j < len(sell.bids) {
+				bidRemaining = sell.bids[j].amount
+			}
+		}
+	}
+	if amount <= 0 || cost <= 0 {
+		return ArbitrageOpportunity{}, false
+	}
+
+	tradingFees := cost*buy.fee.taker + proceeds*sell.fee.taker
+	sellPrice := proceeds / amount
+	profit := proceeds - cost - tradingFees - buy.fee.withdrawal*sellPrice
+	if profit <= 0 {
+		return ArbitrageOpportunity{}, false
+	}
+	profitPercentage := profit / cost * 100
+	if profitPercentage < s.minimumProfit {
+		return ArbitrageOpportunity{}, false
+	}
+
+	// Transfers are only checked once a spread is found as the base currency
+	// must leave the buying exchange and arrive at the selling exchange
+	err := canTransfer(buy.exch.CanWithdraw(buy.pair.Base, a))
+	if err != nil {
+		if s.verbose {
+			log.Debugf(log.OrderBook, "%s %s cannot withdraw %s: %v",
+				ArbitrageScannerName, buy.exch.GetName(), buy.pair.Base, err)
+		}
+		return ArbitrageOpportunity{}, false
+	}
+	err = canTransfer(sell.exch.CanDeposit(sell.pair.Base, a))
+	if err != nil {
+		if s.verbose {
+			log.Debugf(log.OrderBook, "%s %s cannot deposit %s: %v",
+				ArbitrageScannerName, sell.exch.GetName(), sell.pair.Base, err)
+		}
+		return ArbitrageOpportunity{}, false
+	}
+
+	return ArbitrageOpportunity{
+		Pair:                 buy.pair,
+		Asset:                a,
+		BuyExchange:          buy.exch.GetName(),
+		SellExchange:         sell.exch.GetName(),
+		Amount:               amount,
+		BuyPrice:             cost / amount,
+		SellPrice:            sellPrice,
+		Cost:                 cost,
+		Proceeds:             proceeds,
+		TradingFees:          tradingFees,
+		WithdrawalFee:        buy.fee.withdrawal,
+		WithdrawalFeeUnknown: !buy.fee.withdrawalKnown,
+		Profit:               profit,
+		ProfitPercentage:     profitPercentage,
+		Timestamp:            time.Now(),
+	}, true
+}
+
+// canTransfer treats a currency without a loaded state as operational
+func canTransfer(err error) error {
+	if errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
+		return nil
+	}
+	return err
+}
+
+// notify pushes an opportunity to the communications manager
+func (s *ArbitrageScanner) notify(opp *ArbitrageOpportunity) {
+	key := fmt.Sprintf("%s %s %s>%s", opp.Asset, opp.Pair, opp.BuyExchange, opp.SellExchange)
+	s.pushEvent(key, opp.Timestamp, fmt.Sprintf("%s %s: buy %v on %s at %v, sell on %s at %v, profit %v %s (%.4f%%)",
+		opp.Pair,
+		opp.Asset,
+		opp.Amount,
+		opp.BuyExchange,
+		opp.BuyPrice,
+		opp.SellExchange,
+		opp.SellPrice,
+		opp.Profit,
+		opp.Pair.Quote,
+		opp.ProfitPercentage))
+}
+
+// pushEvent pushes a message to the communications manager, the same key is
+// only pushed once per notification interval
+func (s *ArbitrageScanner) pushEvent(key string, t time.Time, msg string) {
+	if s.comms == nil {
+		return
+	}
+	s.m.Lock()
+	last, ok := s.notified[key]
+	if ok && t.Sub(last) < arbitrageNotifyInterval {
+		s.m.Unlock()
+		return
+	}
+	s.notified[key] = t
+	s.m.Unlock()
+	s.comms.PushEvent(base.Event{Type: "arbitrage", Message: msg})
+}
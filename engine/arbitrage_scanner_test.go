@@ -0,0 +1,352 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var errArbitrageTest = errors.New("arbitrage test error")
+
+// arbExchange is a minimal exchange with fixed fees and transfer states
+type arbExchange struct {
+	exchange.IBotExchange
+	name          string
+	pairs         currency.Pairs
+	taker         float64
+	withdrawal    float64
+	withdrawalErr error
+	withdrawErr   error
+	depositErr    error
+	limits        order.ExecutionLimits
+}
+
+func (a *arbExchange) GetName() string { return a.name }
+
+func (a *arbExchange) IsEnabled() bool { return true }
+
+func (a *arbExchange) GetAssetTypes(bool) asset.Items { return asset.Items{asset.Spot} }
+
+func (a *arbExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return a.pairs, nil
+}
+
+func (a *arbExchange) CanTradePair(currency.Pair, asset.Item) error { return nil }
+
+func (a *arbExchange) CanWithdraw(currency.Code, asset.Item) error { return a.withdrawErr }
+
+func (a *arbExchange) CanDeposit(currency.Code, asset.Item) error { return a.depositErr }
+
+func (a *arbExchange) GetOrderExecutionLimits(i asset.Item, p currency.Pair) (*order.Limits, error) {
+	return a.limits.GetOrderExecutionLimits(i, p)
+}
+
+func (a *arbExchange) GetFeeByType(_ context.Context, f *exchange.FeeBuilder) (float64, error) {
+	if f.FeeType == exchange.CryptocurrencyWithdrawalFee {
+		return a.withdrawal, a.withdrawalErr
+	}
+	return a.taker * f.PurchasePrice * f.Amount, nil
+}
+
+// arbExchangeManager supplies the test exchanges to the scanner
+type arbExchangeManager struct {
+	exchanges []exchange.IBotExchange
+}
+
+func (a *arbExchangeManager) GetExchanges() ([]exchange.IBotExchange, error) {
+	return a.exchanges, nil
+}
+
+func (a *arbExchangeManager) GetExchangeByName(name string) (exchange.IBotExchange, error) {
+	for i := range a.exchanges {
+		if strings.EqualFold(a.exchanges[i].GetName(), name) {
+			return a.exchanges[i], nil
+		}
+	}
+	return nil, ErrExchangeNotFound
+}
+
+// arbComms records the events pushed by the scanner
+type arbComms struct {
+	m      sync.Mutex
+	events []base.Event
+}
+
+func (a *arbComms) PushEvent(evt base.Event) {
+	a.m.Lock()
+	a.events = append(a.events, evt)
+	a.m.Unlock()
+}
+
+// arbitrageSetup loads a buying book on exchange A which is crossed by the
+// bids of exchange B
+func arbitrageSetup(t *testing.T, prefix string) (*ArbitrageScanner, *arbExchange, *arbExchange, *arbComms) {
+	t.Helper()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	a := &arbExchange{name: prefix + "a", pairs: currency.Pairs{p}, taker: 0.001, withdrawal: 0.01}
+	b := &arbExchange{name: prefix + "b", pairs: currency.Pairs{p}, taker: 0.001, withdrawal: 0.01}
+	books := []orderbook.Base{
+		{
+			Exchange: a.name,
+			Pair:     p,
+			Asset:    asset.Spot,
+			Bids:     orderbook.Items{{Price: 99, Amount: 1}},
+			Asks:     orderbook.Items{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}},
+		},
+		{
+			Exchange: b.name,
+			Pair:     p,
+			Asset:    asset.Spot,
+			Bids:     orderbook.Items{{Price: 103, Amount: 1.5}, {Price: 100.5, Amount: 5}},
+			Asks:     orderbook.Items{{Price: 104, Amount: 1}},
+		},
+	}
+	for i := range books {
+		err := books[i].Process()
+		if !errors.Is(err, nil) {
+			t.Fatalf("error '%v', expected '%v'", err, nil)
+		}
+	}
+	comms := &arbComms{}
+	s, err := SetupArbitrageScanner(&arbExchangeManager{exchanges: []exchange.IBotExchange{a, b}},
+		comms, asset.Items{asset.Spot}, 0, 0, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	s.started = 1
+	return s, a, b, comms
+}
+
+func TestSetupArbitrageScanner(t *testing.T) {
+	t.Parallel()
+	_, err := SetupArbitrageScanner(nil, nil, nil, 0, 0, 0, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
+	}
+	em := &arbExchangeManager{}
+	_, err = SetupArbitrageScanner(em, nil, nil, 0, 0, 0, false)
+	if !errors.Is(err, errArbitrageAssetsRequired) {
+		t.Errorf("error '%v', expected '%v'", err, errArbitrageAssetsRequired)
+	}
+	_, err = SetupArbitrageScanner(em, nil, asset.Items{"fake"}, 0, 0, 0, false)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("error '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	_, err = SetupArbitrageScanner(em, nil, asset.Items{asset.Spot}, -1, 0, 0, false)
+	if !errors.Is(err, errNegativeMinimumProfit) {
+		t.Errorf("error '%v', expected '%v'", err, errNegativeMinimumProfit)
+	}
+	s, err := SetupArbitrageScanner(em, nil, asset.Items{asset.Spot}, 0, 0, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if s.sleep != DefaultArbitrageScannerDelay {
+		t.Errorf("received '%v', expected '%v'", s.sleep, DefaultArbitrageScannerDelay)
+	}
+	if s.maxBookAge != DefaultArbitrageMaxBookAge {
+		t.Errorf("received '%v', expected '%v'", s.maxBookAge, DefaultArbitrageMaxBookAge)
+	}
+}
+
+func TestArbitrageScannerStartStop(t *testing.T) {
+	t.Parallel()
+	var s *ArbitrageScanner
+	err := s.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	err = s.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	if s.IsRunning() {
+		t.Error("expected nil scanner to not be running")
+	}
+	_, err = s.GetOpportunities()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+
+	s, err = SetupArbitrageScanner(&arbExchangeManager{}, nil, asset.Items{asset.Spot}, 0, 0, time.Hour, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	_, err = s.GetOpportunities()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	_, err = s.SubscribeOpportunities()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = s.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = s.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = s.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !s.IsRunning() {
+		t.Error("expected scanner to be running")
+	}
+	err = s.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if s.IsRunning() {
+		t.Error("expected scanner to be stopped")
+	}
+}
+
+func TestArbitrageScannerScan(t *testing.T) {
+	t.Parallel()
+	s, _, _, comms := arbitrageSetup(t, "arbscanscan")
+	s.scan()
+	opportunities, err := s.GetOpportunities()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(opportunities) != 1 {
+		t.Fatalf("received '%v' opportunities, expected '%v'", len(opportunities), 1)
+	}
+	opp := opportunities[0]
+	if opp.BuyExchange != "arbscanscana" || opp.SellExchange != "arbscanscanb" {
+		t.Errorf("received '%v' to '%v', expected '%v' to '%v'",
+			opp.BuyExchange, opp.SellExchange, "arbscanscana", "arbscanscanb")
+	}
+	// 1 at 100 and 0.5 at 101 crosses 1.5 at 103, the next ask and bid no
+	// longer cover the taker fees
+	expected := map[string][2]float64{
+		"amount":      {opp.Amount, 1.5},
+		"cost":        {opp.Cost, 150.5},
+		"proceeds":    {opp.Proceeds, 154.5},
+		"sellPrice":   {opp.SellPrice, 103},
+		"tradingFees": {opp.TradingFees, 0.305},
+		"profit":      {opp.Profit, 2.665},
+	}
+	for k, v := range expected {
+		if !floatEquals(v[0], v[1]) {
+			t.Errorf("%s received '%v', expected '%v'", k, v[0], v[1])
+		}
+	}
+	if opp.WithdrawalFeeUnknown {
+		t.Error("expected withdrawal fee to be known")
+	}
+
+	s.scan()
+	comms.m.Lock()
+	pushed := len(comms.events)
+	comms.m.Unlock()
+	if pushed != 1 {
+		t.Errorf("received '%v' comms events, expected '%v'", pushed, 1)
+	}
+}
+
+func TestArbitrageScannerFilters(t *testing.T) {
+	t.Parallel()
+	s, a, b, _ := arbitrageSetup(t, "arbscanfilters")
+
+	s.minimumProfit = 5
+	s.scan()
+	if len(s.opportunities) != 0 {
+		t.Errorf("received '%v' opportunities, expected '%v'", len(s.opportunities), 0)
+	}
+	s.minimumProfit = 0
+
+	b.depositErr = errArbitrageTest
+	s.scan()
+	if len(s.opportunities) != 0 {
+		t.Errorf("received '%v' opportunities, expected '%v'", len(s.opportunities), 0)
+	}
+	b.depositErr = nil
+
+	a.withdrawErr = errArbitrageTest
+	s.scan()
+	if len(s.opportunities) != 0 {
+		t.Errorf("received '%v' opportunities, expected '%v'", len(s.opportunities), 0)
+	}
+	a.withdrawErr = nil
+
+	s.maxBookAge = time.Nanosecond
+	time.Sleep(time.Millisecond)
+	s.scan()
+	if len(s.opportunities) != 0 {
+		t.Errorf("received '%v' opportunities, expected '%v'", len(s.opportunities), 0)
+	}
+	s.maxBookAge = DefaultArbitrageMaxBookAge
+
+	// unknown withdrawal fees are reported rather than discarded
+	a.withdrawalErr = errArbitrageTest
+	s.fees = make(map[arbitrageFeeKey]*arbitrageFee)
+	s.scan()
+	if len(s.opportunities) != 1 {
+		t.Fatalf("received '%v' opportunities, expected '%v'", len(s.opportunities), 1)
+	}
+	if !s.opportunities[0].WithdrawalFeeUnknown {
+		t.Error("expected withdrawal fee to be unknown")
+	}
+	if !floatEquals(s.opportunities[0].Profit, 3.695) {
+		t.Errorf("received '%v', expected '%v'", s.opportunities[0].Profit, 3.695)
+	}
+}
+
+func TestArbitrageScannerSubscribe(t *testing.T) {
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := dispatch.Stop(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	s, _, _, _ := arbitrageSetup(t, "arbscansubscribe")
+	pipe, err := s.SubscribeOpportunities()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			t.Error(err)
+		}
+	}()
+	// dispatch drops updates when the receiver is busy so keep scanning
+	// until the subscriber picks one up
+	deadline := time.Now().Add(time.Second * 5)
+	for {
+		s.scan()
+		select {
+		case data := <-pipe.C:
+			opp, ok := (*data.(*interface{})).(ArbitrageOpportunity)
+			if !ok {
+				t.Fatal("unexpected data type")
+			}
+			if !floatEquals(opp.Amount, 1.5) {
+				t.Errorf("received '%v', expected '%v'", opp.Amount, 1.5)
+			}
+			return
+		case <-time.After(time.Millisecond * 10):
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("opportunity was not published")
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	// ArbitrageScannerName defines the manager name string
+	ArbitrageScannerName = "arbitrage_scanner"
+	// DefaultArbitrageScannerDelay defines the default duration between scans
+	// of the orderbooks
+	DefaultArbitrageScannerDelay = time.Second
+	// DefaultArbitrageMaxBookAge defines the default age after which an
+	// orderbook is considered stale and is ignored
+	DefaultArbitrageMaxBookAge = 30 * time.Second
+	// arbitrageFeeRefresh defines how long fetched fees are cached for
+	arbitrageFeeRefresh = time.Hour
+	// arbitrageNotifyInterval defines the minimum duration between comms
+	// notifications of the same opportunity
+	arbitrageNotifyInterval = 5 * time.Minute
+)
+
+var (
+	errArbitrageAssetsRequired = errors.New("at least one asset type required")
+	errNegativeMinimumProfit   = errors.New("minimum profit percentage cannot be negative")
+	errInvalidFeeRate          = errors.New("invalid fee rate")
+	errNoOrderbookLiquidity    = errors.New("orderbook has no liquidity")
+	errOrderbookStale          = errors.New("orderbook is stale")
+	errNegativeThreshold       = errors.New("triangular threshold cannot be negative")
+	errInsufficientDepth       = errors.New("insufficient orderbook depth")
+)
+
+// ArbitrageScanner continuously compares the orderbooks of the same pair
+// across enabled exchanges and reports spreads which can be executed after
+// trading and withdrawal fees
+type ArbitrageScanner struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	comms           iCommsManager
+	assets          asset.Items
+	minimumProfit   float64
+	maxBookAge      time.Duration
+	sleep           time.Duration
+	verbose         bool
+	mux             *dispatch.Mux
+	id              uuid.UUID
+	triangularID    uuid.UUID
+	m               sync.Mutex
+	fees            map[arbitrageFeeKey]*arbitrageFee
+	opportunities   []ArbitrageOpportunity
+	// thresholds holds the minimum triangular profit percentage keyed by
+	// lower case exchange name
+	thresholds map[string]float64
+	triangular []TriangularOpportunity
+	// notified holds the last time an opportunity was pushed to comms
+	notified map[string]time.Time
+}
+
+// arbitrageFeeKey identifies the fees of a pair on an exchange
+type arbitrageFeeKey struct {
+	Exchange string
+	Asset    asset.Item
+	Base     *currency.Item
+	Quote    *currency.Item
+}
+
+// arbitrageFee holds the cached fees of a pair on an exchange
+type arbitrageFee struct {
+	// taker is the taker fee as a fraction of the trade value
+	taker float64
+	// withdrawal is the fee in base currency to withdraw from the exchange
+	withdrawal      float64
+	withdrawalKnown bool
+	updated         time.Time
+}
+
+// arbitrageMarketKey groups the same pair across exchanges
+type arbitrageMarketKey struct {
+	Asset asset.Item
+	Base  *currency.Item
+	Quote *currency.Item
+}
+
+// arbitrageBook is an orderbook snapshot with the fees of its exchange
+type arbitrageBook struct {
+	exch  exchange.IBotExchange
+	asset asset.Item
+	pair  currency.Pair
+	bids  []arbitrageLevel
+	asks  []arbitrageLevel
+	fee   *arbitrageFee
+}
+
+// arbitrageLevel is a single orderbook price level
+type arbitrageLevel struct {
+	price  float64
+	amount float64
+}
+
+// ArbitrageOpportunity is a spread between two exchanges which can be
+// executed by buying on one exchange, withdrawing the base currency and
+// selling on the other. All values other than Amount and WithdrawalFee are
+// denominated in the quote currency of the pair
+type ArbitrageOpportunity struct {
+	Pair         currency.Pair
+	Asset        asset.Item
+	BuyExchange  string
+	SellExchange string
+	// Amount is the base currency amount executable on both books
+	Amount float64
+	// BuyPrice and SellPrice are the volume weighted average prices
+	BuyPrice  float64
+	SellPrice float64
+	Cost      float64
+	Proceeds  float64
+	// TradingFees are the taker fees paid on both exchanges
+	TradingFees float64
+	// WithdrawalFee is the base currency fee to move the bought amount to
+	// the selling exchange
+	WithdrawalFee        float64
+	WithdrawalFeeUnknown bool
+	Profit               float64
+	ProfitPercentage     float64
+	Timestamp            time.Time
+}
+
+// triangularKey groups the books of an exchange and asset
+type triangularKey struct {
+	Exchange string
+	Asset    asset.Item
+}
+
+// triangularEdge is a conversion from one currency to another on a book
+type triangularEdge struct {
+	from currency.Code
+	to   currency.Code
+	book *arbitrageBook
+	// buy is true when the base currency is bought with the quote currency
+	buy bool
+}
+
+// TriangularLeg is a single market order of a triangular cycle
+type TriangularLeg struct {
+	Pair currency.Pair
+	Side order.Side
+	// Amount is the base currency amount of the order
+	Amount       float64
+	AveragePrice float64
+	// Fee is the taker fee in the currency received by the order
+	Fee float64
+}
+
+// TriangularOpportunity is a profitable cycle of three market orders on a
+// single exchange which starts and ends in the same currency. Amounts and
+// profit are denominated in the starting currency
+type TriangularOpportunity struct {
+	Exchange         string
+	Asset            asset.Item
+	Currency         currency.Code
+	Legs             []TriangularLeg
+	StartAmount      float64
+	EndAmount        float64
+	Profit           float64
+	ProfitPercentage float64
+	Timestamp        time.Time
+}
@@ -0,0 +1,288 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetTriangularThresholds sets the minimum profit percentage of triangular
+// cycles keyed by exchange name. Exchanges without a threshold are not
+// scanned for triangular cycles
+func (s *ArbitrageScanner) SetTriangularThresholds(thresholds map[string]float64) error {
+	if s == nil {
+		return fmt.Errorf("%s %w", ArbitrageScannerName, ErrNilSubsystem)
+	}
+	t := make(map[string]float64, len(thresholds))
+	for k, v := range thresholds {
+		if v < 0 {
+			return fmt.Errorf("%s %w", k, errNegativeThreshold)
+		}
+		t[strings.ToLower(k)] = v
+	}
+	s.m.Lock()
+	s.thresholds = t
+	s.m.Unlock()
+	return nil
+}
+
+// GetTriangularOpportunities returns the triangular cycles found by the most
+// recent scan, ordered by descending profit percentage
+func (s *ArbitrageScanner) GetTriangularOpportunities() ([]TriangularOpportunity, error) {
+	if s == nil {
+		return nil, fmt.Errorf("%s %w", ArbitrageScannerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&s.started) == 0 {
+		return nil, fmt.Errorf("%s %w", ArbitrageScannerName, ErrSubSystemNotStarted)
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	resp := make([]TriangularOpportunity, len(s.triangular))
+	copy(resp, s.triangular)
+	return resp, nil
+}
+
+// SubscribeTriangularOpportunities returns a pipe which receives every
+// triangular cycle as it is found. Data received is of type
+// TriangularOpportunity
+func (s *ArbitrageScanner) SubscribeTriangularOpportunities() (dispatch.Pipe, error) {
+	if s == nil {
+		return dispatch.Pipe{}, fmt.Errorf("%s %w", ArbitrageScannerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&s.started) == 0 {
+		return dispatch.Pipe{}, fmt.Errorf("%s %w", ArbitrageScannerName, ErrSubSystemNotStarted)
+	}
+	return s.mux.Subscribe(s.triangularID)
+}
+
+// findTriangular builds a currency graph from the books of each exchange with
+// a triangular threshold and returns the profitable cycles of three orders
+func (s *ArbitrageScanner) findTriangular(books []*arbitrageBook) []TriangularOpportunity {
+	s.m.Lock()
+	thresholds := s.thresholds
+	s.m.Unlock()
+	if len(thresholds) == 0 {
+		return nil
+	}
+
+	graphs := make(map[triangularKey]map[*currency.Item][]triangularEdge)
+	for i := range books {
+		if _, ok := thresholds[strings.ToLower(books[i].exch.GetName())]; !ok {
+			continue
+		}
+		key := triangularKey{Exchange: books[i].exch.GetName(), Asset: books[i].asset}
+		graph, ok := graphs[key]
+		if !ok {
+			graph = make(map[*currency.Item][]triangularEdge)
+			graphs[key] = graph
+		}
+		p := books[i].pair
+		graph[p.Base.Item] = append(graph[p.Base.Item], triangularEdge{from: p.Base, to: p.Quote, book: books[i]})
+		graph[p.Quote.Item] = append(graph[p.Quote.Item], triangularEdge{from: p.Quote, to: p.Base, book: books[i], buy: true})
+	}
+
+	var opportunities []TriangularOpportunity
+	for key, graph := range graphs {
+		threshold := thresholds[strings.ToLower(key.Exchange)]
+		for _, first := range graph {
+			for x := range first {
+				for _, second := range graph[first[x].to.Item] {
+					if second.to.Item == first[x].from.Item {
+						continue
+					}
+					for _, third := range graph[second.to.Item] {
+						if third.to.Item != first[x].from.Item {
+							continue
+						}
+						// Each cycle is reported once in each direction, starting
+						// from its alphabetically lowest currency
+						start := first[x].from.String()
+						if start > second.from.String() || start > third.from.String() {
+							continue
+						}
+						opp, ok := s.evaluateTriangular(key, threshold, []triangularEdge{first[x], second, third})
+						if !ok {
+							continue
+						}
+						opportunities = append(opportunities, opp)
+					}
+				}
+			}
+		}
+	}
+	sort.Slice(opportunities, func(i, j int) bool {
+		return opportunities[i].ProfitPercentage > opportunities[j].ProfitPercentage
+	})
+	return opportunities
+}
+
+// evaluateTriangular sizes a cycle to the depth available at the best price
+// of each leg and returns it when the cycle remains profitable after taker
+// fees and order execution limits
+func (s *ArbitrageScanner) evaluateTriangular(key triangularKey, threshold float64, legs []triangularEdge) (TriangularOpportunity, bool) {
+	// Best price rates and the capacity of each leg at the best price in the
+	// starting currency
+	rate := 1.0
+	startAmount := 0.0
+	for i := range legs {
+		var legRate, capacity float64
+		if legs[i].buy {
+			best := legs[i].book.asks[0]
+			legRate = 1 / best.price
+			capacity = best.price * best.amount
+		} else {
+			best := legs[i].book.bids[0]
+			legRate = best.price
+			capacity = best.amount
+		}
+		capacity /= rate
+		if i == 0 || capacity < startAmount {
+			startAmount = capacity
+		}
+		rate *= legRate * (1 - legs[i].book.fee.taker)
+	}
+	if rate <= 1 {
+		return TriangularOpportunity{}, false
+	}
+
+	opp := TriangularOpportunity{
+		Exchange: key.Exchange,
+		Asset:    key.Asset,
+		Currency: legs[0].from,
+		Legs:     make([]TriangularLeg, len(legs)),
+	}
+	amount := startAmount
+	for i := range legs {
+		var spent float64
+		var err error
+		opp.Legs[i], spent, amount, err = s.simulateLeg(&legs[i], amount)
+		if err != nil {
+			if s.verbose {
+				log.Debugf(log.OrderBook, "%s %s %s cycle from %s not executable: %v",
+					ArbitrageScannerName, key.Exchange, opp.Legs[i].Pair, opp.Currency, err)
+			}
+			return TriangularOpportunity{}, false
+		}
+		if i == 0 {
+			opp.StartAmount = spent
+		}
+	}
+	opp.EndAmount = amount
+	opp.Profit = opp.EndAmount - opp.StartAmount
+	if opp.Profit <= 0 {
+		return TriangularOpportunity{}, false
+	}
+	opp.ProfitPercentage = opp.Profit / opp.StartAmount * 100
+	if opp.ProfitPercentage < threshold {
+		return TriangularOpportunity{}, false
+	}
+	opp.Timestamp = time.Now()
+	return opp, true
+}
+
+// simulateLeg walks the book of a leg as a market order for the supplied
+// amount of the currency being sold. The order amount is rounded down to the
+// exchange step size and checked against its execution limits. It returns
+// the leg, the amount actually sold and the amount received after fees
+func (s *ArbitrageScanner) simulateLeg(edge *triangularEdge, amount float64) (TriangularLeg, float64, float64, error) {
+	book := edge.book
+	leg := TriangularLeg{Pair: book.pair, Side: order.Sell}
+	levels := book.bids
+	if edge.buy {
+		leg.Side = order.Buy
+		levels = book.asks
+	}
+
+	// The base amount of the order, for buys the amount of quote available
+	// is converted by walking the asks
+	qty := amount
+	if edge.buy {
+		qty = 0
+		remaining := amount
+		for i := range levels {
+			cost := levels[i].price * levels[i].amount
+			if cost >= remaining {
+				qty += remaining / levels[i].price
+				break
+			}
+			qty += levels[i].amount
+			remaining -= cost
+		}
+	}
+	// Execution limits are optional, when they are not loaded for the pair
+	// the order amount is used as is
+	limits, err := book.exch.GetOrderExecutionLimits(book.asset, book.pair)
+	if err != nil {
+		limits = nil
+	}
+	qty = limits.ConformToAmount(qty)
+	if qty <= 0 {
+		return leg, 0, 0, errInsufficientDepth
+	}
+
+	var filled, value, worst float64
+	for i := range levels {
+		take := levels[i].amount
+		if filled+take >= qty {
+			take = qty - filled
+		}
+		filled += take
+		value += take * levels[i].price
+		worst = levels[i].price
+		if filled >= qty {
+			break
+		}
+	}
+	// Sizing at the best price can exceed the first level by a rounding
+	// error so a small shortfall is tolerated
+	if filled < qty*(1-1e-9) {
+		return leg, 0, 0, errInsufficientDepth
+	}
+	// Checked as an immediate or cancel limit order at the worst price
+	// reached so the minimum notional is enforced
+	err = limits.Conforms(worst, qty, order.Limit)
+	if err != nil {
+		return leg, 0, 0, err
+	}
+
+	leg.Amount = qty
+	leg.AveragePrice = value / qty
+	if edge.buy {
+		leg.Fee = qty * book.fee.taker
+		return leg, value, qty - leg.Fee, nil
+	}
+	leg.Fee = value * book.fee.taker
+	return leg, qty, value - leg.Fee, nil
+}
+
+// notifyTriangular pushes a triangular cycle to the communications manager
+func (s *ArbitrageScanner) notifyTriangular(opp *TriangularOpportunity) {
+	path := make([]string, 0, len(opp.Legs)+1)
+	path = append(path, opp.Currency.String())
+	for i := range opp.Legs {
+		if opp.Legs[i].Side == order.Buy {
+			path = append(path, opp.Legs[i].Pair.Base.String())
+		} else {
+			path = append(path, opp.Legs[i].Pair.Quote.String())
+		}
+	}
+	cycle := strings.Join(path, ">")
+	s.pushEvent(fmt.Sprintf("%s %s %s", opp.Exchange, opp.Asset, cycle),
+		opp.Timestamp,
+		fmt.Sprintf("%s %s: triangular cycle %s starting with %v %s, profit %v %s (%.4f%%)",
+			opp.Exchange,
+			opp.Asset,
+			cycle,
+			opp.StartAmount,
+			opp.Currency,
+			opp.Profit,
+			opp.Currency,
+			opp.ProfitPercentage))
+}
@@ -0,0 +1,205 @@
+package engine
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var (
+	triangularETHBTC  = currency.NewPair(currency.ETH, currency.BTC)
+	triangularETHUSDT = currency.NewPair(currency.ETH, currency.USDT)
+	triangularBTCUSDT = currency.NewPair(currency.BTC, currency.USDT)
+)
+
+// triangularSetup loads books on a single exchange where buying ETH with BTC,
+// selling it for USDT and buying back BTC is profitable
+func triangularSetup(t *testing.T, name string) (*ArbitrageScanner, *arbExchange) {
+	t.Helper()
+	e := &arbExchange{
+		name:  name,
+		pairs: currency.Pairs{triangularETHBTC, triangularETHUSDT, triangularBTCUSDT},
+		taker: 0.001,
+	}
+	books := []orderbook.Base{
+		{
+			Pair: triangularETHBTC,
+			Bids: orderbook.Items{{Price: 0.099, Amount: 100}},
+			Asks: orderbook.Items{{Price: 0.1, Amount: 100}},
+		},
+		{
+			Pair: triangularETHUSDT,
+			Bids: orderbook.Items{{Price: 11, Amount: 100}},
+			Asks: orderbook.Items{{Price: 11.1, Amount: 100}},
+		},
+		{
+			Pair: triangularBTCUSDT,
+			Bids: orderbook.Items{{Price: 100, Amount: 10}},
+			Asks: orderbook.Items{{Price: 101, Amount: 10}},
+		},
+	}
+	for i := range books {
+		books[i].Exchange = name
+		books[i].Asset = asset.Spot
+		err := books[i].Process()
+		if !errors.Is(err, nil) {
+			t.Fatalf("error '%v', expected '%v'", err, nil)
+		}
+	}
+	s, err := SetupArbitrageScanner(&arbExchangeManager{exchanges: []exchange.IBotExchange{e}},
+		nil, asset.Items{asset.Spot}, 0, 0, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = s.SetTriangularThresholds(map[string]float64{name: 1})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	s.started = 1
+	return s, e
+}
+
+func TestSetTriangularThresholds(t *testing.T) {
+	t.Parallel()
+	var s *ArbitrageScanner
+	err := s.SetTriangularThresholds(nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	s, err = SetupArbitrageScanner(&arbExchangeManager{}, nil, asset.Items{asset.Spot}, 0, 0, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = s.SetTriangularThresholds(map[string]float64{"test": -1})
+	if !errors.Is(err, errNegativeThreshold) {
+		t.Errorf("error '%v', expected '%v'", err, errNegativeThreshold)
+	}
+	err = s.SetTriangularThresholds(map[string]float64{"TeSt": 1})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if s.thresholds["test"] != 1 {
+		t.Errorf("received '%v', expected '%v'", s.thresholds["test"], 1)
+	}
+	err = s.SetTriangularThresholds(nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(s.thresholds) != 0 {
+		t.Errorf("received '%v', expected '%v'", len(s.thresholds), 0)
+	}
+	_, err = s.GetTriangularOpportunities()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	_, err = s.SubscribeTriangularOpportunities()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestFindTriangular(t *testing.T) {
+	t.Parallel()
+	s, _ := triangularSetup(t, "arbtrifind")
+	s.scan()
+	opportunities, err := s.GetTriangularOpportunities()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(opportunities) != 1 {
+		t.Fatalf("received '%v' opportunities, expected '%v'", len(opportunities), 1)
+	}
+	opp := opportunities[0]
+	if !opp.Currency.Match(currency.BTC) {
+		t.Errorf("received '%v', expected '%v'", opp.Currency, currency.BTC)
+	}
+	expectedLegs := []TriangularLeg{
+		{Pair: triangularETHBTC, Side: order.Buy},
+		{Pair: triangularETHUSDT, Side: order.Sell},
+		{Pair: triangularBTCUSDT, Side: order.Buy},
+	}
+	if len(opp.Legs) != len(expectedLegs) {
+		t.Fatalf("received '%v' legs, expected '%v'", len(opp.Legs), len(expectedLegs))
+	}
+	for i := range expectedLegs {
+		if !opp.Legs[i].Pair.Equal(expectedLegs[i].Pair) || opp.Legs[i].Side != expectedLegs[i].Side {
+			t.Errorf("leg %d received '%v %v', expected '%v %v'", i,
+				opp.Legs[i].Side, opp.Legs[i].Pair, expectedLegs[i].Side, expectedLegs[i].Pair)
+		}
+	}
+	// 10 BTC of USDT at the best ask of BTC-USDT limits the cycle
+	expectedStart := 1010 / (10 * 0.999 * 11 * 0.999)
+	if math.Abs(opp.StartAmount-expectedStart) > 1e-9 {
+		t.Errorf("received '%v', expected '%v'", opp.StartAmount, expectedStart)
+	}
+	expectedPercentage := (110.0/101*0.999*0.999*0.999 - 1) * 100
+	if math.Abs(opp.ProfitPercentage-expectedPercentage) > 1e-6 {
+		t.Errorf("received '%v', expected '%v'", opp.ProfitPercentage, expectedPercentage)
+	}
+
+	err = s.SetTriangularThresholds(map[string]float64{"arbtrifind": 10})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	s.scan()
+	if len(s.triangular) != 0 {
+		t.Errorf("received '%v' opportunities, expected '%v'", len(s.triangular), 0)
+	}
+
+	err = s.SetTriangularThresholds(map[string]float64{"someotherexchange": 0})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	s.scan()
+	if len(s.triangular) != 0 {
+		t.Errorf("received '%v' opportunities, expected '%v'", len(s.triangular), 0)
+	}
+}
+
+func TestFindTriangularLimits(t *testing.T) {
+	t.Parallel()
+	s, e := triangularSetup(t, "arbtrilimits")
+	err := e.limits.LoadLimits([]order.MinMaxLevel{
+		{
+			Pair:       triangularETHBTC,
+			Asset:      asset.Spot,
+			MinAmount:  1,
+			StepAmount: 1,
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	s.scan()
+	if len(s.triangular) != 1 {
+		t.Fatalf("received '%v' opportunities, expected '%v'", len(s.triangular), 1)
+	}
+	// the ETH bought is rounded down to the step size
+	if s.triangular[0].Legs[0].Amount != 92 {
+		t.Errorf("received '%v', expected '%v'", s.triangular[0].Legs[0].Amount, 92)
+	}
+	if math.Abs(s.triangular[0].StartAmount-9.2) > 1e-9 {
+		t.Errorf("received '%v', expected '%v'", s.triangular[0].StartAmount, 9.2)
+	}
+
+	err = e.limits.LoadLimits([]order.MinMaxLevel{
+		{
+			Pair:        triangularBTCUSDT,
+			Asset:       asset.Spot,
+			MinNotional: 1e6,
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	s.scan()
+	if len(s.triangular) != 0 {
+		t.Errorf("received '%v' opportunities, expected '%v'", len(s.triangular), 0)
+	}
+}
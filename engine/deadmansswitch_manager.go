@@ -0,0 +1,286 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupDeadMansSwitchManager applies configuration parameters before running.
+// The connection monitor and communications manager are optional, without a
+// connection monitor connectivity is assumed and the fallback cancellation
+// is never triggered
+func SetupDeadMansSwitchManager(em iExchangeManager, om iDeadMansSwitchOrderManager, cm iConnectionMonitor, comms iCommsManager, cfg *config.DeadMansSwitch, verbose bool) (*DeadMansSwitchManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.Timeout <= 0 {
+		return nil, errDeadMansSwitchTimeoutUnset
+	}
+	if cfg.HeartbeatInterval <= 0 || cfg.HeartbeatInterval >= cfg.Timeout {
+		return nil, errDeadMansSwitchHeartbeatInvalid
+	}
+	if cfg.GracePeriod <= 0 {
+		return nil, errDeadMansSwitchGracePeriodUnset
+	}
+	return &DeadMansSwitchManager{
+		shutdown:          make(chan struct{}),
+		exchangeManager:   em,
+		orderManager:      om,
+		connectionMonitor: cm,
+		comms:             comms,
+		timeout:           cfg.Timeout,
+		heartbeat:         cfg.HeartbeatInterval,
+		gracePeriod:       cfg.GracePeriod,
+		verbose:           verbose,
+		exchanges:         make(map[string]*deadMansSwitchState),
+	}, nil
+}
+
+// Start runs the subsystem
+func (d *DeadMansSwitchManager) Start() error {
+	if d == nil {
+		return fmt.Errorf("%s %w", DeadMansSwitchManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&d.started, 0, 1) {
+		return fmt.Errorf("%s %w", DeadMansSwitchManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Dead man's switch manager %s", MsgSubSystemStarting)
+	d.wg.Add(1)
+	go d.run()
+	log.Debugf(log.OrderMgr, "Dead man's switch manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem. Exchange native switches are left armed so open
+// orders are cancelled by the exchange once the timeout elapses
+func (d *DeadMansSwitchManager) Stop() error {
+	if d == nil {
+		return fmt.Errorf("%s %w", DeadMansSwitchManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&d.started) == 0 {
+		return fmt.Errorf("%s %w", DeadMansSwitchManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Dead man's switch manager %s", MsgSubSystemShuttingDown)
+	close(d.shutdown)
+	d.wg.Wait()
+	d.shutdown = make(chan struct{})
+	atomic.StoreInt32(&d.started, 0)
+	log.Debugf(log.OrderMgr, "Dead man's switch manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (d *DeadMansSwitchManager) IsRunning() bool {
+	if d == nil {
+		return false
+	}
+	return atomic.LoadInt32(&d.started) == 1
+}
+
+func (d *DeadMansSwitchManager) run() {
+	defer d.wg.Done()
+	d.process(context.TODO(), time.Now())
+	timer := time.NewTimer(d.heartbeat)
+	for {
+		select {
+		case <-d.shutdown:
+			timer.Stop()
+			return
+		case <-timer.C:
+			d.process(context.TODO(), time.Now())
+			timer.Reset(d.heartbeat)
+		}
+	}
+}
+
+// process tracks connectivity, cancels the open orders of exchanges without
+// an armed switch when connectivity returns after the grace period and
+// refreshes the exchange native switches
+func (d *DeadMansSwitchManager) process(ctx context.Context, now time.Time) {
+	if !d.isOnline() {
+		if d.offlineSince.IsZero() {
+			d.offlineSince = now
+			d.pushEvent(DeadMansSwitchManagerName,
+				fmt.Sprintf("Dead man's switch: Connectivity lost, armed exchange switches will cancel open orders after %v. Other exchanges will have open orders cancelled if connectivity is lost for longer than %v",
+					d.timeout,
+					d.gracePeriod))
+		}
+		return
+	}
+
+	exchanges, err := d.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Dead man's switch: Unable to get exchanges: %v", err)
+		return
+	}
+
+	if !d.offlineSince.IsZero() {
+		outage := now.Sub(d.offlineSince)
+		d.offlineSince = time.Time{}
+		d.pushEvent(DeadMansSwitchManagerName,
+			fmt.Sprintf("Dead man's switch: Connectivity restored after %v", outage))
+		if outage >= d.gracePeriod {
+			d.cancelUnprotected(ctx, exchanges, outage)
+		}
+	}
+	d.refresh(ctx, exchanges)
+}
+
+// refresh arms or refreshes the native switch of every enabled exchange
+// which supports one. Changes in the state of a switch are audited
+func (d *DeadMansSwitchManager) refresh(ctx context.Context, exchanges []exchange.IBotExchange) {
+	for i := range exchanges {
+		if !exchanges[i].IsEnabled() {
+			continue
+		}
+		name := exchanges[i].GetName()
+		state, ok := d.exchanges[strings.ToLower(name)]
+		if !ok {
+			state = &deadMansSwitchState{
+				name:   name,
+				native: supportsDeadMansSwitch(exchanges[i]),
+			}
+			d.exchanges[strings.ToLower(name)] = state
+			if !state.native {
+				log.Debugf(log.OrderMgr,
+					"Dead man's switch: %s has no native dead man's switch, open orders will be cancelled once connectivity returns after %v",
+					name,
+					d.gracePeriod)
+			}
+		}
+		if !state.native {
+			continue
+		}
+
+		err := exchanges[i].SetDeadMansSwitch(ctx, d.timeout)
+		switch {
+		case errors.Is(err, common.ErrFunctionNotSupported):
+			state.native = false
+			state.armed = false
+			log.Debugf(log.OrderMgr,
+				"Dead man's switch: %s has no native dead man's switch, open orders will be cancelled once connectivity returns after %v",
+				name,
+				d.gracePeriod)
+		case err != nil:
+			if state.armed || state.lastErr != err.Error() {
+				d.pushEvent(name,
+					fmt.Sprintf("Dead man's switch: %s switch could not be refreshed: %v", name, err))
+			}
+			state.armed = false
+			state.lastErr = err.Error()
+		default:
+			if !state.armed {
+				d.pushEvent(name,
+					fmt.Sprintf("Dead man's switch: %s switch armed, open orders will be cancelled by the exchange if not refreshed within %v",
+						name,
+						d.timeout))
+			} else if d.verbose {
+				log.Debugf(log.OrderMgr, "Dead man's switch: %s switch refreshed", name)
+			}
+			state.armed = true
+			state.lastErr = ""
+		}
+	}
+}
+
+// cancelUnprotected cancels the open orders held by the order manager on
+// every enabled exchange which did not have an armed switch when
+// connectivity was lost
+func (d *DeadMansSwitchManager) cancelUnprotected(ctx context.Context, exchanges []exchange.IBotExchange, outage time.Duration) {
+	for i := range exchanges {
+		if !exchanges[i].IsEnabled() {
+			continue
+		}
+		name := exchanges[i].GetName()
+		if state, ok := d.exchanges[strings.ToLower(name)]; ok && state.armed {
+			continue
+		}
+		active, err := d.orderManager.GetOrdersActive(&order.Filter{Exchange: name})
+		if err != nil {
+			d.pushEvent(name,
+				fmt.Sprintf("Dead man's switch: %s open orders could not be retrieved for cancellation: %v", name, err))
+			continue
+		}
+		if len(active) == 0 {
+			continue
+		}
+		var errs common.Errors
+		for j := range active {
+			err = d.orderManager.Cancel(ctx, &order.Cancel{
+				Exchange:      active[j].Exchange,
+				ID:            active[j].ID,
+				AccountID:     active[j].AccountID,
+				ClientID:      active[j].ClientID,
+				ClientOrderID: active[j].ClientOrderID,
+				WalletAddress: active[j].WalletAddress,
+				Type:          active[j].Type,
+				Side:          active[j].Side,
+				Pair:          active[j].Pair,
+				AssetType:     active[j].AssetType,
+			})
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		msg := fmt.Sprintf("Dead man's switch: Connectivity lost for %v, cancelled %d of %d open orders on %s",
+			outage,
+			len(active)-len(errs),
+			len(active),
+			name)
+		if len(errs) > 0 {
+			msg += fmt.Sprintf(". Errors: %v", errs)
+		}
+		d.pushEvent(name, msg)
+	}
+}
+
+// isOnline returns whether internet connectivity is available, connectivity
+// is assumed when it is not monitored
+func (d *DeadMansSwitchManager) isOnline() bool {
+	if d.connectionMonitor == nil || !d.connectionMonitor.IsRunning() {
+		return true
+	}
+	return d.connectionMonitor.IsOnline()
+}
+
+// pushEvent logs, relays and audits a dead man's switch event
+func (d *DeadMansSwitchManager) pushEvent(identifier, msg string) {
+	log.Warnln(log.OrderMgr, msg)
+	if d.comms != nil {
+		d.comms.PushEvent(base.Event{
+			Type:    deadMansSwitchEventType,
+			Message: msg,
+		})
+	}
+	audit.Event(identifier, deadMansSwitchEventType, msg)
+}
+
+// supportsDeadMansSwitch returns whether an exchange advertises a native dead
+// man's switch and has authenticated REST support to arm it
+func supportsDeadMansSwitch(exch exchange.IBotExchange) bool {
+	b := exch.GetBase()
+	if b == nil {
+		return false
+	}
+	return (b.Features.Supports.RESTCapabilities.DeadMansSwitch ||
+		b.Features.Supports.WebsocketCapabilities.DeadMansSwitch) &&
+		exch.GetAuthenticatedAPISupport(exchange.RestAuthentication)
+}
@@ -0,0 +1,331 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+)
+
+var errDMSTest = errors.New("test error")
+
+// dmsExchange is an exchange which records dead man's switch requests
+type dmsExchange struct {
+	exchange.IBotExchange
+	name     string
+	base     exchange.Base
+	auth     bool
+	err      error
+	timeouts []time.Duration
+}
+
+func (d *dmsExchange) GetName() string {
+	return d.name
+}
+
+func (d *dmsExchange) IsEnabled() bool {
+	return true
+}
+
+func (d *dmsExchange) GetBase() *exchange.Base {
+	return &d.base
+}
+
+func (d *dmsExchange) GetAuthenticatedAPISupport(_ uint8) bool {
+	return d.auth
+}
+
+func (d *dmsExchange) SetDeadMansSwitch(_ context.Context, timeout time.Duration) error {
+	d.timeouts = append(d.timeouts, timeout)
+	return d.err
+}
+
+// dmsOrderManager holds active orders and records cancellations
+type dmsOrderManager struct {
+	active    []order.Detail
+	getErr    error
+	cancelErr error
+	cancelled []order.Cancel
+}
+
+func (d *dmsOrderManager) GetOrdersActive(f *order.Filter) ([]order.Detail, error) {
+	if d.getErr != nil {
+		return nil, d.getErr
+	}
+	var resp []order.Detail
+	for i := range d.active {
+		if strings.EqualFold(d.active[i].Exchange, f.Exchange) {
+			resp = append(resp, d.active[i])
+		}
+	}
+	return resp, nil
+}
+
+func (d *dmsOrderManager) Cancel(_ context.Context, c *order.Cancel) error {
+	if d.cancelErr != nil {
+		return d.cancelErr
+	}
+	d.cancelled = append(d.cancelled, *c)
+	return nil
+}
+
+// dmsConnectionMonitor reports a settable connectivity state
+type dmsConnectionMonitor struct {
+	online bool
+}
+
+func (d *dmsConnectionMonitor) IsRunning() bool {
+	return true
+}
+
+func (d *dmsConnectionMonitor) IsOnline() bool {
+	return d.online
+}
+
+func dmsConfig() *config.DeadMansSwitch {
+	return &config.DeadMansSwitch{
+		Timeout:           time.Minute,
+		HeartbeatInterval: time.Second * 15,
+		GracePeriod:       time.Second * 30,
+	}
+}
+
+// dmsSetup returns a manager with an exchange supporting a native switch and
+// an exchange which relies on the fallback cancellation
+func dmsSetup(t *testing.T) (*DeadMansSwitchManager, *dmsExchange, *dmsExchange, *dmsOrderManager, *dmsConnectionMonitor, *arbComms) {
+	t.Helper()
+	native := &dmsExchange{name: "native", auth: true}
+	native.base.Features.Supports.RESTCapabilities = protocol.Features{DeadMansSwitch: true}
+	fallback := &dmsExchange{name: "fallback", auth: true}
+	om := &dmsOrderManager{
+		active: []order.Detail{
+			{Exchange: "native", ID: "1"},
+			{Exchange: "fallback", ID: "2"},
+			{Exchange: "fallback", ID: "3"},
+		},
+	}
+	cm := &dmsConnectionMonitor{online: true}
+	comms := &arbComms{}
+	d, err := SetupDeadMansSwitchManager(&arbExchangeManager{exchanges: []exchange.IBotExchange{native, fallback}},
+		om, cm, comms, dmsConfig(), false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	return d, native, fallback, om, cm, comms
+}
+
+func TestSetupDeadMansSwitchManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupDeadMansSwitchManager(nil, nil, nil, nil, nil, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupDeadMansSwitchManager(&arbExchangeManager{}, nil, nil, nil, nil, false)
+	if !errors.Is(err, errNilOrderManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilOrderManager)
+	}
+	_, err = SetupDeadMansSwitchManager(&arbExchangeManager{}, &dmsOrderManager{}, nil, nil, nil, false)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("error '%v', expected '%v'", err, errNilConfig)
+	}
+	cfg := dmsConfig()
+	cfg.Timeout = 0
+	_, err = SetupDeadMansSwitchManager(&arbExchangeManager{}, &dmsOrderManager{}, nil, nil, cfg, false)
+	if !errors.Is(err, errDeadMansSwitchTimeoutUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errDeadMansSwitchTimeoutUnset)
+	}
+	cfg = dmsConfig()
+	cfg.HeartbeatInterval = cfg.Timeout
+	_, err = SetupDeadMansSwitchManager(&arbExchangeManager{}, &dmsOrderManager{}, nil, nil, cfg, false)
+	if !errors.Is(err, errDeadMansSwitchHeartbeatInvalid) {
+		t.Errorf("error '%v', expected '%v'", err, errDeadMansSwitchHeartbeatInvalid)
+	}
+	cfg = dmsConfig()
+	cfg.GracePeriod = 0
+	_, err = SetupDeadMansSwitchManager(&arbExchangeManager{}, &dmsOrderManager{}, nil, nil, cfg, false)
+	if !errors.Is(err, errDeadMansSwitchGracePeriodUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errDeadMansSwitchGracePeriodUnset)
+	}
+	d, err := SetupDeadMansSwitchManager(&arbExchangeManager{}, &dmsOrderManager{}, nil, nil, dmsConfig(), false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if d == nil {
+		t.Error("expected manager")
+	}
+}
+
+func TestDeadMansSwitchManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var d *DeadMansSwitchManager
+	err := d.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	err = d.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	if d.IsRunning() {
+		t.Error("expected not running")
+	}
+
+	d, err = SetupDeadMansSwitchManager(&arbExchangeManager{}, &dmsOrderManager{}, nil, nil, dmsConfig(), false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = d.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = d.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = d.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !d.IsRunning() {
+		t.Error("expected running")
+	}
+	err = d.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if d.IsRunning() {
+		t.Error("expected not running")
+	}
+}
+
+func TestDeadMansSwitchRefresh(t *testing.T) {
+	t.Parallel()
+	d, native, fallback, _, _, comms := dmsSetup(t)
+	d.process(context.Background(), time.Now())
+	if len(native.timeouts) != 1 || native.timeouts[0] != time.Minute {
+		t.Fatalf("received '%v', expected '%v'", native.timeouts, []time.Duration{time.Minute})
+	}
+	if len(fallback.timeouts) != 0 {
+		t.Errorf("received '%v' requests, expected '%v'", len(fallback.timeouts), 0)
+	}
+	if !d.exchanges["native"].armed {
+		t.Error("expected native switch to be armed")
+	}
+	if len(comms.events) != 1 || !strings.Contains(comms.events[0].Message, "native switch armed") {
+		t.Fatalf("received '%v', expected armed event", comms.events)
+	}
+
+	// refreshing an armed switch is not audited
+	d.process(context.Background(), time.Now())
+	if len(native.timeouts) != 2 {
+		t.Errorf("received '%v' requests, expected '%v'", len(native.timeouts), 2)
+	}
+	if len(comms.events) != 1 {
+		t.Errorf("received '%v' events, expected '%v'", len(comms.events), 1)
+	}
+
+	// failures are audited once until the error changes
+	native.err = errDMSTest
+	d.process(context.Background(), time.Now())
+	d.process(context.Background(), time.Now())
+	if d.exchanges["native"].armed {
+		t.Error("expected native switch to be disarmed")
+	}
+	if len(comms.events) != 2 || !strings.Contains(comms.events[1].Message, errDMSTest.Error()) {
+		t.Errorf("received '%v', expected refresh failure event", comms.events)
+	}
+
+	native.err = nil
+	d.process(context.Background(), time.Now())
+	if len(comms.events) != 3 || !d.exchanges["native"].armed {
+		t.Errorf("received '%v', expected switch to be armed again", comms.events)
+	}
+
+	// exchanges which advertise a switch but do not implement it fall back
+	native.err = common.ErrFunctionNotSupported
+	d.process(context.Background(), time.Now())
+	d.process(context.Background(), time.Now())
+	if d.exchanges["native"].native || d.exchanges["native"].armed {
+		t.Error("expected native switch to be unsupported")
+	}
+	if len(native.timeouts) != 6 {
+		t.Errorf("received '%v' requests, expected '%v'", len(native.timeouts), 6)
+	}
+
+	// authenticated support is required to arm a switch
+	native.auth = false
+	if supportsDeadMansSwitch(native) {
+		t.Error("expected switch to be unsupported without authenticated support")
+	}
+}
+
+func TestDeadMansSwitchFallback(t *testing.T) {
+	t.Parallel()
+	d, native, _, om, cm, comms := dmsSetup(t)
+	start := time.Now()
+	d.process(context.Background(), start)
+
+	// an outage shorter than the grace period leaves orders untouched
+	cm.online = false
+	d.process(context.Background(), start)
+	d.process(context.Background(), start.Add(time.Second))
+	if len(native.timeouts) != 1 {
+		t.Errorf("received '%v' requests, expected '%v'", len(native.timeouts), 1)
+	}
+	cm.online = true
+	d.process(context.Background(), start.Add(time.Second*10))
+	if len(om.cancelled) != 0 {
+		t.Errorf("received '%v' cancellations, expected '%v'", len(om.cancelled), 0)
+	}
+	// armed, connectivity lost and restored
+	if len(comms.events) != 3 {
+		t.Fatalf("received '%v' events, expected '%v'", len(comms.events), 3)
+	}
+
+	// only orders on exchanges without an armed switch are cancelled
+	cm.online = false
+	d.process(context.Background(), start.Add(time.Minute))
+	cm.online = true
+	d.process(context.Background(), start.Add(time.Minute*2))
+	if len(om.cancelled) != 2 {
+		t.Fatalf("received '%v' cancellations, expected '%v'", len(om.cancelled), 2)
+	}
+	for i := range om.cancelled {
+		if om.cancelled[i].Exchange != "fallback" {
+			t.Errorf("received '%v', expected '%v'", om.cancelled[i].Exchange, "fallback")
+		}
+	}
+	last := comms.events[len(comms.events)-1].Message
+	if !strings.Contains(last, "cancelled 2 of 2 open orders on fallback") {
+		t.Errorf("received '%v', expected cancellation event", last)
+	}
+
+	om.cancelled = nil
+	om.cancelErr = errDMSTest
+	cm.online = false
+	d.process(context.Background(), start.Add(time.Minute*3))
+	cm.online = true
+	d.process(context.Background(), start.Add(time.Minute*4))
+	last = comms.events[len(comms.events)-1].Message
+	if !strings.Contains(last, "cancelled 0 of 2 open orders on fallback") ||
+		!strings.Contains(last, errDMSTest.Error()) {
+		t.Errorf("received '%v', expected cancellation failure event", last)
+	}
+
+	om.getErr = errDMSTest
+	cm.online = false
+	d.process(context.Background(), start.Add(time.Minute*5))
+	cm.online = true
+	d.process(context.Background(), start.Add(time.Minute*6))
+	last = comms.events[len(comms.events)-1].Message
+	if !strings.Contains(last, "could not be retrieved") {
+		t.Errorf("received '%v', expected retrieval failure event", last)
+	}
+}
@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	// DeadMansSwitchManagerName defines the manager name string
+	DeadMansSwitchManagerName = "dead_mans_switch"
+	// deadMansSwitchEventType is the type of comms and audit events raised
+	// by the dead man's switch manager
+	deadMansSwitchEventType = "deadmansswitch"
+)
+
+var (
+	errDeadMansSwitchTimeoutUnset     = errors.New("dead man's switch timeout must be greater than zero")
+	errDeadMansSwitchHeartbeatInvalid = errors.New("dead man's switch heartbeat interval must be greater than zero and shorter than the timeout")
+	errDeadMansSwitchGracePeriodUnset = errors.New("dead man's switch grace period must be greater than zero")
+)
+
+// iDeadMansSwitchOrderManager defines a limited scoped order manager which
+// supplies the open orders cancelled on exchanges without a native dead man's
+// switch
+type iDeadMansSwitchOrderManager interface {
+	GetOrdersActive(*order.Filter) ([]order.Detail, error)
+	Cancel(context.Context, *order.Cancel) error
+}
+
+// iConnectionMonitor defines a limited scoped connection manager which
+// reports internet connectivity
+type iConnectionMonitor interface {
+	IsRunning() bool
+	IsOnline() bool
+}
+
+// DeadMansSwitchManager arms exchange native dead man's switches and refreshes
+// them on a heartbeat so open orders are cancelled by the exchange when the
+// engine stops responding. Exchanges without a native switch have their open
+// orders cancelled once connectivity returns after a grace period
+type DeadMansSwitchManager struct {
+	started           int32
+	shutdown          chan struct{}
+	wg                sync.WaitGroup
+	exchangeManager   iExchangeManager
+	orderManager      iDeadMansSwitchOrderManager
+	connectionMonitor iConnectionMonitor
+	comms             iCommsManager
+	timeout           time.Duration
+	heartbeat         time.Duration
+	gracePeriod       time.Duration
+	verbose           bool
+	// exchanges holds the switch state keyed by lower case exchange name
+	exchanges map[string]*deadMansSwitchState
+	// offlineSince is set while connectivity is lost
+	offlineSince time.Time
+}
+
+// deadMansSwitchState holds the dead man's switch state of an exchange
+type deadMansSwitchState struct {
+	name string
+	// native is false when the exchange cannot arm a dead man's switch and
+	// relies on the fallback cancellation
+	native bool
+	// armed is true when the native switch was refreshed on the last
+	// heartbeat
+	armed bool
+	// lastErr is the last error received refreshing the switch, it is used
+	// to only raise an event when the error changes
+	lastErr string
+}
@@ -41,6 +41,11 @@ type Engine struct {
 	ExchangeManager         *ExchangeManager
 	ntpManager              *ntpManager
 	OrderManager            *OrderManager
+	executionManager        *ExecutionManager
+	fillLedger              *FillLedger
+	arbitrageScanner        *ArbitrageScanner
+	deadMansSwitchManager   *DeadMansSwitchManager
+	orderbookRecorder       *OrderbookRecorder
 	portfolioManager        *portfolioManager
 	gctScriptManager        *gctscript.GctScriptManager
 	websocketRoutineManager *websocketRoutineManager
@@ -159,6 +164,14 @@ func validateSettings(b *Engine, s *Settings, flagSet map[string]bool) {
 		b.Settings.PortfolioManagerDelay = PortfolioSleepDelay
 	}
 
+	if !flagSet["deadmansswitch"] {
+		b.Settings.EnableDeadMansSwitch = b.Config.DeadMansSwitch.Enabled
+	}
+
+	if !flagSet["orderbookrecorder"] {
+		b.Settings.EnableOrderbookRecorder = b.Config.OrderbookRecorder.Enabled
+	}
+
 	if !flagSet["grpc"] {
 		b.Settings.EnableGRPC = b.Config.RemoteControl.GRPC.Enabled
 	}
@@ -257,6 +270,14 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable event manager: %v", s.EnableEventManager)
 	gctlog.Debugf(gctlog.Global, "\t Event manager sleep delay: %v", s.EventManagerDelay)
 	gctlog.Debugf(gctlog.Global, "\t Enable order manager: %v", s.EnableOrderManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable execution manager: %v", s.EnableExecutionManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable fill ledger: %v", s.EnableFillLedger)
+	gctlog.Debugf(gctlog.Global, "\t Fill ledger cost basis: %v", s.FillLedgerCostBasis)
+	gctlog.Debugf(gctlog.Global, "\t Enable arbitrage scanner: %v", s.EnableArbitrageScanner)
+	gctlog.Debugf(gctlog.Global, "\t Arbitrage minimum profit percentage: %v", s.ArbitrageMinimumProfit)
+	gctlog.Debugf(gctlog.Global, "\t Arbitrage comms notifications: %v", s.ArbitrageNotify)
+	gctlog.Debugf(gctlog.Global, "\t Enable dead man's switch: %v", s.EnableDeadMansSwitch)
+	gctlog.Debugf(gctlog.Global, "\t Enable orderbook recorder: %v", s.EnableOrderbookRecorder)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange sync manager: %v", s.EnableExchangeSyncManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable deposit address manager: %v\n", s.EnableDepositAddressManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable websocket routine: %v\n", s.EnableWebsocketRoutine)
@@ -285,6 +306,7 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange websocket support: %v", s.EnableExchangeWebsocketSupport)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange verbose mode: %v", s.EnableExchangeVerbose)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange HTTP rate limiter: %v", s.EnableExchangeHTTPRateLimiter)
+	gctlog.Debugf(gctlog.Global, "\t Exchange HTTP rate limit store directory: %v", s.RateLimitStoreDirectory)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange HTTP debugging: %v", s.EnableExchangeHTTPDebugging)
 	gctlog.Debugf(gctlog.Global, "\t Max HTTP request jobs: %v", s.MaxHTTPRequestJobsLimit)
 	gctlog.Debugf(gctlog.Global, "\t HTTP request max retry attempts: %v", s.RequestMaxRetryAttempts)
@@ -510,6 +532,22 @@ func (bot *Engine) Start() error {
 		if err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
 		} else {
+			err = bot.OrderManager.SetRiskControls(&bot.Config.OrderManager.RiskControls)
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Order manager unable to set risk controls: %s", err)
+			}
+			if bot.DatabaseManager.IsRunning() {
+				err = bot.OrderManager.SetDatabase(bot.DatabaseManager)
+				if err != nil {
+					gctlog.Errorf(gctlog.Global, "Order manager unable to persist orders to database: %s", err)
+				}
+			}
+			if bot.Settings.DataDir != "" {
+				err = bot.OrderManager.SetSyntheticOrderFile(filepath.Join(bot.Settings.DataDir, syntheticOrderFileName))
+				if err != nil {
+					gctlog.Errorf(gctlog.Global, "Order manager unable to persist synthetic orders: %s", err)
+				}
+			}
 			err = bot.OrderManager.Start()
 			if err != nil {
 				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
@@ -517,6 +555,106 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableExecutionManager && bot.OrderManager.IsRunning() {
+		bot.executionManager, err = SetupExecutionManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			DefaultExecutionManagerDelay,
+			bot.Settings.Verbose)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Execution manager unable to setup: %s", err)
+		} else {
+			err = bot.executionManager.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Execution manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableFillLedger && bot.OrderManager.IsRunning() {
+		bot.fillLedger, err = SetupFillLedger(
+			bot.OrderManager,
+			CostBasis(bot.Settings.FillLedgerCostBasis),
+			DefaultFillLedgerDelay,
+			bot.Settings.Verbose)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Fill ledger unable to setup: %s", err)
+		} else {
+			err = bot.fillLedger.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Fill ledger unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableDeadMansSwitch && bot.OrderManager.IsRunning() {
+		var connMonitor iConnectionMonitor
+		if bot.connectionManager != nil {
+			connMonitor = bot.connectionManager
+		}
+		var comms iCommsManager
+		if bot.CommunicationsManager != nil {
+			comms = bot.CommunicationsManager
+		}
+		bot.deadMansSwitchManager, err = SetupDeadMansSwitchManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			connMonitor,
+			comms,
+			&bot.Config.DeadMansSwitch,
+			bot.Settings.Verbose)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Dead man's switch manager unable to setup: %s", err)
+		} else {
+			err = bot.deadMansSwitchManager.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Dead man's switch manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableOrderbookRecorder {
+		bot.orderbookRecorder, err = SetupOrderbookRecorder(
+			bot.ExchangeManager,
+			bot.getOrderbookRecorderConfig(),
+			bot.Settings.Verbose)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Orderbook recorder unable to setup: %s", err)
+		} else {
+			err = bot.orderbookRecorder.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Orderbook recorder unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableArbitrageScanner {
+		var comms iCommsManager
+		if bot.Settings.ArbitrageNotify && bot.CommunicationsManager != nil {
+			comms = bot.CommunicationsManager
+		}
+		bot.arbitrageScanner, err = SetupArbitrageScanner(
+			bot.ExchangeManager,
+			comms,
+			asset.Items{asset.Spot},
+			bot.Settings.ArbitrageMinimumProfit,
+			DefaultArbitrageMaxBookAge,
+			DefaultArbitrageScannerDelay,
+			bot.Settings.Verbose)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Arbitrage scanner unable to setup: %s", err)
+		} else {
+			err = bot.arbitrageScanner.SetTriangularThresholds(bot.Config.ArbitrageScanner.TriangularThresholds)
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Arbitrage scanner unable to set triangular thresholds: %s", err)
+			}
+			err = bot.arbitrageScanner.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Arbitrage scanner unable to start: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableExchangeSyncManager {
 		exchangeSyncCfg := &Config{
 			SyncTicker:           bot.Settings.EnableTickerSyncing,
@@ -618,6 +756,31 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.executionManager.IsRunning() {
+		if err := bot.executionManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Execution manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.fillLedger.IsRunning() {
+		if err := bot.fillLedger.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Fill ledger unable to stop. Error: %v", err)
+		}
+	}
+	if bot.deadMansSwitchManager.IsRunning() {
+		if err := bot.deadMansSwitchManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Dead man's switch manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.orderbookRecorder.IsRunning() {
+		if err := bot.orderbookRecorder.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Orderbook recorder unable to stop. Error: %v", err)
+		}
+	}
+	if bot.arbitrageScanner.IsRunning() {
+		if err := bot.arbitrageScanner.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Arbitrage scanner unable to stop. Error: %v", err)
+		}
+	}
 	if bot.OrderManager.IsRunning() {
 		if err := bot.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
@@ -826,6 +989,24 @@ func (bot *Engine) LoadExchange(name string, wg *sync.WaitGroup) error {
 			)
 		}
 	}
+	if bot.Settings.RateLimitStoreDirectory != "" {
+		var store *request.FileBudgetStore
+		store, err = request.NewFileBudgetStore(bot.Settings.RateLimitStoreDirectory)
+		if err == nil {
+			err = exch.GetBase().Requester.SetBudgetStore(store)
+		}
+		switch {
+		case errors.Is(err, request.ErrBudgetsUnsupported):
+			gctlog.Debugf(gctlog.ExchangeSys,
+				"Loaded exchange %s rate limits cannot be shared between processes.\n",
+				exch.GetName())
+		case err != nil:
+			gctlog.Errorf(gctlog.ExchangeSys,
+				"Loaded exchange %s rate limits cannot be shared: %s.\n",
+				exch.GetName(),
+				err)
+		}
+	}
 
 	exchCfg.Enabled = true
 	err = exch.Setup(exchCfg)
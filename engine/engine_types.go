@@ -31,6 +31,11 @@ type Settings struct {
 	EnableDepositAddressManager bool
 	EnableEventManager          bool
 	EnableOrderManager          bool
+	EnableExecutionManager      bool
+	EnableFillLedger            bool
+	EnableArbitrageScanner      bool
+	EnableDeadMansSwitch        bool
+	EnableOrderbookRecorder     bool
 	EnableConnectivityMonitor   bool
 	EnableDatabaseManager       bool
 	EnableGCTScriptManager      bool
@@ -40,6 +45,13 @@ type Settings struct {
 	EventManagerDelay           time.Duration
 	Verbose                     bool
 
+	// Fill ledger settings
+	FillLedgerCostBasis string
+
+	// Arbitrage scanner settings
+	ArbitrageMinimumProfit float64
+	ArbitrageNotify        bool
+
 	// Exchange syncer settings
 	EnableTickerSyncing    bool
 	EnableOrderbookSyncing bool
@@ -68,6 +80,7 @@ type Settings struct {
 	MaxHTTPRequestJobsLimit        int
 	TradeBufferProcessingInterval  time.Duration
 	RequestMaxRetryAttempts        int
+	RateLimitStoreDirectory        string
 
 	// Global HTTP related settings
 	GlobalHTTPTimeout   time.Duration
@@ -0,0 +1,698 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupExecutionManager applies configuration parameters before running
+func SetupExecutionManager(em iExchangeManager, om iExecutionOrderManager, interval time.Duration, verbose bool) (*ExecutionManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if interval <= 0 {
+		interval = DefaultExecutionManagerDelay
+	}
+	return &ExecutionManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: em,
+		orderManager:    om,
+		sleep:           interval,
+		verbose:         verbose,
+		orders:          make(map[string]*executionOrder),
+	}, nil
+}
+
+// Start runs the subsystem
+func (e *ExecutionManager) Start() error {
+	if e == nil {
+		return fmt.Errorf("%s %w", ExecutionManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&e.started, 0, 1) {
+		return fmt.Errorf("%s %w", ExecutionManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Execution manager %s", MsgSubSystemStarting)
+	e.wg.Add(1)
+	go e.run()
+	log.Debugf(log.OrderMgr, "Execution manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem, parent orders stop being sliced but open child
+// orders are left on the exchange
+func (e *ExecutionManager) Stop() error {
+	if e == nil {
+		return fmt.Errorf("%s %w", ExecutionManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&e.started) == 0 {
+		return fmt.Errorf("%s %w", ExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Execution manager %s", MsgSubSystemShuttingDown)
+	close(e.shutdown)
+	e.wg.Wait()
+	e.shutdown = make(chan struct{})
+	atomic.StoreInt32(&e.started, 0)
+	log.Debugf(log.OrderMgr, "Execution manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (e *ExecutionManager) IsRunning() bool {
+	if e == nil {
+		return false
+	}
+	return atomic.LoadInt32(&e.started) == 1
+}
+
+func (e *ExecutionManager) run() {
+	defer e.wg.Done()
+	timer := time.NewTimer(e.sleep)
+	for {
+		select {
+		case <-e.shutdown:
+			timer.Stop()
+			return
+		case <-timer.C:
+			e.process(context.TODO())
+			timer.Reset(e.sleep)
+		}
+	}
+}
+
+// Submit validates a parent order, builds its slicing schedule and hands it
+// to the manager to be executed
+func (e *ExecutionManager) Submit(ctx context.Context, s *ExecutionSubmit) (*ExecutionOrder, error) {
+	if e == nil {
+		return nil, fmt.Errorf("%s %w", ExecutionManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&e.started) == 0 {
+		return nil, fmt.Errorf("%s %w", ExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	if s == nil {
+		return nil, errNilExecutionSubmit
+	}
+	s.Type = order.Market
+	if s.Price > 0 {
+		s.Type = order.Limit
+	}
+	s.Algorithm = ExecutionAlgorithm(strings.ToUpper(string(s.Algorithm)))
+	switch s.Algorithm {
+	case TWAP, VWAP:
+		if s.Duration <= 0 || s.Interval <= 0 {
+			return nil, errExecutionDurationUnset
+		}
+		if s.Interval.Duration() > s.Duration {
+			return nil, errExecutionIntervalInvalid
+		}
+	case Iceberg:
+		if s.ClipSize <= 0 {
+			return nil, errExecutionClipSizeUnset
+		}
+		if s.Price <= 0 {
+			return nil, errExecutionPriceUnset
+		}
+	default:
+		return nil, fmt.Errorf("%w %q", errExecutionAlgorithmUnsupported, s.Algorithm)
+	}
+	if s.Exchange == "" {
+		return nil, errExchangeNameIsEmpty
+	}
+	err := s.Submit.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ExecutionManagerName, err)
+	}
+	exch, err := e.exchangeManager.GetExchangeByName(s.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	o := &executionOrder{
+		ExecutionOrder: ExecutionOrder{
+			ID:          id.String(),
+			Exchange:    exch.GetName(),
+			Pair:        s.Pair,
+			AssetType:   s.AssetType,
+			Side:        s.Side,
+			Type:        s.Type,
+			Algorithm:   s.Algorithm,
+			Status:      ExecutionActive,
+			Amount:      s.Amount,
+			Price:       s.Price,
+			Duration:    s.Duration,
+			Interval:    s.Interval,
+			ClipSize:    s.ClipSize,
+			StartTime:   now,
+			LastUpdated: now,
+		},
+		clientOrderID: s.ClientOrderID,
+		nextSlice:     now,
+	}
+
+	tick, err := exch.FetchTicker(ctx, s.Pair, s.AssetType)
+	if err != nil {
+		log.Warnf(log.OrderMgr,
+			"Execution manager: unable to fetch %s %s %s arrival price, slippage will not be calculated: %s",
+			o.Exchange,
+			o.Pair,
+			o.AssetType,
+			err)
+	} else {
+		o.ArrivalPrice = tickerSidePrice(o.Side, tick)
+	}
+
+	switch o.Algorithm {
+	case TWAP:
+		weights := make([]float64, executionSlices(o.Duration, o.Interval))
+		for i := range weights {
+			weights[i] = 1
+		}
+		o.schedule = cumulativeSchedule(o.Amount, weights)
+	case VWAP:
+		var candles kline.Item
+		candles, err = exch.GetHistoricCandles(ctx, o.Pair, o.AssetType, now.Add(-o.Duration), now, o.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("%s unable to build VWAP volume profile: %w", ExecutionManagerName, err)
+		}
+		var weights []float64
+		weights, err = volumeWeights(candles.Candles, executionSlices(o.Duration, o.Interval))
+		if err != nil {
+			return nil, fmt.Errorf("%s unable to build VWAP volume profile: %w", ExecutionManagerName, err)
+		}
+		o.schedule = cumulativeSchedule(o.Amount, weights)
+	case Iceberg:
+		o.Slices = int(math.Ceil(o.Amount / o.ClipSize))
+	}
+	if o.Slices == 0 {
+		o.Slices = len(o.schedule)
+	}
+
+	e.m.Lock()
+	e.orders[o.ID] = o
+	resp := o.snapshot()
+	e.m.Unlock()
+
+	log.Infof(log.OrderMgr,
+		"Execution manager: %s order %s started for %s %s %s %s amount=%v slices=%v",
+		o.Algorithm,
+		o.ID,
+		o.Exchange,
+		o.Pair,
+		o.AssetType,
+		o.Side,
+		o.Amount,
+		o.Slices)
+	return resp, nil
+}
+
+// Pause stops a parent order from submitting further child orders, child
+// orders already on the exchange are left open
+func (e *ExecutionManager) Pause(id string) (*ExecutionOrder, error) {
+	return e.setStatus(id, ExecutionActive, ExecutionPaused)
+}
+
+// Resume continues a paused parent order from its next slice
+func (e *ExecutionManager) Resume(id string) (*ExecutionOrder, error) {
+	return e.setStatus(id, ExecutionPaused, ExecutionActive)
+}
+
+func (e *ExecutionManager) setStatus(id string, from, to ExecutionStatus) (*ExecutionOrder, error) {
+	if e == nil {
+		return nil, fmt.Errorf("%s %w", ExecutionManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&e.started) == 0 {
+		return nil, fmt.Errorf("%s %w", ExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	o, ok := e.orders[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrExecutionOrderNotFound, id)
+	}
+	if o.Status != from {
+		return nil, fmt.Errorf("%w from %s to %s", errExecutionStatusInvalid, o.Status, to)
+	}
+	o.Status = to
+	o.LastUpdated = time.Now()
+	if to == ExecutionActive {
+		o.nextSlice = o.LastUpdated
+	}
+	return o.snapshot(), nil
+}
+
+// Cancel stops a parent order and cancels any of its open child orders
+func (e *ExecutionManager) Cancel(ctx context.Context, id string) (*ExecutionOrder, error) {
+	if e == nil {
+		return nil, fmt.Errorf("%s %w", ExecutionManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&e.started) == 0 {
+		return nil, fmt.Errorf("%s %w", ExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	e.m.Lock()
+	o, ok := e.orders[id]
+	if !ok {
+		e.m.Unlock()
+		return nil, fmt.Errorf("%w %s", ErrExecutionOrderNotFound, id)
+	}
+	if o.Status != ExecutionActive && o.Status != ExecutionPaused {
+		e.m.Unlock()
+		return nil, fmt.Errorf("%w from %s to %s", errExecutionStatusInvalid, o.Status, ExecutionCancelled)
+	}
+	o.Status = ExecutionCancelled
+	o.LastUpdated = time.Now()
+	var open []string
+	for _, c := range o.children {
+		if !c.inactive {
+			open = append(open, c.orderID)
+		}
+	}
+	e.m.Unlock()
+
+	var errs common.Errors
+	for i := range open {
+		err := e.orderManager.Cancel(ctx, &order.Cancel{
+			Exchange:  o.Exchange,
+			ID:        open[i],
+			Pair:      o.Pair,
+			AssetType: o.AssetType,
+			Side:      o.Side,
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.updateFills(o)
+	if len(errs) > 0 {
+		return o.snapshot(), fmt.Errorf("%s unable to cancel child orders: %w", ExecutionManagerName, errs)
+	}
+	return o.snapshot(), nil
+}
+
+// GetExecutionOrder returns a snapshot of a parent order's progress
+func (e *ExecutionManager) GetExecutionOrder(id string) (*ExecutionOrder, error) {
+	if e == nil {
+		return nil, fmt.Errorf("%s %w", ExecutionManagerName, ErrNilSubsystem)
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	o, ok := e.orders[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrExecutionOrderNotFound, id)
+	}
+	return o.snapshot(), nil
+}
+
+// GetExecutionOrders returns a snapshot of all parent orders ordered by start
+// time
+func (e *ExecutionManager) GetExecutionOrders() ([]ExecutionOrder, error) {
+	if e == nil {
+		return nil, fmt.Errorf("%s %w", ExecutionManagerName, ErrNilSubsystem)
+	}
+	e.m.Lock()
+	resp := make([]ExecutionOrder, 0, len(e.orders))
+	for _, o := range e.orders {
+		resp = append(resp, *o.snapshot())
+	}
+	e.m.Unlock()
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].StartTime.Before(resp[j].StartTime)
+	})
+	return resp, nil
+}
+
+// process updates child order fills of every parent order and submits the
+// next slice of active parent orders which are due. Child orders are
+// submitted without holding the lock so that parent orders can be queried and
+// managed while requests are in flight
+func (e *ExecutionManager) process(ctx context.Context) {
+	now := time.Now()
+	var due []*executionSlice
+	e.m.Lock()
+	for _, o := range e.orders {
+		if o.isResolved() && !o.hasOpenChildren() {
+			continue
+		}
+		e.updateFills(o)
+		if o.Status != ExecutionActive {
+			continue
+		}
+		if o.isFinished() {
+			o.Status = ExecutionCompleted
+			o.LastUpdated = now
+			if remaining := o.Amount - o.Filled; remaining > o.Amount*executionDustRatio && o.FailureReason == "" {
+				o.FailureReason = fmt.Sprintf("remaining amount %v was not executed", remaining)
+			}
+			log.Infof(log.OrderMgr,
+				"Execution manager: %s order %s completed filled=%v average price=%v slippage=%.2fbps",
+				o.Algorithm,
+				o.ID,
+				o.Filled,
+				o.AveragePrice,
+				o.SlippageBPS)
+			continue
+		}
+		if slice := e.dueSlice(o, now); slice != nil {
+			due = append(due, slice)
+		}
+	}
+	e.m.Unlock()
+
+	for i := range due {
+		e.submitSlice(ctx, due[i], now)
+	}
+}
+
+// dueSlice returns the next child order of a parent order if it is due,
+// slices which do not meet exchange execution limits are carried into the
+// next slice. The caller must hold the manager lock
+func (e *ExecutionManager) dueSlice(o *executionOrder, now time.Time) *executionSlice {
+	var amount float64
+	var final bool
+	switch o.Algorithm {
+	case Iceberg:
+		if o.hasOpenChildren() {
+			return nil
+		}
+		amount = math.Min(o.ClipSize, o.Amount-o.committed())
+		final = true
+	default:
+		if now.Before(o.nextSlice) || o.SlicesSubmitted >= len(o.schedule) {
+			return nil
+		}
+		amount = o.schedule[o.SlicesSubmitted] - o.committed()
+		o.SlicesSubmitted++
+		o.nextSlice = now.Add(o.Interval.Duration())
+		final = o.SlicesSubmitted == len(o.schedule)
+	}
+
+	exch, err := e.exchangeManager.GetExchangeByName(o.Exchange)
+	if err != nil {
+		o.fail(err, now)
+		return nil
+	}
+	limits, err := exch.GetOrderExecutionLimits(o.AssetType, o.Pair)
+	if err == nil {
+		amount = limits.ConformToAmount(amount)
+	}
+	if amount <= o.Amount*executionDustRatio {
+		o.exhausted = final
+		return nil
+	}
+	err = exch.CheckOrderExecutionLimits(o.AssetType, o.Pair, o.Price, amount, o.Type)
+	if err != nil {
+		if !final {
+			if e.verbose {
+				log.Debugf(log.OrderMgr,
+					"Execution manager: %s order %s slice of %v carried forward: %s",
+					o.Algorithm,
+					o.ID,
+					amount,
+					err)
+			}
+			return nil
+		}
+		o.FailureReason = fmt.Sprintf("remaining amount %v cannot be executed: %s", amount, err)
+		o.exhausted = true
+		return nil
+	}
+	return &executionSlice{
+		parent: o,
+		exch:   exch,
+		submit: order.Submit{
+			Exchange:      o.Exchange,
+			Pair:          o.Pair,
+			AssetType:     o.AssetType,
+			Side:          o.Side,
+			Type:          o.Type,
+			Price:         o.Price,
+			Amount:        amount,
+			ClientOrderID: o.clientOrderID,
+		},
+	}
+}
+
+// submitSlice submits a due child order and records it against its parent
+// order. The child order is cancelled if the parent order was cancelled while
+// it was being submitted
+func (e *ExecutionManager) submitSlice(ctx context.Context, s *executionSlice, now time.Time) {
+	referencePrice := s.submit.Price
+	if s.submit.Type == order.Market {
+		tick, err := s.exch.FetchTicker(ctx, s.submit.Pair, s.submit.AssetType)
+		if err == nil {
+			referencePrice = tickerSidePrice(s.submit.Side, tick)
+		}
+	}
+	resp, err := e.orderManager.Submit(ctx, &s.submit)
+
+	o := s.parent
+	e.m.Lock()
+	if err != nil {
+		if !o.isResolved() {
+			o.fail(err, now)
+		}
+		e.m.Unlock()
+		return
+	}
+	o.children = append(o.children, &executionChild{
+		orderID:        resp.OrderID,
+		amount:         s.submit.Amount,
+		referencePrice: referencePrice,
+	})
+	o.ChildOrderIDs = append(o.ChildOrderIDs, resp.OrderID)
+	o.Submitted += s.submit.Amount
+	if o.Algorithm == Iceberg {
+		// clips cancelled before filling are resubmitted
+		o.SlicesSubmitted++
+		if o.SlicesSubmitted > o.Slices {
+			o.Slices = o.SlicesSubmitted
+		}
+	}
+	o.LastUpdated = now
+	cancelled := o.Status == ExecutionCancelled
+	e.m.Unlock()
+
+	if e.verbose {
+		log.Debugf(log.OrderMgr,
+			"Execution manager: %s order %s submitted child order %s amount=%v",
+			o.Algorithm,
+			o.ID,
+			resp.OrderID,
+			s.submit.Amount)
+	}
+	if !cancelled {
+		return
+	}
+	err = e.orderManager.Cancel(ctx, &order.Cancel{
+		Exchange:  s.submit.Exchange,
+		ID:        resp.OrderID,
+		Pair:      s.submit.Pair,
+		AssetType: s.submit.AssetType,
+		Side:      s.submit.Side,
+	})
+	if err != nil {
+		log.Errorf(log.OrderMgr,
+			"Execution manager: %s order %s unable to cancel child order %s: %s",
+			o.Algorithm,
+			o.ID,
+			resp.OrderID,
+			err)
+	}
+}
+
+// updateFills refreshes the fill state of open child orders from the order
+// manager and recalculates the parent order's progress
+func (e *ExecutionManager) updateFills(o *executionOrder) {
+	var changed bool
+	for _, c := range o.children {
+		if c.inactive {
+			continue
+		}
+		det, err := e.orderManager.GetByExchangeAndID(o.Exchange, c.orderID)
+		if err != nil {
+			if e.verbose {
+				log.Debugf(log.OrderMgr,
+					"Execution manager: %s order %s child order %s: %s",
+					o.Algorithm,
+					o.ID,
+					c.orderID,
+					err)
+			}
+			continue
+		}
+		filled := det.ExecutedAmount
+		if det.Status == order.Filled && filled == 0 {
+			filled = det.Amount
+		}
+		price := det.AverageExecutedPrice
+		if price == 0 {
+			price = det.Price
+		}
+		if price == 0 {
+			price = c.referencePrice
+		}
+		inactive := det.IsInactive()
+		if filled != c.filled || price != c.averagePrice || inactive != c.inactive {
+			c.filled, c.averagePrice, c.inactive = filled, price, inactive
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	var filled, notional float64
+	for _, c := range o.children {
+		filled += c.filled
+		notional += c.filled * c.averagePrice
+	}
+	o.Filled = filled
+	if filled > 0 {
+		o.AveragePrice = notional / filled
+	}
+	if o.ArrivalPrice > 0 && o.AveragePrice > 0 {
+		o.SlippageBPS = (o.AveragePrice - o.ArrivalPrice) / o.ArrivalPrice * 10000
+		if o.Side == order.Sell || o.Side == order.Ask {
+			o.SlippageBPS = -o.SlippageBPS
+		}
+	}
+	o.LastUpdated = time.Now()
+}
+
+// committed returns the amount of the parent order which is filled or still
+// open on the exchange
+func (o *executionOrder) committed() float64 {
+	var amount float64
+	for _, c := range o.children {
+		if c.inactive {
+			amount += c.filled
+			continue
+		}
+		amount += c.amount
+	}
+	return amount
+}
+
+func (o *executionOrder) hasOpenChildren() bool {
+	for _, c := range o.children {
+		if !c.inactive {
+			return true
+		}
+	}
+	return false
+}
+
+// isFinished returns whether every slice has been submitted, or the
+// remainder cannot be, and all child orders are no longer open
+func (o *executionOrder) isFinished() bool {
+	if o.hasOpenChildren() {
+		return false
+	}
+	if o.exhausted {
+		return true
+	}
+	if o.Algorithm == Iceberg {
+		return o.Amount-o.committed() <= o.Amount*executionDustRatio
+	}
+	return o.SlicesSubmitted >= len(o.schedule)
+}
+
+func (o *executionOrder) isResolved() bool {
+	return o.Status == ExecutionCompleted ||
+		o.Status == ExecutionCancelled ||
+		o.Status == ExecutionFailed
+}
+
+func (o *executionOrder) fail(err error, now time.Time) {
+	o.Status = ExecutionFailed
+	o.FailureReason = err.Error()
+	o.LastUpdated = now
+	log.Errorf(log.OrderMgr,
+		"Execution manager: %s order %s failed: %s",
+		o.Algorithm,
+		o.ID,
+		err)
+}
+
+// snapshot returns a copy of the parent order's progress, the caller must
+// hold the manager lock
+func (o *executionOrder) snapshot() *ExecutionOrder {
+	cpy := o.ExecutionOrder
+	cpy.ChildOrderIDs = append([]string(nil), o.ChildOrderIDs...)
+	return &cpy
+}
+
+// executionSlices returns the number of slices a duration is split into
+func executionSlices(duration time.Duration, interval kline.Interval) int {
+	return int(math.Ceil(float64(duration) / float64(interval.Duration())))
+}
+
+// volumeWeights returns the relative volume of the most recent candles for
+// each slice, slices without a candle use the average volume
+func volumeWeights(candles []kline.Candle, slices int) ([]float64, error) {
+	if len(candles) == 0 {
+		return nil, errExecutionNoVolume
+	}
+	sorted := append([]kline.Candle(nil), candles...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+	if len(sorted) > slices {
+		sorted = sorted[len(sorted)-slices:]
+	}
+	var total float64
+	for i := range sorted {
+		total += sorted[i].Volume
+	}
+	if total <= 0 {
+		return nil, errExecutionNoVolume
+	}
+	average := total / float64(len(sorted))
+	weights := make([]float64, slices)
+	offset := slices - len(sorted)
+	for i := range weights {
+		if i < offset {
+			weights[i] = average
+			continue
+		}
+		weights[i] = sorted[i-offset].Volume
+	}
+	return weights, nil
+}
+
+// cumulativeSchedule splits an amount by weight and returns the cumulative
+// amount to have been submitted after each slice
+func cumulativeSchedule(amount float64, weights []float64) []float64 {
+	var total float64
+	for i := range weights {
+		total += weights[i]
+	}
+	schedule := make([]float64, len(weights))
+	var sum float64
+	for i := range weights {
+		sum += weights[i]
+		schedule[i] = amount * sum / total
+	}
+	// avoid float drift leaving dust on the final slice
+	schedule[len(schedule)-1] = amount
+	return schedule
+}
@@ -0,0 +1,648 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// emfExchange aka execution manager fake exchange overrides market data
+// functions so that no API calls are made
+type emfExchange struct {
+	exchange.IBotExchange
+	candles []kline.Candle
+}
+
+// FetchTicker overrides testExchange's fetch ticker function
+func (f emfExchange) FetchTicker(_ context.Context, p currency.Pair, a asset.Item) (*ticker.Price, error) {
+	return &ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         p,
+		AssetType:    a,
+		Last:         100,
+		Bid:          99,
+		Ask:          101,
+	}, nil
+}
+
+// GetHistoricCandles overrides testExchange's get historic candles function
+func (f emfExchange) GetHistoricCandles(_ context.Context, p currency.Pair, a asset.Item, _, _ time.Time, i kline.Interval) (kline.Item, error) {
+	return kline.Item{
+		Exchange: testExchange,
+		Pair:     p,
+		Asset:    a,
+		Interval: i,
+		Candles:  f.candles,
+	}, nil
+}
+
+// emfOrderManager stores submitted child orders without touching an exchange
+type emfOrderManager struct {
+	orders    map[string]*order.Detail
+	submitErr error
+	// onSubmit is called while a child order is being submitted
+	onSubmit func()
+}
+
+func (f *emfOrderManager) Submit(_ context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	if f.onSubmit != nil {
+		f.onSubmit()
+	}
+	if f.submitErr != nil {
+		return nil, f.submitErr
+	}
+	id := strconv.Itoa(len(f.orders) + 1)
+	f.orders[id] = &order.Detail{
+		Exchange:  s.Exchange,
+		ID:        id,
+		Pair:      s.Pair,
+		AssetType: s.AssetType,
+		Side:      s.Side,
+		Type:      s.Type,
+		Price:     s.Price,
+		Amount:    s.Amount,
+		Status:    order.New,
+	}
+	return &OrderSubmitResponse{
+		SubmitResponse: order.SubmitResponse{IsOrderPlaced: true, OrderID: id},
+	}, nil
+}
+
+func (f *emfOrderManager) Cancel(_ context.Context, c *order.Cancel) error {
+	det, ok := f.orders[c.ID]
+	if !ok {
+		return ErrOrderNotFound
+	}
+	det.Status = order.Cancelled
+	return nil
+}
+
+func (f *emfOrderManager) GetByExchangeAndID(_, id string) (*order.Detail, error) {
+	det, ok := f.orders[id]
+	if !ok {
+		return nil, ErrOrderNotFound
+	}
+	cpy := *det
+	return &cpy, nil
+}
+
+// fill marks a child order as fully executed at a price
+func (f *emfOrderManager) fill(id string, price float64) {
+	det := f.orders[id]
+	det.Status = order.Filled
+	det.ExecutedAmount = det.Amount
+	det.AverageExecutedPrice = price
+}
+
+func executionManagerSetup(t *testing.T, candles []kline.Candle) (*ExecutionManager, *emfOrderManager, exchange.IBotExchange) {
+	t.Helper()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(emfExchange{IBotExchange: exch, candles: candles})
+	om := &emfOrderManager{orders: make(map[string]*order.Detail)}
+	e, err := SetupExecutionManager(em, om, time.Hour, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	e.started = 1
+	return e, om, exch
+}
+
+func executionSubmission(algo ExecutionAlgorithm) *ExecutionSubmit {
+	return &ExecutionSubmit{
+		Submit: order.Submit{
+			Exchange:  testExchange,
+			Pair:      currency.NewPair(currency.BTC, currency.USD),
+			AssetType: asset.Spot,
+			Side:      order.Buy,
+			Amount:    3,
+		},
+		Algorithm: algo,
+		Duration:  time.Minute * 3,
+		Interval:  kline.OneMin,
+	}
+}
+
+// nextSlice makes the next TWAP or VWAP slice of every parent order due
+func nextSlice(e *ExecutionManager) {
+	e.m.Lock()
+	for _, o := range e.orders {
+		o.nextSlice = time.Time{}
+	}
+	e.m.Unlock()
+}
+
+func TestSetupExecutionManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupExecutionManager(nil, nil, 0, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupExecutionManager(SetupExchangeManager(), nil, 0, false)
+	if !errors.Is(err, errNilOrderManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilOrderManager)
+	}
+	e, err := SetupExecutionManager(SetupExchangeManager(), &emfOrderManager{}, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if e.sleep != DefaultExecutionManagerDelay {
+		t.Errorf("received '%v', expected '%v'", e.sleep, DefaultExecutionManagerDelay)
+	}
+}
+
+func TestExecutionManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var e *ExecutionManager
+	err := e.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	err = e.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	if e.IsRunning() {
+		t.Error("expected nil manager to not be running")
+	}
+
+	e, err = SetupExecutionManager(SetupExchangeManager(), &emfOrderManager{}, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = e.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = e.Start()
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = e.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !e.IsRunning() {
+		t.Error("expected manager to be running")
+	}
+	err = e.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestExecutionManagerSubmit(t *testing.T) {
+	t.Parallel()
+	e, _, _ := executionManagerSetup(t, nil)
+	_, err := e.Submit(context.Background(), nil)
+	if !errors.Is(err, errNilExecutionSubmit) {
+		t.Errorf("error '%v', expected '%v'", err, errNilExecutionSubmit)
+	}
+
+	s := executionSubmission("fake")
+	_, err = e.Submit(context.Background(), s)
+	if !errors.Is(err, errExecutionAlgorithmUnsupported) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionAlgorithmUnsupported)
+	}
+
+	s = executionSubmission(TWAP)
+	s.Duration = 0
+	_, err = e.Submit(context.Background(), s)
+	if !errors.Is(err, errExecutionDurationUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionDurationUnset)
+	}
+
+	s = executionSubmission(TWAP)
+	s.Interval = kline.OneHour
+	_, err = e.Submit(context.Background(), s)
+	if !errors.Is(err, errExecutionIntervalInvalid) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionIntervalInvalid)
+	}
+
+	s = executionSubmission(Iceberg)
+	_, err = e.Submit(context.Background(), s)
+	if !errors.Is(err, errExecutionClipSizeUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionClipSizeUnset)
+	}
+
+	s.ClipSize = 1
+	_, err = e.Submit(context.Background(), s)
+	if !errors.Is(err, errExecutionPriceUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionPriceUnset)
+	}
+
+	s = executionSubmission(VWAP)
+	_, err = e.Submit(context.Background(), s)
+	if !errors.Is(err, errExecutionNoVolume) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionNoVolume)
+	}
+
+	s = executionSubmission(TWAP)
+	s.Amount = 0
+	_, err = e.Submit(context.Background(), s)
+	if !errors.Is(err, order.ErrAmountIsInvalid) {
+		t.Errorf("error '%v', expected '%v'", err, order.ErrAmountIsInvalid)
+	}
+
+	s = executionSubmission("twap")
+	o, err := e.Submit(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.Algorithm != TWAP {
+		t.Errorf("received '%v', expected '%v'", o.Algorithm, TWAP)
+	}
+	if o.Type != order.Market {
+		t.Errorf("received '%v', expected '%v'", o.Type, order.Market)
+	}
+	if o.Slices != 3 {
+		t.Errorf("received '%v', expected '%v'", o.Slices, 3)
+	}
+	if o.ArrivalPrice != 101 {
+		t.Errorf("received '%v', expected '%v'", o.ArrivalPrice, 101)
+	}
+
+	e.started = 0
+	_, err = e.Submit(context.Background(), s)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestExecutionManagerTWAP(t *testing.T) {
+	t.Parallel()
+	e, om, _ := executionManagerSetup(t, nil)
+	o, err := e.Submit(context.Background(), executionSubmission(TWAP))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	e.process(context.Background())
+	// the next slice is not due yet
+	e.process(context.Background())
+	if len(om.orders) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(om.orders), 1)
+	}
+	om.fill("1", 102)
+	nextSlice(e)
+	e.process(context.Background())
+	om.fill("2", 104)
+	nextSlice(e)
+	e.process(context.Background())
+	if len(om.orders) != 3 {
+		t.Fatalf("received '%v', expected '%v'", len(om.orders), 3)
+	}
+	for _, det := range om.orders {
+		if det.Amount != 1 {
+			t.Errorf("received '%v', expected '%v'", det.Amount, 1)
+		}
+	}
+
+	resp, err := e.GetExecutionOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != ExecutionActive {
+		t.Errorf("received '%v', expected '%v'", resp.Status, ExecutionActive)
+	}
+	if resp.Filled != 2 {
+		t.Errorf("received '%v', expected '%v'", resp.Filled, 2)
+	}
+
+	// market orders reported as filled without execution details use the
+	// ticker price at submission
+	om.orders["3"].Status = order.Filled
+	e.process(context.Background())
+	resp, err = e.GetExecutionOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != ExecutionCompleted {
+		t.Errorf("received '%v', expected '%v'", resp.Status, ExecutionCompleted)
+	}
+	if resp.Filled != 3 {
+		t.Errorf("received '%v', expected '%v'", resp.Filled, 3)
+	}
+	if resp.AveragePrice != 102.33333333333333 {
+		t.Errorf("received '%v', expected '%v'", resp.AveragePrice, 102.33333333333333)
+	}
+	expectedSlippage := (resp.AveragePrice - 101) / 101 * 10000
+	if resp.SlippageBPS != expectedSlippage {
+		t.Errorf("received '%v', expected '%v'", resp.SlippageBPS, expectedSlippage)
+	}
+	if len(resp.ChildOrderIDs) != 3 {
+		t.Errorf("received '%v', expected '%v'", len(resp.ChildOrderIDs), 3)
+	}
+}
+
+func TestExecutionManagerVWAP(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	e, om, _ := executionManagerSetup(t, []kline.Candle{
+		{Time: now.Add(-time.Minute), Volume: 30},
+		{Time: now.Add(-time.Minute * 2), Volume: 10},
+	})
+	s := executionSubmission(VWAP)
+	s.Amount = 5
+	_, err := e.Submit(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	// the missing first candle uses the average volume of 20
+	expected := []float64{5 * 20.0 / 60, 5 * 10.0 / 60, 5 * 30.0 / 60}
+	for i := range expected {
+		nextSlice(e)
+		e.process(context.Background())
+		det, ok := om.orders[strconv.Itoa(i+1)]
+		if !ok {
+			t.Fatalf("expected slice %v to be submitted", i+1)
+		}
+		if math.Abs(det.Amount-expected[i]) > 1e-9 {
+			t.Errorf("received '%v', expected '%v'", det.Amount, expected[i])
+		}
+		om.fill(det.ID, 100)
+	}
+}
+
+func TestExecutionManagerIceberg(t *testing.T) {
+	t.Parallel()
+	e, om, _ := executionManagerSetup(t, nil)
+	s := executionSubmission(Iceberg)
+	s.Amount = 2.5
+	s.ClipSize = 1
+	s.Price = 100
+	o, err := e.Submit(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.Type != order.Limit {
+		t.Errorf("received '%v', expected '%v'", o.Type, order.Limit)
+	}
+	if o.Slices != 3 {
+		t.Errorf("received '%v', expected '%v'", o.Slices, 3)
+	}
+
+	e.process(context.Background())
+	e.process(context.Background())
+	if len(om.orders) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(om.orders), 1)
+	}
+	om.fill("1", 100)
+	e.process(context.Background())
+	// an unfilled clip cancelled on the exchange is resubmitted
+	om.orders["2"].Status = order.Cancelled
+	e.process(context.Background())
+	om.fill("3", 100)
+	e.process(context.Background())
+	if om.orders["4"].Amount != 0.5 {
+		t.Errorf("received '%v', expected '%v'", om.orders["4"].Amount, 0.5)
+	}
+	om.fill("4", 100)
+	e.process(context.Background())
+
+	resp, err := e.GetExecutionOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != ExecutionCompleted {
+		t.Errorf("received '%v', expected '%v'", resp.Status, ExecutionCompleted)
+	}
+	if resp.Filled != 2.5 {
+		t.Errorf("received '%v', expected '%v'", resp.Filled, 2.5)
+	}
+	if resp.SlicesSubmitted != 4 {
+		t.Errorf("received '%v', expected '%v'", resp.SlicesSubmitted, 4)
+	}
+}
+
+func TestExecutionManagerLimits(t *testing.T) {
+	t.Parallel()
+	e, om, exch := executionManagerSetup(t, nil)
+	err := exch.GetBase().LoadLimits([]order.MinMaxLevel{
+		{
+			Pair:       currency.NewPair(currency.BTC, currency.USD),
+			Asset:      asset.Spot,
+			MinAmount:  0.1,
+			StepAmount: 0.1,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := executionSubmission(TWAP)
+	s.Amount = 0.25
+	s.Duration = time.Minute * 4
+	o, err := e.Submit(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	for i := 0; i < 4; i++ {
+		nextSlice(e)
+		e.process(context.Background())
+		for id := range om.orders {
+			om.fill(id, 100)
+		}
+	}
+	// slices of 0.0625 are below the minimum so are carried forward until
+	// the schedule reaches 0.1 and 0.2, the final 0.05 remainder is left
+	if len(om.orders) != 2 {
+		t.Fatalf("received '%v', expected '%v'", len(om.orders), 2)
+	}
+	e.process(context.Background())
+	resp, err := e.GetExecutionOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != ExecutionCompleted {
+		t.Errorf("received '%v', expected '%v'", resp.Status, ExecutionCompleted)
+	}
+	if resp.FailureReason == "" {
+		t.Error("expected reason for unexecuted remainder")
+	}
+}
+
+func TestExecutionManagerSubmitFailure(t *testing.T) {
+	t.Parallel()
+	e, om, _ := executionManagerSetup(t, nil)
+	om.submitErr = errors.New("rejected")
+	o, err := e.Submit(context.Background(), executionSubmission(TWAP))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	e.process(context.Background())
+	resp, err := e.GetExecutionOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != ExecutionFailed {
+		t.Errorf("received '%v', expected '%v'", resp.Status, ExecutionFailed)
+	}
+	if resp.FailureReason != "rejected" {
+		t.Errorf("received '%v', expected '%v'", resp.FailureReason, "rejected")
+	}
+}
+
+func TestExecutionManagerPauseResumeCancel(t *testing.T) {
+	t.Parallel()
+	e, om, _ := executionManagerSetup(t, nil)
+	_, err := e.Pause("fake")
+	if !errors.Is(err, ErrExecutionOrderNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, ErrExecutionOrderNotFound)
+	}
+	s := executionSubmission(TWAP)
+	s.Price = 100
+	o, err := e.Submit(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	_, err = e.Resume(o.ID)
+	if !errors.Is(err, errExecutionStatusInvalid) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionStatusInvalid)
+	}
+	e.process(context.Background())
+
+	resp, err := e.Pause(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != ExecutionPaused {
+		t.Errorf("received '%v', expected '%v'", resp.Status, ExecutionPaused)
+	}
+	nextSlice(e)
+	e.process(context.Background())
+	if len(om.orders) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(om.orders), 1)
+	}
+
+	resp, err = e.Resume(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != ExecutionActive {
+		t.Errorf("received '%v', expected '%v'", resp.Status, ExecutionActive)
+	}
+	e.process(context.Background())
+	if len(om.orders) != 2 {
+		t.Fatalf("received '%v', expected '%v'", len(om.orders), 2)
+	}
+	om.fill("1", 100)
+
+	resp, err = e.Cancel(context.Background(), o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != ExecutionCancelled {
+		t.Errorf("received '%v', expected '%v'", resp.Status, ExecutionCancelled)
+	}
+	if om.orders["2"].Status != order.Cancelled {
+		t.Errorf("received '%v', expected '%v'", om.orders["2"].Status, order.Cancelled)
+	}
+	if resp.Filled != 1 {
+		t.Errorf("received '%v', expected '%v'", resp.Filled, 1)
+	}
+	_, err = e.Cancel(context.Background(), o.ID)
+	if !errors.Is(err, errExecutionStatusInvalid) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionStatusInvalid)
+	}
+
+	orders, err := e.GetExecutionOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(orders) != 1 {
+		t.Errorf("received '%v', expected '%v'", len(orders), 1)
+	}
+}
+
+func TestExecutionManagerCancelDuringSubmit(t *testing.T) {
+	t.Parallel()
+	e, om, _ := executionManagerSetup(t, nil)
+	o, err := e.Submit(context.Background(), executionSubmission(TWAP))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	// the parent order is managed while its child order is in flight, which
+	// would deadlock if the lock was held across the submission
+	om.onSubmit = func() {
+		om.onSubmit = nil
+		_, err = e.GetExecutionOrder(o.ID)
+		if !errors.Is(err, nil) {
+			t.Errorf("error '%v', expected '%v'", err, nil)
+		}
+		_, err = e.Cancel(context.Background(), o.ID)
+		if !errors.Is(err, nil) {
+			t.Errorf("error '%v', expected '%v'", err, nil)
+		}
+	}
+	e.process(context.Background())
+	if len(om.orders) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(om.orders), 1)
+	}
+	// the child order submitted after the parent was cancelled is cancelled
+	if om.orders["1"].Status != order.Cancelled {
+		t.Errorf("received '%v', expected '%v'", om.orders["1"].Status, order.Cancelled)
+	}
+	e.process(context.Background())
+	resp, err := e.GetExecutionOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != ExecutionCancelled {
+		t.Errorf("received '%v', expected '%v'", resp.Status, ExecutionCancelled)
+	}
+	if len(resp.ChildOrderIDs) != 1 {
+		t.Errorf("received '%v', expected '%v'", len(resp.ChildOrderIDs), 1)
+	}
+}
+
+func TestVolumeWeights(t *testing.T) {
+	t.Parallel()
+	_, err := volumeWeights(nil, 2)
+	if !errors.Is(err, errExecutionNoVolume) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionNoVolume)
+	}
+	now := time.Now()
+	_, err = volumeWeights([]kline.Candle{{Time: now}}, 2)
+	if !errors.Is(err, errExecutionNoVolume) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionNoVolume)
+	}
+	weights, err := volumeWeights([]kline.Candle{
+		{Time: now, Volume: 3},
+		{Time: now.Add(-time.Minute), Volume: 2},
+		{Time: now.Add(-time.Minute * 2), Volume: 1},
+	}, 2)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(weights) != 2 || weights[0] != 2 || weights[1] != 3 {
+		t.Errorf("received '%v', expected '%v'", weights, []float64{2, 3})
+	}
+}
+
+func TestCumulativeSchedule(t *testing.T) {
+	t.Parallel()
+	schedule := cumulativeSchedule(1, []float64{1, 1, 1})
+	if len(schedule) != 3 {
+		t.Fatalf("received '%v', expected '%v'", len(schedule), 3)
+	}
+	if schedule[0] != 1.0/3 {
+		t.Errorf("received '%v', expected '%v'", schedule[0], 1.0/3)
+	}
+	if schedule[2] != 1 {
+		t.Errorf("received '%v', expected '%v'", schedule[2], 1)
+	}
+}
@@ -0,0 +1,163 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	// ExecutionManagerName defines the manager name string
+	ExecutionManagerName = "execution_manager"
+	// DefaultExecutionManagerDelay defines the default duration between
+	// checks of parent order schedules and child order fills
+	DefaultExecutionManagerDelay = time.Second
+	// executionDustRatio is the fraction of a parent order amount below which
+	// a remainder is not worth submitting
+	executionDustRatio = 1e-9
+)
+
+// ExecutionAlgorithm defines how a parent order is sliced into child orders
+type ExecutionAlgorithm string
+
+// Supported execution algorithms
+const (
+	// TWAP splits the parent order into equal slices over a duration
+	TWAP ExecutionAlgorithm = "TWAP"
+	// VWAP weights each slice by the volume traded over the previous
+	// duration
+	VWAP ExecutionAlgorithm = "VWAP"
+	// Iceberg only shows a clip of the parent order on the book at a time,
+	// submitting the next clip once the previous one has filled
+	Iceberg ExecutionAlgorithm = "ICEBERG"
+)
+
+// ExecutionStatus defines the state of a parent order
+type ExecutionStatus string
+
+// Parent order statuses
+const (
+	ExecutionActive    ExecutionStatus = "ACTIVE"
+	ExecutionPaused    ExecutionStatus = "PAUSED"
+	ExecutionCompleted ExecutionStatus = "COMPLETED"
+	ExecutionCancelled ExecutionStatus = "CANCELLED"
+	ExecutionFailed    ExecutionStatus = "FAILED"
+)
+
+var (
+	// ErrExecutionOrderNotFound occurs when a parent order ID is unknown
+	ErrExecutionOrderNotFound        = errors.New("execution order not found")
+	errNilExecutionSubmit            = errors.New("nil execution submission received")
+	errExecutionAlgorithmUnsupported = errors.New("unsupported execution algorithm")
+	errExecutionDurationUnset        = errors.New("execution duration and interval must be set")
+	errExecutionIntervalInvalid      = errors.New("execution interval must not exceed the duration")
+	errExecutionClipSizeUnset        = errors.New("iceberg clip size must be set")
+	errExecutionPriceUnset           = errors.New("iceberg orders require a limit price")
+	errExecutionStatusInvalid        = errors.New("execution order status cannot be changed")
+	errExecutionNoVolume             = errors.New("no candle data available to build volume profile")
+)
+
+// iExecutionOrderManager defines a limited scoped order manager which
+// submits and tracks child orders
+type iExecutionOrderManager interface {
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+	Cancel(context.Context, *order.Cancel) error
+	GetByExchangeAndID(string, string) (*order.Detail, error)
+}
+
+// ExecutionManager slices parent orders into child orders using execution
+// algorithms and tracks their progress via the order manager
+type ExecutionManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	orderManager    iExecutionOrderManager
+	sleep           time.Duration
+	verbose         bool
+	m               sync.Mutex
+	orders          map[string]*executionOrder
+}
+
+// ExecutionSubmit defines a parent order to be executed by an algorithm.
+// The embedded Submit Price sets the limit price of each child order,
+// child orders are market orders when it is zero
+type ExecutionSubmit struct {
+	order.Submit
+	Algorithm ExecutionAlgorithm
+	// Duration is the period TWAP and VWAP orders are spread across
+	Duration time.Duration
+	// Interval is the time between TWAP and VWAP slices and the candle
+	// interval used to build the VWAP volume profile
+	Interval kline.Interval
+	// ClipSize is the visible amount of each iceberg child order
+	ClipSize float64
+}
+
+// ExecutionOrder is a snapshot of a parent order's progress
+type ExecutionOrder struct {
+	ID              string
+	Exchange        string
+	Pair            currency.Pair
+	AssetType       asset.Item
+	Side            order.Side
+	Type            order.Type
+	Algorithm       ExecutionAlgorithm
+	Status          ExecutionStatus
+	Amount          float64
+	Price           float64
+	Duration        time.Duration
+	Interval        kline.Interval
+	ClipSize        float64
+	Slices          int
+	SlicesSubmitted int
+	Submitted       float64
+	Filled          float64
+	AveragePrice    float64
+	ArrivalPrice    float64
+	// SlippageBPS is the average fill price versus the arrival price in basis
+	// points, positive values are worse than the arrival price
+	SlippageBPS   float64
+	ChildOrderIDs []string
+	FailureReason string
+	StartTime     time.Time
+	LastUpdated   time.Time
+}
+
+// executionOrder holds a parent order and its schedule
+type executionOrder struct {
+	ExecutionOrder
+	clientOrderID string
+	// schedule holds the cumulative amount to have been submitted after each
+	// TWAP or VWAP slice
+	schedule  []float64
+	nextSlice time.Time
+	// exhausted is set when the remainder of the parent order can no longer
+	// be submitted
+	exhausted bool
+	children  []*executionChild
+}
+
+// executionSlice is a child order due to be submitted for a parent order
+type executionSlice struct {
+	parent *executionOrder
+	exch   exchange.IBotExchange
+	submit order.Submit
+}
+
+// executionChild tracks a child order's fill state
+type executionChild struct {
+	orderID        string
+	amount         float64
+	filled         float64
+	averagePrice   float64
+	referencePrice float64
+	inactive       bool
+}
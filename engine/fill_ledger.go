@@ -0,0 +1,521 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupFillLedger applies configuration parameters before running
+func SetupFillLedger(om iFillLedgerOrderManager, costBasis CostBasis, interval time.Duration, verbose bool) (*FillLedger, error) {
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	costBasis = CostBasis(strings.ToUpper(string(costBasis)))
+	switch costBasis {
+	case "":
+		costBasis = FIFO
+	case FIFO, AverageCost:
+	default:
+		return nil, fmt.Errorf("%w %q", errCostBasisUnsupported, costBasis)
+	}
+	if interval <= 0 {
+		interval = DefaultFillLedgerDelay
+	}
+	return &FillLedger{
+		shutdown:     make(chan struct{}),
+		orderManager: om,
+		costBasis:    costBasis,
+		sleep:        interval,
+		verbose:      verbose,
+		positions:    make(map[ledgerKey]*ledgerPosition),
+		orders:       make(map[string]*ledgerOrder),
+		closed:       make(map[string]struct{}),
+	}, nil
+}
+
+// Start runs the subsystem
+func (f *FillLedger) Start() error {
+	if f == nil {
+		return fmt.Errorf("%s %w", FillLedgerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&f.started, 0, 1) {
+		return fmt.Errorf("%s %w", FillLedgerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Fill ledger %s", MsgSubSystemStarting)
+	f.wg.Add(1)
+	go f.run()
+	log.Debugf(log.OrderMgr, "Fill ledger %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem, recorded positions are retained
+func (f *FillLedger) Stop() error {
+	if f == nil {
+		return fmt.Errorf("%s %w", FillLedgerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&f.started) == 0 {
+		return fmt.Errorf("%s %w", FillLedgerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Fill ledger %s", MsgSubSystemShuttingDown)
+	close(f.shutdown)
+	f.wg.Wait()
+	f.shutdown = make(chan struct{})
+	atomic.StoreInt32(&f.started, 0)
+	log.Debugf(log.OrderMgr, "Fill ledger %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (f *FillLedger) IsRunning() bool {
+	if f == nil {
+		return false
+	}
+	return atomic.LoadInt32(&f.started) == 1
+}
+
+func (f *FillLedger) run() {
+	defer f.wg.Done()
+	f.process()
+	timer := time.NewTimer(f.sleep)
+	for {
+		select {
+		case <-f.shutdown:
+			timer.Stop()
+			return
+		case <-timer.C:
+			f.process()
+			timer.Reset(f.sleep)
+		}
+	}
+}
+
+// process records any fills from the order manager which have not yet been
+// applied to the ledger
+func (f *FillLedger) process() {
+	orders, err := f.orderManager.GetOrdersFiltered(&order.Filter{})
+	if err != nil {
+		log.Errorf(log.OrderMgr, "%s cannot get orders: %v", FillLedgerName, err)
+		return
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	current := make(map[string]struct{}, len(orders))
+	for i := range orders {
+		current[orders[i].InternalOrderID] = struct{}{}
+		f.processOrder(&orders[i])
+	}
+	for id := range f.closed {
+		if _, ok := current[id]; !ok {
+			delete(f.closed, id)
+		}
+	}
+}
+
+// processOrder records new fills for an order. Individual trades are used
+// when the exchange supplies them, otherwise a fill is derived from the
+// change in executed amount since the order was last processed. Tracking is
+// dropped once the order reaches a terminal status
+func (f *FillLedger) processOrder(o *order.Detail) {
+	if o.InternalOrderID == "" || o.Pair.IsEmpty() {
+		return
+	}
+	if _, ok := f.closed[o.InternalOrderID]; ok {
+		return
+	}
+	tracked, ok := f.orders[o.InternalOrderID]
+	if !ok {
+		if o.ExecutedAmount <= 0 && len(o.Trades) == 0 {
+			return
+		}
+		tracked = &ledgerOrder{trades: make(map[string]struct{})}
+		f.orders[o.InternalOrderID] = tracked
+	}
+
+	if len(o.Trades) > 0 {
+		f.processTrades(o, tracked)
+	} else {
+		f.processExecuted(o, tracked)
+	}
+
+	if o.IsInactive() {
+		delete(f.orders, o.InternalOrderID)
+		f.closed[o.InternalOrderID] = struct{}{}
+	}
+}
+
+// processTrades records the trades of an order which have not yet been
+// recorded. Trades covered by fills already derived from the executed amount
+// of the order are only recorded for the remainder. The caller must hold the
+// lock
+func (f *FillLedger) processTrades(o *order.Detail, tracked *ledgerOrder) {
+	for x := range o.Trades {
+		id := o.Trades[x].TID
+		if id == "" {
+			id = strconv.Itoa(x)
+		}
+		if _, ok := tracked.trades[id]; ok {
+			continue
+		}
+		tracked.trades[id] = struct{}{}
+		amount, fee := o.Trades[x].Amount, o.Trades[x].Fee
+		if tracked.derived > 0 {
+			covered := math.Min(tracked.derived, amount)
+			tracked.derived -= covered
+			amount -= covered
+			coveredFee := math.Min(tracked.derivedFee, fee)
+			tracked.derivedFee -= coveredFee
+			fee -= coveredFee
+		}
+		if amount <= ledgerDustAmount {
+			continue
+		}
+		side := o.Trades[x].Side
+		if side == "" || side == order.AnySide || side == order.UnknownSide {
+			side = o.Side
+		}
+		feeAsset := o.FeeAsset
+		if o.Trades[x].FeeAsset != "" {
+			feeAsset = currency.NewCode(o.Trades[x].FeeAsset)
+		}
+		tracked.executed += amount
+		tracked.cost += amount * o.Trades[x].Price
+		tracked.fee += fee
+		f.record(o, &LedgerFill{
+			OrderID:   o.ID,
+			TradeID:   o.Trades[x].TID,
+			Side:      side,
+			Price:     o.Trades[x].Price,
+			Amount:    amount,
+			Fee:       fee,
+			FeeAsset:  feeAsset,
+			Timestamp: o.Trades[x].Timestamp,
+		})
+	}
+}
+
+// processExecuted records a fill from the change in executed amount of an
+// order. The caller must hold the lock
+func (f *FillLedger) processExecuted(o *order.Detail, tracked *ledgerOrder) {
+	amount := o.ExecutedAmount - tracked.executed
+	if amount <= ledgerDustAmount {
+		return
+	}
+	price := o.Price
+	cost := tracked.cost + amount*price
+	if o.AverageExecutedPrice > 0 {
+		cost = o.ExecutedAmount * o.AverageExecutedPrice
+		price = (cost - tracked.cost) / amount
+	}
+	fee := o.Fee - tracked.fee
+	if fee < 0 {
+		fee = 0
+	}
+	tracked.executed = o.ExecutedAmount
+	tracked.cost = cost
+	tracked.fee = o.Fee
+	if price <= 0 {
+		log.Warnf(log.OrderMgr,
+			"%s cannot determine fill price of %s order %s, fill of %v not recorded",
+			FillLedgerName,
+			o.Exchange,
+			o.ID,
+			amount)
+		return
+	}
+	tracked.derived += amount
+	tracked.derivedFee += fee
+	f.record(o, &LedgerFill{
+		OrderID:   o.ID,
+		Side:      o.Side,
+		Price:     price,
+		Amount:    amount,
+		Fee:       fee,
+		FeeAsset:  o.FeeAsset,
+		Timestamp: o.LastUpdated,
+	})
+}
+
+// record applies a fill to its position. The caller must hold the lock
+func (f *FillLedger) record(o *order.Detail, fill *LedgerFill) {
+	var direction float64
+	switch fill.Side {
+	case order.Buy, order.Bid:
+		direction = 1
+	case order.Sell, order.Ask:
+		direction = -1
+	default:
+		log.Warnf(log.OrderMgr,
+			"%s cannot record %s order %s fill with side %q",
+			FillLedgerName,
+			o.Exchange,
+			o.ID,
+			fill.Side)
+		return
+	}
+	if fill.Timestamp.IsZero() {
+		fill.Timestamp = time.Now()
+	}
+	key := ledgerKey{
+		Exchange: strings.ToLower(o.Exchange),
+		Asset:    o.AssetType,
+		Base:     o.Pair.Base.Item,
+		Quote:    o.Pair.Quote.Item,
+	}
+	pos, ok := f.positions[key]
+	if !ok {
+		pos = &ledgerPosition{
+			exchange: o.Exchange,
+			asset:    o.AssetType,
+			pair:     o.Pair,
+		}
+		f.positions[key] = pos
+	}
+	pos.fills = append(pos.fills, *fill)
+	pos.updated = fill.Timestamp
+
+	fee, err := ledgerFeeInQuote(pos, fill)
+	if err != nil {
+		log.Warnf(log.OrderMgr,
+			"%s %s order %s fee not deducted from realised pnl: %v",
+			FillLedgerName,
+			o.Exchange,
+			o.ID,
+			err)
+	}
+	pos.fees += fee
+	pos.realised -= fee
+	pos.apply(fill.Amount*direction, fill.Price, f.costBasis)
+	if fill.FeeAsset.Match(pos.pair.Base) {
+		// Fees charged in the base currency are paid out of the position
+		pos.apply(-fill.Fee, fill.Price, f.costBasis)
+	}
+	if f.verbose {
+		log.Debugf(log.OrderMgr,
+			"%s %s %s %s recorded %s fill of %v at %v, realised pnl %v",
+			FillLedgerName,
+			pos.exchange,
+			pos.asset,
+			pos.pair,
+			fill.Side,
+			fill.Amount,
+			fill.Price,
+			pos.realised)
+	}
+}
+
+// apply matches a signed fill amount against open lots in the opposite
+// direction, realising PnL on the closed amount, and opens a lot with any
+// remainder
+func (p *ledgerPosition) apply(amount, price float64, costBasis CostBasis) {
+	for amount != 0 && len(p.lots) > 0 && (p.lots[0].amount > 0) != (amount > 0) {
+		lot := &p.lots[0]
+		direction := 1.0
+		if lot.amount < 0 {
+			direction = -1
+		}
+		closed := math.Min(math.Abs(amount), math.Abs(lot.amount))
+		p.realised += closed * (price - lot.price) * direction
+		lot.amount -= closed * direction
+		amount += closed * direction
+		if math.Abs(lot.amount) <= ledgerDustAmount {
+			p.lots = p.lots[1:]
+		}
+		if math.Abs(amount) <= ledgerDustAmount {
+			amount = 0
+		}
+	}
+	if amount == 0 {
+		return
+	}
+	if costBasis == AverageCost && len(p.lots) > 0 {
+		lot := &p.lots[0]
+		total := lot.amount + amount
+		lot.price = (lot.amount*lot.price + amount*price) / total
+		lot.amount = total
+		return
+	}
+	p.lots = append(p.lots, ledgerLot{amount: amount, price: price})
+}
+
+// snapshot returns the position marked to the latest ticker price. Long
+// positions are marked at the bid and short positions at the ask
+func (p *ledgerPosition) snapshot() LedgerPosition {
+	var amount, cost float64
+	for i := range p.lots {
+		amount += p.lots[i].amount
+		cost += p.lots[i].amount * p.lots[i].price
+	}
+	s := LedgerPosition{
+		Exchange:    p.exchange,
+		Asset:       p.asset,
+		Pair:        p.pair,
+		Amount:      amount,
+		RealisedPNL: p.realised,
+		Fees:        p.fees,
+		Fills:       len(p.fills),
+		LastUpdate:  p.updated,
+	}
+	if amount == 0 {
+		return s
+	}
+	s.AverageEntryPrice = cost / amount
+	closingSide := order.Sell
+	if amount < 0 {
+		closingSide = order.Buy
+	}
+	t, err := ticker.GetTicker(p.exchange, p.pair, p.asset)
+	if err == nil {
+		s.MarkPrice = tickerSidePrice(closingSide, t)
+	}
+	if s.MarkPrice > 0 {
+		s.UnrealisedPNL = amount*s.MarkPrice - cost
+	}
+	return s
+}
+
+// GetPositions returns positions matching the exchange, asset and pair.
+// Empty values match all positions
+func (f *FillLedger) GetPositions(exchangeName string, a asset.Item, pair currency.Pair) ([]LedgerPosition, error) {
+	if f == nil {
+		return nil, fmt.Errorf("%s %w", FillLedgerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&f.started) == 0 {
+		return nil, fmt.Errorf("%s %w", FillLedgerName, ErrSubSystemNotStarted)
+	}
+	f.m.Lock()
+	resp := make([]LedgerPosition, 0, len(f.positions))
+	for _, p := range f.positions {
+		if !p.matches(exchangeName, a, pair) {
+			continue
+		}
+		resp = append(resp, p.snapshot())
+	}
+	f.m.Unlock()
+	sortLedgerPositions(resp)
+	return resp, nil
+}
+
+// GetFills returns the fills recorded for a position
+func (f *FillLedger) GetFills(exchangeName string, a asset.Item, pair currency.Pair) ([]LedgerFill, error) {
+	if f == nil {
+		return nil, fmt.Errorf("%s %w", FillLedgerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&f.started) == 0 {
+		return nil, fmt.Errorf("%s %w", FillLedgerName, ErrSubSystemNotStarted)
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	p, ok := f.positions[ledgerKey{
+		Exchange: strings.ToLower(exchangeName),
+		Asset:    a,
+		Base:     pair.Base.Item,
+		Quote:    pair.Quote.Item,
+	}]
+	if !ok {
+		return nil, nil
+	}
+	resp := make([]LedgerFill, len(p.fills))
+	copy(resp, p.fills)
+	return resp, nil
+}
+
+// GetPnL returns the realised and unrealised PnL of positions matching the
+// exchange, asset and pair converted to the reporting currency. When no
+// reporting currency is supplied all positions must share a quote currency
+func (f *FillLedger) GetPnL(exchangeName string, a asset.Item, pair currency.Pair, reportingCurrency currency.Code) (*LedgerPNL, error) {
+	positions, err := f.GetPositions(exchangeName, a, pair)
+	if err != nil {
+		return nil, err
+	}
+	if reportingCurrency.IsEmpty() {
+		for i := range positions {
+			switch {
+			case reportingCurrency.IsEmpty():
+				reportingCurrency = positions[i].Pair.Quote
+			case !reportingCurrency.Match(positions[i].Pair.Quote):
+				return nil, errReportingCurrencyRequired
+			}
+		}
+	}
+	resp := &LedgerPNL{
+		Currency:  reportingCurrency,
+		Positions: make([]LedgerPositionPNL, len(positions)),
+	}
+	for i := range positions {
+		realised, err := ledgerConvert(positions[i].Exchange, positions[i].Asset, positions[i].RealisedPNL, positions[i].Pair.Quote, reportingCurrency)
+		if err != nil {
+			return nil, err
+		}
+		unrealised, err := ledgerConvert(positions[i].Exchange, positions[i].Asset, positions[i].UnrealisedPNL, positions[i].Pair.Quote, reportingCurrency)
+		if err != nil {
+			return nil, err
+		}
+		resp.Positions[i] = LedgerPositionPNL{
+			Exchange:      positions[i].Exchange,
+			Asset:         positions[i].Asset,
+			Pair:          positions[i].Pair,
+			RealisedPNL:   realised,
+			UnrealisedPNL: unrealised,
+		}
+		resp.RealisedPNL += realised
+		resp.UnrealisedPNL += unrealised
+	}
+	return resp, nil
+}
+
+// matches returns whether the position matches the exchange, asset and pair,
+// empty values match all positions
+func (p *ledgerPosition) matches(exchangeName string, a asset.Item, pair currency.Pair) bool {
+	return (exchangeName == "" || strings.EqualFold(p.exchange, exchangeName)) &&
+		(a == "" || p.asset == a) &&
+		(pair.IsEmpty() || p.pair.Equal(pair))
+}
+
+func sortLedgerPositions(p []LedgerPosition) {
+	sort.Slice(p, func(i, j int) bool {
+		if p[i].Exchange != p[j].Exchange {
+			return p[i].Exchange < p[j].Exchange
+		}
+		if p[i].Asset != p[j].Asset {
+			return p[i].Asset < p[j].Asset
+		}
+		return p[i].Pair.String() < p[j].Pair.String()
+	})
+}
+
+// ledgerFeeInQuote returns a fill fee in the quote currency of the position.
+// Fees without an asset are assumed to be charged in the quote currency and
+// fees charged in the base currency are valued at the fill price
+func ledgerFeeInQuote(p *ledgerPosition, fill *LedgerFill) (float64, error) {
+	if fill.Fee == 0 {
+		return 0, nil
+	}
+	if fill.FeeAsset.Match(p.pair.Base) {
+		return fill.Fee * fill.Price, nil
+	}
+	if fill.FeeAsset.IsEmpty() {
+		return fill.Fee, nil
+	}
+	return ledgerConvert(p.exchange, p.asset, fill.Fee, fill.FeeAsset, p.pair.Quote)
+}
+
+// ledgerConvert converts a value between currencies using the latest ticker
+// for the pair on the exchange, falling back to forex rates
+func ledgerConvert(exchangeName string, a asset.Item, value float64, from, to currency.Code) (float64, error) {
+	converted, err := convertCurrencyValue(exchangeName, a, value, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("%w %s to %s: %v", errLedgerConversion, from, to, err)
+	}
+	return converted, nil
+}
@@ -0,0 +1,393 @@
+package engine
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+const fillLedgerTestExchange = "fillledgertest"
+
+// flOrderManager supplies a fixed set of orders to the fill ledger
+type flOrderManager struct {
+	m      sync.Mutex
+	orders []order.Detail
+}
+
+func (f *flOrderManager) GetOrdersFiltered(*order.Filter) ([]order.Detail, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	resp := make([]order.Detail, len(f.orders))
+	for i := range f.orders {
+		resp[i] = f.orders[i].Copy()
+	}
+	return resp, nil
+}
+
+func (f *flOrderManager) add(o ...order.Detail) {
+	f.m.Lock()
+	f.orders = append(f.orders, o...)
+	f.m.Unlock()
+}
+
+func fillLedgerSetup(t *testing.T, costBasis CostBasis) (*FillLedger, *flOrderManager) {
+	t.Helper()
+	om := &flOrderManager{}
+	f, err := SetupFillLedger(om, costBasis, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	f.started = 1
+	return f, om
+}
+
+func ledgerFill(id string, p currency.Pair, side order.Side, amount, price float64) order.Detail {
+	return order.Detail{
+		Exchange:             fillLedgerTestExchange,
+		InternalOrderID:      id,
+		ID:                   id,
+		Pair:                 p,
+		AssetType:            asset.Spot,
+		Side:                 side,
+		Amount:               amount,
+		ExecutedAmount:       amount,
+		AverageExecutedPrice: price,
+		Status:               order.Filled,
+	}
+}
+
+func ledgerPositionFor(t *testing.T, f *FillLedger, p currency.Pair) LedgerPosition {
+	t.Helper()
+	positions, err := f.GetPositions(fillLedgerTestExchange, asset.Spot, p)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(positions) != 1 {
+		t.Fatalf("received '%v' positions, expected '%v'", len(positions), 1)
+	}
+	return positions[0]
+}
+
+func floatEquals(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSetupFillLedger(t *testing.T) {
+	t.Parallel()
+	_, err := SetupFillLedger(nil, FIFO, 0, false)
+	if !errors.Is(err, errNilOrderManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilOrderManager)
+	}
+	_, err = SetupFillLedger(&flOrderManager{}, "LIFO", 0, false)
+	if !errors.Is(err, errCostBasisUnsupported) {
+		t.Errorf("error '%v', expected '%v'", err, errCostBasisUnsupported)
+	}
+	f, err := SetupFillLedger(&flOrderManager{}, "", 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if f.costBasis != FIFO {
+		t.Errorf("received '%v', expected '%v'", f.costBasis, FIFO)
+	}
+	if f.sleep != DefaultFillLedgerDelay {
+		t.Errorf("received '%v', expected '%v'", f.sleep, DefaultFillLedgerDelay)
+	}
+	f, err = SetupFillLedger(&flOrderManager{}, "average", 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if f.costBasis != AverageCost {
+		t.Errorf("received '%v', expected '%v'", f.costBasis, AverageCost)
+	}
+}
+
+func TestFillLedgerStartStop(t *testing.T) {
+	t.Parallel()
+	var f *FillLedger
+	if f.IsRunning() {
+		t.Error("expected nil fill ledger to not be running")
+	}
+	err := f.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	err = f.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	_, err = f.GetPositions("", "", currency.Pair{})
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+
+	f, err = SetupFillLedger(&flOrderManager{}, FIFO, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	_, err = f.GetPositions("", "", currency.Pair{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = f.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = f.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !f.IsRunning() {
+		t.Error("expected fill ledger to be running")
+	}
+	err = f.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = f.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestFillLedgerCostBasis(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	for _, tc := range []struct {
+		costBasis  CostBasis
+		realised   float64
+		entryPrice float64
+	}{
+		{FIFO, 25, 110},
+		{AverageCost, 22.5, 105},
+	} {
+		f, om := fillLedgerSetup(t, tc.costBasis)
+		om.add(ledgerFill("1", p, order.Buy, 1, 100), ledgerFill("2", p, order.Buy, 1, 110))
+		f.process()
+		om.add(ledgerFill("3", p, order.Sell, 1.5, 120))
+		f.process()
+		// fills are only recorded once
+		f.process()
+		pos := ledgerPositionFor(t, f, p)
+		if !floatEquals(pos.RealisedPNL, tc.realised) {
+			t.Errorf("%v received '%v', expected '%v'", tc.costBasis, pos.RealisedPNL, tc.realised)
+		}
+		if !floatEquals(pos.Amount, 0.5) {
+			t.Errorf("%v received '%v', expected '%v'", tc.costBasis, pos.Amount, 0.5)
+		}
+		if !floatEquals(pos.AverageEntryPrice, tc.entryPrice) {
+			t.Errorf("%v received '%v', expected '%v'", tc.costBasis, pos.AverageEntryPrice, tc.entryPrice)
+		}
+		if pos.Fills != 3 {
+			t.Errorf("%v received '%v', expected '%v'", tc.costBasis, pos.Fills, 3)
+		}
+	}
+}
+
+func TestFillLedgerShortAndFees(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.ETH, currency.USDT)
+	f, om := fillLedgerSetup(t, FIFO)
+	short := ledgerFill("1", p, order.Sell, 1, 100)
+	short.Fee = 0.5
+	short.FeeAsset = currency.USDT
+	cover := ledgerFill("2", p, order.Buy, 2, 90)
+	cover.Fee = 0.01
+	cover.FeeAsset = currency.ETH
+	om.add(short, cover)
+	f.process()
+	pos := ledgerPositionFor(t, f, p)
+	// 10 realised on the short less 0.5 quote fee and 0.01 ETH valued at 90
+	if !floatEquals(pos.RealisedPNL, 8.6) {
+		t.Errorf("received '%v', expected '%v'", pos.RealisedPNL, 8.6)
+	}
+	if !floatEquals(pos.Fees, 1.4) {
+		t.Errorf("received '%v', expected '%v'", pos.Fees, 1.4)
+	}
+	// the ETH fee is paid out of the long
+	if !floatEquals(pos.Amount, 0.99) || !floatEquals(pos.AverageEntryPrice, 90) {
+		t.Errorf("received amount '%v' price '%v', expected amount '%v' price '%v'", pos.Amount, pos.AverageEntryPrice, 0.99, 90)
+	}
+}
+
+func TestFillLedgerPartialFills(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.LTC, currency.USDT)
+	f, om := fillLedgerSetup(t, FIFO)
+	o := ledgerFill("1", p, order.Buy, 1, 100)
+	o.ExecutedAmount = 0.5
+	o.Status = order.PartiallyFilled
+	om.add(o)
+	f.process()
+	om.m.Lock()
+	om.orders[0].ExecutedAmount = 1
+	om.orders[0].AverageExecutedPrice = 102
+	om.orders[0].Status = order.Filled
+	om.m.Unlock()
+	f.process()
+	fills, err := f.GetFills(fillLedgerTestExchange, asset.Spot, p)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(fills) != 2 {
+		t.Fatalf("received '%v' fills, expected '%v'", len(fills), 2)
+	}
+	if !floatEquals(fills[1].Amount, 0.5) || !floatEquals(fills[1].Price, 104) {
+		t.Errorf("received amount '%v' price '%v', expected amount '%v' price '%v'", fills[1].Amount, fills[1].Price, 0.5, 104)
+	}
+
+	// individual trades take precedence and are recorded once by trade ID
+	traded := ledgerFill("2", p, order.Sell, 1, 0)
+	traded.Trades = []order.TradeHistory{
+		{TID: "a", Price: 110, Amount: 0.25},
+		{TID: "b", Price: 112, Amount: 0.75},
+	}
+	om.add(traded)
+	f.process()
+	f.process()
+	pos := ledgerPositionFor(t, f, p)
+	if pos.Fills != 4 {
+		t.Errorf("received '%v', expected '%v'", pos.Fills, 4)
+	}
+	// lots of 0.5 at 100 and 0.5 at 104 closed at 110 and 112
+	if !floatEquals(pos.RealisedPNL, 0.25*10+0.25*12+0.5*8) {
+		t.Errorf("received '%v', expected '%v'", pos.RealisedPNL, 0.25*10+0.25*12+0.5*8)
+	}
+	if pos.Amount != 0 {
+		t.Errorf("received '%v', expected '%v'", pos.Amount, 0)
+	}
+}
+
+func TestFillLedgerTradesAfterExecutedAmount(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.XRP, currency.USDT)
+	f, om := fillLedgerSetup(t, FIFO)
+	o := ledgerFill("1", p, order.Buy, 2, 100)
+	o.ExecutedAmount = 1
+	o.Fee = 0.1
+	o.Status = order.PartiallyFilled
+	om.add(o)
+	f.process()
+
+	// trades supplied later are only recorded for the amount not already
+	// recorded from the executed amount
+	om.m.Lock()
+	om.orders[0].ExecutedAmount = 2
+	om.orders[0].Fee = 0.2
+	om.orders[0].Trades = []order.TradeHistory{
+		{TID: "a", Price: 100, Amount: 1, Fee: 0.1},
+		{TID: "b", Price: 110, Amount: 1, Fee: 0.1},
+	}
+	om.m.Unlock()
+	f.process()
+	pos := ledgerPositionFor(t, f, p)
+	if !floatEquals(pos.Amount, 2) {
+		t.Errorf("received '%v', expected '%v'", pos.Amount, 2)
+	}
+	if !floatEquals(pos.AverageEntryPrice, 105) {
+		t.Errorf("received '%v', expected '%v'", pos.AverageEntryPrice, 105)
+	}
+	if !floatEquals(pos.Fees, 0.2) {
+		t.Errorf("received '%v', expected '%v'", pos.Fees, 0.2)
+	}
+	if pos.Fills != 2 {
+		t.Errorf("received '%v', expected '%v'", pos.Fills, 2)
+	}
+}
+
+func TestFillLedgerTerminalOrders(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.DOGE, currency.USDT)
+	f, om := fillLedgerSetup(t, FIFO)
+	open := ledgerFill("1", p, order.Buy, 2, 100)
+	open.ExecutedAmount = 1
+	open.Status = order.PartiallyFilled
+	om.add(open, ledgerFill("2", p, order.Buy, 1, 100))
+	f.process()
+	if _, ok := f.orders["1"]; !ok {
+		t.Error("expected open order to be tracked")
+	}
+	if _, ok := f.orders["2"]; ok {
+		t.Error("expected filled order to no longer be tracked")
+	}
+	if _, ok := f.closed["2"]; !ok {
+		t.Error("expected filled order to be closed")
+	}
+
+	// closed orders are not recorded again
+	f.process()
+	if pos := ledgerPositionFor(t, f, p); !floatEquals(pos.Amount, 2) {
+		t.Errorf("received '%v', expected '%v'", pos.Amount, 2)
+	}
+
+	om.m.Lock()
+	om.orders = om.orders[:1]
+	om.orders[0].Status = order.Cancelled
+	om.m.Unlock()
+	f.process()
+	if len(f.orders) != 0 {
+		t.Errorf("received '%v', expected '%v'", len(f.orders), 0)
+	}
+	if _, ok := f.closed["2"]; ok {
+		t.Error("expected order no longer returned by the order manager to be removed")
+	}
+	if _, ok := f.closed["1"]; !ok {
+		t.Error("expected cancelled order to be closed")
+	}
+}
+
+func TestFillLedgerPnL(t *testing.T) {
+	t.Parallel()
+	btc := currency.NewPair(currency.BTC, currency.USDT)
+	xrp := currency.NewPair(currency.XRP, currency.USD)
+	f, om := fillLedgerSetup(t, FIFO)
+	om.add(ledgerFill("1", btc, order.Buy, 2, 100), ledgerFill("2", xrp, order.Buy, 10, 1))
+	f.process()
+
+	for _, tick := range []*ticker.Price{
+		{Pair: btc, Bid: 110, Ask: 111, Last: 110},
+		{Pair: currency.NewPair(currency.USDT, currency.USD), Last: 0.5},
+	} {
+		tick.ExchangeName = fillLedgerTestExchange
+		tick.AssetType = asset.Spot
+		err := ticker.ProcessTicker(tick)
+		if !errors.Is(err, nil) {
+			t.Fatalf("error '%v', expected '%v'", err, nil)
+		}
+	}
+	pos := ledgerPositionFor(t, f, btc)
+	if pos.MarkPrice != 110 {
+		t.Errorf("received '%v', expected '%v'", pos.MarkPrice, 110)
+	}
+	if !floatEquals(pos.UnrealisedPNL, 20) {
+		t.Errorf("received '%v', expected '%v'", pos.UnrealisedPNL, 20)
+	}
+
+	_, err := f.GetPnL("", "", currency.Pair{}, currency.Code{})
+	if !errors.Is(err, errReportingCurrencyRequired) {
+		t.Errorf("error '%v', expected '%v'", err, errReportingCurrencyRequired)
+	}
+	pnl, err := f.GetPnL(fillLedgerTestExchange, asset.Spot, btc, currency.Code{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if !pnl.Currency.Match(currency.USDT) || !floatEquals(pnl.UnrealisedPNL, 20) {
+		t.Errorf("received '%v %v', expected '%v %v'", pnl.UnrealisedPNL, pnl.Currency, 20, currency.USDT)
+	}
+	pnl, err = f.GetPnL(fillLedgerTestExchange, asset.Spot, btc, currency.USD)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if !floatEquals(pnl.UnrealisedPNL, 10) {
+		t.Errorf("received '%v', expected '%v'", pnl.UnrealisedPNL, 10)
+	}
+	_, err = f.GetPnL(fillLedgerTestExchange, asset.Spot, btc, currency.GBP)
+	if !errors.Is(err, errLedgerConversion) {
+		t.Errorf("error '%v', expected '%v'", err, errLedgerConversion)
+	}
+}
@@ -0,0 +1,154 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	// FillLedgerName defines the manager name string
+	FillLedgerName = "fill_ledger"
+	// DefaultFillLedgerDelay defines the default duration between checks of
+	// the order manager for new fills
+	DefaultFillLedgerDelay = time.Second
+	// ledgerDustAmount is the position amount below which a position is
+	// considered flat
+	ledgerDustAmount = 1e-12
+)
+
+// CostBasis defines how open lots are matched against closing fills
+type CostBasis string
+
+// Supported cost basis methods
+const (
+	// FIFO closes the oldest open lot first
+	FIFO CostBasis = "FIFO"
+	// AverageCost merges open lots into a single lot at the volume weighted
+	// average entry price
+	AverageCost CostBasis = "AVERAGE"
+)
+
+var (
+	errCostBasisUnsupported      = errors.New("unsupported cost basis")
+	errLedgerConversion          = errors.New("cannot convert between currencies")
+	errReportingCurrencyRequired = errors.New("reporting currency required when positions are quoted in different currencies")
+)
+
+// iFillLedgerOrderManager defines a limited scoped order manager which
+// supplies order fills
+type iFillLedgerOrderManager interface {
+	GetOrdersFiltered(*order.Filter) ([]order.Detail, error)
+}
+
+// FillLedger aggregates order fills from the order manager into positions
+// and tracks their realised and unrealised PnL
+type FillLedger struct {
+	started      int32
+	shutdown     chan struct{}
+	wg           sync.WaitGroup
+	orderManager iFillLedgerOrderManager
+	costBasis    CostBasis
+	sleep        time.Duration
+	verbose      bool
+	m            sync.Mutex
+	positions    map[ledgerKey]*ledgerPosition
+	// orders holds the fills already processed by internal order ID
+	orders map[string]*ledgerOrder
+	// closed holds the internal order IDs of orders in a terminal status
+	// which have been fully recorded, they are removed once the order
+	// manager no longer returns the order
+	closed map[string]struct{}
+}
+
+// ledgerKey identifies a position
+type ledgerKey struct {
+	Exchange string
+	Asset    asset.Item
+	Base     *currency.Item
+	Quote    *currency.Item
+}
+
+// ledgerOrder tracks the fills of an order which have been recorded
+type ledgerOrder struct {
+	executed float64
+	// cost is the executed amount multiplied by the average executed price
+	cost float64
+	fee  float64
+	// derived and derivedFee are the executed amount and fee recorded from
+	// the order's executed amount which have not yet been matched to trades
+	derived    float64
+	derivedFee float64
+	trades     map[string]struct{}
+}
+
+// ledgerPosition holds the open lots and PnL of an exchange, asset and pair
+type ledgerPosition struct {
+	exchange string
+	asset    asset.Item
+	pair     currency.Pair
+	lots     []ledgerLot
+	realised float64
+	fees     float64
+	fills    []LedgerFill
+	updated  time.Time
+}
+
+// ledgerLot is an open lot, a negative amount is a short lot
+type ledgerLot struct {
+	amount float64
+	price  float64
+}
+
+// LedgerFill is a single execution recorded in the ledger
+type LedgerFill struct {
+	OrderID   string
+	TradeID   string
+	Side      order.Side
+	Price     float64
+	Amount    float64
+	Fee       float64
+	FeeAsset  currency.Code
+	Timestamp time.Time
+}
+
+// LedgerPosition is a snapshot of a position. All prices and PnL values are
+// denominated in the quote currency of the pair
+type LedgerPosition struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	// Amount is the net position, negative when short
+	Amount            float64
+	AverageEntryPrice float64
+	MarkPrice         float64
+	RealisedPNL       float64
+	UnrealisedPNL     float64
+	// Fees are the fees already deducted from RealisedPNL
+	Fees       float64
+	Fills      int
+	LastUpdate time.Time
+}
+
+// LedgerPNL holds the total PnL of positions converted to a reporting
+// currency
+type LedgerPNL struct {
+	Currency      currency.Code
+	RealisedPNL   float64
+	UnrealisedPNL float64
+	Positions     []LedgerPositionPNL
+}
+
+// LedgerPositionPNL holds the PnL of a position converted to a reporting
+// currency
+type LedgerPositionPNL struct {
+	Exchange      string
+	Asset         asset.Item
+	Pair          currency.Pair
+	RealisedPNL   float64
+	UnrealisedPNL float64
+}
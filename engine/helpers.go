@@ -48,6 +48,11 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		CommunicationsManagerName:     bot.CommunicationsManager.IsRunning(),
 		ConnectionManagerName:         bot.connectionManager.IsRunning(),
 		OrderManagerName:              bot.OrderManager.IsRunning(),
+		ExecutionManagerName:          bot.executionManager.IsRunning(),
+		FillLedgerName:                bot.fillLedger.IsRunning(),
+		ArbitrageScannerName:          bot.arbitrageScanner.IsRunning(),
+		DeadMansSwitchManagerName:     bot.deadMansSwitchManager.IsRunning(),
+		OrderbookRecorderName:         bot.orderbookRecorder.IsRunning(),
 		PortfolioManagerName:          bot.portfolioManager.IsRunning(),
 		NTPManagerName:                bot.ntpManager.IsRunning(),
 		DatabaseConnectionManagerName: bot.DatabaseManager.IsRunning(),
@@ -140,10 +145,139 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 				if err != nil {
 					return err
 				}
+				err = bot.OrderManager.SetRiskControls(&bot.Config.OrderManager.RiskControls)
+				if err != nil {
+					return err
+				}
+				if bot.DatabaseManager.IsRunning() {
+					err = bot.OrderManager.SetDatabase(bot.DatabaseManager)
+					if err != nil {
+						return err
+					}
+				}
+				if bot.Settings.DataDir != "" {
+					err = bot.OrderManager.SetSyntheticOrderFile(filepath.Join(bot.Settings.DataDir, syntheticOrderFileName))
+					if err != nil {
+						return err
+					}
+				}
 			}
 			return bot.OrderManager.Start()
 		}
 		return bot.OrderManager.Stop()
+	case ExecutionManagerName:
+		if enable {
+			if bot.executionManager == nil {
+				if bot.OrderManager == nil {
+					return fmt.Errorf("%s %w", ExecutionManagerName, errNilOrderManager)
+				}
+				bot.executionManager, err = SetupExecutionManager(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					DefaultExecutionManagerDelay,
+					bot.Settings.Verbose)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.executionManager.Start()
+		}
+		return bot.executionManager.Stop()
+	case FillLedgerName:
+		if enable {
+			if bot.fillLedger == nil {
+				if bot.OrderManager == nil {
+					return fmt.Errorf("%s %w", FillLedgerName, errNilOrderManager)
+				}
+				bot.fillLedger, err = SetupFillLedger(
+					bot.OrderManager,
+					CostBasis(bot.Settings.FillLedgerCostBasis),
+					DefaultFillLedgerDelay,
+					bot.Settings.Verbose)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.fillLedger.Start()
+		}
+		return bot.fillLedger.Stop()
+	case ArbitrageScannerName:
+		if enable {
+			if bot.arbitrageScanner == nil {
+				if bot.ExchangeManager == nil {
+					return fmt.Errorf("%s %w", ArbitrageScannerName, errNilExchangeManager)
+				}
+				var comms iCommsManager
+				if bot.Settings.ArbitrageNotify && bot.CommunicationsManager != nil {
+					comms = bot.CommunicationsManager
+				}
+				bot.arbitrageScanner, err = SetupArbitrageScanner(
+					bot.ExchangeManager,
+					comms,
+					asset.Items{asset.Spot},
+					bot.Settings.ArbitrageMinimumProfit,
+					DefaultArbitrageMaxBookAge,
+					DefaultArbitrageScannerDelay,
+					bot.Settings.Verbose)
+				if err != nil {
+					return err
+				}
+				err = bot.arbitrageScanner.SetTriangularThresholds(bot.Config.ArbitrageScanner.TriangularThresholds)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.arbitrageScanner.Start()
+		}
+		return bot.arbitrageScanner.Stop()
+	case DeadMansSwitchManagerName:
+		if enable {
+			if bot.deadMansSwitchManager == nil {
+				if bot.OrderManager == nil {
+					return fmt.Errorf("%s %w", DeadMansSwitchManagerName, errNilOrderManager)
+				}
+				if bot.ExchangeManager == nil {
+					return fmt.Errorf("%s %w", DeadMansSwitchManagerName, errNilExchangeManager)
+				}
+				var connMonitor iConnectionMonitor
+				if bot.connectionManager != nil {
+					connMonitor = bot.connectionManager
+				}
+				var comms iCommsManager
+				if bot.CommunicationsManager != nil {
+					comms = bot.CommunicationsManager
+				}
+				bot.deadMansSwitchManager, err = SetupDeadMansSwitchManager(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					connMonitor,
+					comms,
+					&bot.Config.DeadMansSwitch,
+					bot.Settings.Verbose)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.deadMansSwitchManager.Start()
+		}
+		return bot.deadMansSwitchManager.Stop()
+	case OrderbookRecorderName:
+		if enable {
+			if bot.orderbookRecorder == nil {
+				if bot.ExchangeManager == nil {
+					return fmt.Errorf("%s %w", OrderbookRecorderName, errNilExchangeManager)
+				}
+				bot.orderbookRecorder, err = SetupOrderbookRecorder(
+					bot.ExchangeManager,
+					bot.getOrderbookRecorderConfig(),
+					bot.Settings.Verbose)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.orderbookRecorder.Start()
+		}
+		return bot.orderbookRecorder.Stop()
 	case PortfolioManagerName:
 		if enable {
 			if bot.portfolioManager == nil {
@@ -282,6 +416,16 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 	return fmt.Errorf("%s: %w", subSystemName, errSubsystemNotFound)
 }
 
+// getOrderbookRecorderConfig returns the orderbook recorder config, recording
+// to the data directory when no directory is configured
+func (bot *Engine) getOrderbookRecorderConfig() *config.OrderbookRecorder {
+	cfg := bot.Config.OrderbookRecorder
+	if cfg.Directory == "" {
+		cfg.Directory = filepath.Join(bot.Settings.DataDir, orderbookRecorderDirectory)
+	}
+	return &cfg
+}
+
 // GetExchangeOTPs returns OTP codes for all exchanges which have a otpsecret
 // stored
 func (bot *Engine) GetExchangeOTPs() (map[string]string, error) {
@@ -555,6 +699,24 @@ func GetRelatableCurrencies(p currency.Pair, incOrig, incUSDT bool) currency.Pai
 	return pairs
 }
 
+// convertCurrencyValue converts a value between currencies using the latest
+// ticker stored for the pair on the exchange in either direction, falling back
+// to forex rates for currencies without a ticker
+func convertCurrencyValue(exchangeName string, a asset.Item, value float64, from, to currency.Code) (float64, error) {
+	if value == 0 || from.Match(to) {
+		return value, nil
+	}
+	t, err := ticker.GetTicker(exchangeName, currency.NewPair(from, to), a)
+	if err == nil && t.Last > 0 {
+		return value * t.Last, nil
+	}
+	t, err = ticker.GetTicker(exchangeName, currency.NewPair(to, from), a)
+	if err == nil && t.Last > 0 {
+		return value / t.Last, nil
+	}
+	return currency.ConvertCurrency(value, from, to)
+}
+
 // GetSpecificOrderbook returns a specific orderbook given the currency,
 // exchangeName and assetType
 func (bot *Engine) GetSpecificOrderbook(ctx context.Context, p currency.Pair, exchangeName string, assetType asset.Item) (*orderbook.Base, error) {
@@ -800,7 +962,9 @@ func verifyCert(pemData []byte) error {
 	return nil
 }
 
-func checkCerts(certDir string) error {
+// CheckCerts ensures the gRPC TLS certificate and key exist and have not
+// expired, regenerating them if required
+func CheckCerts(certDir string) error {
 	certFile := filepath.Join(certDir, "cert.pem")
 	keyFile := filepath.Join(certDir, "key.pem")
 
@@ -96,8 +96,8 @@ func CreateTestBot(t *testing.T) *Engine {
 
 func TestGetSubsystemsStatus(t *testing.T) {
 	m := (&Engine{}).GetSubsystemsStatus()
-	if len(m) != 15 {
-		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 14, len(m))
+	if len(m) != 20 {
+		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 20, len(m))
 	}
 }
 
@@ -144,6 +144,36 @@ func TestSetSubsystem(t *testing.T) {
 			EnableError:  nil,
 			DisableError: nil,
 		},
+		{
+			Subsystem:    ExecutionManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilOrderManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    FillLedgerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilOrderManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    ArbitrageScannerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilExchangeManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    DeadMansSwitchManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilOrderManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    OrderbookRecorderName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilExchangeManager,
+			DisableError: ErrNilSubsystem,
+		},
 		{
 			Subsystem:    PortfolioManagerName,
 			Engine:       &Engine{Config: &config.Config{}},
@@ -1142,7 +1172,7 @@ func TestCheckAndGenCerts(t *testing.T) {
 	}
 
 	defer cleanup()
-	if err := checkCerts(tempDir); err != nil {
+	if err := CheckCerts(tempDir); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1151,7 +1181,7 @@ func TestCheckAndGenCerts(t *testing.T) {
 	if err := os.Remove(certFile); err != nil {
 		t.Fatal(err)
 	}
-	if err := checkCerts(tempDir); err != nil {
+	if err := CheckCerts(tempDir); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1164,7 +1194,7 @@ func TestCheckAndGenCerts(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err = checkCerts(tempDir); err != nil {
+	if err = CheckCerts(tempDir); err != nil {
 		t.Fatal(err)
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	orderDB "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -39,6 +40,10 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 			commsManager:    communicationsManager,
 			wg:              wg,
 		},
+		synthetic: syntheticStore{
+			orders:   make(map[string]*SyntheticOrder),
+			watchers: make(map[string]bool),
+		},
 		verbose: verbose,
 	}, nil
 }
@@ -60,11 +65,43 @@ func (m *OrderManager) Start() error {
 		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
 	}
 	log.Debugln(log.OrderMgr, "Order manager starting...")
+	if m.db != nil {
+		err := m.loadOrdersFromDatabase()
+		if err != nil {
+			atomic.StoreInt32(&m.started, 0)
+			return fmt.Errorf("order manager unable to restore orders from database: %w", err)
+		}
+	}
+	err := m.loadSyntheticOrders()
+	if err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return fmt.Errorf("order manager unable to restore synthetic orders: %w", err)
+	}
 	m.shutdown = make(chan struct{})
 	go m.run()
 	return nil
 }
 
+// SetDatabase enables writing the order store through to the database.
+// It must be called before Start so that stored orders can be restored
+func (m *OrderManager) SetDatabase(dcm iDatabaseConnectionManager) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if dcm == nil {
+		return errNilDatabaseConnectionManager
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	db, err := orderDB.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	m.db = db
+	return nil
+}
+
 // Stop attempts to shutdown the subsystem
 func (m *OrderManager) Stop() error {
 	if m == nil {
@@ -99,8 +136,12 @@ func (m *OrderManager) gracefulShutdown() {
 // run will periodically process orders
 func (m *OrderManager) run() {
 	log.Debugln(log.OrderMgr, "Order manager started.")
+	if m.db != nil {
+		m.reconcileRestoredOrders()
+	}
 	m.processOrders()
-	tick := time.NewTicker(orderManagerDelay)
+	m.watchArmedSyntheticOrders()
+	tick := time.NewTicker(orderPollMinInterval)
 	m.orderStore.wg.Add(1)
 	defer func() {
 		log.Debugln(log.OrderMgr, "Order manager shutdown.")
@@ -115,6 +156,7 @@ func (m *OrderManager) run() {
 			return
 		case <-tick.C:
 			go m.processOrders()
+			m.watchArmedSyntheticOrders()
 		}
 	}
 }
@@ -186,6 +228,10 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 		err = errors.New("order id is empty")
 		return err
 	}
+	if m.isSyntheticOrder(cancel.ID) {
+		err = m.CancelSyntheticOrder(cancel.ID)
+		return err
+	}
 
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(cancel.Exchange)
 	if err != nil {
@@ -213,6 +259,8 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 	}
 
 	od.Status = order.Cancelled
+	m.updateSyntheticParent(od)
+	m.persistOrder(od)
 	msg := fmt.Sprintf("Order manager: Exchange %s order ID=%v cancelled.",
 		od.Exchange, od.ID)
 	log.Debugln(log.OrderMgr, msg)
@@ -265,6 +313,10 @@ func (m *OrderManager) validate(newOrder *order.Submit) error {
 		return errors.New("order exchange name must be specified")
 	}
 
+	if m.IsKillSwitchEngaged() {
+		return ErrKillSwitchEngaged
+	}
+
 	if err := newOrder.Validate(); err != nil {
 		return fmt.Errorf("order manager: %w", err)
 	}
@@ -322,6 +374,19 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 		mod.Price = det.Price
 	}
 
+	err = m.checkRiskControls(ctx, &order.Submit{
+		Exchange:  det.Exchange,
+		Pair:      det.Pair,
+		AssetType: det.AssetType,
+		Side:      det.Side,
+		Type:      det.Type,
+		Amount:    mod.Amount,
+		Price:     mod.Price,
+	}, det)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get exchange instance and submit order modification request.
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(mod.Exchange)
 	if err != nil {
@@ -353,6 +418,7 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 		message = "Order manager: Exchange %s order ID=%v: modified on exchange, but failed to modify locally"
 	} else {
 		message = "Order manager: Exchange %s order ID=%v: modified successfully"
+		m.persistOrder(det)
 	}
 	m.orderStore.commsManager.PushEvent(base.Event{
 		Type:    "order",
@@ -375,6 +441,10 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	if err != nil {
 		return nil, err
 	}
+	err = m.checkRiskControls(ctx, newOrder, nil)
+	if err != nil {
+		return nil, err
+	}
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(newOrder.Exchange)
 	if err != nil {
 		return nil, err
@@ -426,6 +496,10 @@ func (m *OrderManager) SubmitFakeOrder(newOrder *order.Submit, resultingOrder or
 	if err != nil {
 		return nil, err
 	}
+	err = m.checkRiskControls(context.TODO(), newOrder, nil)
+	if err != nil {
+		return nil, err
+	}
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(newOrder.Exchange)
 	if err != nil {
 		return nil, err
@@ -536,7 +610,7 @@ func (m *OrderManager) processSubmittedOrder(newOrder *order.Submit, result orde
 	if result.FullyMatched {
 		status = order.Filled
 	}
-	err = m.orderStore.add(&order.Detail{
+	det := &order.Detail{
 		ImmediateOrCancel: newOrder.ImmediateOrCancel,
 		HiddenOrder:       newOrder.HiddenOrder,
 		FillOrKill:        newOrder.FillOrKill,
@@ -565,10 +639,12 @@ func (m *OrderManager) processSubmittedOrder(newOrder *order.Submit, result orde
 		LastUpdated:       time.Now(),
 		Pair:              newOrder.Pair,
 		Leverage:          newOrder.Leverage,
-	})
+	}
+	err = m.orderStore.add(det)
 	if err != nil {
 		return nil, fmt.Errorf("unable to add %v order %v to orderStore: %s", newOrder.Exchange, result.OrderID, err)
 	}
+	m.persistOrder(det)
 
 	return &OrderSubmitResponse{
 		SubmitResponse: order.SubmitResponse{
@@ -580,7 +656,8 @@ func (m *OrderManager) processSubmittedOrder(newOrder *order.Submit, result orde
 }
 
 // processOrders iterates over all exchange orders via API
-// and adds them to the internal order store
+// and adds them to the internal order store. Exchanges are only polled once
+// their adaptive poll interval has elapsed or a resync has been requested
 func (m *OrderManager) processOrders() {
 	if !atomic.CompareAndSwapInt32(&m.processingOrders, 0, 1) {
 		return
@@ -598,10 +675,16 @@ func (m *OrderManager) processOrders() {
 		if !exchanges[i].GetAuthenticatedAPISupport(exchange.RestAuthentication) {
 			continue
 		}
+		due, resync := m.isOrderPollDue(exchanges[i])
+		if !due {
+			continue
+		}
 		log.Debugf(log.OrderMgr,
 			"Order manager: Processing orders for exchange %v.",
 			exchanges[i].GetName())
 
+		var changed bool
+
 		supportedAssets := exchanges[i].GetAssetTypes(true)
 		for y := range supportedAssets {
 			pairs, err := exchanges[i].GetEnabledPairs(supportedAssets[y])
@@ -654,30 +737,51 @@ func (m *OrderManager) processOrders() {
 			}
 
 			for z := range result {
+				var previous order.Detail
+				if existing, err := m.orderStore.getByExchangeAndID(result[z].Exchange, result[z].ID); err == nil {
+					previous = *existing
+				}
 				upsertResponse, err := m.UpsertOrder(&result[z])
 				if err != nil {
 					log.Error(log.OrderMgr, err)
+					continue
 				}
 				requiresProcessing[upsertResponse.OrderDetails.InternalOrderID] = false
+				if upsertResponse.IsNewOrder ||
+					previous.Status != upsertResponse.OrderDetails.Status ||
+					previous.ExecutedAmount != upsertResponse.OrderDetails.ExecutedAmount {
+					changed = true
+				}
+			}
+			for x := range requiresProcessing {
+				if requiresProcessing[x] {
+					// a tracked order is no longer active on the exchange
+					changed = true
+					break
+				}
 			}
 			if !exchanges[i].GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder {
 				continue
 			}
 			wg.Add(1)
-			go m.processMatchingOrders(exchanges[i], orders, requiresProcessing, &wg)
+			go m.processMatchingOrders(exchanges[i], orders, requiresProcessing, resync, &wg)
 		}
+		m.orderPolled(exchanges[i], changed)
 	}
 	wg.Wait()
 }
 
-func (m *OrderManager) processMatchingOrders(exch exchange.IBotExchange, orders []order.Detail, requiresProcessing map[string]bool, wg *sync.WaitGroup) {
+// processMatchingOrders fetches tracked orders which were not returned as
+// active by the exchange. Recently updated orders are skipped unless a resync
+// is requested
+func (m *OrderManager) processMatchingOrders(exch exchange.IBotExchange, orders []order.Detail, requiresProcessing map[string]bool, resync bool, wg *sync.WaitGroup) {
 	defer func() {
 		if wg != nil {
 			wg.Done()
 		}
 	}()
 	for x := range orders {
-		if time.Since(orders[x].LastUpdated) < time.Minute {
+		if !resync && time.Since(orders[x].LastUpdated) < time.Minute {
 			continue
 		}
 		if requiresProcessing[orders[x].InternalOrderID] {
@@ -789,6 +893,9 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 	if upsertResponse.IsNewOrder {
 		status = "added"
 	}
+	m.persistOrder(&upsertResponse.OrderDetails)
+	m.updateSyntheticParent(&upsertResponse.OrderDetails)
+
 	msg = fmt.Sprintf("Order manager: Exchange %s %s order ID=%v internal ID=%v pair=%v price=%.8f amount=%.8f side=%v type=%v status=%v.",
 		upsertResponse.OrderDetails.Exchange, status, upsertResponse.OrderDetails.ID, upsertResponse.OrderDetails.InternalOrderID,
 		upsertResponse.OrderDetails.Pair, upsertResponse.OrderDetails.Price, upsertResponse.OrderDetails.Amount,
@@ -801,6 +908,224 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 	return upsertResponse, nil
 }
 
+// persistOrder writes an order and its trades through to the database when
+// order persistence is enabled. Failures are logged as the order has already
+// been accepted by the exchange
+func (m *OrderManager) persistOrder(det *order.Detail) {
+	if m.db == nil || det == nil {
+		return
+	}
+	err := m.db.Upsert(orderDetailToDatabase(det))
+	if err != nil {
+		log.Errorf(log.OrderMgr,
+			"Order manager: Unable to save %v order %v to database: %s",
+			det.Exchange,
+			det.ID,
+			err)
+	}
+}
+
+// loadOrdersFromDatabase rehydrates the order store with the stored orders of
+// loaded exchanges which are open or were updated within orderRestoreWindow
+func (m *OrderManager) loadOrdersFromDatabase() error {
+	orders, err := m.db.GetOpenOrUpdatedSince(closedOrderStatuses, time.Now().Add(-orderRestoreWindow))
+	if err != nil {
+		return err
+	}
+	var restored int
+	for i := range orders {
+		det, err := orderDatabaseToDetail(&orders[i])
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Order manager: Unable to restore %v order %v: %s",
+				orders[i].Exchange,
+				orders[i].ExchangeOrderID,
+				err)
+			continue
+		}
+		err = m.orderStore.add(det)
+		if err != nil {
+			if m.verbose {
+				log.Debugf(log.OrderMgr,
+					"Order manager: Skipping restore of %v order %v: %s",
+					det.Exchange,
+					det.ID,
+					err)
+			}
+			continue
+		}
+		restored++
+	}
+	log.Debugf(log.OrderMgr, "Order manager: Restored %d orders from database.", restored)
+	return nil
+}
+
+// reconcileRestoredOrders compares restored active orders against the active
+// orders reported by each exchange so that anything filled or cancelled while
+// the bot was offline is brought up to date
+func (m *OrderManager) reconcileRestoredOrders() {
+	type exchangeAsset struct {
+		exchange string
+		asset    asset.Item
+	}
+	active := m.orderStore.getActiveOrders(nil)
+	grouped := make(map[exchangeAsset][]order.Detail)
+	for i := range active {
+		key := exchangeAsset{strings.ToLower(active[i].Exchange), active[i].AssetType}
+		grouped[key] = append(grouped[key], active[i])
+	}
+
+	for key, restored := range grouped {
+		exch, err := m.orderStore.exchangeManager.GetExchangeByName(key.exchange)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: Unable to reconcile orders: %s", err)
+			continue
+		}
+		if !exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+			continue
+		}
+		var pairs currency.Pairs
+		for i := range restored {
+			if !pairs.Contains(restored[i].Pair, true) {
+				pairs = append(pairs, restored[i].Pair)
+			}
+		}
+		result, err := exch.GetActiveOrders(context.TODO(), &order.GetOrdersRequest{
+			Side:      order.AnySide,
+			Type:      order.AnyType,
+			Pairs:     pairs,
+			AssetType: key.asset,
+		})
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Order manager: Unable to reconcile active orders for %s and asset type %s: %s",
+				key.exchange,
+				key.asset,
+				err)
+			continue
+		}
+		stillActive := make(map[string]bool, len(result))
+		for i := range result {
+			stillActive[result[i].ID] = true
+			_, err = m.UpsertOrder(&result[i])
+			if err != nil {
+				log.Error(log.OrderMgr, err)
+			}
+		}
+		canFetch := exch.GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder
+		for i := range restored {
+			if stillActive[restored[i].ID] {
+				continue
+			}
+			if !canFetch {
+				log.Warnf(log.OrderMgr,
+					"Order manager: %s order %v is no longer active on the exchange and cannot be fetched to reconcile its status",
+					key.exchange,
+					restored[i].ID)
+				continue
+			}
+			err = m.FetchAndUpdateExchangeOrder(exch, &restored[i], key.asset)
+			if err != nil {
+				log.Error(log.OrderMgr, err)
+			}
+		}
+	}
+}
+
+// orderDetailToDatabase converts an order to its database representation
+func orderDetailToDatabase(det *order.Detail) *orderDB.Details {
+	resp := &orderDB.Details{
+		ID:                   det.InternalOrderID,
+		Exchange:             det.Exchange,
+		ExchangeOrderID:      det.ID,
+		ClientOrderID:        det.ClientOrderID,
+		ClientID:             det.ClientID,
+		AccountID:            det.AccountID,
+		Base:                 det.Pair.Base.String(),
+		Quote:                det.Pair.Quote.String(),
+		Asset:                det.AssetType.String(),
+		Side:                 det.Side.String(),
+		Type:                 det.Type.String(),
+		Status:               det.Status.String(),
+		Price:                det.Price,
+		Amount:               det.Amount,
+		ExecutedAmount:       det.ExecutedAmount,
+		RemainingAmount:      det.RemainingAmount,
+		AverageExecutedPrice: det.AverageExecutedPrice,
+		Cost:                 det.Cost,
+		Fee:                  det.Fee,
+		FeeAsset:             det.FeeAsset.String(),
+		Leverage:             det.Leverage,
+		TriggerPrice:         det.TriggerPrice,
+		Created:              det.Date,
+		LastUpdated:          det.LastUpdated,
+	}
+	for i := range det.Trades {
+		resp.Trades = append(resp.Trades, orderDB.Trade{
+			TID:       det.Trades[i].TID,
+			Price:     det.Trades[i].Price,
+			Amount:    det.Trades[i].Amount,
+			Fee:       det.Trades[i].Fee,
+			FeeAsset:  det.Trades[i].FeeAsset,
+			Side:      det.Trades[i].Side.String(),
+			Timestamp: det.Trades[i].Timestamp,
+		})
+	}
+	return resp
+}
+
+// orderDatabaseToDetail converts a stored order back into an order detail
+func orderDatabaseToDetail(d *orderDB.Details) (*order.Detail, error) {
+	a, err := asset.New(d.Asset)
+	if err != nil {
+		return nil, err
+	}
+	cp, err := currency.NewPairFromStrings(d.Base, d.Quote)
+	if err != nil {
+		return nil, err
+	}
+	resp := &order.Detail{
+		InternalOrderID:      d.ID,
+		Exchange:             d.Exchange,
+		ID:                   d.ExchangeOrderID,
+		ClientOrderID:        d.ClientOrderID,
+		ClientID:             d.ClientID,
+		AccountID:            d.AccountID,
+		Pair:                 cp,
+		AssetType:            a,
+		Side:                 order.Side(d.Side),
+		Type:                 order.Type(d.Type),
+		Status:               order.Status(d.Status),
+		Price:                d.Price,
+		Amount:               d.Amount,
+		ExecutedAmount:       d.ExecutedAmount,
+		RemainingAmount:      d.RemainingAmount,
+		AverageExecutedPrice: d.AverageExecutedPrice,
+		Cost:                 d.Cost,
+		Fee:                  d.Fee,
+		Leverage:             d.Leverage,
+		TriggerPrice:         d.TriggerPrice,
+		Date:                 d.Created,
+		LastUpdated:          d.LastUpdated,
+	}
+	if d.FeeAsset != "" {
+		resp.FeeAsset = currency.NewCode(d.FeeAsset)
+	}
+	for i := range d.Trades {
+		resp.Trades = append(resp.Trades, order.TradeHistory{
+			Price:     d.Trades[i].Price,
+			Amount:    d.Trades[i].Amount,
+			Fee:       d.Trades[i].Fee,
+			Exchange:  d.Exchange,
+			TID:       d.Trades[i].TID,
+			Side:      order.Side(d.Trades[i].Side),
+			Timestamp: d.Trades[i].Timestamp,
+			FeeAsset:  d.Trades[i].FeeAsset,
+		})
+	}
+	return resp, nil
+}
+
 // get returns all orders for all exchanges
 // should not be exported as it can have large impact if used improperly
 func (s *store) get() map[string][]*order.Detail {
@@ -0,0 +1,390 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetRiskControls sets the pre-trade risk controls which are checked before
+// every order submission and modification
+func (m *OrderManager) SetRiskControls(cfg *config.OrderRiskControls) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if cfg == nil {
+		return errNilRiskControls
+	}
+	if cfg.MaxOrderNotional < 0 ||
+		cfg.MaxPairNotional < 0 ||
+		cfg.MaxOpenOrdersPerExchange < 0 ||
+		cfg.PriceBandPercentage < 0 ||
+		cfg.MaxOrdersPerMinute < 0 {
+		return errRiskControlNegative
+	}
+	positions := make(map[string]float64, len(cfg.MaxPositions))
+	for code, limit := range cfg.MaxPositions {
+		if limit < 0 {
+			return fmt.Errorf("%w: %s max position", errRiskControlNegative, code)
+		}
+		positions[strings.ToUpper(code)] = limit
+	}
+
+	m.risk.m.Lock()
+	defer m.risk.m.Unlock()
+	m.risk.cfg = *cfg
+	m.risk.cfg.MaxPositions = positions
+	m.risk.notionalCurrency = currency.Code{}
+	if cfg.NotionalCurrency != "" {
+		m.risk.notionalCurrency = currency.NewCode(cfg.NotionalCurrency)
+	}
+	return nil
+}
+
+// EngageKillSwitch blocks all new orders and cancels every active order and
+// unresolved synthetic order held by the order manager. The kill switch
+// remains engaged when cancellations fail
+func (m *OrderManager) EngageKillSwitch(ctx context.Context, reason string) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.risk.m.Lock()
+	if m.risk.killSwitch {
+		m.risk.m.Unlock()
+		return errKillSwitchAlreadyEngaged
+	}
+	m.risk.killSwitch = true
+	m.risk.m.Unlock()
+	m.pushRiskEvent(OrderManagerName,
+		fmt.Sprintf("Order manager: Kill switch engaged, cancelling all orders. Reason: %s", reason))
+
+	var unresolved []string
+	m.synthetic.m.Lock()
+	for id, o := range m.synthetic.orders {
+		if o.isUnresolved() {
+			unresolved = append(unresolved, id)
+		}
+	}
+	m.synthetic.m.Unlock()
+
+	var errs common.Errors
+	for i := range unresolved {
+		err := m.CancelSyntheticOrder(unresolved[i])
+		// OCO siblings are cancelled together so may already be resolved
+		if err != nil && !errors.Is(err, errSyntheticOrderNotCancellable) {
+			errs = append(errs, err)
+		}
+	}
+
+	active := m.orderStore.getActiveOrders(nil)
+	for i := range active {
+		err := m.Cancel(ctx, &order.Cancel{
+			Exchange:      active[i].Exchange,
+			ID:            active[i].ID,
+			AccountID:     active[i].AccountID,
+			ClientID:      active[i].ClientID,
+			ClientOrderID: active[i].ClientOrderID,
+			WalletAddress: active[i].WalletAddress,
+			Type:          active[i].Type,
+			Side:          active[i].Side,
+			Pair:          active[i].Pair,
+			AssetType:     active[i].AssetType,
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DisengageKillSwitch allows new orders to be submitted again
+func (m *OrderManager) DisengageKillSwitch() error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	m.risk.m.Lock()
+	if !m.risk.killSwitch {
+		m.risk.m.Unlock()
+		return errKillSwitchNotEngaged
+	}
+	m.risk.killSwitch = false
+	m.risk.m.Unlock()
+	m.pushRiskEvent(OrderManagerName, "Order manager: Kill switch disengaged, new orders are allowed.")
+	return nil
+}
+
+// IsKillSwitchEngaged returns whether new orders are blocked by the kill
+// switch
+func (m *OrderManager) IsKillSwitchEngaged() bool {
+	if m == nil {
+		return false
+	}
+	m.risk.m.Lock()
+	defer m.risk.m.Unlock()
+	return m.risk.killSwitch
+}
+
+// checkRiskControls ensures an order passes the pre-trade risk controls.
+// replacing is set to the stored order when checking a modification so that
+// it is not counted against itself. Breaches are relayed to the
+// communications manager and written to the audit log
+func (m *OrderManager) checkRiskControls(ctx context.Context, o *order.Submit, replacing *order.Detail) error {
+	m.risk.m.Lock()
+	if m.risk.killSwitch {
+		m.risk.m.Unlock()
+		return ErrKillSwitchEngaged
+	}
+	cfg := m.risk.cfg
+	notionalCurrency := m.risk.notionalCurrency
+	m.risk.m.Unlock()
+	if !cfg.Enabled {
+		return nil
+	}
+
+	err := m.checkRiskLimits(ctx, &cfg, notionalCurrency, o, replacing)
+	if err == nil && replacing == nil {
+		err = m.recordRiskSubmission(o.Exchange, cfg.MaxOrdersPerMinute)
+	}
+	if err != nil {
+		m.pushRiskEvent(o.Exchange,
+			fmt.Sprintf("Order manager: Exchange %s %s %s %s order amount=%v price=%v rejected: %v",
+				o.Exchange,
+				o.Pair,
+				o.Side,
+				o.Type,
+				o.Amount,
+				o.Price,
+				err))
+	}
+	return err
+}
+
+// checkRiskLimits checks an order against the configured notional, open
+// order, position and price band limits
+func (m *OrderManager) checkRiskLimits(ctx context.Context, cfg *config.OrderRiskControls, notionalCurrency currency.Code, o *order.Submit, replacing *order.Detail) error {
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(o.Exchange)
+	if err != nil {
+		return err
+	}
+
+	var tick *ticker.Price
+	fetchTicker := func() (*ticker.Price, error) {
+		if tick != nil {
+			return tick, nil
+		}
+		t, err := exch.FetchTicker(ctx, o.Pair, o.AssetType)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errRiskReferencePriceUnset, err)
+		}
+		tick = t
+		return tick, nil
+	}
+
+	if cfg.PriceBandPercentage > 0 && o.Price > 0 {
+		t, err := fetchTicker()
+		if err != nil {
+			return err
+		}
+		if t.Last <= 0 {
+			return errRiskReferencePriceUnset
+		}
+		deviation := math.Abs(o.Price-t.Last) / t.Last * 100
+		if deviation > cfg.PriceBandPercentage {
+			return fmt.Errorf("%w: price %v deviates %.2f%% from last price %v, band is %v%%",
+				ErrRiskLimitBreached,
+				o.Price,
+				deviation,
+				t.Last,
+				cfg.PriceBandPercentage)
+		}
+	}
+
+	if cfg.MaxOrderNotional <= 0 &&
+		cfg.MaxPairNotional <= 0 &&
+		cfg.MaxOpenOrdersPerExchange <= 0 &&
+		len(cfg.MaxPositions) == 0 {
+		return nil
+	}
+
+	// Market orders are valued at the side of the book they will execute
+	// against
+	price := o.Price
+	if price <= 0 {
+		t, err := fetchTicker()
+		if err != nil {
+			return err
+		}
+		price = tickerSidePrice(o.Side, t)
+		if price <= 0 {
+			return errRiskReferencePriceUnset
+		}
+	}
+
+	notional, err := riskNotional(o.Exchange, o.AssetType, o.Amount*price, o.Pair.Quote, notionalCurrency)
+	if err != nil {
+		return err
+	}
+	if cfg.MaxOrderNotional > 0 && notional > cfg.MaxOrderNotional {
+		return fmt.Errorf("%w: order notional %v exceeds max order notional %v",
+			ErrRiskLimitBreached,
+			notional,
+			cfg.MaxOrderNotional)
+	}
+
+	if cfg.MaxPairNotional > 0 || cfg.MaxOpenOrdersPerExchange > 0 {
+		var openOrders int64
+		pairNotional := notional
+		active := m.orderStore.getActiveOrders(&order.Filter{Exchange: o.Exchange})
+		for i := range active {
+			if replacing != nil && active[i].ID == replacing.ID {
+				continue
+			}
+			openOrders++
+			if active[i].AssetType != o.AssetType || !active[i].Pair.Equal(o.Pair) {
+				continue
+			}
+			openPrice := active[i].Price
+			if openPrice <= 0 {
+				openPrice = price
+			}
+			var openNotional float64
+			openNotional, err = riskNotional(o.Exchange,
+				o.AssetType,
+				(active[i].Amount-active[i].ExecutedAmount)*openPrice,
+				active[i].Pair.Quote,
+				notionalCurrency)
+			if err != nil {
+				return err
+			}
+			pairNotional += openNotional
+		}
+		if replacing == nil &&
+			cfg.MaxOpenOrdersPerExchange > 0 &&
+			openOrders >= cfg.MaxOpenOrdersPerExchange {
+			return fmt.Errorf("%w: %v open orders on %s, max is %v",
+				ErrRiskLimitBreached,
+				openOrders,
+				o.Exchange,
+				cfg.MaxOpenOrdersPerExchange)
+		}
+		if cfg.MaxPairNotional > 0 && pairNotional > cfg.MaxPairNotional {
+			return fmt.Errorf("%w: %s %s open notional %v exceeds max pair notional %v",
+				ErrRiskLimitBreached,
+				o.Exchange,
+				o.Pair,
+				pairNotional,
+				cfg.MaxPairNotional)
+		}
+	}
+
+	if len(cfg.MaxPositions) > 0 {
+		// A buy increases the position in the base currency and a sell
+		// increases the position in the quote currency
+		code, amount := o.Pair.Base, o.Amount
+		if o.Side == order.Sell || o.Side == order.Ask {
+			code, amount = o.Pair.Quote, o.Amount*price
+		}
+		limit, ok := cfg.MaxPositions[code.Upper().String()]
+		if !ok {
+			return nil
+		}
+		holdings, err := exch.FetchAccountInfo(ctx, o.AssetType)
+		if err != nil {
+			return fmt.Errorf("cannot determine %s position: %w", code, err)
+		}
+		var held float64
+		for i := range holdings.Accounts {
+			if holdings.Accounts[i].AssetType != o.AssetType {
+				continue
+			}
+			for j := range holdings.Accounts[i].Currencies {
+				if holdings.Accounts[i].Currencies[j].CurrencyName.Match(code) {
+					held += holdings.Accounts[i].Currencies[j].TotalValue
+				}
+			}
+		}
+		if held+amount > limit {
+			return fmt.Errorf("%w: %s position %v would exceed max position %v",
+				ErrRiskLimitBreached,
+				code,
+				held+amount,
+				limit)
+		}
+	}
+	return nil
+}
+
+// recordRiskSubmission records an order submission for an exchange, erroring
+// when the maximum orders per minute has been reached
+func (m *OrderManager) recordRiskSubmission(exchangeName string, maxPerMinute int64) error {
+	if maxPerMinute <= 0 {
+		return nil
+	}
+	exchangeName = strings.ToLower(exchangeName)
+	now := time.Now()
+	m.risk.m.Lock()
+	defer m.risk.m.Unlock()
+	if m.risk.submissions == nil {
+		m.risk.submissions = make(map[string][]time.Time)
+	}
+	recent := m.risk.submissions[exchangeName][:0]
+	for _, t := range m.risk.submissions[exchangeName] {
+		if now.Sub(t) < riskOrderRateWindow {
+			recent = append(recent, t)
+		}
+	}
+	m.risk.submissions[exchangeName] = recent
+	if int64(len(recent)) >= maxPerMinute {
+		return fmt.Errorf("%w: %v orders submitted to %s in the last minute, max is %v",
+			ErrRiskLimitBreached,
+			len(recent),
+			exchangeName,
+			maxPerMinute)
+	}
+	m.risk.submissions[exchangeName] = append(recent, now)
+	return nil
+}
+
+// pushRiskEvent logs, relays and audits a risk control event
+func (m *OrderManager) pushRiskEvent(identifier, msg string) {
+	log.Warnln(log.OrderMgr, msg)
+	m.orderStore.commsManager.PushEvent(base.Event{
+		Type:    riskEventType,
+		Message: msg,
+	})
+	audit.Event(identifier, riskEventType, msg)
+}
+
+// riskNotional converts a quote currency value to the notional currency using
+// the exchange's tickers or forex rates, the value is returned as is when no
+// notional currency is set
+func riskNotional(exchangeName string, a asset.Item, value float64, quote, notionalCurrency currency.Code) (float64, error) {
+	if notionalCurrency.IsEmpty() {
+		return value, nil
+	}
+	converted, err := convertCurrencyValue(exchangeName, a, value, quote, notionalCurrency)
+	if err != nil {
+		return 0, fmt.Errorf("cannot convert %s notional to %s: %w", quote, notionalCurrency, err)
+	}
+	return converted, nil
+}
@@ -0,0 +1,363 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// ormExchange extends the synthetic order fake exchange with a fixed ticker
+// and account holdings so risk controls can be checked without API calls
+type ormExchange struct {
+	omsExchange
+}
+
+// FetchTicker overrides testExchange's fetch ticker function
+func (f ormExchange) FetchTicker(context.Context, currency.Pair, asset.Item) (*ticker.Price, error) {
+	return syntheticTicker(99, 101), nil
+}
+
+// FetchAccountInfo overrides testExchange's fetch account info function
+func (f ormExchange) FetchAccountInfo(_ context.Context, a asset.Item) (account.Holdings, error) {
+	return account.Holdings{
+		Exchange: testExchange,
+		Accounts: []account.SubAccount{{
+			AssetType: a,
+			Currencies: []account.Balance{
+				{CurrencyName: currency.BTC, TotalValue: 1},
+				{CurrencyName: currency.USD, TotalValue: 1000},
+			},
+		}},
+	}, nil
+}
+
+func riskControlsSetup(t *testing.T, cfg *config.OrderRiskControls) (*OrderManager, *int64) {
+	t.Helper()
+	m, _ := syntheticOrdersSetup(t)
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(testExchange)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	em := SetupExchangeManager()
+	submitted := new(int64)
+	em.Add(ormExchange{omsExchange: omsExchange{
+		omfExchange: omfExchange{IBotExchange: exch},
+		submitted:   submitted,
+	}})
+	m.orderStore.exchangeManager = em
+	cfg.Enabled = true
+	err = m.SetRiskControls(cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	return m, submitted
+}
+
+func riskSubmission(side order.Side, amount, price float64) *order.Submit {
+	s := &order.Submit{
+		Exchange:  testExchange,
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		AssetType: asset.Spot,
+		Side:      side,
+		Type:      order.Market,
+		Amount:    amount,
+		Price:     price,
+	}
+	if price > 0 {
+		s.Type = order.Limit
+	}
+	return s
+}
+
+func TestSetRiskControls(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	err := m.SetRiskControls(nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	m, _ = syntheticOrdersSetup(t)
+	err = m.SetRiskControls(nil)
+	if !errors.Is(err, errNilRiskControls) {
+		t.Errorf("error '%v', expected '%v'", err, errNilRiskControls)
+	}
+	err = m.SetRiskControls(&config.OrderRiskControls{MaxOrderNotional: -1})
+	if !errors.Is(err, errRiskControlNegative) {
+		t.Errorf("error '%v', expected '%v'", err, errRiskControlNegative)
+	}
+	err = m.SetRiskControls(&config.OrderRiskControls{MaxPositions: map[string]float64{"btc": -1}})
+	if !errors.Is(err, errRiskControlNegative) {
+		t.Errorf("error '%v', expected '%v'", err, errRiskControlNegative)
+	}
+	err = m.SetRiskControls(&config.OrderRiskControls{
+		Enabled:          true,
+		NotionalCurrency: "usd",
+		MaxPositions:     map[string]float64{"btc": 1},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if _, ok := m.risk.cfg.MaxPositions["BTC"]; !ok {
+		t.Error("expected max position currency codes to be upper cased")
+	}
+	if !m.risk.notionalCurrency.Match(currency.USD) {
+		t.Errorf("received '%v', expected '%v'", m.risk.notionalCurrency, currency.USD)
+	}
+}
+
+func TestRiskNotional(t *testing.T) {
+	t.Parallel()
+	notional, err := riskNotional("riskNotional", asset.Spot, 100, currency.USDT, currency.Code{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if notional != 100 {
+		t.Errorf("received '%v', expected '%v'", notional, 100)
+	}
+	_, err = riskNotional("riskNotional", asset.Spot, 100, currency.USDT, currency.USD)
+	if err == nil {
+		t.Error("expected error without a ticker or forex rate")
+	}
+
+	err = ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: "riskNotional",
+		Pair:         currency.NewPair(currency.USDT, currency.USD),
+		AssetType:    asset.Spot,
+		Last:         0.99,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	// stablecoin quotes are converted using the exchange ticker
+	notional, err = riskNotional("riskNotional", asset.Spot, 100, currency.USDT, currency.USD)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if notional != 99 {
+		t.Errorf("received '%v', expected '%v'", notional, 99)
+	}
+	notional, err = riskNotional("riskNotional", asset.Spot, 99, currency.USD, currency.USDT)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if notional != 100 {
+		t.Errorf("received '%v', expected '%v'", notional, 100)
+	}
+}
+
+func TestCheckRiskControls(t *testing.T) {
+	t.Parallel()
+	m, _ := syntheticOrdersSetup(t)
+	err := m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 1000, 0), nil)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v' when risk controls are disabled", err, nil)
+	}
+
+	m, _ = riskControlsSetup(t, &config.OrderRiskControls{PriceBandPercentage: 5})
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 1, 104), nil)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 1, 106), nil)
+	if !errors.Is(err, ErrRiskLimitBreached) {
+		t.Errorf("error '%v', expected '%v'", err, ErrRiskLimitBreached)
+	}
+
+	// market buys are valued at the ask
+	m, _ = riskControlsSetup(t, &config.OrderRiskControls{MaxOrderNotional: 200, NotionalCurrency: "USD"})
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 1.98, 0), nil)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 1.99, 0), nil)
+	if !errors.Is(err, ErrRiskLimitBreached) {
+		t.Errorf("error '%v', expected '%v'", err, ErrRiskLimitBreached)
+	}
+
+	m, _ = riskControlsSetup(t, &config.OrderRiskControls{MaxPairNotional: 300, MaxOpenOrdersPerExchange: 2})
+	open := &order.Detail{
+		Exchange:  testExchange,
+		ID:        "open",
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Status:    order.Active,
+		Amount:    3,
+		Price:     100,
+	}
+	err = m.orderStore.add(open)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 1, 100), nil)
+	if !errors.Is(err, ErrRiskLimitBreached) {
+		t.Errorf("error '%v', expected '%v'", err, ErrRiskLimitBreached)
+	}
+	// a modification is not counted against itself
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 2, 100), open)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	open.ExecutedAmount = 1
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 1, 100), nil)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.orderStore.add(&order.Detail{
+		Exchange:  testExchange,
+		ID:        "open2",
+		Pair:      currency.NewPair(currency.LTC, currency.USD),
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Status:    order.Active,
+		Amount:    1,
+		Price:     1,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 0.1, 100), nil)
+	if !errors.Is(err, ErrRiskLimitBreached) {
+		t.Errorf("error '%v', expected '%v' for max open orders", err, ErrRiskLimitBreached)
+	}
+
+	m, _ = riskControlsSetup(t, &config.OrderRiskControls{MaxPositions: map[string]float64{"btc": 2, "usd": 1100}})
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 1, 100), nil)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 1.5, 100), nil)
+	if !errors.Is(err, ErrRiskLimitBreached) {
+		t.Errorf("error '%v', expected '%v'", err, ErrRiskLimitBreached)
+	}
+	// sells increase the quote currency position, valued at the bid
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Sell, 1.1, 0), nil)
+	if !errors.Is(err, ErrRiskLimitBreached) {
+		t.Errorf("error '%v', expected '%v'", err, ErrRiskLimitBreached)
+	}
+
+	m, _ = riskControlsSetup(t, &config.OrderRiskControls{MaxOrdersPerMinute: 2})
+	for i := 0; i < 2; i++ {
+		err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 1, 100), nil)
+		if !errors.Is(err, nil) {
+			t.Fatalf("error '%v', expected '%v'", err, nil)
+		}
+	}
+	err = m.checkRiskControls(context.Background(), riskSubmission(order.Buy, 1, 100), nil)
+	if !errors.Is(err, ErrRiskLimitBreached) {
+		t.Errorf("error '%v', expected '%v'", err, ErrRiskLimitBreached)
+	}
+}
+
+func TestSubmitRiskControls(t *testing.T) {
+	t.Parallel()
+	m, submitted := riskControlsSetup(t, &config.OrderRiskControls{MaxOrderNotional: 100})
+	_, err := m.Submit(context.Background(), riskSubmission(order.Buy, 2, 100))
+	if !errors.Is(err, ErrRiskLimitBreached) {
+		t.Errorf("error '%v', expected '%v'", err, ErrRiskLimitBreached)
+	}
+	if atomic.LoadInt64(submitted) != 0 {
+		t.Error("expected order to be rejected before reaching the exchange")
+	}
+	resp, err := m.Submit(context.Background(), riskSubmission(order.Buy, 1, 100))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	_, err = m.Modify(context.Background(), &order.Modify{
+		Exchange: testExchange,
+		ID:       resp.OrderID,
+		Amount:   2,
+	})
+	if !errors.Is(err, ErrRiskLimitBreached) {
+		t.Errorf("error '%v', expected '%v'", err, ErrRiskLimitBreached)
+	}
+}
+
+func TestKillSwitch(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	if m.IsKillSwitchEngaged() {
+		t.Error("expected nil order manager kill switch to not be engaged")
+	}
+	err := m.EngageKillSwitch(context.Background(), "test")
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	err = m.DisengageKillSwitch()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+
+	m, _ = riskControlsSetup(t, &config.OrderRiskControls{})
+	resp, err := m.Submit(context.Background(), riskSubmission(order.Buy, 1, 100))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	s := syntheticSubmission(order.OCO)
+	s.TakeProfitPrice = 120
+	s.StopLossPrice = 80
+	_, err = m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	err = m.EngageKillSwitch(context.Background(), "test")
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if !m.IsKillSwitchEngaged() {
+		t.Error("expected kill switch to be engaged")
+	}
+	err = m.EngageKillSwitch(context.Background(), "test")
+	if !errors.Is(err, errKillSwitchAlreadyEngaged) {
+		t.Errorf("error '%v', expected '%v'", err, errKillSwitchAlreadyEngaged)
+	}
+	det, err := m.GetByExchangeAndID(testExchange, resp.OrderID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if det.Status != order.Cancelled {
+		t.Errorf("received '%v', expected '%v'", det.Status, order.Cancelled)
+	}
+	orders, err := m.GetSyntheticOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	for i := range orders {
+		if orders[i].Status != SyntheticCancelled {
+			t.Errorf("received '%v', expected '%v'", orders[i].Status, SyntheticCancelled)
+		}
+	}
+
+	_, err = m.Submit(context.Background(), riskSubmission(order.Buy, 1, 100))
+	if !errors.Is(err, ErrKillSwitchEngaged) {
+		t.Errorf("error '%v', expected '%v'", err, ErrKillSwitchEngaged)
+	}
+	_, err = m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, ErrKillSwitchEngaged) {
+		t.Errorf("error '%v', expected '%v'", err, ErrKillSwitchEngaged)
+	}
+
+	err = m.DisengageKillSwitch()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.DisengageKillSwitch()
+	if !errors.Is(err, errKillSwitchNotEngaged) {
+		t.Errorf("error '%v', expected '%v'", err, errKillSwitchNotEngaged)
+	}
+	_, err = m.Submit(context.Background(), riskSubmission(order.Buy, 1, 100))
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+}
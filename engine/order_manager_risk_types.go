@@ -0,0 +1,43 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// riskEventType is the communications and audit event type used for risk
+// control breaches and kill switch changes
+const riskEventType = "risk"
+
+// riskOrderRateWindow is the rolling window MaxOrdersPerMinute is checked
+// against
+const riskOrderRateWindow = time.Minute
+
+var (
+	// ErrKillSwitchEngaged occurs when an order is submitted or modified while
+	// the order manager kill switch is engaged
+	ErrKillSwitchEngaged = errors.New("order manager kill switch engaged, new orders are blocked")
+	// ErrRiskLimitBreached occurs when an order breaches a pre-trade risk
+	// control
+	ErrRiskLimitBreached        = errors.New("order breaches risk limit")
+	errNilRiskControls          = errors.New("nil risk controls received")
+	errRiskControlNegative      = errors.New("risk control limits cannot be negative")
+	errRiskReferencePriceUnset  = errors.New("cannot determine order reference price")
+	errKillSwitchAlreadyEngaged = errors.New("kill switch already engaged")
+	errKillSwitchNotEngaged     = errors.New("kill switch not engaged")
+)
+
+// riskStore holds the pre-trade risk controls along with the state required
+// to enforce them
+type riskStore struct {
+	m                sync.Mutex
+	cfg              config.OrderRiskControls
+	notionalCurrency currency.Code
+	killSwitch       bool
+	// submissions holds recent order submission times by exchange
+	submissions map[string][]time.Time
+}
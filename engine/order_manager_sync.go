@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+// ResyncExchangeOrders flags an exchange so every tracked order is fetched over
+// REST on the next poll. It is called when a websocket connection is
+// established or re-established, as order updates may have been missed while
+// disconnected
+func (m *OrderManager) ResyncExchangeOrders(exchangeName string) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if exchangeName == "" {
+		return errExchangeNameUnset
+	}
+	m.orderSync.m.Lock()
+	defer m.orderSync.m.Unlock()
+	s := m.orderSync.state(exchangeName)
+	s.resync = true
+	s.interval = orderPollMinInterval
+	return nil
+}
+
+// isOrderPollDue returns whether an exchange's orders should be polled over
+// REST and whether that poll is a full resync. The first poll for an exchange
+// is always due
+func (m *OrderManager) isOrderPollDue(exch exchange.IBotExchange) (due, resync bool) {
+	maxInterval := orderPollMaxInterval(exch)
+	m.orderSync.m.Lock()
+	defer m.orderSync.m.Unlock()
+	s := m.orderSync.state(exch.GetName())
+	if s.resync {
+		s.resync = false
+		return true, true
+	}
+	if s.lastPoll.IsZero() {
+		return true, false
+	}
+	interval := s.interval
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+	return time.Since(s.lastPoll) >= interval, false
+}
+
+// orderPolled records a completed poll and adapts the interval until the next
+// one. Polls which find order changes reset the interval to
+// orderPollMinInterval, otherwise the interval is doubled up to the maximum
+// for the exchange
+func (m *OrderManager) orderPolled(exch exchange.IBotExchange, changed bool) {
+	maxInterval := orderPollMaxInterval(exch)
+	m.orderSync.m.Lock()
+	defer m.orderSync.m.Unlock()
+	s := m.orderSync.state(exch.GetName())
+	s.lastPoll = time.Now()
+	if changed || s.interval == 0 {
+		s.interval = orderPollMinInterval
+		return
+	}
+	s.interval *= 2
+	if s.interval > maxInterval {
+		s.interval = maxInterval
+	}
+}
+
+// state returns the poll state for an exchange, creating it if needed. The
+// caller must hold the lock
+func (s *orderSyncStore) state(exchangeName string) *orderSyncState {
+	exchangeName = strings.ToLower(exchangeName)
+	if s.exchanges == nil {
+		s.exchanges = make(map[string]*orderSyncState)
+	}
+	st, ok := s.exchanges[exchangeName]
+	if !ok {
+		st = &orderSyncState{}
+		s.exchanges[exchangeName] = st
+	}
+	return st
+}
+
+// orderPollMaxInterval returns the longest interval between REST order polls
+// for an exchange. REST polling is only a fallback when an authenticated
+// websocket order feed is connected, so a far longer interval is permitted
+func orderPollMaxInterval(exch exchange.IBotExchange) time.Duration {
+	if !exch.IsWebsocketEnabled() ||
+		!exch.GetBase().GetSupportedFeatures().WebsocketCapabilities.GetOrders {
+		return orderManagerDelay
+	}
+	ws, err := exch.GetWebsocket()
+	if err != nil || !ws.IsConnected() || !ws.CanUseAuthenticatedEndpoints() {
+		return orderManagerDelay
+	}
+	return orderPollWebsocketMaxInterval
+}
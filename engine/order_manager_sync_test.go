@@ -0,0 +1,90 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestResyncExchangeOrders(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	err := m.ResyncExchangeOrders(testExchange)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	m = &OrderManager{}
+	err = m.ResyncExchangeOrders(testExchange)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	m, _ = syntheticOrdersSetup(t)
+	err = m.ResyncExchangeOrders("")
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errExchangeNameUnset)
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(testExchange)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	m.orderPolled(exch, false)
+	if due, _ := m.isOrderPollDue(exch); due {
+		t.Error("expected poll to not be due straight after polling")
+	}
+	err = m.ResyncExchangeOrders(testExchange)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	due, resync := m.isOrderPollDue(exch)
+	if !due || !resync {
+		t.Errorf("received due '%v' resync '%v', expected due 'true' resync 'true'", due, resync)
+	}
+	due, resync = m.isOrderPollDue(exch)
+	if due || resync {
+		t.Errorf("received due '%v' resync '%v', expected resync to only be returned once", due, resync)
+	}
+}
+
+func TestOrderPollSchedule(t *testing.T) {
+	t.Parallel()
+	m, _ := syntheticOrdersSetup(t)
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(testExchange)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if max := orderPollMaxInterval(exch); max != orderManagerDelay {
+		t.Errorf("received '%v', expected '%v' without a websocket order feed", max, orderManagerDelay)
+	}
+	due, resync := m.isOrderPollDue(exch)
+	if !due || resync {
+		t.Errorf("received due '%v' resync '%v', expected first poll to be due without resync", due, resync)
+	}
+
+	m.orderPolled(exch, false)
+	state := m.orderSync.state(testExchange)
+	if state.interval != orderPollMinInterval {
+		t.Errorf("received '%v', expected '%v'", state.interval, orderPollMinInterval)
+	}
+	state.lastPoll = time.Now().Add(-orderPollMinInterval)
+	if due, _ = m.isOrderPollDue(exch); !due {
+		t.Error("expected poll to be due once the interval has elapsed")
+	}
+
+	// polls without changes back off up to the maximum interval
+	for i := 0; i < 10; i++ {
+		m.orderPolled(exch, false)
+	}
+	if state.interval != orderManagerDelay {
+		t.Errorf("received '%v', expected '%v'", state.interval, orderManagerDelay)
+	}
+	state.lastPoll = time.Now().Add(-orderManagerDelay / 2)
+	if due, _ = m.isOrderPollDue(exch); due {
+		t.Error("expected poll to not be due before the backed off interval has elapsed")
+	}
+
+	m.orderPolled(exch, true)
+	if state.interval != orderPollMinInterval {
+		t.Errorf("received '%v', expected '%v' after changes were found", state.interval, orderPollMinInterval)
+	}
+}
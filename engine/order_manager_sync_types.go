@@ -0,0 +1,32 @@
+package engine
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// orderPollMinInterval is the shortest interval between REST order polls
+	// for an exchange and the rate at which poll schedules are checked
+	orderPollMinInterval = time.Second * 2
+	// orderPollWebsocketMaxInterval is the longest interval between REST
+	// order polls for an exchange which has a live authenticated websocket
+	// order feed. Exchanges without one are capped at orderManagerDelay
+	orderPollWebsocketMaxInterval = time.Minute * 5
+)
+
+// orderSyncStore holds the REST polling schedule for each exchange
+type orderSyncStore struct {
+	m         sync.Mutex
+	exchanges map[string]*orderSyncState
+}
+
+// orderSyncState defines when an exchange was last polled and whether a full
+// resync has been requested
+type orderSyncState struct {
+	lastPoll time.Time
+	interval time.Duration
+	// resync is set when the websocket connection is (re)established and
+	// forces the next poll to fetch every tracked order
+	resync bool
+}
@@ -0,0 +1,657 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// IsSyntheticOrderType returns whether the order manager can emulate the
+// order type for exchanges which only support market and limit orders
+func IsSyntheticOrderType(t order.Type) bool {
+	switch t {
+	case order.Stop,
+		order.StopLimit,
+		order.TakeProfit,
+		order.TrailingStop,
+		order.OCO,
+		order.Bracket:
+		return true
+	}
+	return false
+}
+
+// SetSyntheticOrderFile sets the file unresolved synthetic orders are saved to
+// so that they survive a restart. It must be called before Start
+func (m *OrderManager) SetSyntheticOrderFile(path string) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if path == "" {
+		return errSyntheticFilePathUnset
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	m.synthetic.m.Lock()
+	m.synthetic.filePath = path
+	m.synthetic.m.Unlock()
+	return nil
+}
+
+// SubmitSynthetic validates and stores an order type which is emulated by the
+// order manager. Trigger orders are armed immediately while bracket exits are
+// armed once the bracket entry order has been filled
+func (m *OrderManager) SubmitSynthetic(ctx context.Context, s *SyntheticSubmit) (*SyntheticSubmitResponse, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if s == nil {
+		return nil, errNilOrder
+	}
+	if !IsSyntheticOrderType(s.Type) {
+		return nil, fmt.Errorf("%w: %v", errSyntheticTypeUnsupported, s.Type)
+	}
+
+	// The plain order which will eventually be sent to the exchange is
+	// validated up front so that a trigger cannot fire an invalid order
+	plain := s.Submit
+	plain.Type = order.Market
+	if s.Price > 0 {
+		plain.Type = order.Limit
+	}
+	err := m.validate(&plain)
+	if err != nil {
+		return nil, err
+	}
+	_, err = m.orderStore.exchangeManager.GetExchangeByName(s.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	switch s.Type {
+	case order.Stop, order.TakeProfit:
+		if s.TriggerPrice <= 0 {
+			return nil, errSyntheticTriggerPriceUnset
+		}
+	case order.StopLimit:
+		if s.TriggerPrice <= 0 {
+			return nil, errSyntheticTriggerPriceUnset
+		}
+		if s.Price <= 0 {
+			return nil, errSyntheticLimitPriceUnset
+		}
+	case order.TrailingStop:
+		if s.TrailingDistance <= 0 {
+			return nil, errSyntheticTrailingUnset
+		}
+	case order.OCO:
+		err = checkSyntheticExitPrices(s.Side, s.TakeProfitPrice, s.StopLossPrice)
+	case order.Bracket:
+		err = checkSyntheticExitPrices(oppositeSide(s.Side), s.TakeProfitPrice, s.StopLossPrice)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	newSynthetic := func(t order.Type, side order.Side, trigger float64) (*SyntheticOrder, error) {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+		return &SyntheticOrder{
+			ID:            id.String(),
+			Exchange:      s.Exchange,
+			Pair:          s.Pair,
+			AssetType:     s.AssetType,
+			Side:          side,
+			Type:          t,
+			Amount:        s.Amount,
+			TriggerPrice:  trigger,
+			ClientOrderID: s.ClientOrderID,
+			Status:        SyntheticArmed,
+			Created:       now,
+			LastUpdated:   now,
+		}, nil
+	}
+
+	var legs []*SyntheticOrder
+	switch s.Type {
+	case order.OCO, order.Bracket:
+		exitSide := s.Side
+		if s.Type == order.Bracket {
+			exitSide = oppositeSide(s.Side)
+		}
+		var takeProfit, stopLoss *SyntheticOrder
+		takeProfit, err = newSynthetic(order.TakeProfit, exitSide, s.TakeProfitPrice)
+		if err != nil {
+			return nil, err
+		}
+		stopLoss, err = newSynthetic(order.Stop, exitSide, s.StopLossPrice)
+		if err != nil {
+			return nil, err
+		}
+		takeProfit.LinkedID = stopLoss.ID
+		stopLoss.LinkedID = takeProfit.ID
+		legs = append(legs, takeProfit, stopLoss)
+	default:
+		var o *SyntheticOrder
+		o, err = newSynthetic(s.Type, s.Side, s.TriggerPrice)
+		if err != nil {
+			return nil, err
+		}
+		o.LimitPrice = s.Price
+		if s.Type == order.TrailingStop {
+			o.TrailingDistance = s.TrailingDistance
+			o.TriggerPrice = 0
+		}
+		legs = append(legs, o)
+	}
+
+	resp := &SyntheticSubmitResponse{}
+	if s.Type == order.Bracket {
+		resp.Entry, err = m.Submit(ctx, &plain)
+		if err != nil {
+			return nil, err
+		}
+		for i := range legs {
+			legs[i].ParentOrderID = resp.Entry.InternalOrderID
+			legs[i].Status = SyntheticPending
+		}
+	}
+
+	m.synthetic.m.Lock()
+	for i := range legs {
+		m.synthetic.orders[legs[i].ID] = legs[i]
+		resp.Orders = append(resp.Orders, *legs[i])
+	}
+	m.saveSyntheticOrders()
+	m.synthetic.m.Unlock()
+
+	for i := range resp.Orders {
+		m.pushSyntheticEvent(&resp.Orders[i], "submitted")
+	}
+
+	if resp.Entry != nil {
+		// A market entry can be filled on submission
+		var entry *order.Detail
+		entry, err = m.orderStore.getByInternalOrderID(resp.Entry.InternalOrderID)
+		if err == nil {
+			m.updateSyntheticParent(entry)
+		}
+		return resp, nil
+	}
+	m.ensureSyntheticWatcher(s.Exchange)
+	return resp, nil
+}
+
+// CancelSyntheticOrder cancels an unresolved synthetic order along with its
+// linked OCO sibling
+func (m *OrderManager) CancelSyntheticOrder(id string) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.synthetic.m.Lock()
+	o, ok := m.synthetic.orders[id]
+	if !ok {
+		m.synthetic.m.Unlock()
+		return fmt.Errorf("%w: %v", ErrSyntheticOrderNotFound, id)
+	}
+	if !o.isUnresolved() {
+		m.synthetic.m.Unlock()
+		return fmt.Errorf("%w: %v is %v", errSyntheticOrderNotCancellable, id, o.Status)
+	}
+	now := time.Now()
+	cancelled := []SyntheticOrder{*o.cancel(now)}
+	if sibling, ok := m.synthetic.orders[o.LinkedID]; ok && sibling.isUnresolved() {
+		cancelled = append(cancelled, *sibling.cancel(now))
+	}
+	m.saveSyntheticOrders()
+	m.synthetic.m.Unlock()
+
+	for i := range cancelled {
+		m.pushSyntheticEvent(&cancelled[i], "cancelled")
+	}
+	return nil
+}
+
+// GetSyntheticOrders returns a copy of all synthetic orders sorted by creation
+// time
+func (m *OrderManager) GetSyntheticOrders() ([]SyntheticOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.synthetic.m.Lock()
+	defer m.synthetic.m.Unlock()
+	resp := make([]SyntheticOrder, 0, len(m.synthetic.orders))
+	for _, o := range m.synthetic.orders {
+		resp = append(resp, *o)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Created.Before(resp[j].Created)
+	})
+	return resp, nil
+}
+
+// isSyntheticOrder returns whether the ID belongs to a synthetic order
+func (m *OrderManager) isSyntheticOrder(id string) bool {
+	m.synthetic.m.Lock()
+	defer m.synthetic.m.Unlock()
+	_, ok := m.synthetic.orders[id]
+	return ok
+}
+
+// updateSyntheticParent arms pending bracket exits once their entry order has
+// been filled. Exits of an entry cancelled after a partial fill are resized
+// to the executed amount and exits of an unfilled entry are cancelled
+func (m *OrderManager) updateSyntheticParent(det *order.Detail) {
+	if det == nil || det.InternalOrderID == "" || !det.IsInactive() {
+		return
+	}
+	var updated []SyntheticOrder
+	var armed bool
+	now := time.Now()
+	m.synthetic.m.Lock()
+	for _, o := range m.synthetic.orders {
+		if o.Status != SyntheticPending || o.ParentOrderID != det.InternalOrderID {
+			continue
+		}
+		switch {
+		case det.ExecutedAmount > 0:
+			if det.ExecutedAmount < o.Amount {
+				o.Amount = det.ExecutedAmount
+			}
+			fallthrough
+		case det.Status == order.Filled:
+			o.Status = SyntheticArmed
+			o.LastUpdated = now
+			armed = true
+		default:
+			o.cancel(now)
+		}
+		updated = append(updated, *o)
+	}
+	if len(updated) > 0 {
+		m.saveSyntheticOrders()
+	}
+	m.synthetic.m.Unlock()
+
+	for i := range updated {
+		m.pushSyntheticEvent(&updated[i], strings.ToLower(string(updated[i].Status)))
+	}
+	if armed {
+		m.ensureSyntheticWatcher(det.Exchange)
+	}
+}
+
+// watchArmedSyntheticOrders ensures every exchange with armed synthetic
+// orders has a ticker subscription. Subscriptions fail until an exchange has
+// published ticker data so this is retried on each order manager cycle
+func (m *OrderManager) watchArmedSyntheticOrders() {
+	exchanges := make(map[string]string)
+	m.synthetic.m.Lock()
+	for _, o := range m.synthetic.orders {
+		if o.Status == SyntheticArmed {
+			exchanges[strings.ToLower(o.Exchange)] = o.Exchange
+		}
+	}
+	m.synthetic.m.Unlock()
+	for _, exchangeName := range exchanges {
+		m.ensureSyntheticWatcher(exchangeName)
+	}
+}
+
+// ensureSyntheticWatcher subscribes to an exchange's ticker updates if there
+// is not already an active subscription
+func (m *OrderManager) ensureSyntheticWatcher(exchangeName string) {
+	if atomic.LoadInt32(&m.started) == 0 {
+		return
+	}
+	key := strings.ToLower(exchangeName)
+	m.synthetic.m.Lock()
+	defer m.synthetic.m.Unlock()
+	if m.synthetic.watchers[key] {
+		return
+	}
+	pipe, err := ticker.SubscribeToExchangeTickers(exchangeName)
+	if err != nil {
+		if m.verbose {
+			log.Debugf(log.OrderMgr,
+				"Order manager: Synthetic orders waiting for %v ticker data: %s",
+				exchangeName,
+				err)
+		}
+		return
+	}
+	m.synthetic.watchers[key] = true
+	m.orderStore.wg.Add(1)
+	go m.watchSyntheticPrices(key, pipe, m.shutdown)
+}
+
+// watchSyntheticPrices processes ticker updates for an exchange until there
+// are no armed synthetic orders left or the order manager shuts down
+func (m *OrderManager) watchSyntheticPrices(exchangeName string, pipe dispatch.Pipe, shutdown chan struct{}) {
+	defer func() {
+		err := pipe.Release()
+		if err != nil {
+			log.Error(log.OrderMgr, err)
+		}
+		m.synthetic.m.Lock()
+		delete(m.synthetic.watchers, exchangeName)
+		m.synthetic.m.Unlock()
+		m.orderStore.wg.Done()
+	}()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case data, ok := <-pipe.C:
+			if !ok {
+				return
+			}
+			t, ok := (*data.(*interface{})).(ticker.Price)
+			if !ok {
+				continue
+			}
+			if !m.processSyntheticPrice(&t) {
+				return
+			}
+		}
+	}
+}
+
+// processSyntheticPrice evaluates armed synthetic orders against a ticker
+// update, submitting the child order of each one which triggers. It returns
+// whether the exchange still has armed synthetic orders to watch
+func (m *OrderManager) processSyntheticPrice(t *ticker.Price) bool {
+	var fired []SyntheticOrder
+	var changed bool
+	now := time.Now()
+	m.synthetic.m.Lock()
+	for _, o := range m.synthetic.orders {
+		if o.Status != SyntheticArmed ||
+			!strings.EqualFold(o.Exchange, t.ExchangeName) ||
+			o.AssetType != t.AssetType ||
+			!o.Pair.Equal(t.Pair) {
+			continue
+		}
+		if sibling, ok := m.synthetic.orders[o.LinkedID]; ok && sibling.Status == SyntheticTriggered {
+			// The linked order's child is being submitted, this order is
+			// cancelled once it is accepted
+			continue
+		}
+		price := tickerSidePrice(o.Side, t)
+		if price <= 0 {
+			continue
+		}
+		triggered, updated := o.evaluate(price)
+		if updated {
+			o.LastUpdated = now
+			changed = true
+		}
+		if !triggered {
+			continue
+		}
+		o.Status = SyntheticTriggered
+		o.LastUpdated = now
+		changed = true
+		fired = append(fired, *o)
+	}
+	if changed {
+		m.saveSyntheticOrders()
+	}
+	m.synthetic.m.Unlock()
+
+	for i := range fired {
+		m.submitSyntheticChild(&fired[i])
+	}
+
+	m.synthetic.m.Lock()
+	defer m.synthetic.m.Unlock()
+	for _, o := range m.synthetic.orders {
+		if o.Status == SyntheticArmed && strings.EqualFold(o.Exchange, t.ExchangeName) {
+			return true
+		}
+	}
+	return false
+}
+
+// submitSyntheticChild sends the order held by a triggered synthetic order
+// to the exchange. A linked OCO sibling is cancelled once the child order has
+// been accepted and is left armed if it is rejected so that the position
+// keeps its remaining exit
+func (m *OrderManager) submitSyntheticChild(o *SyntheticOrder) {
+	child := &order.Submit{
+		Exchange:      o.Exchange,
+		Pair:          o.Pair,
+		AssetType:     o.AssetType,
+		Side:          o.Side,
+		Type:          order.Market,
+		Amount:        o.Amount,
+		ClientOrderID: o.ClientOrderID,
+	}
+	if o.LimitPrice > 0 {
+		child.Type = order.Limit
+		child.Price = o.LimitPrice
+	}
+	resp, err := m.Submit(context.TODO(), child)
+
+	var cancelled *SyntheticOrder
+	now := time.Now()
+	m.synthetic.m.Lock()
+	stored, ok := m.synthetic.orders[o.ID]
+	if ok {
+		if err != nil {
+			stored.Status = SyntheticFailed
+			stored.FailureReason = err.Error()
+		} else {
+			stored.ChildOrderID = resp.OrderID
+			if sibling, ok := m.synthetic.orders[stored.LinkedID]; ok && sibling.isUnresolved() {
+				c := *sibling.cancel(now)
+				cancelled = &c
+			}
+		}
+		stored.LastUpdated = now
+		*o = *stored
+		m.saveSyntheticOrders()
+	}
+	m.synthetic.m.Unlock()
+
+	if cancelled != nil {
+		m.pushSyntheticEvent(cancelled, "cancelled by linked order")
+	}
+
+	if err != nil {
+		log.Errorf(log.OrderMgr,
+			"Order manager: Synthetic %v order %v unable to submit child order: %s",
+			o.Type,
+			o.ID,
+			err)
+		m.pushSyntheticEvent(o, "failed")
+		return
+	}
+	m.pushSyntheticEvent(o, "triggered")
+}
+
+// pushSyntheticEvent logs and relays a synthetic order state change
+func (m *OrderManager) pushSyntheticEvent(o *SyntheticOrder, action string) {
+	msg := fmt.Sprintf("Order manager: Exchange %s synthetic %v order ID=%v %s pair=%v side=%v amount=%v trigger=%v child=%v.",
+		o.Exchange,
+		o.Type,
+		o.ID,
+		action,
+		o.Pair,
+		o.Side,
+		o.Amount,
+		o.TriggerPrice,
+		o.ChildOrderID)
+	log.Debugln(log.OrderMgr, msg)
+	m.orderStore.commsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+}
+
+// saveSyntheticOrders writes all unresolved synthetic orders to file. The
+// caller must hold the synthetic store lock
+func (m *OrderManager) saveSyntheticOrders() {
+	if m.synthetic.filePath == "" {
+		return
+	}
+	unresolved := make([]*SyntheticOrder, 0, len(m.synthetic.orders))
+	for _, o := range m.synthetic.orders {
+		if o.isUnresolved() {
+			unresolved = append(unresolved, o)
+		}
+	}
+	sort.Slice(unresolved, func(i, j int) bool {
+		return unresolved[i].Created.Before(unresolved[j].Created)
+	})
+	payload, err := json.MarshalIndent(unresolved, "", " ")
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to encode synthetic orders: %s", err)
+		return
+	}
+	err = file.Write(m.synthetic.filePath, payload)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to save synthetic orders: %s", err)
+	}
+}
+
+// loadSyntheticOrders restores unresolved synthetic orders saved by a
+// previous run
+func (m *OrderManager) loadSyntheticOrders() error {
+	m.synthetic.m.Lock()
+	defer m.synthetic.m.Unlock()
+	if m.synthetic.filePath == "" || !file.Exists(m.synthetic.filePath) {
+		return nil
+	}
+	payload, err := ioutil.ReadFile(m.synthetic.filePath)
+	if err != nil {
+		return err
+	}
+	var orders []SyntheticOrder
+	err = json.Unmarshal(payload, &orders)
+	if err != nil {
+		return err
+	}
+	var restored int
+	for i := range orders {
+		if !orders[i].isUnresolved() {
+			continue
+		}
+		m.synthetic.orders[orders[i].ID] = &orders[i]
+		restored++
+	}
+	log.Debugf(log.OrderMgr, "Order manager: Restored %d synthetic orders.", restored)
+	return nil
+}
+
+// evaluate checks whether the price meets the trigger condition, moving the
+// trigger price of a trailing stop when the price moves in its favour. It
+// returns whether the order triggered and whether its state was updated
+func (o *SyntheticOrder) evaluate(price float64) (triggered, updated bool) {
+	sell := o.Side == order.Sell || o.Side == order.Ask
+	switch o.Type {
+	case order.Stop, order.StopLimit:
+		if sell {
+			return price <= o.TriggerPrice, false
+		}
+		return price >= o.TriggerPrice, false
+	case order.TakeProfit:
+		if sell {
+			return price >= o.TriggerPrice, false
+		}
+		return price <= o.TriggerPrice, false
+	case order.TrailingStop:
+		if sell {
+			if price > o.Watermark {
+				o.Watermark = price
+				o.TriggerPrice = price - o.TrailingDistance
+				updated = true
+			}
+			return price <= o.TriggerPrice, updated
+		}
+		if o.Watermark == 0 || price < o.Watermark {
+			o.Watermark = price
+			o.TriggerPrice = price + o.TrailingDistance
+			updated = true
+		}
+		return price >= o.TriggerPrice, updated
+	}
+	return false, false
+}
+
+// isUnresolved returns whether the synthetic order has yet to trigger or be
+// cancelled
+func (o *SyntheticOrder) isUnresolved() bool {
+	return o.Status == SyntheticArmed || o.Status == SyntheticPending
+}
+
+// cancel marks the synthetic order as cancelled and returns it
+func (o *SyntheticOrder) cancel(now time.Time) *SyntheticOrder {
+	o.Status = SyntheticCancelled
+	o.LastUpdated = now
+	return o
+}
+
+// tickerSidePrice returns the price an order on the given side would execute
+// against, falling back to the last traded price
+func tickerSidePrice(side order.Side, t *ticker.Price) float64 {
+	switch {
+	case (side == order.Sell || side == order.Ask) && t.Bid > 0:
+		return t.Bid
+	case (side == order.Buy || side == order.Bid) && t.Ask > 0:
+		return t.Ask
+	}
+	return t.Last
+}
+
+// checkSyntheticExitPrices ensures the take profit and stop loss prices of an
+// exit on the given side do not overlap
+func checkSyntheticExitPrices(exitSide order.Side, takeProfit, stopLoss float64) error {
+	if takeProfit <= 0 || stopLoss <= 0 {
+		return errSyntheticExitPricesUnset
+	}
+	if exitSide == order.Sell || exitSide == order.Ask {
+		if takeProfit <= stopLoss {
+			return errSyntheticExitPricesInvalid
+		}
+		return nil
+	}
+	if takeProfit >= stopLoss {
+		return errSyntheticExitPricesInvalid
+	}
+	return nil
+}
+
+// oppositeSide returns the side which closes a position opened by side
+func oppositeSide(side order.Side) order.Side {
+	if side == order.Sell || side == order.Ask {
+		return order.Buy
+	}
+	return order.Sell
+}
@@ -0,0 +1,668 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// omsExchange extends the order manager fake exchange to accept submitted
+// orders so that synthetic child orders can be placed without API calls
+type omsExchange struct {
+	omfExchange
+	submitted *int64
+}
+
+// SubmitOrder overrides testExchange's submit order function
+func (f omsExchange) SubmitOrder(_ context.Context, s *order.Submit) (order.SubmitResponse, error) {
+	id := atomic.AddInt64(f.submitted, 1)
+	return order.SubmitResponse{
+		IsOrderPlaced: true,
+		FullyMatched:  s.Type == order.Market,
+		OrderID:       "synthetic-test-" + strconv.FormatInt(id, 10),
+	}, nil
+}
+
+// CanTradePair overrides testExchange's currency state check which requires
+// the currency state manager
+func (f omsExchange) CanTradePair(currency.Pair, asset.Item) error {
+	return nil
+}
+
+func syntheticOrdersSetup(t *testing.T) (*OrderManager, *int64) {
+	t.Helper()
+	var wg sync.WaitGroup
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	submitted := new(int64)
+	em.Add(omsExchange{
+		omfExchange: omfExchange{IBotExchange: exch},
+		submitted:   submitted,
+	})
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	m.started = 1
+	return m, submitted
+}
+
+func syntheticSubmission(t order.Type) *SyntheticSubmit {
+	return &SyntheticSubmit{
+		Submit: order.Submit{
+			Exchange:  testExchange,
+			Pair:      currency.NewPair(currency.BTC, currency.USD),
+			AssetType: asset.Spot,
+			Side:      order.Sell,
+			Type:      t,
+			Amount:    1,
+		},
+	}
+}
+
+func syntheticTicker(bid, ask float64) *ticker.Price {
+	return &ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Bid:          bid,
+		Ask:          ask,
+		Last:         (bid + ask) / 2,
+	}
+}
+
+func TestIsSyntheticOrderType(t *testing.T) {
+	t.Parallel()
+	for _, oType := range []order.Type{order.Stop, order.StopLimit, order.TakeProfit, order.TrailingStop, order.OCO, order.Bracket} {
+		if !IsSyntheticOrderType(oType) {
+			t.Errorf("expected %v to be emulated", oType)
+		}
+	}
+	for _, oType := range []order.Type{order.Market, order.Limit, order.StopMarket} {
+		if IsSyntheticOrderType(oType) {
+			t.Errorf("expected %v to not be emulated", oType)
+		}
+	}
+}
+
+func TestSetSyntheticOrderFile(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	err := m.SetSyntheticOrderFile("test")
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	m, _ = syntheticOrdersSetup(t)
+	err = m.SetSyntheticOrderFile("")
+	if !errors.Is(err, errSyntheticFilePathUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errSyntheticFilePathUnset)
+	}
+	err = m.SetSyntheticOrderFile("test")
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	m.started = 0
+	err = m.SetSyntheticOrderFile("test")
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestSubmitSynthetic(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.SubmitSynthetic(context.Background(), nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	m, _ = syntheticOrdersSetup(t)
+	m.started = 0
+	_, err = m.SubmitSynthetic(context.Background(), nil)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	m.started = 1
+	_, err = m.SubmitSynthetic(context.Background(), nil)
+	if !errors.Is(err, errNilOrder) {
+		t.Errorf("error '%v', expected '%v'", err, errNilOrder)
+	}
+	_, err = m.SubmitSynthetic(context.Background(), syntheticSubmission(order.Market))
+	if !errors.Is(err, errSyntheticTypeUnsupported) {
+		t.Errorf("error '%v', expected '%v'", err, errSyntheticTypeUnsupported)
+	}
+
+	s := syntheticSubmission(order.Stop)
+	s.Amount = 0
+	_, err = m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, order.ErrAmountIsInvalid) {
+		t.Errorf("error '%v', expected '%v'", err, order.ErrAmountIsInvalid)
+	}
+	_, err = m.SubmitSynthetic(context.Background(), syntheticSubmission(order.Stop))
+	if !errors.Is(err, errSyntheticTriggerPriceUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errSyntheticTriggerPriceUnset)
+	}
+	s = syntheticSubmission(order.StopLimit)
+	s.TriggerPrice = 100
+	_, err = m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, errSyntheticLimitPriceUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errSyntheticLimitPriceUnset)
+	}
+	_, err = m.SubmitSynthetic(context.Background(), syntheticSubmission(order.TrailingStop))
+	if !errors.Is(err, errSyntheticTrailingUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errSyntheticTrailingUnset)
+	}
+	_, err = m.SubmitSynthetic(context.Background(), syntheticSubmission(order.OCO))
+	if !errors.Is(err, errSyntheticExitPricesUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errSyntheticExitPricesUnset)
+	}
+	s = syntheticSubmission(order.OCO)
+	s.TakeProfitPrice = 90
+	s.StopLossPrice = 100
+	_, err = m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, errSyntheticExitPricesInvalid) {
+		t.Errorf("error '%v', expected '%v'", err, errSyntheticExitPricesInvalid)
+	}
+
+	s = syntheticSubmission(order.StopLimit)
+	s.TriggerPrice = 100
+	s.Price = 99
+	resp, err := m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(resp.Orders) != 1 || resp.Entry != nil {
+		t.Fatalf("received '%+v', expected a single order", resp)
+	}
+	if resp.Orders[0].Status != SyntheticArmed || resp.Orders[0].LimitPrice != 99 {
+		t.Errorf("received '%+v', expected an armed stop limit order", resp.Orders[0])
+	}
+
+	s = syntheticSubmission(order.OCO)
+	s.TakeProfitPrice = 120
+	s.StopLossPrice = 80
+	resp, err = m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(resp.Orders) != 2 {
+		t.Fatalf("received '%v' orders, expected '%v'", len(resp.Orders), 2)
+	}
+	if resp.Orders[0].Type != order.TakeProfit || resp.Orders[1].Type != order.Stop {
+		t.Errorf("received '%v' '%v', expected take profit and stop legs", resp.Orders[0].Type, resp.Orders[1].Type)
+	}
+	if resp.Orders[0].LinkedID != resp.Orders[1].ID || resp.Orders[1].LinkedID != resp.Orders[0].ID {
+		t.Error("expected OCO legs to be linked")
+	}
+
+	orders, err := m.GetSyntheticOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(orders) != 3 {
+		t.Errorf("received '%v' orders, expected '%v'", len(orders), 3)
+	}
+}
+
+func TestSyntheticOrderEvaluate(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name    string
+		order   SyntheticOrder
+		prices  []float64
+		trigger []bool
+	}{
+		{
+			name:    "sell stop",
+			order:   SyntheticOrder{Type: order.Stop, Side: order.Sell, TriggerPrice: 100},
+			prices:  []float64{101, 100},
+			trigger: []bool{false, true},
+		},
+		{
+			name:    "buy stop",
+			order:   SyntheticOrder{Type: order.StopLimit, Side: order.Buy, TriggerPrice: 100},
+			prices:  []float64{99, 100},
+			trigger: []bool{false, true},
+		},
+		{
+			name:    "sell take profit",
+			order:   SyntheticOrder{Type: order.TakeProfit, Side: order.Sell, TriggerPrice: 100},
+			prices:  []float64{99, 101},
+			trigger: []bool{false, true},
+		},
+		{
+			name:    "buy take profit",
+			order:   SyntheticOrder{Type: order.TakeProfit, Side: order.Buy, TriggerPrice: 100},
+			prices:  []float64{101, 100},
+			trigger: []bool{false, true},
+		},
+		{
+			name:    "sell trailing stop",
+			order:   SyntheticOrder{Type: order.TrailingStop, Side: order.Sell, TrailingDistance: 10},
+			prices:  []float64{100, 120, 111, 110},
+			trigger: []bool{false, false, false, true},
+		},
+		{
+			name:    "buy trailing stop",
+			order:   SyntheticOrder{Type: order.TrailingStop, Side: order.Buy, TrailingDistance: 10},
+			prices:  []float64{100, 80, 89, 90},
+			trigger: []bool{false, false, false, true},
+		},
+	}
+	for x := range testCases {
+		tt := testCases[x]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for i := range tt.prices {
+				triggered, _ := tt.order.evaluate(tt.prices[i])
+				if triggered != tt.trigger[i] {
+					t.Errorf("price %v received '%v', expected '%v'", tt.prices[i], triggered, tt.trigger[i])
+				}
+			}
+		})
+	}
+}
+
+func TestProcessSyntheticPrice(t *testing.T) {
+	t.Parallel()
+	m, submitted := syntheticOrdersSetup(t)
+	s := syntheticSubmission(order.OCO)
+	s.TakeProfitPrice = 120
+	s.StopLossPrice = 80
+	resp, err := m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	trailing := syntheticSubmission(order.TrailingStop)
+	trailing.TrailingDistance = 5
+	trailingResp, err := m.SubmitSynthetic(context.Background(), trailing)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	if !m.processSyntheticPrice(syntheticTicker(100, 101)) {
+		t.Error("expected orders to still be watched")
+	}
+	if atomic.LoadInt64(submitted) != 0 {
+		t.Errorf("received '%v' child orders, expected '%v'", atomic.LoadInt64(submitted), 0)
+	}
+
+	// Bid falls through the stop loss and the trailing stop
+	if m.processSyntheticPrice(syntheticTicker(79, 80)) {
+		t.Error("expected no orders left to watch")
+	}
+	if atomic.LoadInt64(submitted) != 2 {
+		t.Errorf("received '%v' child orders, expected '%v'", atomic.LoadInt64(submitted), 2)
+	}
+	orders, err := m.GetSyntheticOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	statuses := make(map[string]SyntheticOrder)
+	for i := range orders {
+		statuses[orders[i].ID] = orders[i]
+	}
+	takeProfit := statuses[resp.Orders[0].ID]
+	if takeProfit.Status != SyntheticCancelled {
+		t.Errorf("received '%v', expected '%v'", takeProfit.Status, SyntheticCancelled)
+	}
+	stopLoss := statuses[resp.Orders[1].ID]
+	if stopLoss.Status != SyntheticTriggered || stopLoss.ChildOrderID == "" {
+		t.Errorf("received '%+v', expected a triggered order with a child", stopLoss)
+	}
+	trailingStop := statuses[trailingResp.Orders[0].ID]
+	if trailingStop.Status != SyntheticTriggered || trailingStop.Watermark != 100 {
+		t.Errorf("received '%+v', expected a triggered order with a watermark of 100", trailingStop)
+	}
+	child, err := m.orderStore.getByExchangeAndID(testExchange, stopLoss.ChildOrderID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if child.Type != order.Market || child.Side != order.Sell {
+		t.Errorf("received '%v' '%v', expected a market sell", child.Type, child.Side)
+	}
+}
+
+func TestProcessSyntheticPriceSubmitFailure(t *testing.T) {
+	t.Parallel()
+	m, submitted := syntheticOrdersSetup(t)
+	s := syntheticSubmission(order.OCO)
+	s.TakeProfitPrice = 120
+	s.StopLossPrice = 80
+	resp, err := m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	// The exchange is unavailable when the stop loss triggers
+	em, ok := m.orderStore.exchangeManager.(*ExchangeManager)
+	if !ok {
+		t.Fatal("unexpected exchange manager type")
+	}
+	exch, err := em.GetExchangeByName(testExchange)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = em.RemoveExchange(testExchange)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if !m.processSyntheticPrice(syntheticTicker(79, 80)) {
+		t.Error("expected the take profit to still be watched")
+	}
+	if atomic.LoadInt64(submitted) != 0 {
+		t.Errorf("received '%v' child orders, expected '%v'", atomic.LoadInt64(submitted), 0)
+	}
+	orders, err := m.GetSyntheticOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	statuses := make(map[string]SyntheticOrder)
+	for i := range orders {
+		statuses[orders[i].ID] = orders[i]
+	}
+	if stopLoss := statuses[resp.Orders[1].ID]; stopLoss.Status != SyntheticFailed || stopLoss.FailureReason == "" {
+		t.Errorf("received '%+v', expected a failed order with a reason", stopLoss)
+	}
+	if takeProfit := statuses[resp.Orders[0].ID]; takeProfit.Status != SyntheticArmed {
+		t.Errorf("received '%v', expected '%v'", takeProfit.Status, SyntheticArmed)
+	}
+
+	// The remaining exit still protects the position
+	em.Add(exch)
+	if m.processSyntheticPrice(syntheticTicker(120, 121)) {
+		t.Error("expected no orders left to watch")
+	}
+	if atomic.LoadInt64(submitted) != 1 {
+		t.Errorf("received '%v' child orders, expected '%v'", atomic.LoadInt64(submitted), 1)
+	}
+	orders, err = m.GetSyntheticOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	for i := range orders {
+		if orders[i].ID == resp.Orders[0].ID && (orders[i].Status != SyntheticTriggered || orders[i].ChildOrderID == "") {
+			t.Errorf("received '%+v', expected a triggered order with a child", orders[i])
+		}
+	}
+}
+
+func TestBracketSyntheticOrder(t *testing.T) {
+	t.Parallel()
+	m, _ := syntheticOrdersSetup(t)
+	s := syntheticSubmission(order.Bracket)
+	s.Side = order.Buy
+	s.Price = 100
+	s.TakeProfitPrice = 80
+	s.StopLossPrice = 120
+	_, err := m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, errSyntheticExitPricesInvalid) {
+		t.Errorf("error '%v', expected '%v'", err, errSyntheticExitPricesInvalid)
+	}
+
+	s.TakeProfitPrice = 120
+	s.StopLossPrice = 80
+	resp, err := m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Entry == nil || len(resp.Orders) != 2 {
+		t.Fatalf("received '%+v', expected an entry with two exits", resp)
+	}
+	for i := range resp.Orders {
+		if resp.Orders[i].Status != SyntheticPending || resp.Orders[i].Side != order.Sell {
+			t.Errorf("received '%+v', expected a pending sell exit", resp.Orders[i])
+		}
+	}
+
+	// entry is partially filled and then cancelled
+	entry, err := m.orderStore.getByInternalOrderID(resp.Entry.InternalOrderID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	update := entry.Copy()
+	update.Status = order.Cancelled
+	update.ExecutedAmount = 0.4
+	_, err = m.UpsertOrder(&update)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	orders, err := m.GetSyntheticOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	for i := range orders {
+		if orders[i].Status != SyntheticArmed || orders[i].Amount != 0.4 {
+			t.Errorf("received '%+v', expected an armed exit resized to the fill", orders[i])
+		}
+	}
+
+	// an unfilled entry cancels its exits
+	resp, err = m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Cancel(context.Background(), &order.Cancel{
+		Exchange:  testExchange,
+		ID:        resp.Entry.OrderID,
+		Pair:      s.Pair,
+		AssetType: s.AssetType,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	orders, err = m.GetSyntheticOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	for i := range orders {
+		if orders[i].ParentOrderID == resp.Entry.InternalOrderID && orders[i].Status != SyntheticCancelled {
+			t.Errorf("received '%v', expected '%v'", orders[i].Status, SyntheticCancelled)
+		}
+	}
+
+	// a market entry fills on submission and arms its exits immediately
+	s.Price = 0
+	resp, err = m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	orders, err = m.GetSyntheticOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	for i := range orders {
+		if orders[i].ParentOrderID == resp.Entry.InternalOrderID && orders[i].Status != SyntheticArmed {
+			t.Errorf("received '%v', expected '%v'", orders[i].Status, SyntheticArmed)
+		}
+	}
+}
+
+func TestCancelSyntheticOrder(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	err := m.CancelSyntheticOrder("")
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	m, _ = syntheticOrdersSetup(t)
+	err = m.CancelSyntheticOrder("fake")
+	if !errors.Is(err, ErrSyntheticOrderNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSyntheticOrderNotFound)
+	}
+
+	s := syntheticSubmission(order.OCO)
+	s.TakeProfitPrice = 120
+	s.StopLossPrice = 80
+	resp, err := m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	// synthetic orders are cancelled through the regular cancel path
+	err = m.Cancel(context.Background(), &order.Cancel{
+		Exchange: testExchange,
+		ID:       resp.Orders[0].ID,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	orders, err := m.GetSyntheticOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	for i := range orders {
+		if orders[i].Status != SyntheticCancelled {
+			t.Errorf("received '%v', expected '%v'", orders[i].Status, SyntheticCancelled)
+		}
+	}
+	err = m.CancelSyntheticOrder(resp.Orders[1].ID)
+	if !errors.Is(err, errSyntheticOrderNotCancellable) {
+		t.Errorf("error '%v', expected '%v'", err, errSyntheticOrderNotCancellable)
+	}
+}
+
+func TestSyntheticOrderPersistence(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "synthetic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}()
+	path := filepath.Join(dir, syntheticOrderFileName)
+
+	m, _ := syntheticOrdersSetup(t)
+	m.synthetic.filePath = path
+	s := syntheticSubmission(order.TrailingStop)
+	s.TrailingDistance = 5
+	resp, err := m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	s = syntheticSubmission(order.Stop)
+	s.TriggerPrice = 50
+	cancelled, err := m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.CancelSyntheticOrder(cancelled.Orders[0].ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	m.processSyntheticPrice(syntheticTicker(100, 101))
+
+	restored, _ := syntheticOrdersSetup(t)
+	restored.started = 0
+	err = restored.SetSyntheticOrderFile(path)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = restored.loadSyntheticOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	restored.started = 1
+	orders, err := restored.GetSyntheticOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(orders) != 1 {
+		t.Fatalf("received '%v' orders, expected '%v'", len(orders), 1)
+	}
+	if orders[0].ID != resp.Orders[0].ID || orders[0].Watermark != 100 || orders[0].TriggerPrice != 95 {
+		t.Errorf("received '%+v', expected the trailing stop with its watermark", orders[0])
+	}
+	if !orders[0].Pair.Equal(s.Pair) {
+		t.Errorf("received '%v', expected '%v'", orders[0].Pair, s.Pair)
+	}
+
+	err = ioutil.WriteFile(path, []byte("bad"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = restored.loadSyntheticOrders()
+	if err == nil {
+		t.Error("expected error loading malformed synthetic orders")
+	}
+}
+
+func TestSyntheticOrderWatcher(t *testing.T) {
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := dispatch.Stop(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	m, submitted := syntheticOrdersSetup(t)
+	m.shutdown = make(chan struct{})
+	defer func() {
+		// release the watcher's pipe before dispatch is stopped
+		close(m.shutdown)
+		m.orderStore.wg.Wait()
+	}()
+
+	s := syntheticSubmission(order.Stop)
+	s.TriggerPrice = 90
+	_, err := m.SubmitSynthetic(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	// no ticker data has been published yet so the watcher is retried later
+	m.synthetic.m.Lock()
+	watching := m.synthetic.watchers[strings.ToLower(testExchange)]
+	m.synthetic.m.Unlock()
+	if watching {
+		t.Fatal("expected watcher to wait for ticker data")
+	}
+
+	err = ticker.ProcessTicker(syntheticTicker(100, 101))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.watchArmedSyntheticOrders()
+	m.synthetic.m.Lock()
+	watching = m.synthetic.watchers[strings.ToLower(testExchange)]
+	m.synthetic.m.Unlock()
+	if !watching {
+		t.Fatal("expected watcher to subscribe to ticker data")
+	}
+
+	// dispatch drops updates when the receiver is busy so keep publishing
+	// until the watcher picks one up
+	deadline := time.Now().Add(time.Second * 5)
+	for atomic.LoadInt64(submitted) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("synthetic order was not triggered by ticker update")
+		}
+		err = ticker.ProcessTicker(syntheticTicker(89, 90))
+		if err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+}
@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// SyntheticStatus defines the lifecycle state of an order emulated by the
+// order manager
+type SyntheticStatus string
+
+// Synthetic order statuses
+const (
+	// SyntheticPending orders are bracket legs waiting for the entry to fill
+	SyntheticPending SyntheticStatus = "PENDING"
+	// SyntheticArmed orders are watching market prices for their trigger
+	SyntheticArmed SyntheticStatus = "ARMED"
+	// SyntheticTriggered orders have submitted their child order
+	SyntheticTriggered SyntheticStatus = "TRIGGERED"
+	// SyntheticCancelled orders were cancelled by the user or a linked order
+	SyntheticCancelled SyntheticStatus = "CANCELLED"
+	// SyntheticFailed orders were triggered but the child order was rejected
+	SyntheticFailed SyntheticStatus = "FAILED"
+)
+
+// syntheticOrderFileName is the data directory file unresolved synthetic
+// orders are saved to
+const syntheticOrderFileName = "syntheticorders.json"
+
+var (
+	// ErrSyntheticOrderNotFound occurs when a synthetic order ID is unknown
+	ErrSyntheticOrderNotFound       = errors.New("synthetic order not found")
+	errSyntheticTypeUnsupported     = errors.New("order type cannot be emulated")
+	errSyntheticTriggerPriceUnset   = errors.New("trigger price must be set")
+	errSyntheticLimitPriceUnset     = errors.New("limit price must be set for a stop limit order")
+	errSyntheticTrailingUnset       = errors.New("trailing distance must be set for a trailing stop order")
+	errSyntheticExitPricesUnset     = errors.New("take profit and stop loss prices must be set")
+	errSyntheticExitPricesInvalid   = errors.New("take profit price must be on the profitable side of the stop loss price")
+	errSyntheticOrderNotCancellable = errors.New("synthetic order can no longer be cancelled")
+	errSyntheticFilePathUnset       = errors.New("synthetic order file path is not set")
+)
+
+// SyntheticSubmit contains the parameters of an order type which is emulated
+// by the order manager on top of plain market and limit orders.
+// The embedded Submit Type selects the behaviour:
+// Stop, StopLimit, TakeProfit and TrailingStop place a single trigger order
+// which submits a limit order at Price, or a market order when Price is zero,
+// OCO places a linked take profit and stop loss pair of market exits and
+// Bracket submits an entry order, market when Price is zero, with an OCO exit
+// that is armed once the entry fills
+type SyntheticSubmit struct {
+	order.Submit
+	// TrailingDistance is the absolute price distance a trailing stop follows
+	// the best price seen since it was armed
+	TrailingDistance float64
+	// TakeProfitPrice is the take profit trigger for OCO and Bracket orders
+	TakeProfitPrice float64
+	// StopLossPrice is the stop loss trigger for OCO and Bracket orders
+	StopLossPrice float64
+}
+
+// SyntheticOrder is an order held by the order manager which submits a child
+// order to the exchange once its trigger condition is met
+type SyntheticOrder struct {
+	ID               string          `json:"id"`
+	Exchange         string          `json:"exchange"`
+	Pair             currency.Pair   `json:"pair"`
+	AssetType        asset.Item      `json:"asset"`
+	Side             order.Side      `json:"side"`
+	Type             order.Type      `json:"type"`
+	Amount           float64         `json:"amount"`
+	TriggerPrice     float64         `json:"triggerPrice"`
+	LimitPrice       float64         `json:"limitPrice,omitempty"`
+	TrailingDistance float64         `json:"trailingDistance,omitempty"`
+	Watermark        float64         `json:"watermark,omitempty"`
+	LinkedID         string          `json:"linkedID,omitempty"`
+	ParentOrderID    string          `json:"parentOrderID,omitempty"`
+	ChildOrderID     string          `json:"childOrderID,omitempty"`
+	ClientOrderID    string          `json:"clientOrderID,omitempty"`
+	Status           SyntheticStatus `json:"status"`
+	FailureReason    string          `json:"failureReason,omitempty"`
+	Created          time.Time       `json:"created"`
+	LastUpdated      time.Time       `json:"lastUpdated"`
+}
+
+// SyntheticSubmitResponse contains the synthetic orders created by a
+// submission along with the bracket entry order if one was placed
+type SyntheticSubmitResponse struct {
+	Orders []SyntheticOrder
+	Entry  *OrderSubmitResponse
+}
+
+// syntheticStore holds synthetic orders by ID and tracks which exchanges
+// have an active ticker subscription
+type syntheticStore struct {
+	m        sync.Mutex
+	orders   map[string]*SyntheticOrder
+	watchers map[string]bool
+	filePath string
+}
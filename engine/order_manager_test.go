@@ -7,9 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	orderDB "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -88,6 +91,46 @@ func (f omfExchange) ModifyOrder(ctx context.Context, action *order.Modify) (ord
 	return ans, nil
 }
 
+// omfDatabase overrides the order database service to record writes and
+// return stored orders without requiring a database connection
+type omfDatabase struct {
+	orders   []orderDB.Details
+	upserted []*orderDB.Details
+}
+
+func (f *omfDatabase) Upsert(orders ...*orderDB.Details) error {
+	f.upserted = append(f.upserted, orders...)
+	return nil
+}
+
+func (f *omfDatabase) GetByID(id string) (*orderDB.Details, error) {
+	for i := len(f.upserted) - 1; i >= 0; i-- {
+		if f.upserted[i].ID == id {
+			return f.upserted[i], nil
+		}
+	}
+	return nil, ErrOrderNotFound
+}
+
+func (f *omfDatabase) GetInRange(string, time.Time, time.Time) ([]orderDB.Details, error) {
+	return nil, nil
+}
+
+func (f *omfDatabase) GetAll() ([]orderDB.Details, error) {
+	return f.orders, nil
+}
+
+func (f *omfDatabase) GetOpenOrUpdatedSince(closedStatuses []string, since time.Time) ([]orderDB.Details, error) {
+	var resp []orderDB.Details
+	for i := range f.orders {
+		if !common.StringDataCompareInsensitive(closedStatuses, f.orders[i].Status) ||
+			!f.orders[i].LastUpdated.Before(since) {
+			resp = append(resp, f.orders[i])
+		}
+	}
+	return resp, nil
+}
+
 func TestSetupOrderManager(t *testing.T) {
 	_, err := SetupOrderManager(nil, nil, nil, false)
 	if !errors.Is(err, errNilExchangeManager) {
@@ -1056,7 +1099,7 @@ func Test_processMatchingOrders(t *testing.T) {
 	}
 	var wg sync.WaitGroup
 	wg.Add(1)
-	m.processMatchingOrders(exch, orders, requiresProcessing, &wg)
+	m.processMatchingOrders(exch, orders, requiresProcessing, false, &wg)
 	wg.Wait()
 	res, err := m.GetOrdersFiltered(&order.Filter{Exchange: testExchange})
 	if err != nil {
@@ -1153,3 +1196,343 @@ func Test_getActiveOrders(t *testing.T) {
 		t.Errorf("Test_getActiveOrders - Expected 0 results, got: %d", len(res))
 	}
 }
+
+// offlineOrdersSetup returns a started order manager with a fake exchange
+// which does not require any API calls during setup
+func offlineOrdersSetup(t *testing.T) *OrderManager {
+	t.Helper()
+	var wg sync.WaitGroup
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(omfExchange{
+		IBotExchange: exch,
+	})
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	m.started = 1
+	return m
+}
+
+func TestOrderManagerSetDatabase(t *testing.T) {
+	var m *OrderManager
+	err := m.SetDatabase(nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+
+	m = offlineOrdersSetup(t)
+	err = m.SetDatabase(nil)
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilDatabaseConnectionManager)
+	}
+
+	err = m.SetDatabase(&DatabaseConnectionManager{})
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+
+	m.started = 0
+	err = m.SetDatabase(&DatabaseConnectionManager{})
+	if !errors.Is(err, database.ErrNilInstance) {
+		t.Errorf("error '%v', expected '%v'", err, database.ErrNilInstance)
+	}
+}
+
+func TestLoadOrdersFromDatabase(t *testing.T) {
+	m := offlineOrdersSetup(t)
+	db := &omfDatabase{
+		orders: []orderDB.Details{
+			{
+				ID:              "internal-1",
+				Exchange:        testExchange,
+				ExchangeOrderID: "restored",
+				Base:            currency.BTC.String(),
+				Quote:           currency.USD.String(),
+				Asset:           asset.Spot.String(),
+				Side:            order.Buy.String(),
+				Type:            order.Limit.String(),
+				Status:          order.Active.String(),
+				Amount:          1,
+				FeeAsset:        currency.USD.String(),
+				Trades: []orderDB.Trade{
+					{
+						TID:    "1337",
+						Amount: 0.5,
+						Side:   order.Buy.String(),
+					},
+				},
+			},
+			{
+				ID:              "internal-2",
+				Exchange:        "unloaded",
+				ExchangeOrderID: "skipped",
+				Base:            currency.BTC.String(),
+				Quote:           currency.USD.String(),
+				Asset:           asset.Spot.String(),
+			},
+			{
+				ID:              "internal-3",
+				Exchange:        testExchange,
+				ExchangeOrderID: "bad asset",
+				Base:            currency.BTC.String(),
+				Quote:           currency.USD.String(),
+				Asset:           "fake",
+			},
+			{
+				ID:              "internal-4",
+				Exchange:        testExchange,
+				ExchangeOrderID: "recently filled",
+				Base:            currency.BTC.String(),
+				Quote:           currency.USD.String(),
+				Asset:           asset.Spot.String(),
+				Status:          order.Filled.String(),
+				Amount:          1,
+				ExecutedAmount:  1,
+				LastUpdated:     time.Now(),
+			},
+			{
+				ID:              "internal-5",
+				Exchange:        testExchange,
+				ExchangeOrderID: "historic",
+				Base:            currency.BTC.String(),
+				Quote:           currency.USD.String(),
+				Asset:           asset.Spot.String(),
+				Status:          order.Filled.String(),
+				Amount:          1,
+				ExecutedAmount:  1,
+				LastUpdated:     time.Now().Add(-orderRestoreWindow * 2),
+			},
+		},
+	}
+	m.db = db
+	err := m.loadOrdersFromDatabase()
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+
+	o, err := m.GetByExchangeAndID(testExchange, "restored")
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.InternalOrderID != "internal-1" {
+		t.Errorf("received '%v', expected '%v'", o.InternalOrderID, "internal-1")
+	}
+	if o.Status != order.Active {
+		t.Errorf("received '%v', expected '%v'", o.Status, order.Active)
+	}
+	stored, err := m.orderStore.getByExchangeAndID(testExchange, "restored")
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if !stored.FeeAsset.Match(currency.USD) {
+		t.Errorf("received '%v', expected '%v'", stored.FeeAsset, currency.USD)
+	}
+	if len(o.Trades) != 1 || o.Trades[0].TID != "1337" {
+		t.Errorf("expected restored trade, received '%v'", o.Trades)
+	}
+
+	_, err = m.GetByExchangeAndID(testExchange, "bad asset")
+	if !errors.Is(err, ErrOrderNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, ErrOrderNotFound)
+	}
+	_, err = m.GetByExchangeAndID("unloaded", "skipped")
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, ErrExchangeNotFound)
+	}
+
+	// Closed orders are only restored when updated within the restore window
+	_, err = m.GetByExchangeAndID(testExchange, "recently filled")
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	_, err = m.GetByExchangeAndID(testExchange, "historic")
+	if !errors.Is(err, ErrOrderNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, ErrOrderNotFound)
+	}
+}
+
+func TestPersistOrder(t *testing.T) {
+	m := offlineOrdersSetup(t)
+	db := &omfDatabase{}
+	m.db = db
+
+	_, err := m.UpsertOrder(&order.Detail{
+		Exchange:  testExchange,
+		ID:        "upserted",
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		AssetType: asset.Spot,
+		Amount:    1,
+		Status:    order.Active,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(db.upserted) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(db.upserted), 1)
+	}
+	if db.upserted[0].ExchangeOrderID != "upserted" || db.upserted[0].ID == "" {
+		t.Errorf("unexpected stored order '%+v'", db.upserted[0])
+	}
+
+	resp, err := m.SubmitFakeOrder(&order.Submit{
+		Exchange:  testExchange,
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Amount:    1,
+		Price:     1,
+	}, order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       "submitted",
+	}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(db.upserted) != 2 {
+		t.Fatalf("received '%v', expected '%v'", len(db.upserted), 2)
+	}
+	if db.upserted[1].ID != resp.InternalOrderID {
+		t.Errorf("received '%v', expected '%v'", db.upserted[1].ID, resp.InternalOrderID)
+	}
+
+	_, err = m.Modify(context.Background(), &order.Modify{
+		Exchange: testExchange,
+		ID:       "submitted",
+		Price:    2,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	stored, err := m.db.GetByID(resp.InternalOrderID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if stored.ExchangeOrderID != "modified_order_id" || stored.Price != 2 {
+		t.Errorf("modified order not stored '%+v'", stored)
+	}
+
+	err = m.Cancel(context.Background(), &order.Cancel{
+		Exchange:  testExchange,
+		ID:        "modified_order_id",
+		AssetType: asset.Spot,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	stored, err = m.db.GetByID(resp.InternalOrderID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if stored.Status != order.Cancelled.String() {
+		t.Errorf("received '%v', expected '%v'", stored.Status, order.Cancelled)
+	}
+}
+
+func TestReconcileRestoredOrders(t *testing.T) {
+	m := offlineOrdersSetup(t)
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.GetBase().API.AuthenticatedSupport = true
+	exch.GetBase().Features.Supports.RESTCapabilities.GetOrder = true
+	m.db = &omfDatabase{
+		orders: []orderDB.Details{
+			{
+				ID:              "internal-2",
+				Exchange:        testExchange,
+				ExchangeOrderID: "Order2-active-to-inactive",
+				Base:            currency.BTC.String(),
+				Quote:           currency.USD.String(),
+				Asset:           asset.Spot.String(),
+				Side:            order.Sell.String(),
+				Status:          order.Active.String(),
+				Amount:          1,
+				LastUpdated:     time.Now(),
+			},
+		},
+	}
+	err = m.loadOrdersFromDatabase()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	m.reconcileRestoredOrders()
+
+	// Order2 was active when stored but is no longer returned as active by
+	// the exchange, so it is fetched and updated to cancelled
+	o, err := m.GetByExchangeAndID(testExchange, "Order2-active-to-inactive")
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.Status != order.Cancelled {
+		t.Errorf("received '%v', expected '%v'", o.Status, order.Cancelled)
+	}
+	if o.InternalOrderID != "internal-2" {
+		t.Errorf("received '%v', expected '%v'", o.InternalOrderID, "internal-2")
+	}
+
+	// Order3 is returned by the exchange as active and added to the store
+	_, err = m.GetByExchangeAndID(testExchange, "Order3-unknown-to-active")
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestOrderDatabaseConversion(t *testing.T) {
+	t.Parallel()
+	tt := time.Now().UTC().Truncate(time.Second)
+	det := &order.Detail{
+		InternalOrderID: "internal",
+		Exchange:        testExchange,
+		ID:              "exchange",
+		ClientOrderID:   "strategy",
+		Pair:            currency.NewPair(currency.BTC, currency.USD),
+		AssetType:       asset.Spot,
+		Side:            order.Sell,
+		Type:            order.StopLimit,
+		Status:          order.PartiallyFilled,
+		Price:           1337,
+		Amount:          2,
+		ExecutedAmount:  1,
+		RemainingAmount: 1,
+		Date:            tt,
+		LastUpdated:     tt,
+		Trades: []order.TradeHistory{
+			{
+				TID:       "1",
+				Price:     1337,
+				Amount:    1,
+				Side:      order.Sell,
+				Timestamp: tt,
+			},
+		},
+	}
+	resp, err := orderDatabaseToDetail(orderDetailToDatabase(det))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.InternalOrderID != det.InternalOrderID ||
+		resp.ID != det.ID ||
+		resp.ClientOrderID != det.ClientOrderID ||
+		!resp.Pair.Equal(det.Pair) ||
+		resp.AssetType != det.AssetType ||
+		resp.Side != det.Side ||
+		resp.Type != det.Type ||
+		resp.Status != det.Status ||
+		resp.Price != det.Price ||
+		resp.ExecutedAmount != det.ExecutedAmount ||
+		!resp.Date.Equal(det.Date) {
+		t.Errorf("received '%+v', expected '%+v'", resp, det)
+	}
+	if len(resp.Trades) != 1 || resp.Trades[0].Side != order.Sell || resp.Trades[0].Exchange != testExchange {
+		t.Errorf("unexpected trades '%+v'", resp.Trades)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	orderDB "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -15,6 +16,21 @@ const OrderManagerName = "orders"
 // vars for the fund manager package
 var (
 	orderManagerDelay = time.Second * 10
+	// orderRestoreWindow is how far back closed orders are restored from the
+	// database on startup, open orders are always restored
+	orderRestoreWindow = time.Hour * 24
+	// closedOrderStatuses are the stored statuses of orders which are no
+	// longer open on the exchange
+	closedOrderStatuses = []string{
+		order.Filled.String(),
+		order.Cancelled.String(),
+		order.InsufficientBalance.String(),
+		order.MarketUnavailable.String(),
+		order.Rejected.String(),
+		order.PartiallyCancelled.String(),
+		order.Expired.String(),
+		order.Closed.String(),
+	}
 	// ErrOrdersAlreadyExists occurs when the order already exists in the manager
 	ErrOrdersAlreadyExists = errors.New("order already exists")
 	// ErrOrderNotFound occurs when an order is not found in the orderstore
@@ -52,6 +68,10 @@ type OrderManager struct {
 	orderStore       store
 	cfg              orderManagerConfig
 	verbose          bool
+	db               orderDB.IDBService
+	synthetic        syntheticStore
+	risk             riskStore
+	orderSync        orderSyncStore
 }
 
 // OrderSubmitResponse contains the order response along with an internal order ID
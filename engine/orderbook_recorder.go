@@ -0,0 +1,306 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/recorder"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupOrderbookRecorder applies configuration parameters before running
+func SetupOrderbookRecorder(em iExchangeManager, cfg *config.OrderbookRecorder, verbose bool) (*OrderbookRecorder, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.Directory == "" {
+		return nil, errOrderbookRecorderDirectoryUnset
+	}
+	interval := cfg.SnapshotInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &OrderbookRecorder{
+		shutdown:         make(chan struct{}),
+		exchangeManager:  em,
+		directory:        cfg.Directory,
+		snapshotInterval: interval,
+		maxFileSize:      cfg.MaxFileSize,
+		verbose:          verbose,
+		gaps:             make(map[recordedBook]*recorder.Record),
+	}, nil
+}
+
+// Start runs the subsystem
+func (r *OrderbookRecorder) Start() error {
+	if r == nil {
+		return fmt.Errorf("%s %w", OrderbookRecorderName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 0, 1) {
+		return fmt.Errorf("%s %w", OrderbookRecorderName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderBook, "Orderbook recorder %s", MsgSubSystemStarting)
+	w, err := recorder.NewWriter(r.directory, r.maxFileSize)
+	if err != nil {
+		atomic.StoreInt32(&r.started, 0)
+		return err
+	}
+	r.writer = w
+	r.records = make(chan *recorder.Record, orderbookRecorderBuffer)
+	r.written = make(chan struct{})
+	r.attach()
+	go r.write()
+	r.wg.Add(1)
+	go r.run()
+	log.Debugf(log.OrderBook, "Orderbook recorder %s writing to %s", MsgSubSystemStarted, r.directory)
+	return nil
+}
+
+// Stop stops the subsystem
+func (r *OrderbookRecorder) Stop() error {
+	if r == nil {
+		return fmt.Errorf("%s %w", OrderbookRecorderName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&r.started) == 0 {
+		return fmt.Errorf("%s %w", OrderbookRecorderName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderBook, "Orderbook recorder %s", MsgSubSystemShuttingDown)
+	close(r.shutdown)
+	r.wg.Wait()
+	r.shutdown = make(chan struct{})
+	for i := range r.buffers {
+		r.buffers[i].SetRecorder(nil)
+	}
+	r.buffers = nil
+	// Nothing records once detached, queued records and gaps not yet
+	// followed by a record of their book are written before the recording
+	// is closed
+	r.queueGaps()
+	close(r.records)
+	<-r.written
+	err := r.writer.Close()
+	if err != nil {
+		log.Errorf(log.OrderBook, "%s cannot close recording: %v", OrderbookRecorderName, err)
+	}
+	if dropped := atomic.SwapInt64(&r.dropped, 0); dropped > 0 {
+		log.Warnf(log.OrderBook, "%s dropped %d records while the write queue was full", OrderbookRecorderName, dropped)
+	}
+	atomic.StoreInt32(&r.started, 0)
+	log.Debugf(log.OrderBook, "Orderbook recorder %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (r *OrderbookRecorder) IsRunning() bool {
+	if r == nil {
+		return false
+	}
+	return atomic.LoadInt32(&r.started) == 1
+}
+
+// RecordSnapshot queues a snapshot of a book to be written, the snapshot is
+// dropped when the write queue is full
+func (r *OrderbookRecorder) RecordSnapshot(book *orderbook.Base) {
+	rec, err := recorder.NewSnapshotRecord(book, time.Now())
+	if err != nil {
+		log.Errorf(log.OrderBook, "%s %v", OrderbookRecorderName, err)
+		return
+	}
+	r.push(rec)
+}
+
+// RecordUpdate queues an update applied to a book to be written, the update
+// is dropped when the write queue is full
+func (r *OrderbookRecorder) RecordUpdate(exchangeName string, u *buffer.Update, updateByID bool) {
+	rec, err := recorder.NewDeltaRecord(exchangeName, u, updateByID, time.Now())
+	if err != nil {
+		log.Errorf(log.OrderBook, "%s %v", OrderbookRecorderName, err)
+		return
+	}
+	r.push(rec)
+}
+
+// push queues a record without blocking the caller which holds the orderbook
+// buffer lock. Once a record of a book is dropped a gap record is queued
+// ahead of its next record, unless that record is a snapshot which replaces
+// the book
+func (r *OrderbookRecorder) push(rec *recorder.Record) {
+	book := recordedBook{exchange: strings.ToLower(rec.Exchange), asset: rec.Asset, pair: rec.Pair}
+	r.gapMtx.Lock()
+	defer r.gapMtx.Unlock()
+	if gap, ok := r.gaps[book]; ok && rec.Type != recorder.Snapshot {
+		select {
+		case r.records <- gap:
+			delete(r.gaps, book)
+		default:
+			atomic.AddInt64(&r.dropped, 1)
+			return
+		}
+	}
+	select {
+	case r.records <- rec:
+		if rec.Type == recorder.Snapshot {
+			delete(r.gaps, book)
+		}
+	default:
+		atomic.AddInt64(&r.dropped, 1)
+		if _, ok := r.gaps[book]; !ok {
+			r.gaps[book] = recorder.NewGapRecord(rec.Exchange, rec.Pair, rec.Asset, rec.Timestamp)
+		}
+	}
+}
+
+// queueGaps queues the gap record of every book with dropped records, waiting
+// for space in the write queue
+func (r *OrderbookRecorder) queueGaps() {
+	r.gapMtx.Lock()
+	defer r.gapMtx.Unlock()
+	for book, gap := range r.gaps {
+		r.records <- gap
+		delete(r.gaps, book)
+	}
+}
+
+// write writes queued records until the queue is closed on shutdown
+func (r *OrderbookRecorder) write() {
+	defer close(r.written)
+	for rec := range r.records {
+		r.writeRecord(rec)
+	}
+}
+
+func (r *OrderbookRecorder) writeRecord(rec *recorder.Record) {
+	err := r.writer.Write(rec)
+	if err != nil {
+		log.Errorf(log.OrderBook, "%s cannot write %s %s %s %s: %v",
+			OrderbookRecorderName,
+			rec.Exchange,
+			rec.Asset,
+			rec.Pair,
+			rec.Type,
+			err)
+	}
+}
+
+// run records snapshots of every book on the snapshot interval
+func (r *OrderbookRecorder) run() {
+	defer r.wg.Done()
+	r.snapshot()
+	t := time.NewTicker(r.snapshotInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case <-t.C:
+			r.attach()
+			r.snapshot()
+			if dropped := atomic.SwapInt64(&r.dropped, 0); dropped > 0 {
+				log.Warnf(log.OrderBook, "%s dropped %d records while the write queue was full",
+					OrderbookRecorderName,
+					dropped)
+			}
+		}
+	}
+}
+
+// attach sets the recorder on the websocket orderbook buffer of enabled
+// exchanges which have not yet been attached, exchanges loaded after the
+// recorder has started are attached on the next snapshot
+func (r *OrderbookRecorder) attach() {
+	exchanges, err := r.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.OrderBook, "%s cannot get exchanges: %v", OrderbookRecorderName, err)
+		return
+	}
+	for x := range exchanges {
+		ws := r.getWebsocketBuffer(exchanges[x])
+		if ws == nil || r.isAttached(ws) {
+			continue
+		}
+		ws.SetRecorder(r)
+		r.buffers = append(r.buffers, ws)
+		if r.verbose {
+			log.Debugf(log.OrderBook, "%s recording %s websocket orderbook updates",
+				OrderbookRecorderName,
+				exchanges[x].GetName())
+		}
+	}
+}
+
+func (r *OrderbookRecorder) isAttached(ws *buffer.Orderbook) bool {
+	for i := range r.buffers {
+		if r.buffers[i] == ws {
+			return true
+		}
+	}
+	return false
+}
+
+// getWebsocketBuffer returns the websocket orderbook buffer of an enabled
+// exchange, nil is returned when the exchange does not use the websocket
+func (r *OrderbookRecorder) getWebsocketBuffer(exch exchange.IBotExchange) *buffer.Orderbook {
+	if !exch.IsEnabled() || !exch.IsWebsocketEnabled() {
+		return nil
+	}
+	ws, err := exch.GetWebsocket()
+	if err != nil || ws == nil {
+		return nil
+	}
+	return &ws.Orderbook
+}
+
+// snapshot records every book of every enabled exchange. Books held by a
+// websocket buffer are recorded through the buffer so that they are in
+// sequence with the updates recorded, all other books are retrieved from the
+// orderbook store
+func (r *OrderbookRecorder) snapshot() {
+	exchanges, err := r.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.OrderBook, "%s cannot get exchanges: %v", OrderbookRecorderName, err)
+		return
+	}
+	for x := range exchanges {
+		if !exchanges[x].IsEnabled() {
+			continue
+		}
+		ws := r.getWebsocketBuffer(exchanges[x])
+		if ws != nil {
+			ws.RecordSnapshots()
+		}
+		assets := exchanges[x].GetAssetTypes(true)
+		for y := range assets {
+			pairs, err := exchanges[x].GetEnabledPairs(assets[y])
+			if err != nil {
+				log.Errorf(log.OrderBook, "%s %s %s cannot get enabled pairs: %v",
+					OrderbookRecorderName, exchanges[x].GetName(), assets[y], err)
+				continue
+			}
+			for z := range pairs {
+				if ws != nil {
+					if _, err = ws.GetOrderbook(pairs[z], assets[y]); err == nil {
+						continue
+					}
+				}
+				book, err := orderbook.Get(exchanges[x].GetName(), pairs[z], assets[y])
+				if err != nil {
+					if r.verbose {
+						log.Debugf(log.OrderBook, "%s %s %s %s skipped: %v",
+							OrderbookRecorderName, exchanges[x].GetName(), assets[y], pairs[z], err)
+					}
+					continue
+				}
+				r.RecordSnapshot(book)
+			}
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package engine
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/recorder"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
+)
+
+// recExchange is an exchange which serves spot books over the websocket when
+// ws is set, otherwise books are served from the orderbook store
+type recExchange struct {
+	exchange.IBotExchange
+	name  string
+	pairs currency.Pairs
+	ws    *stream.Websocket
+}
+
+func (r *recExchange) GetName() string {
+	return r.name
+}
+
+func (r *recExchange) IsEnabled() bool {
+	return true
+}
+
+func (r *recExchange) IsWebsocketEnabled() bool {
+	return r.ws != nil
+}
+
+func (r *recExchange) GetWebsocket() (*stream.Websocket, error) {
+	return r.ws, nil
+}
+
+func (r *recExchange) GetAssetTypes(_ bool) asset.Items {
+	return asset.Items{asset.Spot}
+}
+
+func (r *recExchange) GetEnabledPairs(_ asset.Item) (currency.Pairs, error) {
+	return r.pairs, nil
+}
+
+func recTempDir(t *testing.T) (string, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "gct-orderbook-recorder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestSetupOrderbookRecorder(t *testing.T) {
+	t.Parallel()
+	_, err := SetupOrderbookRecorder(nil, nil, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
+	}
+	em := &arbExchangeManager{}
+	_, err = SetupOrderbookRecorder(em, nil, false)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("error '%v', expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupOrderbookRecorder(em, &config.OrderbookRecorder{}, false)
+	if !errors.Is(err, errOrderbookRecorderDirectoryUnset) {
+		t.Errorf("error '%v', expected '%v'", err, errOrderbookRecorderDirectoryUnset)
+	}
+	r, err := SetupOrderbookRecorder(em, &config.OrderbookRecorder{Directory: "test"}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if r.snapshotInterval != time.Minute {
+		t.Errorf("received '%v', expected '%v'", r.snapshotInterval, time.Minute)
+	}
+}
+
+func TestOrderbookRecorderStartStop(t *testing.T) {
+	t.Parallel()
+	var r *OrderbookRecorder
+	err := r.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	err = r.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	if r.IsRunning() {
+		t.Error("expected nil recorder to not be running")
+	}
+
+	dir, cleanup := recTempDir(t)
+	defer cleanup()
+	r, err = SetupOrderbookRecorder(&arbExchangeManager{}, &config.OrderbookRecorder{Directory: dir, SnapshotInterval: time.Hour}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = r.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = r.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !r.IsRunning() {
+		t.Error("expected recorder to be running")
+	}
+	err = r.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = r.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestOrderbookRecorderPush(t *testing.T) {
+	t.Parallel()
+	r := &OrderbookRecorder{
+		records: make(chan *recorder.Record, 1),
+		gaps:    make(map[recordedBook]*recorder.Record),
+	}
+	r.RecordSnapshot(nil)
+	r.RecordUpdate("test", nil, false)
+	if len(r.records) != 0 {
+		t.Fatalf("received '%v', expected '%v'", len(r.records), 0)
+	}
+	r.RecordSnapshot(&orderbook.Base{})
+	r.RecordUpdate("test", &buffer.Update{}, false)
+	if len(r.records) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(r.records), 1)
+	}
+	if r.dropped != 1 {
+		t.Fatalf("received '%v', expected '%v'", r.dropped, 1)
+	}
+	if len(r.gaps) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(r.gaps), 1)
+	}
+}
+
+func TestOrderbookRecorderDroppedUpdates(t *testing.T) {
+	t.Parallel()
+	dir, cleanup := recTempDir(t)
+	defer cleanup()
+	w, err := recorder.NewWriter(dir, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	r := &OrderbookRecorder{
+		records: make(chan *recorder.Record, 2),
+		gaps:    make(map[recordedBook]*recorder.Record),
+	}
+	drain := func() {
+		t.Helper()
+		for len(r.records) > 0 {
+			if err := w.Write(<-r.records); !errors.Is(err, nil) {
+				t.Fatalf("error '%v', expected '%v'", err, nil)
+			}
+		}
+	}
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(sec int) time.Time {
+		return start.Add(time.Duration(sec) * time.Second)
+	}
+	push := func(rec *recorder.Record, err error) {
+		t.Helper()
+		if !errors.Is(err, nil) {
+			t.Fatalf("error '%v', expected '%v'", err, nil)
+		}
+		r.push(rec)
+	}
+	snapshot := func(sec int, price float64) {
+		t.Helper()
+		push(recorder.NewSnapshotRecord(&orderbook.Base{
+			Exchange: "recorderDrop",
+			Pair:     p,
+			Asset:    asset.Spot,
+			Bids:     orderbook.Items{{Price: price, Amount: 1}},
+		}, at(sec)))
+	}
+	update := func(sec int, price float64) {
+		t.Helper()
+		push(recorder.NewDeltaRecord("recorderDrop", &buffer.Update{
+			Pair:  p,
+			Asset: asset.Spot,
+			Bids:  orderbook.Items{{Price: price, Amount: 1}},
+		}, false, at(sec)))
+	}
+
+	snapshot(0, 100)
+	update(1, 99)
+	// The write queue is full, the update is dropped
+	update(2, 98)
+	drain()
+	// A gap is queued ahead of the next update of the book
+	update(3, 97)
+	drain()
+	// A snapshot replaces the book without a gap
+	snapshot(4, 200)
+	update(5, 199)
+	update(6, 198)
+	drain()
+	// Gaps not followed by a further record are written on shutdown
+	r.queueGaps()
+	drain()
+	if err = w.Close(); !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if r.dropped != 2 {
+		t.Errorf("received '%v', expected '%v'", r.dropped, 2)
+	}
+
+	reader, err := recorder.NewReader(dir)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	bookAt := func(sec int) (*orderbook.Base, error) {
+		return reader.BookAt("recorderDrop", p, asset.Spot, at(sec))
+	}
+	book, err := bookAt(1)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(book.Bids) != 2 {
+		t.Errorf("received '%v', expected '%v'", len(book.Bids), 2)
+	}
+	for _, sec := range []int{2, 3, 6} {
+		if _, err = bookAt(sec); err == nil {
+			t.Errorf("expected error rebuilding book with dropped updates at %v seconds", sec)
+		}
+	}
+	book, err = bookAt(5)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(book.Bids) != 2 || book.Bids[0].Price != 200 || book.Bids[1].Price != 199 {
+		t.Errorf("unexpected book after snapshot %+v", book)
+	}
+}
+
+func TestOrderbookRecorderRecord(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	restBook := &orderbook.Base{
+		Exchange: "recorderREST",
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 100, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 101, Amount: 1}},
+	}
+	err := restBook.Process()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	ws := &stream.Websocket{}
+	err = ws.Orderbook.Setup(0, false, false, false, false, false, "recorderWS", make(chan interface{}, 100))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	em := &arbExchangeManager{exchanges: []exchange.IBotExchange{
+		&recExchange{name: "recorderREST", pairs: currency.Pairs{p}},
+		&recExchange{name: "recorderWS", pairs: currency.Pairs{p}, ws: ws},
+	}}
+
+	dir, cleanup := recTempDir(t)
+	defer cleanup()
+	r, err := SetupOrderbookRecorder(em, &config.OrderbookRecorder{Directory: dir, SnapshotInterval: time.Hour}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = r.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	err = ws.Orderbook.LoadSnapshot(&orderbook.Base{
+		Exchange: "recorderWS",
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 200, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 201, Amount: 1}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = ws.Orderbook.Update(&buffer.Update{
+		Pair:       p,
+		Asset:      asset.Spot,
+		Bids:       orderbook.Items{{Price: 199, Amount: 2}},
+		UpdateTime: time.Now(),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	err = r.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	// Updates are no longer recorded once stopped
+	err = ws.Orderbook.Update(&buffer.Update{
+		Pair:       p,
+		Asset:      asset.Spot,
+		Bids:       orderbook.Items{{Price: 198, Amount: 2}},
+		UpdateTime: time.Now(),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	reader, err := recorder.NewReader(dir)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	book, err := reader.BookAt("recorderWS", p, asset.Spot, time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(book.Bids) != 2 || book.Bids[1].Price != 199 || len(book.Asks) != 1 {
+		t.Errorf("unexpected websocket book %+v", book)
+	}
+	book, err = reader.BookAt("recorderREST", p, asset.Spot, time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(book.Bids) != 1 || book.Bids[0].Price != 100 {
+		t.Errorf("unexpected REST book %+v", book)
+	}
+}
@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/recorder"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
+)
+
+const (
+	// OrderbookRecorderName defines the recorder name string
+	OrderbookRecorderName = "orderbook_recorder"
+	// orderbookRecorderDirectory is the folder in the data directory
+	// recordings are written to when no directory is configured
+	orderbookRecorderDirectory = "orderbooks"
+	// orderbookRecorderBuffer is the number of records which can be queued
+	// for writing before records are dropped
+	orderbookRecorderBuffer = 10000
+)
+
+var errOrderbookRecorderDirectoryUnset = errors.New("orderbook recorder directory unset")
+
+// OrderbookRecorder captures full orderbook snapshots on an interval along
+// with every websocket update applied to books, writing them to compressed
+// rotating files which can be read with the recorder package
+type OrderbookRecorder struct {
+	started          int32
+	shutdown         chan struct{}
+	wg               sync.WaitGroup
+	exchangeManager  iExchangeManager
+	directory        string
+	snapshotInterval time.Duration
+	maxFileSize      int64
+	verbose          bool
+	writer           *recorder.Writer
+	records          chan *recorder.Record
+	// written is closed once the queued records have been written
+	written chan struct{}
+	// buffers holds the websocket orderbook buffers records are received
+	// from, they are detached when the recorder stops
+	buffers []*buffer.Orderbook
+	// dropped counts the records dropped while the write queue was full
+	dropped int64
+	// gaps holds the gap records of books with dropped records, a gap record
+	// is written before any further record of its book so that the book is
+	// not rebuilt without the dropped updates
+	gapMtx sync.Mutex
+	gaps   map[recordedBook]*recorder.Record
+}
+
+// recordedBook identifies the book of a record, the exchange name is lower
+// case
+type recordedBook struct {
+	exchange string
+	asset    asset.Item
+	pair     currency.Pair
+}
@@ -18,7 +18,6 @@ import (
 	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/thrasher-corp/gocryptotrader/common"
-	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/common/file/archive"
 	"github.com/thrasher-corp/gocryptotrader/common/timeperiods"
@@ -31,6 +30,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -46,7 +46,6 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -65,6 +64,7 @@ var (
 	errCurrencyPairInvalid  = errors.New("currency provided is not found in the available pairs list")
 	errNoTrades             = errors.New("no trades returned from supplied params")
 	errNilRequestData       = errors.New("nil request data received, cannot continue")
+	errExecutionStatusUnset = errors.New("execution order status must be pause, resume or cancel")
 )
 
 // RPCServer struct
@@ -74,42 +74,16 @@ type RPCServer struct {
 }
 
 func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return ctx, fmt.Errorf("unable to extract metadata")
-	}
-
-	authStr, ok := md["authorization"]
-	if !ok {
-		return ctx, fmt.Errorf("authorization header missing")
-	}
-
-	if !strings.Contains(authStr[0], "Basic") {
-		return ctx, fmt.Errorf("basic not found in authorization header")
-	}
-
-	decoded, err := crypto.Base64Decode(strings.Split(authStr[0], " ")[1])
-	if err != nil {
-		return ctx, fmt.Errorf("unable to base64 decode authorization header")
-	}
-
-	credentials := strings.Split(string(decoded), ":")
-
-	username := credentials[0]
-	password := credentials[1]
-
-	if username != s.Config.RemoteControl.Username ||
-		password != s.Config.RemoteControl.Password {
-		return ctx, fmt.Errorf("username/password mismatch")
-	}
-
-	return ctx, nil
+	return auth.BasicAuth{
+		Username: s.Config.RemoteControl.Username,
+		Password: s.Config.RemoteControl.Password,
+	}.Authenticate(ctx)
 }
 
 // StartRPCServer starts a gRPC server with TLS auth
 func StartRPCServer(engine *Engine) {
 	targetDir := utils.GetTLSDir(engine.Settings.DataDir)
-	err := checkCerts(targetDir)
+	err := CheckCerts(targetDir)
 	if err != nil {
 		log.Errorf(log.GRPCSys, "gRPC checkCerts failed. err: %s\n", err)
 		return
@@ -1185,12 +1159,25 @@ func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderReques
 		Type:          order.Type(r.OrderType),
 		Amount:        r.Amount,
 		Price:         r.Price,
+		TriggerPrice:  r.TriggerPrice,
 		ClientID:      r.ClientId,
 		ClientOrderID: r.ClientId,
 		Exchange:      r.Exchange,
 		AssetType:     a,
 	}
 
+	// Order types the exchange cannot place directly are emulated by the
+	// order manager
+	if oType, typeErr := order.StringToOrderType(r.OrderType); typeErr == nil && IsSyntheticOrderType(oType) {
+		submission.Type = oType
+		return s.submitSyntheticOrder(ctx, &SyntheticSubmit{
+			Submit:           *submission,
+			TrailingDistance: r.TrailingDistance,
+			TakeProfitPrice:  r.TakeProfitPrice,
+			StopLossPrice:    r.StopLossPrice,
+		})
+	}
+
 	resp, err := s.OrderManager.Submit(ctx, submission)
 	if err != nil {
 		return &gctrpc.SubmitOrderResponse{}, err
@@ -1213,6 +1200,25 @@ func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderReques
 	}, err
 }
 
+// submitSyntheticOrder submits an order type emulated by the order manager,
+// returning the bracket entry order if one was placed
+func (s *RPCServer) submitSyntheticOrder(ctx context.Context, submission *SyntheticSubmit) (*gctrpc.SubmitOrderResponse, error) {
+	resp, err := s.OrderManager.SubmitSynthetic(ctx, submission)
+	if err != nil {
+		return &gctrpc.SubmitOrderResponse{}, err
+	}
+	result := &gctrpc.SubmitOrderResponse{
+		OrderPlaced: true,
+	}
+	if resp.Entry != nil {
+		result.OrderId = resp.Entry.OrderID
+	}
+	for i := range resp.Orders {
+		result.SyntheticOrderIds = append(result.SyntheticOrderIds, resp.Orders[i].ID)
+	}
+	return result, nil
+}
+
 // SimulateOrder simulates an order specified by exchange, currency pair and asset
 // type
 func (s *RPCServer) SimulateOrder(ctx context.Context, r *gctrpc.SimulateOrderRequest) (*gctrpc.SimulateOrderResponse, error) {
@@ -2774,6 +2780,20 @@ func (s *RPCServer) WebsocketGetInfo(_ context.Context, r *gctrpc.WebsocketGetIn
 		return nil, err
 	}
 
+	status := w.GetConnectionStatus()
+	connections := make([]*gctrpc.WebsocketConnectionStatus, len(status))
+	for i := range status {
+		connections[i] = &gctrpc.WebsocketConnectionStatus{
+			Name:             status[i].Name,
+			Url:              status[i].URL,
+			Connected:        status[i].Connected,
+			Subscriptions:    int64(status[i].Subscriptions),
+			MaxSubscriptions: int64(status[i].MaxSubscriptions),
+			Reconnections:    int64(status[i].Reconnections),
+			LastError:        status[i].LastError,
+		}
+	}
+
 	return &gctrpc.WebsocketGetInfoResponse{
 		Exchange:      exch.GetName(),
 		Supported:     exch.SupportsWebsocket(),
@@ -2781,6 +2801,7 @@ func (s *RPCServer) WebsocketGetInfo(_ context.Context, r *gctrpc.WebsocketGetIn
 		Authenticated: w.CanUseAuthenticatedEndpoints(),
 		RunningUrl:    w.GetWebsocketURL(),
 		ProxyAddress:  w.GetProxyAddress(),
+		Connections:   connections,
 	}, nil
 }
 
@@ -3945,3 +3966,674 @@ func (s *RPCServer) CurrencyStateTradingPair(_ context.Context, r *gctrpc.Curren
 		cp,
 		asset.Item(r.Asset))
 }
+
+// SubmitExecutionOrder submits a parent order to be sliced into child orders
+// by the execution manager using the TWAP, VWAP or iceberg algorithm
+func (s *RPCServer) SubmitExecutionOrder(ctx context.Context, r *gctrpc.SubmitExecutionOrderRequest) (*gctrpc.ExecutionOrder, error) {
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.executionManager.Submit(ctx, &ExecutionSubmit{
+		Submit: order.Submit{
+			Exchange:      r.Exchange,
+			Pair:          p,
+			AssetType:     a,
+			Side:          side,
+			Amount:        r.Amount,
+			Price:         r.Price,
+			ClientOrderID: r.ClientOrderId,
+		},
+		Algorithm: ExecutionAlgorithm(r.Algorithm),
+		Duration:  time.Duration(r.Duration),
+		Interval:  kline.Interval(r.Interval),
+		ClipSize:  r.ClipSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return executionOrderToRPC(resp), nil
+}
+
+// GetExecutionOrders returns the progress of a parent order when an ID is
+// supplied, otherwise all parent orders known to the execution manager
+func (s *RPCServer) GetExecutionOrders(_ context.Context, r *gctrpc.GetExecutionOrdersRequest) (*gctrpc.GetExecutionOrdersResponse, error) {
+	if r.Id != "" {
+		resp, err := s.executionManager.GetExecutionOrder(r.Id)
+		if err != nil {
+			return nil, err
+		}
+		return &gctrpc.GetExecutionOrdersResponse{
+			Orders: []*gctrpc.ExecutionOrder{executionOrderToRPC(resp)},
+		}, nil
+	}
+
+	resp, err := s.executionManager.GetExecutionOrders()
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]*gctrpc.ExecutionOrder, len(resp))
+	for i := range resp {
+		orders[i] = executionOrderToRPC(&resp[i])
+	}
+	return &gctrpc.GetExecutionOrdersResponse{Orders: orders}, nil
+}
+
+// SetExecutionOrderStatus pauses, resumes or cancels a parent order
+func (s *RPCServer) SetExecutionOrderStatus(ctx context.Context, r *gctrpc.SetExecutionOrderStatusRequest) (*gctrpc.ExecutionOrder, error) {
+	var resp *ExecutionOrder
+	var err error
+	switch strings.ToLower(r.Status) {
+	case "pause":
+		resp, err = s.executionManager.Pause(r.Id)
+	case "resume":
+		resp, err = s.executionManager.Resume(r.Id)
+	case "cancel":
+		resp, err = s.executionManager.Cancel(ctx, r.Id)
+	default:
+		return nil, fmt.Errorf("%w, received %q", errExecutionStatusUnset, r.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return executionOrderToRPC(resp), nil
+}
+
+// executionOrderToRPC converts a parent order snapshot to its RPC type
+func executionOrderToRPC(o *ExecutionOrder) *gctrpc.ExecutionOrder {
+	return &gctrpc.ExecutionOrder{
+		Id:       o.ID,
+		Exchange: o.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: o.Pair.Delimiter,
+			Base:      o.Pair.Base.String(),
+			Quote:     o.Pair.Quote.String(),
+		},
+		Asset:           o.AssetType.String(),
+		Side:            o.Side.String(),
+		OrderType:       o.Type.String(),
+		Algorithm:       string(o.Algorithm),
+		Status:          string(o.Status),
+		Amount:          o.Amount,
+		Price:           o.Price,
+		Duration:        int64(o.Duration),
+		Interval:        int64(o.Interval),
+		ClipSize:        o.ClipSize,
+		Slices:          int64(o.Slices),
+		SlicesSubmitted: int64(o.SlicesSubmitted),
+		Submitted:       o.Submitted,
+		Filled:          o.Filled,
+		AveragePrice:    o.AveragePrice,
+		ArrivalPrice:    o.ArrivalPrice,
+		SlippageBps:     o.SlippageBPS,
+		ChildOrderIds:   o.ChildOrderIDs,
+		FailureReason:   o.FailureReason,
+		StartTime:       o.StartTime.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		LastUpdated:     o.LastUpdated.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+	}
+}
+
+// SetKillSwitch engages the order manager kill switch, cancelling all orders
+// and blocking new ones, or disengages it to allow orders again
+func (s *RPCServer) SetKillSwitch(ctx context.Context, r *gctrpc.SetKillSwitchRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	if !r.Engage {
+		err := s.OrderManager.DisengageKillSwitch()
+		if err != nil {
+			return nil, err
+		}
+		return &gctrpc.GenericResponse{Status: "success", Data: "kill switch disengaged"}, nil
+	}
+	reason := r.Reason
+	if reason == "" {
+		reason = "requested via gRPC"
+	}
+	// the kill switch remains engaged when order cancellations fail
+	err := s.OrderManager.EngageKillSwitch(ctx, reason)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: "success", Data: "kill switch engaged"}, nil
+}
+
+// GetPositions returns the fill ledger positions matching the optional
+// exchange, asset and pair filters
+func (s *RPCServer) GetPositions(_ context.Context, r *gctrpc.GetPositionsRequest) (*gctrpc.GetPositionsResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, p, err := ledgerFilterFromRPC(r.Asset, r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.fillLedger.GetPositions(r.Exchange, a, p)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]*gctrpc.LedgerPosition, len(resp))
+	for i := range resp {
+		positions[i] = &gctrpc.LedgerPosition{
+			Exchange: resp[i].Exchange,
+			Asset:    resp[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: resp[i].Pair.Delimiter,
+				Base:      resp[i].Pair.Base.String(),
+				Quote:     resp[i].Pair.Quote.String(),
+			},
+			Amount:            resp[i].Amount,
+			AverageEntryPrice: resp[i].AverageEntryPrice,
+			MarkPrice:         resp[i].MarkPrice,
+			RealisedPnl:       resp[i].RealisedPNL,
+			UnrealisedPnl:     resp[i].UnrealisedPNL,
+			Fees:              resp[i].Fees,
+			Fills:             int64(resp[i].Fills),
+			LastUpdated:       resp[i].LastUpdate.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return &gctrpc.GetPositionsResponse{Positions: positions}, nil
+}
+
+// GetPnL returns the realised and unrealised PnL of the fill ledger positions
+// matching the optional exchange, asset and pair filters, converted to the
+// reporting currency when supplied
+func (s *RPCServer) GetPnL(_ context.Context, r *gctrpc.GetPnLRequest) (*gctrpc.GetPnLResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, p, err := ledgerFilterFromRPC(r.Asset, r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	var reportingCurrency currency.Code
+	if r.ReportingCurrency != "" {
+		reportingCurrency = currency.NewCode(r.ReportingCurrency)
+	}
+	resp, err := s.fillLedger.GetPnL(r.Exchange, a, p, reportingCurrency)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]*gctrpc.PositionPnL, len(resp.Positions))
+	for i := range resp.Positions {
+		positions[i] = &gctrpc.PositionPnL{
+			Exchange: resp.Positions[i].Exchange,
+			Asset:    resp.Positions[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: resp.Positions[i].Pair.Delimiter,
+				Base:      resp.Positions[i].Pair.Base.String(),
+				Quote:     resp.Positions[i].Pair.Quote.String(),
+			},
+			RealisedPnl:   resp.Positions[i].RealisedPNL,
+			UnrealisedPnl: resp.Positions[i].UnrealisedPNL,
+		}
+	}
+	return &gctrpc.GetPnLResponse{
+		Currency:      resp.Currency.String(),
+		RealisedPnl:   resp.RealisedPNL,
+		UnrealisedPnl: resp.UnrealisedPNL,
+		TotalPnl:      resp.RealisedPNL + resp.UnrealisedPNL,
+		Positions:     positions,
+	}, nil
+}
+
+// ledgerFilterFromRPC converts the optional asset and pair filters of fill
+// ledger requests
+func ledgerFilterFromRPC(assetType string, pair *gctrpc.CurrencyPair) (asset.Item, currency.Pair, error) {
+	var a asset.Item
+	if assetType != "" {
+		var err error
+		a, err = asset.New(assetType)
+		if err != nil {
+			return "", currency.Pair{}, err
+		}
+	}
+	var p currency.Pair
+	if pair != nil && (pair.Base != "" || pair.Quote != "") {
+		p = currency.Pair{
+			Delimiter: pair.Delimiter,
+			Base:      currency.NewCode(pair.Base),
+			Quote:     currency.NewCode(pair.Quote),
+		}
+	}
+	return a, p, nil
+}
+
+// GetFuturesPositions returns the open futures positions held on an exchange
+func (s *RPCServer) GetFuturesPositions(ctx context.Context, r *gctrpc.GetFuturesPositionsRequest) (*gctrpc.GetFuturesPositionsResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	exch, a, p, err := s.futuresParamsFromRPC(r.Exchange, r.Asset, r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := exch.GetFuturesPositions(ctx, a, p)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]*gctrpc.FuturesPosition, len(resp))
+	for i := range resp {
+		positions[i] = &gctrpc.FuturesPosition{
+			Exchange: resp[i].Exchange,
+			Asset:    resp[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: resp[i].Pair.Delimiter,
+				Base:      resp[i].Pair.Base.String(),
+				Quote:     resp[i].Pair.Quote.String(),
+			},
+			Amount:           resp[i].Amount,
+			EntryPrice:       resp[i].EntryPrice,
+			MarkPrice:        resp[i].MarkPrice,
+			LiquidationPrice: resp[i].LiquidationPrice,
+			UnrealisedPnl:    resp[i].UnrealisedPNL,
+			Leverage:         resp[i].Leverage,
+			MarginType:       resp[i].MarginType.String(),
+			Margin:           resp[i].Margin,
+			LastUpdated:      resp[i].LastUpdated.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return &gctrpc.GetFuturesPositionsResponse{Positions: positions}, nil
+}
+
+// GetFundingRates returns the current funding rates of an exchange's
+// perpetual contracts
+func (s *RPCServer) GetFundingRates(ctx context.Context, r *gctrpc.GetFundingRatesRequest) (*gctrpc.GetFundingRatesResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	exch, a, p, err := s.futuresParamsFromRPC(r.Exchange, r.Asset, r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := exch.GetFuturesFundingRates(ctx, a, p)
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]*gctrpc.FundingRate, len(resp))
+	for i := range resp {
+		rates[i] = &gctrpc.FundingRate{
+			Exchange: resp[i].Exchange,
+			Asset:    resp[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: resp[i].Pair.Delimiter,
+				Base:      resp[i].Pair.Base.String(),
+				Quote:     resp[i].Pair.Quote.String(),
+			},
+			Rate:        resp[i].Rate,
+			MarkPrice:   resp[i].MarkPrice,
+			IndexPrice:  resp[i].IndexPrice,
+			NextFunding: resp[i].NextFunding.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+			Time:        resp[i].Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return &gctrpc.GetFundingRatesResponse{Rates: rates}, nil
+}
+
+// SetLeverage sets the leverage of an exchange's futures pair
+func (s *RPCServer) SetLeverage(ctx context.Context, r *gctrpc.SetLeverageRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	exch, a, p, err := s.futuresParamsFromRPC(r.Exchange, r.Asset, r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	err = exch.SetLeverage(ctx, a, p, r.Leverage)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// SetMarginType sets whether an exchange's futures pair uses isolated or cross
+// margin
+func (s *RPCServer) SetMarginType(ctx context.Context, r *gctrpc.SetMarginTypeRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	marginType, err := futures.StringToMarginType(r.MarginType)
+	if err != nil {
+		return nil, err
+	}
+	exch, a, p, err := s.futuresParamsFromRPC(r.Exchange, r.Asset, r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	err = exch.SetMarginType(ctx, a, p, marginType)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// GetCollateral returns the collateral held on an exchange's futures account
+func (s *RPCServer) GetCollateral(ctx context.Context, r *gctrpc.GetCollateralRequest) (*gctrpc.GetCollateralResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	exch, a, _, err := s.futuresParamsFromRPC(r.Exchange, r.Asset, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := exch.GetCollateral(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	collateral := make([]*gctrpc.Collateral, len(resp))
+	for i := range resp {
+		collateral[i] = &gctrpc.Collateral{
+			Currency:          resp[i].Currency.String(),
+			Total:             resp[i].Total,
+			Available:         resp[i].Available,
+			UnrealisedPnl:     resp[i].UnrealisedPNL,
+			InitialMargin:     resp[i].InitialMargin,
+			MaintenanceMargin: resp[i].MaintenanceMargin,
+		}
+	}
+	return &gctrpc.GetCollateralResponse{Collateral: collateral}, nil
+}
+
+// futuresParamsFromRPC returns the exchange, asset and optional pair of a
+// futures management request after checking that they are enabled
+func (s *RPCServer) futuresParamsFromRPC(exchName, assetType string, pair *gctrpc.CurrencyPair) (exchange.IBotExchange, asset.Item, currency.Pair, error) {
+	a, err := asset.New(assetType)
+	if err != nil {
+		return nil, "", currency.Pair{}, err
+	}
+	exch, err := s.GetExchangeByName(exchName)
+	if err != nil {
+		return nil, "", currency.Pair{}, err
+	}
+	var p currency.Pair
+	if pair != nil && (pair.Base != "" || pair.Quote != "") {
+		p = currency.Pair{
+			Delimiter: pair.Delimiter,
+			Base:      currency.NewCode(pair.Base),
+			Quote:     currency.NewCode(pair.Quote),
+		}
+	}
+	err = checkParams(exchName, exch, a, p)
+	if err != nil {
+		return nil, "", currency.Pair{}, err
+	}
+	return exch, a, p, nil
+}
+
+// GetArbitrageOpportunities returns the cross exchange arbitrage opportunities
+// found by the most recent scan
+func (s *RPCServer) GetArbitrageOpportunities(_ context.Context, r *gctrpc.GetArbitrageOpportunitiesRequest) (*gctrpc.GetArbitrageOpportunitiesResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, p, err := arbitrageFilterFromRPC(r.Asset, r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	opportunities, err := s.arbitrageScanner.GetOpportunities()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetArbitrageOpportunitiesResponse{}
+	for i := range opportunities {
+		if !arbitrageFilterMatch(&opportunities[i], a, p) {
+			continue
+		}
+		resp.Opportunities = append(resp.Opportunities, arbitrageOpportunityToRPC(&opportunities[i]))
+	}
+	return resp, nil
+}
+
+// GetArbitrageOpportunityStream streams cross exchange arbitrage
+// opportunities as they are found
+func (s *RPCServer) GetArbitrageOpportunityStream(r *gctrpc.GetArbitrageOpportunityStreamRequest, stream gctrpc.GoCryptoTrader_GetArbitrageOpportunityStreamServer) error {
+	if r == nil {
+		return errNilRequestData
+	}
+	a, p, err := arbitrageFilterFromRPC(r.Asset, r.Pair)
+	if err != nil {
+		return err
+	}
+	pipe, err := s.arbitrageScanner.SubscribeOpportunities()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		pipeErr := pipe.Release()
+		if pipeErr != nil {
+			log.Error(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errDispatchSystem
+		}
+		opp := (*data.(*interface{})).(ArbitrageOpportunity)
+		if !arbitrageFilterMatch(&opp, a, p) {
+			continue
+		}
+		err = stream.Send(arbitrageOpportunityToRPC(&opp))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetTriangularArbitrageOpportunities returns the triangular arbitrage cycles
+// found by the most recent scan
+func (s *RPCServer) GetTriangularArbitrageOpportunities(_ context.Context, r *gctrpc.GetTriangularArbitrageOpportunitiesRequest) (*gctrpc.GetTriangularArbitrageOpportunitiesResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, _, err := arbitrageFilterFromRPC(r.Asset, nil)
+	if err != nil {
+		return nil, err
+	}
+	opportunities, err := s.arbitrageScanner.GetTriangularOpportunities()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetTriangularArbitrageOpportunitiesResponse{}
+	for i := range opportunities {
+		if !triangularFilterMatch(&opportunities[i], r.Exchange, a) {
+			continue
+		}
+		resp.Opportunities = append(resp.Opportunities, triangularOpportunityToRPC(&opportunities[i]))
+	}
+	return resp, nil
+}
+
+// GetTriangularArbitrageStream streams triangular arbitrage cycles as they are
+// found
+func (s *RPCServer) GetTriangularArbitrageStream(r *gctrpc.GetTriangularArbitrageStreamRequest, stream gctrpc.GoCryptoTrader_GetTriangularArbitrageStreamServer) error {
+	if r == nil {
+		return errNilRequestData
+	}
+	a, _, err := arbitrageFilterFromRPC(r.Asset, nil)
+	if err != nil {
+		return err
+	}
+	pipe, err := s.arbitrageScanner.SubscribeTriangularOpportunities()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		pipeErr := pipe.Release()
+		if pipeErr != nil {
+			log.Error(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errDispatchSystem
+		}
+		opp := (*data.(*interface{})).(TriangularOpportunity)
+		if !triangularFilterMatch(&opp, r.Exchange, a) {
+			continue
+		}
+		err = stream.Send(triangularOpportunityToRPC(&opp))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// arbitrageFilterFromRPC returns the optional asset and pair used to filter
+// arbitrage opportunities
+func arbitrageFilterFromRPC(assetType string, pair *gctrpc.CurrencyPair) (asset.Item, currency.Pair, error) {
+	var a asset.Item
+	if assetType != "" {
+		var err error
+		a, err = asset.New(assetType)
+		if err != nil {
+			return "", currency.Pair{}, err
+		}
+	}
+	var p currency.Pair
+	if pair != nil && (pair.Base != "" || pair.Quote != "") {
+		p = currency.Pair{
+			Delimiter: pair.Delimiter,
+			Base:      currency.NewCode(pair.Base),
+			Quote:     currency.NewCode(pair.Quote),
+		}
+	}
+	return a, p, nil
+}
+
+// arbitrageFilterMatch returns whether an opportunity matches the optional
+// asset and pair filters
+func arbitrageFilterMatch(opp *ArbitrageOpportunity, a asset.Item, p currency.Pair) bool {
+	if a != "" && opp.Asset != a {
+		return false
+	}
+	return p.IsEmpty() || opp.Pair.Equal(p)
+}
+
+func arbitrageOpportunityToRPC(opp *ArbitrageOpportunity) *gctrpc.ArbitrageOpportunity {
+	return &gctrpc.ArbitrageOpportunity{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: opp.Pair.Delimiter,
+			Base:      opp.Pair.Base.String(),
+			Quote:     opp.Pair.Quote.String(),
+		},
+		Asset:                opp.Asset.String(),
+		BuyExchange:          opp.BuyExchange,
+		SellExchange:         opp.SellExchange,
+		Amount:               opp.Amount,
+		BuyPrice:             opp.BuyPrice,
+		SellPrice:            opp.SellPrice,
+		Cost:                 opp.Cost,
+		Proceeds:             opp.Proceeds,
+		TradingFees:          opp.TradingFees,
+		WithdrawalFee:        opp.WithdrawalFee,
+		WithdrawalFeeUnknown: opp.WithdrawalFeeUnknown,
+		Profit:               opp.Profit,
+		ProfitPercentage:     opp.ProfitPercentage,
+		Timestamp:            opp.Timestamp.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+	}
+}
+
+// triangularFilterMatch returns whether a triangular cycle matches the
+// optional exchange and asset filters
+func triangularFilterMatch(opp *TriangularOpportunity, exchName string, a asset.Item) bool {
+	if exchName != "" && !strings.EqualFold(opp.Exchange, exchName) {
+		return false
+	}
+	return a == "" || opp.Asset == a
+}
+
+func triangularOpportunityToRPC(opp *TriangularOpportunity) *gctrpc.TriangularArbitrageOpportunity {
+	legs := make([]*gctrpc.TriangularArbitrageLeg, len(opp.Legs))
+	for i := range opp.Legs {
+		legs[i] = &gctrpc.TriangularArbitrageLeg{
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: opp.Legs[i].Pair.Delimiter,
+				Base:      opp.Legs[i].Pair.Base.String(),
+				Quote:     opp.Legs[i].Pair.Quote.String(),
+			},
+			Side:         opp.Legs[i].Side.String(),
+			Amount:       opp.Legs[i].Amount,
+			AveragePrice: opp.Legs[i].AveragePrice,
+			Fee:          opp.Legs[i].Fee,
+		}
+	}
+	return &gctrpc.TriangularArbitrageOpportunity{
+		Exchange:         opp.Exchange,
+		Asset:            opp.Asset.String(),
+		Currency:         opp.Currency.String(),
+		Legs:             legs,
+		StartAmount:      opp.StartAmount,
+		EndAmount:        opp.EndAmount,
+		Profit:           opp.Profit,
+		ProfitPercentage: opp.ProfitPercentage,
+		Timestamp:        opp.Timestamp.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+	}
+}
+
+// GetRateLimitBudgets returns the usage of the weighted HTTP rate limit
+// budgets tracked for an exchange
+func (s *RPCServer) GetRateLimitBudgets(_ context.Context, r *gctrpc.GetRateLimitBudgetsRequest) (*gctrpc.GetRateLimitBudgetsResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	budgets, err := exch.GetBase().Requester.GetBudgetStatus()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetRateLimitBudgetsResponse{
+		Exchange: exch.GetName(),
+		Budgets:  make([]*gctrpc.RateLimitBudget, len(budgets)),
+	}
+	for i := range budgets {
+		resp.Budgets[i] = &gctrpc.RateLimitBudget{
+			Name:     budgets[i].Name,
+			Limit:    int64(budgets[i].Limit),
+			Used:     int64(budgets[i].Used),
+			Interval: budgets[i].Interval.String(),
+			Shared:   budgets[i].Shared,
+		}
+		if !budgets[i].ResetsAt.IsZero() {
+			resp.Budgets[i].ResetsAt = budgets[i].ResetsAt.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone)
+		}
+		if !budgets[i].PausedUntil.IsZero() {
+			resp.Budgets[i].PausedUntil = budgets[i].PausedUntil.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone)
+		}
+	}
+	return resp, nil
+}
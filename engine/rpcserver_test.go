@@ -29,8 +29,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
@@ -154,6 +156,24 @@ func (f fExchange) CanDeposit(c currency.Code, a asset.Item) error {
 	return nil
 }
 
+// GetFuturesPositions overrides testExchange's positions function
+func (f fExchange) GetFuturesPositions(_ context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	return []futures.Position{{
+		Exchange:   fakeExchangeName,
+		Asset:      a,
+		Pair:       p,
+		Amount:     -2,
+		EntryPrice: 100,
+		Leverage:   5,
+		MarginType: futures.Isolated,
+	}}, nil
+}
+
+// SetMarginType overrides testExchange's margin type function
+func (f fExchange) SetMarginType(_ context.Context, _ asset.Item, _ currency.Pair, _ futures.MarginType) error {
+	return nil
+}
+
 // Sets up everything required to run any function inside rpcserver
 // Only use if you require a database, this makes tests slow
 func RPCTestSetup(t *testing.T) *Engine {
@@ -1978,3 +1998,340 @@ func TestCurrencyStateTradingPair(t *testing.T) {
 		t.Fatalf("received: %v, but expected: %v", err, nil)
 	}
 }
+
+func TestExecutionOrderRPC(t *testing.T) {
+	t.Parallel()
+	e, _, _ := executionManagerSetup(t, nil)
+	s := RPCServer{Engine: &Engine{executionManager: e}}
+
+	_, err := s.SubmitExecutionOrder(context.Background(), &gctrpc.SubmitExecutionOrderRequest{
+		Exchange:  testExchange,
+		AssetType: asset.Spot.String(),
+	})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received '%v', expected '%v'", err, errCurrencyPairUnset)
+	}
+
+	parent, err := e.Submit(context.Background(), executionSubmission(TWAP))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	resp, err := s.GetExecutionOrders(context.Background(), &gctrpc.GetExecutionOrdersRequest{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(resp.Orders) != 1 || resp.Orders[0].Id != parent.ID {
+		t.Fatalf("received '%v', expected parent order '%v'", resp.Orders, parent.ID)
+	}
+
+	_, err = s.GetExecutionOrders(context.Background(), &gctrpc.GetExecutionOrdersRequest{Id: "unknown"})
+	if !errors.Is(err, ErrExecutionOrderNotFound) {
+		t.Errorf("received '%v', expected '%v'", err, ErrExecutionOrderNotFound)
+	}
+
+	_, err = s.SetExecutionOrderStatus(context.Background(), &gctrpc.SetExecutionOrderStatusRequest{Id: parent.ID, Status: "stop"})
+	if !errors.Is(err, errExecutionStatusUnset) {
+		t.Errorf("received '%v', expected '%v'", err, errExecutionStatusUnset)
+	}
+
+	o, err := s.SetExecutionOrderStatus(context.Background(), &gctrpc.SetExecutionOrderStatusRequest{Id: parent.ID, Status: "PAUSE"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if o.Status != string(ExecutionPaused) {
+		t.Errorf("received '%v', expected '%v'", o.Status, ExecutionPaused)
+	}
+
+	o, err = s.SetExecutionOrderStatus(context.Background(), &gctrpc.SetExecutionOrderStatusRequest{Id: parent.ID, Status: "cancel"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if o.Status != string(ExecutionCancelled) {
+		t.Errorf("received '%v', expected '%v'", o.Status, ExecutionCancelled)
+	}
+}
+
+func TestSetKillSwitch(t *testing.T) {
+	t.Parallel()
+	m, _ := riskControlsSetup(t, &config.OrderRiskControls{})
+	s := RPCServer{Engine: &Engine{OrderManager: m}}
+	_, err := s.SetKillSwitch(context.Background(), nil)
+	if !errors.Is(err, errNilRequestData) {
+		t.Errorf("received '%v', expected '%v'", err, errNilRequestData)
+	}
+	_, err = s.SetKillSwitch(context.Background(), &gctrpc.SetKillSwitchRequest{})
+	if !errors.Is(err, errKillSwitchNotEngaged) {
+		t.Errorf("received '%v', expected '%v'", err, errKillSwitchNotEngaged)
+	}
+	_, err = s.SetKillSwitch(context.Background(), &gctrpc.SetKillSwitchRequest{Engage: true})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if !m.IsKillSwitchEngaged() {
+		t.Error("expected kill switch to be engaged")
+	}
+	_, err = s.SetKillSwitch(context.Background(), &gctrpc.SetKillSwitchRequest{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestFillLedgerRPC(t *testing.T) {
+	t.Parallel()
+	f, om := fillLedgerSetup(t, FIFO)
+	s := RPCServer{Engine: &Engine{fillLedger: f}}
+	p := currency.NewPair(currency.DOGE, currency.USDT)
+	om.add(ledgerFill("1", p, order.Buy, 10, 1), ledgerFill("2", p, order.Sell, 5, 1.2))
+	f.process()
+
+	_, err := s.GetPositions(context.Background(), &gctrpc.GetPositionsRequest{Asset: "fake"})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	pair := &gctrpc.CurrencyPair{Base: p.Base.String(), Quote: p.Quote.String()}
+	positions, err := s.GetPositions(context.Background(), &gctrpc.GetPositionsRequest{
+		Exchange: fillLedgerTestExchange,
+		Asset:    asset.Spot.String(),
+		Pair:     pair,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(positions.Positions) != 1 || positions.Positions[0].Amount != 5 {
+		t.Fatalf("received '%v', expected a single position of '%v'", positions.Positions, 5)
+	}
+
+	pnl, err := s.GetPnL(context.Background(), &gctrpc.GetPnLRequest{
+		Exchange: fillLedgerTestExchange,
+		Pair:     pair,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if pnl.Currency != currency.USDT.String() || !floatEquals(pnl.RealisedPnl, 1) {
+		t.Errorf("received '%v %v', expected '%v %v'", pnl.RealisedPnl, pnl.Currency, 1, currency.USDT)
+	}
+}
+
+func TestFuturesManagementRPC(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := exch.GetBase()
+	b.Name = fakeExchangeName
+	b.Enabled = true
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	b.CurrencyPairs.Pairs[asset.Futures] = &currency.PairStore{
+		Available:     currency.Pairs{cp},
+		Enabled:       currency.Pairs{cp},
+		AssetEnabled:  convert.BoolPtr(true),
+		ConfigFormat:  &currency.PairFormat{Uppercase: true},
+		RequestFormat: &currency.PairFormat{Uppercase: true},
+	}
+	em.Add(fExchange{IBotExchange: exch})
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+	pair := &gctrpc.CurrencyPair{Base: cp.Base.String(), Quote: cp.Quote.String()}
+
+	_, err = s.GetFuturesPositions(context.Background(), nil)
+	if !errors.Is(err, errNilRequestData) {
+		t.Errorf("received '%v', expected '%v'", err, errNilRequestData)
+	}
+	_, err = s.GetFuturesPositions(context.Background(), &gctrpc.GetFuturesPositionsRequest{
+		Exchange: fakeExchangeName,
+		Asset:    asset.Spot.String(),
+	})
+	if !errors.Is(err, errAssetTypeDisabled) {
+		t.Errorf("received '%v', expected '%v'", err, errAssetTypeDisabled)
+	}
+	positions, err := s.GetFuturesPositions(context.Background(), &gctrpc.GetFuturesPositionsRequest{
+		Exchange: fakeExchangeName,
+		Asset:    asset.Futures.String(),
+		Pair:     pair,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(positions.Positions) != 1 ||
+		positions.Positions[0].Amount != -2 ||
+		positions.Positions[0].MarginType != futures.Isolated.String() {
+		t.Errorf("received '%v', expected a single isolated short position", positions.Positions)
+	}
+
+	_, err = s.GetFundingRates(context.Background(), &gctrpc.GetFundingRatesRequest{
+		Exchange: fakeExchangeName,
+		Asset:    asset.Futures.String(),
+	})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, common.ErrFunctionNotSupported)
+	}
+
+	_, err = s.SetLeverage(context.Background(), &gctrpc.SetLeverageRequest{
+		Exchange: fakeExchangeName,
+		Asset:    asset.Futures.String(),
+		Pair:     &gctrpc.CurrencyPair{Base: "LTC", Quote: "USD"},
+		Leverage: 2,
+	})
+	if !errors.Is(err, errCurrencyPairInvalid) {
+		t.Errorf("received '%v', expected '%v'", err, errCurrencyPairInvalid)
+	}
+
+	_, err = s.SetMarginType(context.Background(), &gctrpc.SetMarginTypeRequest{
+		Exchange:   fakeExchangeName,
+		Asset:      asset.Futures.String(),
+		Pair:       pair,
+		MarginType: "portfolio",
+	})
+	if err == nil {
+		t.Error(unexpectedLackOfError)
+	}
+	resp, err := s.SetMarginType(context.Background(), &gctrpc.SetMarginTypeRequest{
+		Exchange:   fakeExchangeName,
+		Asset:      asset.Futures.String(),
+		Pair:       pair,
+		MarginType: "cross",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != MsgStatusSuccess {
+		t.Errorf("received '%v', expected '%v'", resp.Status, MsgStatusSuccess)
+	}
+
+	_, err = s.GetCollateral(context.Background(), &gctrpc.GetCollateralRequest{
+		Exchange: "bruh",
+		Asset:    asset.Futures.String(),
+	})
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received '%v', expected '%v'", err, ErrExchangeNotFound)
+	}
+}
+
+func TestGetArbitrageOpportunities(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetArbitrageOpportunities(context.Background(), nil)
+	if !errors.Is(err, errNilRequestData) {
+		t.Errorf("received '%v', expected '%v'", err, errNilRequestData)
+	}
+	_, err = s.GetArbitrageOpportunities(context.Background(), &gctrpc.GetArbitrageOpportunitiesRequest{})
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	_, err = s.GetArbitrageOpportunities(context.Background(), &gctrpc.GetArbitrageOpportunitiesRequest{
+		Asset: "fake",
+	})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+
+	s.arbitrageScanner, _, _, _ = arbitrageSetup(t, "arbscanrpc")
+	s.arbitrageScanner.scan()
+	resp, err := s.GetArbitrageOpportunities(context.Background(), &gctrpc.GetArbitrageOpportunitiesRequest{
+		Asset: asset.Spot.String(),
+		Pair:  &gctrpc.CurrencyPair{Base: "BTC", Quote: "USDT"},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(resp.Opportunities) != 1 {
+		t.Fatalf("received '%v' opportunities, expected '%v'", len(resp.Opportunities), 1)
+	}
+	if resp.Opportunities[0].BuyExchange != "arbscanrpca" {
+		t.Errorf("received '%v', expected '%v'", resp.Opportunities[0].BuyExchange, "arbscanrpca")
+	}
+	resp, err = s.GetArbitrageOpportunities(context.Background(), &gctrpc.GetArbitrageOpportunitiesRequest{
+		Pair: &gctrpc.CurrencyPair{Base: "ETH", Quote: "USDT"},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(resp.Opportunities) != 0 {
+		t.Errorf("received '%v' opportunities, expected '%v'", len(resp.Opportunities), 0)
+	}
+}
+
+func TestGetTriangularArbitrageOpportunities(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetTriangularArbitrageOpportunities(context.Background(), nil)
+	if !errors.Is(err, errNilRequestData) {
+		t.Errorf("received '%v', expected '%v'", err, errNilRequestData)
+	}
+	_, err = s.GetTriangularArbitrageOpportunities(context.Background(), &gctrpc.GetTriangularArbitrageOpportunitiesRequest{})
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	_, err = s.GetTriangularArbitrageOpportunities(context.Background(), &gctrpc.GetTriangularArbitrageOpportunitiesRequest{
+		Asset: "fake",
+	})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+
+	s.arbitrageScanner, _ = triangularSetup(t, "arbtrirpc")
+	s.arbitrageScanner.scan()
+	resp, err := s.GetTriangularArbitrageOpportunities(context.Background(), &gctrpc.GetTriangularArbitrageOpportunitiesRequest{
+		Exchange: "ArbTriRPC",
+		Asset:    asset.Spot.String(),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(resp.Opportunities) != 1 {
+		t.Fatalf("received '%v' opportunities, expected '%v'", len(resp.Opportunities), 1)
+	}
+	if len(resp.Opportunities[0].Legs) != 3 {
+		t.Errorf("received '%v' legs, expected '%v'", len(resp.Opportunities[0].Legs), 3)
+	}
+	resp, err = s.GetTriangularArbitrageOpportunities(context.Background(), &gctrpc.GetTriangularArbitrageOpportunitiesRequest{
+		Exchange: "someotherexchange",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(resp.Opportunities) != 0 {
+		t.Errorf("received '%v' opportunities, expected '%v'", len(resp.Opportunities), 0)
+	}
+}
+
+func TestGetRateLimitBudgets(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	for _, name := range []string{"Binance", testExchange} {
+		exch, err := em.NewExchangeByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exch.SetDefaults()
+		em.Add(exch)
+	}
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+
+	_, err := s.GetRateLimitBudgets(context.Background(), nil)
+	if !errors.Is(err, errNilRequestData) {
+		t.Errorf("received '%v', expected '%v'", err, errNilRequestData)
+	}
+	_, err = s.GetRateLimitBudgets(context.Background(), &gctrpc.GetRateLimitBudgetsRequest{Exchange: fakeExchangeName})
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received '%v', expected '%v'", err, ErrExchangeNotFound)
+	}
+	_, err = s.GetRateLimitBudgets(context.Background(), &gctrpc.GetRateLimitBudgetsRequest{Exchange: testExchange})
+	if !errors.Is(err, request.ErrBudgetsUnsupported) {
+		t.Errorf("received '%v', expected '%v'", err, request.ErrBudgetsUnsupported)
+	}
+	resp, err := s.GetRateLimitBudgets(context.Background(), &gctrpc.GetRateLimitBudgetsRequest{Exchange: "Binance"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(resp.Budgets) != 6 {
+		t.Fatalf("received '%v' budgets, expected '%v'", len(resp.Budgets), 6)
+	}
+	if resp.Budgets[0].Name != "binance_spot_weight" || resp.Budgets[0].Limit != 1200 || resp.Budgets[0].Interval != time.Minute.String() {
+		t.Errorf("unexpected budget %+v", resp.Budgets[0])
+	}
+}
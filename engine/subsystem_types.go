@@ -58,6 +58,7 @@ type iOrderManager interface {
 	Cancel(context.Context, *order.Cancel) error
 	GetByExchangeAndID(string, string) (*order.Detail, error)
 	UpdateExistingOrder(*order.Detail) error
+	ResyncExchangeOrders(string) error
 }
 
 // iPortfolioManager limits exposure of accessible functions to portfolio manager
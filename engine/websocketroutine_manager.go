@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"errors"
 	"fmt"
 	"sync/atomic"
 
@@ -237,6 +238,13 @@ func (m *websocketRoutineManager) WebsocketDataHandler(exchName string, data int
 		if err != nil {
 			return err
 		}
+	case stream.ConnectionEstablished:
+		// Order updates may have been missed while disconnected so resync
+		// the exchange's orders over REST
+		err := m.orderManager.ResyncExchangeOrders(exchName)
+		if err != nil && !errors.Is(err, ErrSubSystemNotStarted) {
+			return err
+		}
 	case order.ClassificationError:
 		return fmt.Errorf("%w %s", d.Err, d.Error())
 	case stream.UnhandledMessageWarning:
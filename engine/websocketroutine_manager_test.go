@@ -177,6 +177,10 @@ func TestWebsocketRoutineManagerHandleData(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+	err = m.WebsocketDataHandler(exchName, stream.ConnectionEstablished{Exchange: exchName})
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
 	origOrder := &order.Detail{
 		Exchange: exchName,
 		ID:       orderID,
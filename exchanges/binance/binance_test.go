@@ -15,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
@@ -2588,3 +2589,96 @@ func TestWsOutboundAccountPosition(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetFuturesPositions(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = b.GetFuturesPositions(context.Background(), asset.USDTMarginedFutures, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = b.GetFuturesPositions(context.Background(), asset.CoinMarginedFutures, currency.NewPairWithDelimiter("BTCUSD", "PERP", "_"))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesFundingRates(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetFuturesFundingRates(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if mockTests {
+		t.Skip("skipping test: no mock data recorded for USDT margined mark prices")
+	}
+	_, err = b.GetFuturesFundingRates(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	err := b.SetLeverage(context.Background(), asset.USDTMarginedFutures, p, 2.5)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrInvalidLeverage)
+	}
+	err = b.SetLeverage(context.Background(), asset.USDTMarginedFutures, currency.Pair{}, 2)
+	if !errors.Is(err, futures.ErrPairRequired) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrPairRequired)
+	}
+	err = b.SetLeverage(context.Background(), asset.Spot, p, 2)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = b.SetLeverage(context.Background(), asset.USDTMarginedFutures, p, 2)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	err := b.SetMarginType(context.Background(), asset.USDTMarginedFutures, p, futures.UnknownMargin)
+	if !errors.Is(err, futures.ErrMarginTypeUnsupported) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrMarginTypeUnsupported)
+	}
+	err = b.SetMarginType(context.Background(), asset.USDTMarginedFutures, currency.Pair{}, futures.Cross)
+	if !errors.Is(err, futures.ErrPairRequired) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrPairRequired)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = b.SetMarginType(context.Background(), asset.USDTMarginedFutures, p, futures.Isolated)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetCollateral(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetCollateral(context.Background(), asset.Spot)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = b.GetCollateral(context.Background(), asset.USDTMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -25,6 +25,9 @@ import (
 const (
 	binanceDefaultWebsocketURL = "wss://stream.binance.com:9443/stream"
 	pingDelay                  = time.Minute * 9
+	// binanceMaxStreamsPerConnection defines the max amount of streams a
+	// single websocket connection can subscribe to
+	binanceMaxStreamsPerConnection = 1024
 )
 
 var listenKey string
@@ -88,12 +91,36 @@ func (b *Binance) WsConnect() error {
 	})
 
 	b.Websocket.Wg.Add(1)
-	go b.wsReadData()
+	go b.wsReadData(b.Websocket.Conn)
 
 	b.setupOrderbookManager()
 	return nil
 }
 
+// wsConnectStreams establishes a pooled websocket connection which market
+// data streams are subscribed over
+func (b *Binance) wsConnectStreams(conn stream.Connection) error {
+	var dialer websocket.Dialer
+	dialer.HandshakeTimeout = b.Config.HTTPTimeout
+	dialer.Proxy = http.ProxyFromEnvironment
+	// Pooled connections do not carry the user data stream
+	conn.SetURL(strings.Split(conn.GetURL(), "?streams=")[0])
+	err := conn.Dial(&dialer, http.Header{})
+	if err != nil {
+		return fmt.Errorf("%v - Unable to connect to Websocket. Error: %s",
+			b.Name,
+			err)
+	}
+	conn.SetupPingHandler(stream.PingHandler{
+		UseGorillaHandler: true,
+		MessageType:       websocket.PongMessage,
+		Delay:             pingDelay,
+	})
+	b.Websocket.Wg.Add(1)
+	go b.wsReadData(conn)
+	return nil
+}
+
 func (b *Binance) setupOrderbookManager() {
 	if b.obm == nil {
 		b.obm = &orderbookManager{
@@ -142,11 +169,11 @@ func (b *Binance) KeepAuthKeyAlive() {
 }
 
 // wsReadData receives and passes on websocket messages for processing
-func (b *Binance) wsReadData() {
+func (b *Binance) wsReadData(conn stream.Connection) {
 	defer b.Websocket.Wg.Done()
 
 	for {
-		resp := b.Websocket.Conn.ReadMessage()
+		resp := conn.ReadMessage()
 		if resp.Raw == nil {
 			return
 		}
@@ -569,15 +596,15 @@ func (b *Binance) GenerateSubscriptions() ([]stream.ChannelSubscription, error)
 	return subscriptions, nil
 }
 
-// Subscribe subscribes to a set of channels
-func (b *Binance) Subscribe(channelsToSubscribe []stream.ChannelSubscription) error {
+// Subscribe subscribes to a set of channels over a connection
+func (b *Binance) Subscribe(conn stream.Connection, channelsToSubscribe []stream.ChannelSubscription) error {
 	payload := WsPayload{
 		Method: "SUBSCRIBE",
 	}
 	for i := range channelsToSubscribe {
 		payload.Params = append(payload.Params, channelsToSubscribe[i].Channel)
 		if i%50 == 0 && i != 0 {
-			err := b.Websocket.Conn.SendJSONMessage(payload)
+			err := conn.SendJSONMessage(payload)
 			if err != nil {
 				return err
 			}
@@ -585,24 +612,35 @@ func (b *Binance) Subscribe(channelsToSubscribe []stream.ChannelSubscription) er
 		}
 	}
 	if len(payload.Params) > 0 {
-		err := b.Websocket.Conn.SendJSONMessage(payload)
+		err := conn.SendJSONMessage(payload)
 		if err != nil {
 			return err
 		}
 	}
 	b.Websocket.AddSuccessfulSubscriptions(channelsToSubscribe...)
+	if b.obm != nil {
+		for i := range channelsToSubscribe {
+			if !strings.Contains(channelsToSubscribe[i].Channel, "@depth") {
+				continue
+			}
+			// Resubscribed books are synchronised again via REST as updates
+			// were missed while disconnected, books not yet seen are
+			// synchronised on their first update
+			_ = b.obm.setNeedsFetchingBook(channelsToSubscribe[i].Currency)
+		}
+	}
 	return nil
 }
 
-// Unsubscribe unsubscribes from a set of channels
-func (b *Binance) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription) error {
+// Unsubscribe unsubscribes from a set of channels over a connection
+func (b *Binance) Unsubscribe(conn stream.Connection, channelsToUnsubscribe []stream.ChannelSubscription) error {
 	payload := WsPayload{
 		Method: "UNSUBSCRIBE",
 	}
 	for i := range channelsToUnsubscribe {
 		payload.Params = append(payload.Params, channelsToUnsubscribe[i].Channel)
 		if i%50 == 0 && i != 0 {
-			err := b.Websocket.Conn.SendJSONMessage(payload)
+			err := conn.SendJSONMessage(payload)
 			if err != nil {
 				return err
 			}
@@ -610,7 +648,7 @@ func (b *Binance) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription
 		}
 	}
 	if len(payload.Params) > 0 {
-		err := b.Websocket.Conn.SendJSONMessage(payload)
+		err := conn.SendJSONMessage(payload)
 		if err != nil {
 			return err
 		}
@@ -664,15 +702,22 @@ func (b *Binance) ProcessUpdate(cp currency.Pair, a asset.Item, ws *WebsocketDep
 	}
 
 	return b.Websocket.Orderbook.Update(&buffer.Update{
-		Bids:       updateBid,
-		Asks:       updateAsk,
-		Pair:       cp,
-		UpdateID:   ws.LastUpdateID,
-		UpdateTime: ws.Timestamp,
-		Asset:      a,
+		Bids:          updateBid,
+		Asks:          updateAsk,
+		Pair:          cp,
+		UpdateID:      ws.LastUpdateID,
+		FirstUpdateID: ws.FirstUpdateID,
+		UpdateTime:    ws.Timestamp,
+		Asset:         a,
 	})
 }
 
+// wsResyncOB flags a book which has failed sequence verification to be
+// fetched via REST, the fetch is initiated by the next websocket update
+func (b *Binance) wsResyncOB(p currency.Pair, _ asset.Item) error {
+	return b.obm.setNeedsFetchingBook(p)
+}
+
 // applyBufferUpdate applies the buffer to the orderbook or initiates a new
 // orderbook sync by the REST protocol which is off handed to go routine.
 func (b *Binance) applyBufferUpdate(pair currency.Pair) error {
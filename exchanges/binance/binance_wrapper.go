@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -226,14 +228,18 @@ func (b *Binance) Setup(exch *config.ExchangeConfig) error {
 		ExchangeName:                     exch.Name,
 		RunningURL:                       ePoint,
 		Connector:                        b.WsConnect,
-		Subscriber:                       b.Subscribe,
-		UnSubscriber:                     b.Unsubscribe,
 		GenerateSubscriptions:            b.GenerateSubscriptions,
 		Features:                         &b.Features.Supports.WebsocketCapabilities,
 		OrderbookBufferLimit:             exch.OrderbookConfig.WebsocketBufferLimit,
 		BufferEnabled:                    exch.OrderbookConfig.WebsocketBufferEnabled,
 		SortBuffer:                       true,
 		SortBufferByUpdateIDs:            true,
+		VerifyOrderbookSequence:          true,
+		OrderbookResync:                  b.wsResyncOB,
+		MaxSubscriptionsPerConnection:    binanceMaxStreamsPerConnection,
+		ShardConnector:                   b.wsConnectStreams,
+		ShardSubscriber:                  b.Subscribe,
+		ShardUnsubscriber:                b.Unsubscribe,
 	})
 	if err != nil {
 		return err
@@ -1735,3 +1741,268 @@ func (b *Binance) UpdateOrderExecutionLimits(ctx context.Context, a asset.Item)
 	}
 	return b.LoadLimits(limits)
 }
+
+// GetFuturesPositions returns the open USDT and coin margined futures
+// positions, filtered by pair when one is supplied
+func (b *Binance) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	symbol, err := b.futuresSymbol(a, p)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.Position
+	switch a {
+	case asset.USDTMarginedFutures:
+		positions, err := b.UPositionsInfoV2(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		for i := range positions {
+			if positions[i].PositionAmount == 0 {
+				continue
+			}
+			cp, err := b.futuresSymbolToPair(a, positions[i].Symbol)
+			if err != nil {
+				return nil, err
+			}
+			marginType, err := futures.StringToMarginType(positions[i].MarginType)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, futures.Position{
+				Exchange:         b.Name,
+				Asset:            a,
+				Pair:             cp,
+				Amount:           positions[i].PositionAmount,
+				EntryPrice:       positions[i].EntryPrice,
+				MarkPrice:        positions[i].MarkPrice,
+				LiquidationPrice: positions[i].LiquidationPrice,
+				UnrealisedPNL:    positions[i].UnrealizedProfit,
+				Leverage:         positions[i].Leverage,
+				MarginType:       marginType,
+				Margin:           positions[i].IsolatedMargin,
+				LastUpdated:      time.Now(),
+			})
+		}
+	case asset.CoinMarginedFutures:
+		positions, err := b.FuturesPositionsInfo(ctx, "", "")
+		if err != nil {
+			return nil, err
+		}
+		for i := range positions {
+			if positions[i].PositionAmount == 0 ||
+				(symbol != "" && !strings.EqualFold(positions[i].Symbol, symbol)) {
+				continue
+			}
+			cp, err := b.futuresSymbolToPair(a, positions[i].Symbol)
+			if err != nil {
+				return nil, err
+			}
+			marginType, err := futures.StringToMarginType(positions[i].MarginType)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, futures.Position{
+				Exchange:         b.Name,
+				Asset:            a,
+				Pair:             cp,
+				Amount:           positions[i].PositionAmount,
+				EntryPrice:       positions[i].EntryPrice,
+				MarkPrice:        positions[i].MarkPrice,
+				LiquidationPrice: positions[i].LiquidationPrice,
+				UnrealisedPNL:    positions[i].UnrealizedProfit,
+				Leverage:         float64(positions[i].Leverage),
+				MarginType:       marginType,
+				Margin:           positions[i].IsolatedMargin,
+				LastUpdated:      time.Now(),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return resp, nil
+}
+
+// GetFuturesFundingRates returns the current funding rates of perpetual
+// contracts, filtered by pair when one is supplied
+func (b *Binance) GetFuturesFundingRates(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.FundingRate, error) {
+	symbol, err := b.futuresSymbol(a, p)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.FundingRate
+	switch a {
+	case asset.USDTMarginedFutures:
+		prices, err := b.UGetMarkPrice(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		for i := range prices {
+			cp, err := b.futuresSymbolToPair(a, prices[i].Symbol)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, futures.FundingRate{
+				Exchange:    b.Name,
+				Asset:       a,
+				Pair:        cp,
+				Rate:        prices[i].LastFundingRate,
+				MarkPrice:   prices[i].MarkPrice,
+				IndexPrice:  prices[i].IndexPrice,
+				NextFunding: time.Unix(0, prices[i].NextFundingTime*int64(time.Millisecond)),
+				Time:        time.Unix(0, prices[i].Time*int64(time.Millisecond)),
+			})
+		}
+	case asset.CoinMarginedFutures:
+		prices, err := b.GetIndexAndMarkPrice(ctx, symbol, "")
+		if err != nil {
+			return nil, err
+		}
+		for i := range prices {
+			if prices[i].LastFundingRate == "" {
+				// Delivery contracts are not funded
+				continue
+			}
+			rate, err := strconv.ParseFloat(prices[i].LastFundingRate, 64)
+			if err != nil {
+				return nil, err
+			}
+			cp, err := b.futuresSymbolToPair(a, prices[i].Symbol)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, futures.FundingRate{
+				Exchange:    b.Name,
+				Asset:       a,
+				Pair:        cp,
+				Rate:        rate,
+				MarkPrice:   prices[i].MarkPrice,
+				IndexPrice:  prices[i].IndexPrice,
+				NextFunding: time.Unix(0, prices[i].NextFundingTime*int64(time.Millisecond)),
+				Time:        time.Unix(0, prices[i].Time*int64(time.Millisecond)),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return resp, nil
+}
+
+// SetLeverage sets the initial leverage of a futures pair. Binance only
+// accepts whole number leverage
+func (b *Binance) SetLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	if leverage <= 0 || leverage != math.Trunc(leverage) {
+		return fmt.Errorf("%w, %v must be a whole number", futures.ErrInvalidLeverage, leverage)
+	}
+	if p.IsEmpty() {
+		return futures.ErrPairRequired
+	}
+	var err error
+	switch a {
+	case asset.USDTMarginedFutures:
+		_, err = b.UChangeInitialLeverageRequest(ctx, p, int64(leverage))
+	case asset.CoinMarginedFutures:
+		_, err = b.FuturesChangeInitialLeverage(ctx, p, int64(leverage))
+	default:
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return err
+}
+
+// SetMarginType sets whether a futures pair uses isolated or cross margin
+func (b *Binance) SetMarginType(ctx context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error {
+	if p.IsEmpty() {
+		return futures.ErrPairRequired
+	}
+	var mt string
+	switch marginType {
+	case futures.Isolated:
+		mt = "ISOLATED"
+	case futures.Cross:
+		mt = "CROSSED"
+	default:
+		return fmt.Errorf("%w %q", futures.ErrMarginTypeUnsupported, marginType)
+	}
+	var err error
+	switch a {
+	case asset.USDTMarginedFutures:
+		err = b.UChangeInitialMarginType(ctx, p, mt)
+	case asset.CoinMarginedFutures:
+		_, err = b.FuturesChangeMarginType(ctx, p, mt)
+	default:
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return err
+}
+
+// GetCollateral returns the margin balances of a futures account
+func (b *Binance) GetCollateral(ctx context.Context, a asset.Item) ([]futures.Collateral, error) {
+	var resp []futures.Collateral
+	switch a {
+	case asset.USDTMarginedFutures:
+		info, err := b.UAccountInformationV2(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range info.Assets {
+			if info.Assets[i].WalletBalance == 0 {
+				continue
+			}
+			resp = append(resp, futures.Collateral{
+				Currency:          currency.NewCode(info.Assets[i].Asset),
+				Total:             info.Assets[i].MarginBalance,
+				Available:         info.Assets[i].AvailableBalance,
+				UnrealisedPNL:     info.Assets[i].UnrealizedProfit,
+				InitialMargin:     info.Assets[i].InitialMargin,
+				MaintenanceMargin: info.Assets[i].MaintMargin,
+			})
+		}
+	case asset.CoinMarginedFutures:
+		info, err := b.GetFuturesAccountInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range info.Assets {
+			if info.Assets[i].WalletBalance == 0 {
+				continue
+			}
+			available := info.Assets[i].MarginBalance - info.Assets[i].InitialMargin
+			if available < 0 {
+				available = 0
+			}
+			resp = append(resp, futures.Collateral{
+				Currency:          currency.NewCode(info.Assets[i].Asset),
+				Total:             info.Assets[i].MarginBalance,
+				Available:         available,
+				UnrealisedPNL:     info.Assets[i].UnrealizedProfit,
+				InitialMargin:     info.Assets[i].InitialMargin,
+				MaintenanceMargin: info.Assets[i].MaintMargin,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return resp, nil
+}
+
+// futuresSymbol returns the exchange formatted symbol of a pair, or an empty
+// string when no pair is supplied
+func (b *Binance) futuresSymbol(a asset.Item, p currency.Pair) (string, error) {
+	if p.IsEmpty() {
+		return "", nil
+	}
+	return b.FormatSymbol(p, a)
+}
+
+// futuresSymbolToPair matches a futures symbol against the available pairs of
+// an asset so that pairs without a delimiter are split correctly
+func (b *Binance) futuresSymbolToPair(a asset.Item, symbol string) (currency.Pair, error) {
+	pairs, err := b.GetAvailablePairs(a)
+	if err != nil {
+		return currency.Pair{}, err
+	}
+	pFmt, err := b.GetPairFormat(a, true)
+	if err != nil {
+		return currency.Pair{}, err
+	}
+	return currency.NewPairFromFormattedPairs(symbol, pairs, pFmt)
+}
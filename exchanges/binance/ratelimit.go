@@ -2,11 +2,10 @@ package binance
 
 import (
 	"context"
-	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"golang.org/x/time/rate"
 )
 
 const (
@@ -27,6 +26,12 @@ const (
 	uFuturesRequestRate      = 2400
 	uFuturesOrderInterval    = time.Minute
 	uFuturesOrderRequestRate = 1200
+
+	// Response headers holding the weight and order count used in the
+	// current window, counted per IP and per account respectively
+	usedWeightHeader        = "X-Mbx-Used-Weight-1m"
+	spotOrderCountHeader    = "X-Mbx-Order-Count-10s"
+	futuresOrderCountHeader = "X-Mbx-Order-Count-1m"
 )
 
 // Binance Spot rate limits
@@ -96,158 +101,176 @@ const (
 
 // RateLimit implements the request.Limiter interface
 type RateLimit struct {
-	SpotRate           *rate.Limiter
-	SpotOrdersRate     *rate.Limiter
-	UFuturesRate       *rate.Limiter
-	UFuturesOrdersRate *rate.Limiter
-	CFuturesRate       *rate.Limiter
-	CFuturesOrdersRate *rate.Limiter
+	SpotRate           *request.Budget
+	SpotOrdersRate     *request.Budget
+	UFuturesRate       *request.Budget
+	UFuturesOrdersRate *request.Budget
+	CFuturesRate       *request.Budget
+	CFuturesOrdersRate *request.Budget
 }
 
 // Limit executes rate limiting functionality for Binance
 func (r *RateLimit) Limit(ctx context.Context, f request.EndpointLimit) error {
-	var limiter *rate.Limiter
-	var tokens int
+	budget, weight := r.budget(f)
+	return budget.Wait(ctx, weight)
+}
+
+// HandleResponse corrects the budget of the endpoint from the used weight and
+// order count headers returned by Binance
+func (r *RateLimit) HandleResponse(f request.EndpointLimit, resp *http.Response) error {
+	budget, _ := r.budget(f)
+	var weightBudget *request.Budget
+	var countHeader string
+	switch budget {
+	case r.SpotOrdersRate:
+		weightBudget, countHeader = r.SpotRate, spotOrderCountHeader
+	case r.UFuturesOrdersRate:
+		weightBudget, countHeader = r.UFuturesRate, futuresOrderCountHeader
+	case r.CFuturesOrdersRate:
+		weightBudget, countHeader = r.CFuturesRate, futuresOrderCountHeader
+	default:
+		return budget.HandleResponse(resp, usedWeightHeader)
+	}
+	err := budget.HandleResponse(resp, countHeader)
+	if err != nil {
+		return err
+	}
+	// Order endpoints also count towards the request weight of the market
+	return weightBudget.HandleResponse(resp, usedWeightHeader)
+}
+
+// Budgets returns the weighted budgets tracked for Binance
+func (r *RateLimit) Budgets() []*request.Budget {
+	return []*request.Budget{
+		r.SpotRate,
+		r.SpotOrdersRate,
+		r.UFuturesRate,
+		r.UFuturesOrdersRate,
+		r.CFuturesRate,
+		r.CFuturesOrdersRate,
+	}
+}
+
+// budget returns the budget and request weight of an endpoint
+func (r *RateLimit) budget(f request.EndpointLimit) (budget *request.Budget, weight int) {
 	switch f {
 	case spotDefaultRate:
-		limiter, tokens = r.SpotRate, 1
+		return r.SpotRate, 1
 	case spotOrderbookTickerAllRate,
 		spotSymbolPriceAllRate:
-		limiter, tokens = r.SpotRate, 2
+		return r.SpotRate, 2
 	case spotHistoricalTradesRate,
 		spotOrderbookDepth500Rate:
-		limiter, tokens = r.SpotRate, 5
+		return r.SpotRate, 5
 	case spotOrderbookDepth1000Rate,
 		spotAccountInformationRate,
 		spotExchangeInfo:
-		limiter, tokens = r.SpotRate, 10
+		return r.SpotRate, 10
 	case spotPriceChangeAllRate:
-		limiter, tokens = r.SpotRate, 40
+		return r.SpotRate, 40
 	case spotOrderbookDepth5000Rate:
-		limiter, tokens = r.SpotRate, 50
+		return r.SpotRate, 50
 	case spotOrderRate:
-		limiter, tokens = r.SpotOrdersRate, 1
+		return r.SpotOrdersRate, 1
 	case spotOrderQueryRate:
-		limiter, tokens = r.SpotOrdersRate, 2
+		return r.SpotOrdersRate, 2
 	case spotOpenOrdersSpecificRate:
-		limiter, tokens = r.SpotOrdersRate, 3
+		return r.SpotOrdersRate, 3
 	case spotAllOrdersRate:
-		limiter, tokens = r.SpotOrdersRate, 10
+		return r.SpotOrdersRate, 10
 	case spotOpenOrdersAllRate:
-		limiter, tokens = r.SpotOrdersRate, 40
+		return r.SpotOrdersRate, 40
 	case uFuturesDefaultRate,
 		uFuturesKline100Rate:
-		limiter, tokens = r.UFuturesRate, 1
+		return r.UFuturesRate, 1
 	case uFuturesOrderbook50Rate,
 		uFuturesKline500Rate,
 		uFuturesOrderbookTickerAllRate:
-		limiter, tokens = r.UFuturesRate, 2
+		return r.UFuturesRate, 2
 	case uFuturesOrderbook100Rate,
 		uFuturesKline1000Rate,
 		uFuturesAccountInformationRate:
-		limiter, tokens = r.UFuturesRate, 5
+		return r.UFuturesRate, 5
 	case uFuturesOrderbook500Rate,
 		uFuturesKlineMaxRate:
-		limiter, tokens = r.UFuturesRate, 10
+		return r.UFuturesRate, 10
 	case uFuturesOrderbook1000Rate,
 		uFuturesHistoricalTradesRate:
-		limiter, tokens = r.UFuturesRate, 20
+		return r.UFuturesRate, 20
 	case uFuturesTickerPriceHistoryRate:
-		limiter, tokens = r.UFuturesRate, 40
+		return r.UFuturesRate, 40
 	case uFuturesOrdersDefaultRate:
-		limiter, tokens = r.UFuturesOrdersRate, 1
+		return r.UFuturesOrdersRate, 1
 	case uFuturesBatchOrdersRate,
 		uFuturesGetAllOrdersRate:
-		limiter, tokens = r.UFuturesOrdersRate, 5
+		return r.UFuturesOrdersRate, 5
 	case uFuturesCountdownCancelRate:
-		limiter, tokens = r.UFuturesOrdersRate, 10
+		return r.UFuturesOrdersRate, 10
 	case uFuturesCurrencyForceOrdersRate,
 		uFuturesSymbolOrdersRate:
-		limiter, tokens = r.UFuturesOrdersRate, 20
+		return r.UFuturesOrdersRate, 20
 	case uFuturesIncomeHistoryRate:
-		limiter, tokens = r.UFuturesOrdersRate, 30
+		return r.UFuturesOrdersRate, 30
 	case uFuturesPairOrdersRate,
 		uFuturesGetAllOpenOrdersRate:
-		limiter, tokens = r.UFuturesOrdersRate, 40
+		return r.UFuturesOrdersRate, 40
 	case uFuturesAllForceOrdersRate:
-		limiter, tokens = r.UFuturesOrdersRate, 50
+		return r.UFuturesOrdersRate, 50
 	case cFuturesKline100Rate:
-		limiter, tokens = r.CFuturesRate, 1
+		return r.CFuturesRate, 1
 	case cFuturesKline500Rate,
 		cFuturesOrderbookTickerAllRate:
-		limiter, tokens = r.CFuturesRate, 2
+		return r.CFuturesRate, 2
 	case cFuturesKline1000Rate,
 		cFuturesAccountInformationRate:
-		limiter, tokens = r.CFuturesRate, 5
+		return r.CFuturesRate, 5
 	case cFuturesKlineMaxRate,
 		cFuturesIndexMarkPriceRate:
-		limiter, tokens = r.CFuturesRate, 10
+		return r.CFuturesRate, 10
 	case cFuturesHistoricalTradesRate,
 		cFuturesCurrencyForceOrdersRate:
-		limiter, tokens = r.CFuturesRate, 20
+		return r.CFuturesRate, 20
 	case cFuturesTickerPriceHistoryRate:
-		limiter, tokens = r.CFuturesRate, 40
+		return r.CFuturesRate, 40
 	case cFuturesAllForceOrdersRate:
-		limiter, tokens = r.CFuturesRate, 50
+		return r.CFuturesRate, 50
 	case cFuturesOrdersDefaultRate:
-		limiter, tokens = r.CFuturesOrdersRate, 1
+		return r.CFuturesOrdersRate, 1
 	case cFuturesBatchOrdersRate,
 		cFuturesGetAllOpenOrdersRate:
-		limiter, tokens = r.CFuturesOrdersRate, 5
+		return r.CFuturesOrdersRate, 5
 	case cFuturesCancelAllOrdersRate:
-		limiter, tokens = r.CFuturesOrdersRate, 10
+		return r.CFuturesOrdersRate, 10
 	case cFuturesIncomeHistoryRate,
 		cFuturesSymbolOrdersRate:
-		limiter, tokens = r.CFuturesOrdersRate, 20
+		return r.CFuturesOrdersRate, 20
 	case cFuturesPairOrdersRate:
-		limiter, tokens = r.CFuturesOrdersRate, 40
+		return r.CFuturesOrdersRate, 40
 	case cFuturesOrderbook50Rate:
-		limiter, tokens = r.CFuturesRate, 2
+		return r.CFuturesRate, 2
 	case cFuturesOrderbook100Rate:
-		limiter, tokens = r.CFuturesRate, 5
+		return r.CFuturesRate, 5
 	case cFuturesOrderbook500Rate:
-		limiter, tokens = r.CFuturesRate, 10
+		return r.CFuturesRate, 10
 	case cFuturesOrderbook1000Rate:
-		limiter, tokens = r.CFuturesRate, 20
+		return r.CFuturesRate, 20
 	case cFuturesDefaultRate:
-		limiter, tokens = r.CFuturesRate, 1
+		return r.CFuturesRate, 1
 	default:
-		limiter, tokens = r.SpotRate, 1
-	}
-
-	var finalDelay time.Duration
-	var reserves = make([]*rate.Reservation, tokens)
-	for i := 0; i < tokens; i++ {
-		// Consume tokens 1 at a time as this avoids needing burst capacity in the limiter,
-		// which would otherwise allow the rate limit to be exceeded over short periods
-		reserves[i] = limiter.Reserve()
-		finalDelay = reserves[i].Delay()
-	}
-
-	if dl, ok := ctx.Deadline(); ok && dl.Before(time.Now().Add(finalDelay)) {
-		// Cancel all potential reservations to free up rate limiter if deadline
-		// is exceeded.
-		for x := range reserves {
-			reserves[x].Cancel()
-		}
-		return fmt.Errorf("rate limit delay of %s will exceed deadline: %w",
-			finalDelay,
-			context.DeadlineExceeded)
+		return r.SpotRate, 1
 	}
 
-	time.Sleep(finalDelay)
-	return nil
 }
 
 // SetRateLimit returns the rate limit for the exchange
 func SetRateLimit() *RateLimit {
 	return &RateLimit{
-		SpotRate:           request.NewRateLimit(spotInterval, spotRequestRate),
-		SpotOrdersRate:     request.NewRateLimit(spotOrderInterval, spotOrderRequestRate),
-		UFuturesRate:       request.NewRateLimit(uFuturesInterval, uFuturesRequestRate),
-		UFuturesOrdersRate: request.NewRateLimit(uFuturesOrderInterval, uFuturesOrderRequestRate),
-		CFuturesRate:       request.NewRateLimit(cFuturesInterval, cFuturesRequestRate),
-		CFuturesOrdersRate: request.NewRateLimit(cFuturesOrderInterval, cFuturesOrderRequestRate),
+		SpotRate:           request.NewBudget("binance_spot_weight", spotInterval, spotRequestRate),
+		SpotOrdersRate:     request.NewBudget("binance_spot_orders", spotOrderInterval, spotOrderRequestRate),
+		UFuturesRate:       request.NewBudget("binance_ufutures_weight", uFuturesInterval, uFuturesRequestRate),
+		UFuturesOrdersRate: request.NewBudget("binance_ufutures_orders", uFuturesOrderInterval, uFuturesOrderRequestRate),
+		CFuturesRate:       request.NewBudget("binance_cfutures_weight", cFuturesInterval, cFuturesRequestRate),
+		CFuturesOrdersRate: request.NewBudget("binance_cfutures_orders", cFuturesOrderInterval, cFuturesOrderRequestRate),
 	}
 }
 
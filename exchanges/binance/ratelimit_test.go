@@ -3,6 +3,7 @@ package binance
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
@@ -74,3 +75,37 @@ func TestRateLimit_LimitStatic(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimit_HandleResponse(t *testing.T) {
+	t.Parallel()
+	l := SetRateLimit()
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	resp.Header.Set("X-MBX-USED-WEIGHT-1M", "600")
+	resp.Header.Set("X-MBX-ORDER-COUNT-10S", "20")
+	err := l.HandleResponse(spotOrderRate, resp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if used := l.SpotRate.Status().Used; used != 600 {
+		t.Fatalf("received '%v', expected '%v'", used, 600)
+	}
+	if used := l.SpotOrdersRate.Status().Used; used != 20 {
+		t.Fatalf("received '%v', expected '%v'", used, 20)
+	}
+
+	resp.StatusCode = http.StatusTooManyRequests
+	resp.Header.Set("Retry-After", "10")
+	err = l.HandleResponse(uFuturesDefaultRate, resp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	err = l.Limit(ctx, uFuturesDefaultRate)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("received '%v', expected '%v'", err, context.DeadlineExceeded)
+	}
+	if len(l.Budgets()) != 6 {
+		t.Fatalf("received '%v', expected '%v'", len(l.Budgets()), 6)
+	}
+}
@@ -1060,15 +1060,32 @@ func (b *Bitfinex) WsUpdateOrderbook(p currency.Pair, assetType asset.Item, book
 				err)
 		}
 
+		// The checksum covers the book before this update is applied so it
+		// is checked here, books which fail it are resynced by the
+		// websocket orderbook buffer
 		err = validateCRC32(ob, checkme.Token)
 		if err != nil {
-			return err
+			return b.Websocket.Orderbook.Invalidate(p, assetType, err)
 		}
 	}
 
 	return b.Websocket.Orderbook.Update(&orderbookUpdate)
 }
 
+// wsResyncOB resubscribes to the book channel of a pair which has failed
+// checksum verification so that a new snapshot is sent
+func (b *Bitfinex) wsResyncOB(p currency.Pair, a asset.Item) error {
+	subs := b.Websocket.GetSubscriptions()
+	for i := range subs {
+		if subs[i].Channel == wsBook &&
+			subs[i].Asset == a &&
+			subs[i].Currency.Equal(p) {
+			return b.Websocket.ResubscribeToChannel(&subs[i])
+		}
+	}
+	return fmt.Errorf("%s %s %s book subscription not found", b.Name, p, a)
+}
+
 // GenerateDefaultSubscriptions Adds default subscriptions to websocket to be handled by ManageSubscriptions()
 func (b *Bitfinex) GenerateDefaultSubscriptions() ([]stream.ChannelSubscription, error) {
 	var channels = []string{
@@ -210,6 +210,7 @@ func (b *Bitfinex) Setup(exch *config.ExchangeConfig) error {
 		OrderbookBufferLimit:             exch.OrderbookConfig.WebsocketBufferLimit,
 		BufferEnabled:                    exch.OrderbookConfig.WebsocketBufferEnabled,
 		UpdateEntriesByID:                true,
+		OrderbookResync:                  b.wsResyncOB,
 	})
 	if err != nil {
 		return err
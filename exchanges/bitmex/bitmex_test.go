@@ -2,6 +2,7 @@ package bitmex
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -1134,3 +1136,127 @@ func TestUpdateTickers(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetFuturesPositions(context.Background(), asset.Index, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys not set, skipping test")
+	}
+	_, err = b.GetFuturesPositions(context.Background(), asset.PerpetualContract, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesFundingRates(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetFuturesFundingRates(context.Background(), asset.Index, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	_, err = b.GetFuturesFundingRates(context.Background(), asset.PerpetualContract, currency.NewPair(currency.XBT, currency.USD))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.XBT, currency.USD)
+	err := b.SetLeverage(context.Background(), asset.PerpetualContract, p, 0)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrInvalidLeverage)
+	}
+	err = b.SetLeverage(context.Background(), asset.PerpetualContract, currency.Pair{}, 2)
+	if !errors.Is(err, futures.ErrPairRequired) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrPairRequired)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("API keys not set or canManipulateRealOrders set to false, skipping test")
+	}
+	err = b.SetLeverage(context.Background(), asset.PerpetualContract, p, 2)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.XBT, currency.USD)
+	err := b.SetMarginType(context.Background(), asset.PerpetualContract, p, futures.UnknownMargin)
+	if !errors.Is(err, futures.ErrMarginTypeUnsupported) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrMarginTypeUnsupported)
+	}
+	err = b.SetMarginType(context.Background(), asset.Index, p, futures.Cross)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("API keys not set or canManipulateRealOrders set to false, skipping test")
+	}
+	err = b.SetMarginType(context.Background(), asset.PerpetualContract, p, futures.Cross)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetCollateral(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetCollateral(context.Background(), asset.Index)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys not set, skipping test")
+	}
+	_, err = b.GetCollateral(context.Background(), asset.PerpetualContract)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBitmexSettlementValue(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		value    int64
+		unit     string
+		expected float64
+		code     currency.Code
+	}{
+		{value: 150000000, unit: "XBt", expected: 1.5, code: currency.XBT},
+		{value: 2500000, unit: "USDt", expected: 2.5, code: currency.USDT},
+		{value: 3000000000, unit: "Gwei", expected: 3, code: currency.ETH},
+		{value: 7, unit: "LTC", expected: 7, code: currency.LTC},
+	} {
+		v, c := bitmexSettlementValue(tc.value, tc.unit)
+		if v != tc.expected {
+			t.Errorf("received '%v', expected '%v'", v, tc.expected)
+		}
+		if !c.Match(tc.code) {
+			t.Errorf("received '%v', expected '%v'", c, tc.code)
+		}
+	}
+}
+
+func TestSetDeadMansSwitch(t *testing.T) {
+	t.Parallel()
+	err := b.SetDeadMansSwitch(context.Background(), -time.Second)
+	if !errors.Is(err, exchange.ErrInvalidDeadMansSwitchTimeout) {
+		t.Errorf("received '%v', expected '%v'", err, exchange.ErrInvalidDeadMansSwitchTimeout)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test, either api keys are unset or canManipulateRealOrders is false")
+	}
+	err = b.SetDeadMansSwitch(context.Background(), time.Minute)
+	if err != nil {
+		t.Error(err)
+	}
+	err = b.SetDeadMansSwitch(context.Background(), 0)
+	if err != nil {
+		t.Error(err)
+	}
+}
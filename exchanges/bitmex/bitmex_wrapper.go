@@ -16,6 +16,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -100,6 +101,7 @@ func (b *Bitmex) SetDefaults() {
 				CryptoWithdrawal:    true,
 				TradeFee:            true,
 				CryptoWithdrawalFee: true,
+				DeadMansSwitch:      true,
 			},
 			WebsocketCapabilities: protocol.Features{
 				TradeFetching:          true,
@@ -655,6 +657,19 @@ func (b *Bitmex) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.Ca
 	return cancelAllOrdersResponse, nil
 }
 
+// SetDeadMansSwitch arms the dead man's switch which cancels all open orders
+// when it is not refreshed within the timeout. A zero timeout disarms the
+// switch
+func (b *Bitmex) SetDeadMansSwitch(ctx context.Context, timeout time.Duration) error {
+	if timeout < 0 {
+		return exchange.ErrInvalidDeadMansSwitchTimeout
+	}
+	_, err := b.CancelAllOrdersAfterTime(ctx, OrderCancelAllAfterParams{
+		Timeout: float64(timeout.Milliseconds()),
+	})
+	return err
+}
+
 // GetOrderInfo returns order information based on order ID
 func (b *Bitmex) GetOrderInfo(ctx context.Context, orderID string, pair currency.Pair, assetType asset.Item) (order.Detail, error) {
 	var orderDetail order.Detail
@@ -837,3 +852,203 @@ func (b *Bitmex) GetHistoricCandles(ctx context.Context, pair currency.Pair, a a
 func (b *Bitmex) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	return kline.Item{}, common.ErrFunctionNotSupported
 }
+
+// GetFuturesPositions returns the open positions of an asset, filtered by pair
+// when one is supplied
+func (b *Bitmex) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	symbol, err := b.futuresSymbol(a, p)
+	if err != nil {
+		return nil, err
+	}
+	var params PositionGetParams
+	if symbol != "" {
+		params.Filter = `{"symbol":"` + symbol + `"}`
+	}
+	positions, err := b.GetPositions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	pairs, err := b.GetAvailablePairs(a)
+	if err != nil {
+		return nil, err
+	}
+	pFmt, err := b.GetPairFormat(a, true)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.Position
+	for i := range positions {
+		if !positions[i].IsOpen || positions[i].CurrentQty == 0 {
+			continue
+		}
+		cp, err := currency.NewPairFromFormattedPairs(positions[i].Symbol, pairs, pFmt)
+		if err != nil {
+			return nil, err
+		}
+		if !pairs.Contains(cp, true) {
+			// Position belongs to another asset type
+			continue
+		}
+		marginType := futures.Isolated
+		if positions[i].CrossMargin {
+			marginType = futures.Cross
+		}
+		pnl, _ := bitmexSettlementValue(positions[i].UnrealisedPnl, positions[i].Currency)
+		var margin float64
+		if !positions[i].CrossMargin {
+			margin, _ = bitmexSettlementValue(positions[i].PosMargin, positions[i].Currency)
+		}
+		resp = append(resp, futures.Position{
+			Exchange:         b.Name,
+			Asset:            a,
+			Pair:             cp,
+			Amount:           float64(positions[i].CurrentQty),
+			EntryPrice:       positions[i].AvgEntryPrice,
+			MarkPrice:        positions[i].MarkPrice,
+			LiquidationPrice: positions[i].LiquidationPrice,
+			UnrealisedPNL:    pnl,
+			Leverage:         positions[i].Leverage,
+			MarginType:       marginType,
+			Margin:           margin,
+			LastUpdated:      positions[i].Timestamp,
+		})
+	}
+	return resp, nil
+}
+
+// GetFuturesFundingRates returns the current funding rates of perpetual
+// contracts, filtered by pair when one is supplied
+func (b *Bitmex) GetFuturesFundingRates(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.FundingRate, error) {
+	symbol, err := b.futuresSymbol(a, p)
+	if err != nil {
+		return nil, err
+	}
+	instruments, err := b.GetActiveInstruments(ctx, &GenericRequestParams{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+	pairs, err := b.GetAvailablePairs(a)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.FundingRate
+	for i := range instruments {
+		if instruments[i].FundingTimestamp.IsZero() ||
+			!pairs.Contains(instruments[i].Symbol, true) {
+			continue
+		}
+		resp = append(resp, futures.FundingRate{
+			Exchange:    b.Name,
+			Asset:       a,
+			Pair:        instruments[i].Symbol,
+			Rate:        instruments[i].FundingRate,
+			MarkPrice:   instruments[i].MarkPrice,
+			IndexPrice:  instruments[i].IndicativeSettlePrice,
+			NextFunding: instruments[i].FundingTimestamp,
+			Time:        instruments[i].Timestamp,
+		})
+	}
+	return resp, nil
+}
+
+// SetLeverage sets the leverage of a position. Bitmex isolates the margin of a
+// position when fixed leverage is set
+func (b *Bitmex) SetLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	if leverage <= 0 {
+		return futures.ErrInvalidLeverage
+	}
+	if p.IsEmpty() {
+		return futures.ErrPairRequired
+	}
+	symbol, err := b.futuresSymbol(a, p)
+	if err != nil {
+		return err
+	}
+	_, err = b.LeveragePosition(ctx, PositionUpdateLeverageParams{
+		Symbol:   symbol,
+		Leverage: leverage,
+	})
+	return err
+}
+
+// SetMarginType sets whether a position uses isolated or cross margin
+func (b *Bitmex) SetMarginType(ctx context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error {
+	if marginType != futures.Isolated && marginType != futures.Cross {
+		return fmt.Errorf("%w %q", futures.ErrMarginTypeUnsupported, marginType)
+	}
+	if p.IsEmpty() {
+		return futures.ErrPairRequired
+	}
+	symbol, err := b.futuresSymbol(a, p)
+	if err != nil {
+		return err
+	}
+	_, err = b.IsolatePosition(ctx, PositionIsolateMarginParams{
+		Symbol:  symbol,
+		Enabled: marginType == futures.Isolated,
+	})
+	return err
+}
+
+// GetCollateral returns the margin balances of the account, which are shared
+// by perpetual and futures contracts
+func (b *Bitmex) GetCollateral(ctx context.Context, a asset.Item) ([]futures.Collateral, error) {
+	if a != asset.PerpetualContract && a != asset.Futures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	margins, err := b.GetAllUserMargin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.Collateral
+	for i := range margins {
+		if margins[i].WalletBalance == 0 {
+			continue
+		}
+		total, code := bitmexSettlementValue(margins[i].MarginBalance, margins[i].Currency)
+		available, _ := bitmexSettlementValue(margins[i].AvailableMargin, margins[i].Currency)
+		pnl, _ := bitmexSettlementValue(margins[i].UnrealisedPnl, margins[i].Currency)
+		initial, _ := bitmexSettlementValue(margins[i].InitMargin, margins[i].Currency)
+		maint, _ := bitmexSettlementValue(margins[i].MaintMargin, margins[i].Currency)
+		resp = append(resp, futures.Collateral{
+			Currency:          code,
+			Total:             total,
+			Available:         available,
+			UnrealisedPNL:     pnl,
+			InitialMargin:     initial,
+			MaintenanceMargin: maint,
+		})
+	}
+	return resp, nil
+}
+
+// futuresSymbol returns the exchange formatted symbol of a pair, or an empty
+// string when no pair is supplied
+func (b *Bitmex) futuresSymbol(a asset.Item, p currency.Pair) (string, error) {
+	if a != asset.PerpetualContract && a != asset.Futures {
+		return "", fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if p.IsEmpty() {
+		return "", nil
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return "", err
+	}
+	return fPair.String(), nil
+}
+
+// bitmexSettlementValue converts a value denominated in a Bitmex settlement
+// unit, such as satoshis (XBt), to its currency
+func bitmexSettlementValue(value int64, unit string) (float64, currency.Code) {
+	switch unit {
+	case "XBt":
+		return float64(value) / 1e8, currency.XBT
+	case "USDt":
+		return float64(value) / 1e6, currency.USDT
+	case "Gwei":
+		return float64(value) / 1e9, currency.ETH
+	default:
+		return float64(value), currency.NewCode(unit)
+	}
+}
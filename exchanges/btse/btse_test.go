@@ -937,3 +937,22 @@ func TestWsUnexpectedData(t *testing.T) {
 		t.Error("expected error response from bad data")
 	}
 }
+
+func TestSetDeadMansSwitch(t *testing.T) {
+	t.Parallel()
+	err := b.SetDeadMansSwitch(context.Background(), -time.Second)
+	if !errors.Is(err, exchange.ErrInvalidDeadMansSwitchTimeout) {
+		t.Errorf("received '%v', expected '%v'", err, exchange.ErrInvalidDeadMansSwitchTimeout)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test, either api keys are unset or canManipulateRealOrders is false")
+	}
+	err = b.SetDeadMansSwitch(context.Background(), time.Minute)
+	if err != nil {
+		t.Error(err)
+	}
+	err = b.SetDeadMansSwitch(context.Background(), 0)
+	if err != nil {
+		t.Error(err)
+	}
+}
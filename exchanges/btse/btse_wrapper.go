@@ -109,6 +109,7 @@ func (b *BTSE) SetDefaults() {
 				FiatDepositFee:      true,
 				FiatWithdrawalFee:   true,
 				CryptoWithdrawalFee: true,
+				DeadMansSwitch:      true,
 			},
 			WebsocketCapabilities: protocol.Features{
 				OrderbookFetching: true,
@@ -601,6 +602,16 @@ func (b *BTSE) CancelAllOrders(ctx context.Context, orderCancellation *order.Can
 	return resp, nil
 }
 
+// SetDeadMansSwitch arms the dead man's switch which cancels all open orders
+// when it is not refreshed within the timeout. A zero timeout disarms the
+// switch
+func (b *BTSE) SetDeadMansSwitch(ctx context.Context, timeout time.Duration) error {
+	if timeout < 0 {
+		return exchange.ErrInvalidDeadMansSwitchTimeout
+	}
+	return b.CancelAllAfter(ctx, int(timeout.Milliseconds()))
+}
+
 func orderIntToType(i int) order.Type {
 	if i == 77 {
 		return order.Market
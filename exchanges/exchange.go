@@ -18,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
@@ -42,6 +43,9 @@ const (
 var (
 	// ErrAuthenticatedRequestWithoutCredentialsSet error message for authenticated request without credentials set
 	ErrAuthenticatedRequestWithoutCredentialsSet = errors.New("authenticated HTTP request called but not supported due to unset/default API keys")
+	// ErrInvalidDeadMansSwitchTimeout is returned when a dead man's switch
+	// timeout is negative
+	ErrInvalidDeadMansSwitchTimeout = errors.New("dead man's switch timeout cannot be negative")
 
 	errEndpointStringNotFound = errors.New("endpoint string not found")
 	errTransportNotSet        = errors.New("transport not set, cannot set timeout")
@@ -1391,3 +1395,35 @@ func (a *AssetWebsocketSupport) IsAssetWebsocketSupported(aType asset.Item) bool
 func (b *Base) UpdateCurrencyStates(ctx context.Context, a asset.Item) error {
 	return common.ErrNotYetImplemented
 }
+
+// GetFuturesPositions returns the open futures positions for an asset
+func (b *Base) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetFuturesFundingRates returns the current funding rates for an asset
+func (b *Base) GetFuturesFundingRates(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage used for a pair
+func (b *Base) SetLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginType sets the margin type used for a pair
+func (b *Base) SetMarginType(ctx context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetCollateral returns the collateral held on a futures account
+func (b *Base) GetCollateral(ctx context.Context, a asset.Item) ([]futures.Collateral, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetDeadMansSwitch arms the exchange's dead man's switch which cancels all
+// open orders when it is not refreshed within the timeout. A zero timeout
+// disarms the switch
+func (b *Base) SetDeadMansSwitch(ctx context.Context, timeout time.Duration) error {
+	return common.ErrFunctionNotSupported
+}
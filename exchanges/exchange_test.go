@@ -15,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
@@ -2446,3 +2447,38 @@ func TestGetGetURLTypeFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestFuturesManagementDefaults(t *testing.T) {
+	t.Parallel()
+	var b Base
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := b.GetFuturesPositions(context.Background(), asset.USDTMarginedFutures, p)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, common.ErrFunctionNotSupported)
+	}
+	_, err = b.GetFuturesFundingRates(context.Background(), asset.USDTMarginedFutures, p)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, common.ErrFunctionNotSupported)
+	}
+	err = b.SetLeverage(context.Background(), asset.USDTMarginedFutures, p, 10)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, common.ErrFunctionNotSupported)
+	}
+	err = b.SetMarginType(context.Background(), asset.USDTMarginedFutures, p, futures.Isolated)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, common.ErrFunctionNotSupported)
+	}
+	_, err = b.GetCollateral(context.Background(), asset.USDTMarginedFutures)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestSetDeadMansSwitch(t *testing.T) {
+	t.Parallel()
+	var b Base
+	err := b.SetDeadMansSwitch(context.Background(), time.Minute)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, common.ErrFunctionNotSupported)
+	}
+}
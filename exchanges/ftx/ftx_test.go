@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
@@ -1684,3 +1685,72 @@ func TestUpdateOrderExecutionLimits(t *testing.T) {
 			err)
 	}
 }
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := f.GetFuturesPositions(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip()
+	}
+	_, err = f.GetFuturesPositions(context.Background(), asset.Futures, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesFundingRates(t *testing.T) {
+	t.Parallel()
+	_, err := f.GetFuturesFundingRates(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	_, err = f.GetFuturesFundingRates(context.Background(), asset.Futures, currency.NewPairWithDelimiter("BTC", "PERP", "-"))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	err := f.SetLeverage(context.Background(), asset.Futures, currency.Pair{}, 0)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrInvalidLeverage)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
+	}
+	err = f.SetLeverage(context.Background(), asset.Futures, currency.Pair{}, 5)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	err := f.SetMarginType(context.Background(), asset.Futures, currency.Pair{}, futures.Isolated)
+	if !errors.Is(err, futures.ErrMarginTypeUnsupported) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrMarginTypeUnsupported)
+	}
+	err = f.SetMarginType(context.Background(), asset.Futures, currency.Pair{}, futures.Cross)
+	if err != nil {
+		t.Errorf("received '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestGetCollateral(t *testing.T) {
+	t.Parallel()
+	_, err := f.GetCollateral(context.Background(), asset.Spot)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip()
+	}
+	_, err = f.GetCollateral(context.Background(), asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -289,9 +289,13 @@ func (f *FTX) wsHandleData(respRaw []byte) error {
 			}
 			err = f.WsProcessUpdateOB(&resultData.OBData, p, a)
 			if err != nil {
-				err2 := f.wsResubToOB(p)
-				if err2 != nil {
-					f.Websocket.DataHandler <- err2
+				// Books failing checksum verification are resynced by the
+				// orderbook buffer
+				if !errors.Is(err, buffer.ErrOrderbookOutOfSync) {
+					err2 := f.wsResubToOB(p)
+					if err2 != nil {
+						f.Websocket.DataHandler <- err2
+					}
 				}
 				return err
 			}
@@ -437,9 +441,9 @@ func (f *FTX) WsProcessUpdateOB(data *WsOrderbookData, p currency.Pair, a asset.
 		Asset:      a,
 		Pair:       p,
 		UpdateTime: timestampFromFloat64(data.Time),
+		Checksum:   uint32(data.Checksum),
 	}
 
-	var err error
 	for x := range data.Bids {
 		update.Bids = append(update.Bids, orderbook.Item{
 			Price:  data.Bids[x][0],
@@ -453,24 +457,22 @@ func (f *FTX) WsProcessUpdateOB(data *WsOrderbookData, p currency.Pair, a asset.
 		})
 	}
 
-	err = f.Websocket.Orderbook.Update(&update)
-	if err != nil {
-		return err
-	}
+	return f.Websocket.Orderbook.Update(&update)
+}
 
-	updatedOb, err := f.Websocket.Orderbook.GetOrderbook(p, a)
-	if err != nil {
-		return err
-	}
-	checksum := f.CalcUpdateOBChecksum(updatedOb)
+// updateOBChecksum calculates the checksum of an updated book for the
+// websocket orderbook buffer to compare with the checksum sent by FTX
+func (f *FTX) updateOBChecksum(book *orderbook.Base) uint32 {
+	return uint32(f.CalcUpdateOBChecksum(book))
+}
 
-	if checksum != data.Checksum {
-		log.Warnf(log.ExchangeSys, "%s checksum failure for item %s",
-			f.Name,
-			p)
-		return errors.New("checksum failed")
-	}
-	return nil
+// wsResyncOB resubscribes to the orderbook of a pair which has failed
+// checksum verification so that a new partial is sent
+func (f *FTX) wsResyncOB(p currency.Pair, _ asset.Item) error {
+	return f.Websocket.ResubscribeToChannel(&stream.ChannelSubscription{
+		Channel:  wsOrderbook,
+		Currency: p,
+	})
 }
 
 func (f *FTX) wsResubToOB(p currency.Pair) error {
@@ -16,12 +16,14 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -191,6 +193,8 @@ func (f *FTX) Setup(exch *config.ExchangeConfig) error {
 		Features:                         &f.Features.Supports.WebsocketCapabilities,
 		OrderbookBufferLimit:             exch.OrderbookConfig.WebsocketBufferLimit,
 		BufferEnabled:                    exch.OrderbookConfig.WebsocketBufferEnabled,
+		OrderbookVerifier:                buffer.ChecksumVerifier(f.updateOBChecksum),
+		OrderbookResync:                  f.wsResyncOB,
 	})
 	if err != nil {
 		return err
@@ -1214,3 +1218,141 @@ func (f *FTX) UpdateOrderExecutionLimits(ctx context.Context, _ asset.Item) erro
 	}
 	return f.LoadLimits(limits)
 }
+
+// GetFuturesPositions returns the open futures positions, filtered by pair
+// when one is supplied. FTX positions are always cross margined and share the
+// account leverage
+func (f *FTX) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	var symbol string
+	if !p.IsEmpty() {
+		fPair, err := f.FormatExchangeCurrency(p, a)
+		if err != nil {
+			return nil, err
+		}
+		symbol = fPair.String()
+	}
+	info, err := f.GetAccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.Position
+	for i := range info.Positions {
+		if info.Positions[i].NetSize == 0 ||
+			(symbol != "" && !strings.EqualFold(info.Positions[i].Future, symbol)) {
+			continue
+		}
+		cp, err := currency.NewPairFromString(info.Positions[i].Future)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, futures.Position{
+			Exchange:         f.Name,
+			Asset:            a,
+			Pair:             cp,
+			Amount:           info.Positions[i].NetSize,
+			EntryPrice:       info.Positions[i].EntryPrice,
+			LiquidationPrice: info.Positions[i].EstimatedLiquidationPrice,
+			UnrealisedPNL:    info.Positions[i].UnrealizedPnL,
+			Leverage:         info.Leverage,
+			MarginType:       futures.Cross,
+			LastUpdated:      time.Now(),
+		})
+	}
+	return resp, nil
+}
+
+// GetFuturesFundingRates returns the next funding rate of perpetual futures.
+// When no pair is supplied the rates of all enabled perpetual futures are
+// returned
+func (f *FTX) GetFuturesFundingRates(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.FundingRate, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	pairs := currency.Pairs{p}
+	if p.IsEmpty() {
+		var err error
+		pairs, err = f.GetEnabledPairs(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var resp []futures.FundingRate
+	for i := range pairs {
+		fPair, err := f.FormatExchangeCurrency(pairs[i], a)
+		if err != nil {
+			return nil, err
+		}
+		future, err := f.GetFuture(ctx, fPair.String())
+		if err != nil {
+			return nil, err
+		}
+		if !future.Perpetual {
+			continue
+		}
+		stats, err := f.GetFutureStats(ctx, fPair.String())
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, futures.FundingRate{
+			Exchange:    f.Name,
+			Asset:       a,
+			Pair:        pairs[i],
+			Rate:        stats.NextFundingRate,
+			MarkPrice:   future.Mark,
+			IndexPrice:  future.Index,
+			NextFunding: stats.NextFundingTime,
+			Time:        time.Now(),
+		})
+	}
+	return resp, nil
+}
+
+// SetLeverage sets the account leverage. FTX leverage applies to every
+// position on the account so the pair is ignored
+func (f *FTX) SetLeverage(ctx context.Context, a asset.Item, _ currency.Pair, leverage float64) error {
+	if a != asset.Futures {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if leverage <= 0 {
+		return futures.ErrInvalidLeverage
+	}
+	return f.ChangeAccountLeverage(ctx, leverage)
+}
+
+// SetMarginType only accepts cross margin as FTX does not support isolated
+// margin
+func (f *FTX) SetMarginType(_ context.Context, a asset.Item, _ currency.Pair, marginType futures.MarginType) error {
+	if a != asset.Futures {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if marginType != futures.Cross {
+		return fmt.Errorf("%w %q", futures.ErrMarginTypeUnsupported, marginType)
+	}
+	return nil
+}
+
+// GetCollateral returns the USD collateral of the account, which backs every
+// futures position
+func (f *FTX) GetCollateral(ctx context.Context, a asset.Item) ([]futures.Collateral, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	info, err := f.GetAccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c := futures.Collateral{
+		Currency:          currency.USD,
+		Total:             info.Collateral,
+		Available:         info.FreeCollateral,
+		MaintenanceMargin: info.MaintenanceMarginRequirement * info.TotalPositionSize,
+	}
+	for i := range info.Positions {
+		c.UnrealisedPNL += info.Positions[i].UnrealizedPnL
+		c.InitialMargin += info.Positions[i].CollateralUsed
+	}
+	return []futures.Collateral{c}, nil
+}
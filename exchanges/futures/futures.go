@@ -0,0 +1,24 @@
+package futures
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String implements the stringer interface
+func (m MarginType) String() string {
+	return string(m)
+}
+
+// StringToMarginType converts a case insensitive margin type string, as
+// used across exchange APIs, to a MarginType
+func StringToMarginType(marginType string) (MarginType, error) {
+	switch strings.ToUpper(marginType) {
+	case string(Isolated), "FIXED":
+		return Isolated, nil
+	case string(Cross), "CROSSED":
+		return Cross, nil
+	default:
+		return UnknownMargin, fmt.Errorf("%w %q", errMarginTypeUnrecognised, marginType)
+	}
+}
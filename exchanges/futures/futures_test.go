@@ -0,0 +1,38 @@
+package futures
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStringToMarginType(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		in       string
+		expected MarginType
+		err      error
+	}{
+		{in: "isolated", expected: Isolated},
+		{in: "ISOLATED", expected: Isolated},
+		{in: "fixed", expected: Isolated},
+		{in: "cross", expected: Cross},
+		{in: "CROSSED", expected: Cross},
+		{in: "", expected: UnknownMargin, err: errMarginTypeUnrecognised},
+		{in: "portfolio", expected: UnknownMargin, err: errMarginTypeUnrecognised},
+	} {
+		m, err := StringToMarginType(tc.in)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: received '%v', expected '%v'", tc.in, err, tc.err)
+		}
+		if m != tc.expected {
+			t.Errorf("%q: received '%v', expected '%v'", tc.in, m, tc.expected)
+		}
+	}
+}
+
+func TestMarginTypeString(t *testing.T) {
+	t.Parallel()
+	if Isolated.String() != "ISOLATED" {
+		t.Errorf("received '%v', expected '%v'", Isolated.String(), "ISOLATED")
+	}
+}
@@ -0,0 +1,79 @@
+package futures
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	// ErrMarginTypeUnsupported is returned when an exchange or asset does not
+	// support the requested margin type
+	ErrMarginTypeUnsupported = errors.New("margin type unsupported")
+	// ErrInvalidLeverage is returned when leverage is zero or negative
+	ErrInvalidLeverage = errors.New("leverage must be greater than zero")
+	// ErrPairRequired is returned when a pair specific setting is changed
+	// without a pair
+	ErrPairRequired = errors.New("currency pair required")
+
+	errMarginTypeUnrecognised = errors.New("margin type not recognised")
+)
+
+// MarginType defines how margin is allocated to a position
+type MarginType string
+
+// Supported margin types
+const (
+	// UnknownMargin is returned when an exchange does not report the margin
+	// type of a position
+	UnknownMargin MarginType = ""
+	// Isolated margin is allocated to a single position and limits its loss
+	// to that margin
+	Isolated MarginType = "ISOLATED"
+	// Cross margin is shared across all positions on an account
+	Cross MarginType = "CROSS"
+)
+
+// Position is an open futures or perpetual swap position
+type Position struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	// Amount is the position size in contracts or base currency, as
+	// reported by the exchange, negative when short
+	Amount           float64
+	EntryPrice       float64
+	MarkPrice        float64
+	LiquidationPrice float64
+	UnrealisedPNL    float64
+	Leverage         float64
+	MarginType       MarginType
+	// Margin is the isolated margin allocated to the position, zero for
+	// cross margined positions
+	Margin      float64
+	LastUpdated time.Time
+}
+
+// FundingRate is the current funding rate of a perpetual swap
+type FundingRate struct {
+	Exchange    string
+	Asset       asset.Item
+	Pair        currency.Pair
+	Rate        float64
+	MarkPrice   float64
+	IndexPrice  float64
+	NextFunding time.Time
+	Time        time.Time
+}
+
+// Collateral is the margin balance of a currency held on a futures account
+type Collateral struct {
+	Currency          currency.Code
+	Total             float64
+	Available         float64
+	UnrealisedPNL     float64
+	InitialMargin     float64
+	MaintenanceMargin float64
+}
@@ -550,10 +550,10 @@ type LiquidationOrdersData struct {
 type FundingRatesData struct {
 	EstimatedRate   float64 `json:"estimated_rate,string"`
 	FundingRate     float64 `json:"funding_rate,string"`
-	ContractCode    string  `json:"contractCode"`
+	ContractCode    string  `json:"contract_code"`
 	Symbol          string  `json:"symbol"`
 	FeeAsset        string  `json:"fee_asset"`
-	FundingTime     string  `json:"fundingTime"`
+	FundingTime     string  `json:"funding_time"`
 	NextFundingTime string  `json:"next_funding_time"`
 }
 
@@ -766,6 +766,15 @@ type AvailableLeverageData struct {
 	Timestamp int64 `json:"timestamp"`
 }
 
+// SwapLeverageData stores the leverage switched to for a swap contract
+type SwapLeverageData struct {
+	Data struct {
+		ContractCode string `json:"contract_code"`
+		LeverageRate int64  `json:"lever_rate"`
+	} `json:"data"`
+	Timestamp int64 `json:"ts"`
+}
+
 // FinancialRecordData stores an accounts financial records
 type FinancialRecordData struct {
 	Data struct {
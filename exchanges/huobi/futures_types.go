@@ -552,6 +552,15 @@ type FAvailableLeverageData struct {
 	Timestamp int64 `json:"timestamp"`
 }
 
+// FLeverageData stores the leverage switched to for a futures symbol
+type FLeverageData struct {
+	Data struct {
+		Symbol       string `json:"symbol"`
+		LeverageRate int64  `json:"lever_rate"`
+	} `json:"data"`
+	Timestamp int64 `json:"ts"`
+}
+
 // FOrderData stores order data for futures
 type FOrderData struct {
 	Data struct {
@@ -49,6 +49,7 @@ const (
 	huobiSwapFinancialRecords            = "swap-api/v1/swap_financial_record"
 	huobiSwapSettlementRecords           = "swap-api/v1/swap_user_settlement_records"
 	huobiSwapAvailableLeverage           = "swap-api/v1/swap_available_level_rate"
+	huobiSwapSwitchLeverage              = "swap-api/v1/swap_switch_lever_rate"
 	huobiSwapOrderLimitInfo              = "swap-api/v1/swap_order_limit"
 	huobiSwapTradingFeeInfo              = "swap-api/v1/swap_fee"
 	huobiSwapTransferLimitInfo           = "swap-api/v1/swap_transfer_limit"
@@ -441,11 +442,13 @@ func (h *HUOBI) GetSwapAccountInfo(ctx context.Context, code currency.Pair) (Swa
 func (h *HUOBI) GetSwapPositionsInfo(ctx context.Context, code currency.Pair) (SwapPositionInfo, error) {
 	var resp SwapPositionInfo
 	req := make(map[string]interface{})
-	codeValue, err := h.FormatSymbol(code, asset.CoinMarginedFutures)
-	if err != nil {
-		return resp, err
+	if !code.IsEmpty() {
+		codeValue, err := h.FormatSymbol(code, asset.CoinMarginedFutures)
+		if err != nil {
+			return resp, err
+		}
+		req["contract_code"] = codeValue
 	}
-	req["contract_code"] = codeValue
 	return resp, h.FuturesAuthenticatedHTTPRequest(ctx, exchange.RestFutures, http.MethodPost, huobiSwapPosInfo, nil, req, &resp)
 }
 
@@ -564,6 +567,19 @@ func (h *HUOBI) GetAvailableLeverage(ctx context.Context, code currency.Pair) (A
 	return resp, h.FuturesAuthenticatedHTTPRequest(ctx, exchange.RestFutures, http.MethodPost, huobiSwapAvailableLeverage, nil, req, &resp)
 }
 
+// SetSwapLeverage switches the leverage of a swap contract
+func (h *HUOBI) SetSwapLeverage(ctx context.Context, code currency.Pair, leverage int64) (SwapLeverageData, error) {
+	var resp SwapLeverageData
+	req := make(map[string]interface{})
+	codeValue, err := h.FormatSymbol(code, asset.CoinMarginedFutures)
+	if err != nil {
+		return resp, err
+	}
+	req["contract_code"] = codeValue
+	req["lever_rate"] = leverage
+	return resp, h.FuturesAuthenticatedHTTPRequest(ctx, exchange.RestFutures, http.MethodPost, huobiSwapSwitchLeverage, nil, req, &resp)
+}
+
 // GetSwapOrderLimitInfo gets order limit info for swaps
 func (h *HUOBI) GetSwapOrderLimitInfo(ctx context.Context, code currency.Pair, orderType string) (SwapOrderLimitInfo, error) {
 	var resp SwapOrderLimitInfo
@@ -60,6 +60,7 @@ const (
 	fTransfer                  = "api/v1/contract_master_sub_transfer"
 	fTransferRecords           = "api/v1/contract_master_sub_transfer_record"
 	fAvailableLeverage         = "api/v1/contract_available_level_rate"
+	fSwitchLeverage            = "api/v1/contract_switch_lever_rate"
 	fOrder                     = "api/v1/contract_order"
 	fBatchOrder                = "api/v1/contract_batchorder"
 	fCancelOrder               = "api/v1/contract_cancel"
@@ -481,8 +482,8 @@ func (h *HUOBI) FGetAccountInfo(ctx context.Context, symbol currency.Code) (FUse
 }
 
 // FGetPositionsInfo gets positions info for futures account
-func (h *HUOBI) FGetPositionsInfo(ctx context.Context, symbol currency.Code) (FUserAccountData, error) {
-	var resp FUserAccountData
+func (h *HUOBI) FGetPositionsInfo(ctx context.Context, symbol currency.Code) (FUsersPositionsInfo, error) {
+	var resp FUsersPositionsInfo
 	req := make(map[string]interface{})
 	if symbol != (currency.Code{}) {
 		codeValue, err := h.formatFuturesCode(symbol)
@@ -695,6 +696,19 @@ func (h *HUOBI) FGetAvailableLeverage(ctx context.Context, symbol currency.Code)
 	return resp, h.FuturesAuthenticatedHTTPRequest(ctx, exchange.RestFutures, http.MethodPost, fAvailableLeverage, nil, req, &resp)
 }
 
+// FSetLeverage switches the leverage of every futures contract of a symbol
+func (h *HUOBI) FSetLeverage(ctx context.Context, symbol currency.Code, leverage int64) (FLeverageData, error) {
+	var resp FLeverageData
+	req := make(map[string]interface{})
+	codeValue, err := h.formatFuturesCode(symbol)
+	if err != nil {
+		return resp, err
+	}
+	req["symbol"] = codeValue
+	req["lever_rate"] = leverage
+	return resp, h.FuturesAuthenticatedHTTPRequest(ctx, exchange.RestFutures, http.MethodPost, fSwitchLeverage, nil, req, &resp)
+}
+
 // FOrder places an order for futures
 func (h *HUOBI) FOrder(ctx context.Context, contractCode currency.Pair, symbol, contractType, clientOrderID, direction, offset, orderPriceType string, price, volume, leverageRate float64) (FOrderData, error) {
 	var resp FOrderData
@@ -2,6 +2,7 @@ package huobi
 
 import (
 	"context"
+	"errors"
 	"log"
 	"os"
 	"strconv"
@@ -15,6 +16,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
@@ -2677,3 +2679,136 @@ func TestGetHistoricTrades(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestSetSwapLeverage(t *testing.T) {
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.SetSwapLeverage(context.Background(), cp, 5)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFSetLeverage(t *testing.T) {
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	t.Parallel()
+	_, err := h.FSetLeverage(context.Background(), currency.BTC, 5)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetFuturesPositions(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys not set, skipping test")
+	}
+	_, err = h.GetFuturesPositions(context.Background(), asset.CoinMarginedFutures, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = h.GetFuturesPositions(context.Background(), asset.Futures, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesFundingRates(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetFuturesFundingRates(context.Background(), asset.Futures, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GetFuturesFundingRates(context.Background(), asset.CoinMarginedFutures, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.SetLeverage(context.Background(), asset.CoinMarginedFutures, cp, 0.5)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrInvalidLeverage)
+	}
+	err = h.SetLeverage(context.Background(), asset.CoinMarginedFutures, currency.Pair{}, 2)
+	if !errors.Is(err, futures.ErrPairRequired) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrPairRequired)
+	}
+	err = h.SetLeverage(context.Background(), asset.Spot, cp, 2)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("API keys not set or canManipulateRealOrders set to false, skipping test")
+	}
+	err = h.SetLeverage(context.Background(), asset.CoinMarginedFutures, cp, 2)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.SetMarginType(context.Background(), asset.Spot, cp, futures.Isolated)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	err = h.SetMarginType(context.Background(), asset.CoinMarginedFutures, cp, futures.Cross)
+	if !errors.Is(err, futures.ErrMarginTypeUnsupported) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrMarginTypeUnsupported)
+	}
+	err = h.SetMarginType(context.Background(), asset.CoinMarginedFutures, cp, futures.Isolated)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestGetCollateral(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetCollateral(context.Background(), asset.Spot)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys not set, skipping test")
+	}
+	_, err = h.GetCollateral(context.Background(), asset.CoinMarginedFutures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHuobiPositionAmount(t *testing.T) {
+	t.Parallel()
+	if amount := huobiPositionAmount(5, "buy"); amount != 5 {
+		t.Errorf("received '%v', expected '%v'", amount, 5)
+	}
+	if amount := huobiPositionAmount(5, "sell"); amount != -5 {
+		t.Errorf("received '%v', expected '%v'", amount, -5)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -1763,3 +1764,215 @@ func compatibleVars(side, orderPriceType string, status int64) (OrderVars, error
 	}
 	return resp, nil
 }
+
+// GetFuturesPositions returns the open coin margined swap or futures positions
+// of the account
+func (h *HUOBI) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	var resp []futures.Position
+	switch a {
+	case asset.CoinMarginedFutures:
+		positions, err := h.GetSwapPositionsInfo(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		accounts, err := h.GetSwapAccountInfo(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		liquidation := make(map[string]float64, len(accounts.Data))
+		for i := range accounts.Data {
+			liquidation[accounts.Data[i].ContractCode] = accounts.Data[i].LiquidationPrice
+		}
+		for i := range positions.Data {
+			if positions.Data[i].Volume == 0 {
+				continue
+			}
+			cp, err := currency.NewPairFromString(positions.Data[i].ContractCode)
+			if err != nil {
+				return nil, err
+			}
+			resp = append(resp, futures.Position{
+				Exchange:         h.Name,
+				Asset:            a,
+				Pair:             cp,
+				Amount:           huobiPositionAmount(positions.Data[i].Volume, positions.Data[i].Direction),
+				EntryPrice:       positions.Data[i].CostOpen,
+				LiquidationPrice: liquidation[positions.Data[i].ContractCode],
+				UnrealisedPNL:    positions.Data[i].ProfitUnreal,
+				Leverage:         positions.Data[i].LeverRate,
+				MarginType:       futures.Isolated,
+				Margin:           positions.Data[i].PositionMargin,
+				LastUpdated:      time.Now(),
+			})
+		}
+	case asset.Futures:
+		positions, err := h.FGetPositionsInfo(ctx, p.Base)
+		if err != nil {
+			return nil, err
+		}
+		accounts, err := h.FGetAccountInfo(ctx, p.Base)
+		if err != nil {
+			return nil, err
+		}
+		// Futures of a symbol share their margin and liquidation price
+		liquidation := make(map[string]float64, len(accounts.AccData))
+		for i := range accounts.AccData {
+			liquidation[accounts.AccData[i].Symbol] = accounts.AccData[i].LiquidationPrice
+		}
+		for i := range positions.PosInfo {
+			if positions.PosInfo[i].Volume == 0 {
+				continue
+			}
+			cp, err := currency.NewPairFromString(positions.PosInfo[i].ContractCode)
+			if err != nil {
+				return nil, err
+			}
+			if !p.IsEmpty() && !cp.Equal(p) {
+				continue
+			}
+			resp = append(resp, futures.Position{
+				Exchange:         h.Name,
+				Asset:            a,
+				Pair:             cp,
+				Amount:           huobiPositionAmount(positions.PosInfo[i].Volume, positions.PosInfo[i].Direction),
+				EntryPrice:       positions.PosInfo[i].CostOpen,
+				LiquidationPrice: liquidation[positions.PosInfo[i].Symbol],
+				UnrealisedPNL:    positions.PosInfo[i].ProfitUnreal,
+				Leverage:         positions.PosInfo[i].LeverageRate,
+				MarginType:       futures.Isolated,
+				Margin:           positions.PosInfo[i].PositionMargin,
+				LastUpdated:      time.Unix(0, positions.Timestamp*int64(time.Millisecond)),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return resp, nil
+}
+
+// GetFuturesFundingRates returns the current funding rates of coin margined
+// swaps
+func (h *HUOBI) GetFuturesFundingRates(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.FundingRate, error) {
+	if a != asset.CoinMarginedFutures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	pairs := currency.Pairs{p}
+	if p.IsEmpty() {
+		var err error
+		pairs, err = h.GetEnabledPairs(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var resp []futures.FundingRate
+	for i := range pairs {
+		rate, err := h.GetSwapFundingRates(ctx, pairs[i])
+		if err != nil {
+			return nil, err
+		}
+		var nextFunding time.Time
+		if rate.FundingTime != "" {
+			ms, err := strconv.ParseInt(rate.FundingTime, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			nextFunding = time.Unix(0, ms*int64(time.Millisecond))
+		}
+		resp = append(resp, futures.FundingRate{
+			Exchange:    h.Name,
+			Asset:       a,
+			Pair:        pairs[i],
+			Rate:        rate.FundingRate,
+			NextFunding: nextFunding,
+			Time:        time.Now(),
+		})
+	}
+	return resp, nil
+}
+
+// SetLeverage sets the leverage of a pair, Huobi only accepts whole number
+// leverage. Futures leverage applies to every contract of the base currency
+func (h *HUOBI) SetLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	if leverage < 1 {
+		return futures.ErrInvalidLeverage
+	}
+	if p.IsEmpty() {
+		return futures.ErrPairRequired
+	}
+	var err error
+	switch a {
+	case asset.CoinMarginedFutures:
+		_, err = h.SetSwapLeverage(ctx, p, int64(leverage))
+	case asset.Futures:
+		_, err = h.FSetLeverage(ctx, p.Base, int64(leverage))
+	default:
+		err = fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return err
+}
+
+// SetMarginType sets whether a position uses isolated or cross margin. Huobi
+// coin margined swaps and futures only support isolated margin
+func (h *HUOBI) SetMarginType(_ context.Context, a asset.Item, _ currency.Pair, marginType futures.MarginType) error {
+	if a != asset.CoinMarginedFutures && a != asset.Futures {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if marginType != futures.Isolated {
+		return fmt.Errorf("%w %q", futures.ErrMarginTypeUnsupported, marginType)
+	}
+	return nil
+}
+
+// GetCollateral returns the margin balances of the coin margined swap or
+// futures accounts
+func (h *HUOBI) GetCollateral(ctx context.Context, a asset.Item) ([]futures.Collateral, error) {
+	var resp []futures.Collateral
+	switch a {
+	case asset.CoinMarginedFutures:
+		accounts, err := h.GetSwapAccountInfo(ctx, currency.Pair{})
+		if err != nil {
+			return nil, err
+		}
+		for i := range accounts.Data {
+			if accounts.Data[i].MarginBalance == 0 {
+				continue
+			}
+			resp = append(resp, futures.Collateral{
+				Currency:      currency.NewCode(accounts.Data[i].Symbol),
+				Total:         accounts.Data[i].MarginBalance,
+				Available:     accounts.Data[i].MarginAvailable,
+				UnrealisedPNL: accounts.Data[i].ProfitUnreal,
+				InitialMargin: accounts.Data[i].MarginPosition,
+			})
+		}
+	case asset.Futures:
+		accounts, err := h.FGetAccountInfo(ctx, currency.Code{})
+		if err != nil {
+			return nil, err
+		}
+		for i := range accounts.AccData {
+			if accounts.AccData[i].MarginBalance == 0 {
+				continue
+			}
+			resp = append(resp, futures.Collateral{
+				Currency:      currency.NewCode(accounts.AccData[i].Symbol),
+				Total:         accounts.AccData[i].MarginBalance,
+				Available:     accounts.AccData[i].MarginAvailable,
+				UnrealisedPNL: accounts.AccData[i].ProfitUnreal,
+				InitialMargin: accounts.AccData[i].MarginPosition,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return resp, nil
+}
+
+// huobiPositionAmount returns the contract volume of a position, negative
+// when the position direction is sell
+func huobiPositionAmount(volume float64, direction string) float64 {
+	if strings.EqualFold(direction, "sell") {
+		return -volume
+	}
+	return volume
+}
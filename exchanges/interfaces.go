@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -58,6 +59,7 @@ type IBotExchange interface {
 	CancelOrder(ctx context.Context, o *order.Cancel) error
 	CancelBatchOrders(ctx context.Context, o []order.Cancel) (order.CancelBatchResponse, error)
 	CancelAllOrders(ctx context.Context, orders *order.Cancel) (order.CancelAllResponse, error)
+	SetDeadMansSwitch(ctx context.Context, timeout time.Duration) error
 	GetOrderInfo(ctx context.Context, orderID string, pair currency.Pair, assetType asset.Item) (order.Detail, error)
 	GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error)
 	GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
@@ -93,6 +95,7 @@ type IBotExchange interface {
 	UpdateOrderExecutionLimits(ctx context.Context, a asset.Item) error
 
 	CurrencyStateManagement
+	FuturesManagement
 }
 
 // CurrencyStateManagement defines functionality for currency state management
@@ -104,3 +107,13 @@ type CurrencyStateManagement interface {
 	CanWithdraw(c currency.Code, a asset.Item) error
 	CanDeposit(c currency.Code, a asset.Item) error
 }
+
+// FuturesManagement defines functionality for managing futures and perpetual
+// swap positions. An empty pair applies to all pairs of the asset
+type FuturesManagement interface {
+	GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error)
+	GetFuturesFundingRates(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.FundingRate, error)
+	SetLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error
+	SetMarginType(ctx context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error
+	GetCollateral(ctx context.Context, a asset.Item) ([]futures.Collateral, error)
+}
@@ -354,8 +354,7 @@ func ConvertToNewInterval(item *Item, newInterval Interval) (*Item, error) {
 	var candles []Candle
 	for i := range item.Candles {
 		candles = append(candles, item.Candles[i])
-		intervalCount := int64(i + 1)
-		if oldIntervalsPerNewCandle == intervalCount {
+		if int64(len(candles)) == oldIntervalsPerNewCandle {
 			candleBundles = append(candleBundles, candles)
 			candles = []Candle{}
 		}
@@ -371,12 +370,11 @@ func ConvertToNewInterval(item *Item, newInterval Interval) (*Item, error) {
 		lowest = candleBundles[i][0].Low
 		highest = candleBundles[i][0].High
 		for j := range candleBundles[i] {
-			volume += candleBundles[i][j].Volume
 			if candleBundles[i][j].Low < lowest {
 				lowest = candleBundles[i][j].Low
 			}
 			if candleBundles[i][j].High > highest {
-				lowest = candleBundles[i][j].High
+				highest = candleBundles[i][j].High
 			}
 			volume += candleBundles[i][j].Volume
 		}
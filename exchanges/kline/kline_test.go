@@ -953,10 +953,10 @@ func TestConvertToNewInterval(t *testing.T) {
 	if len(newCandle.Candles) != 1 {
 		t.Error("expected one candle")
 	}
-	if newCandle.Candles[0].Open != 1337 &&
-		newCandle.Candles[0].High != 2000 &&
-		newCandle.Candles[0].Low != 1332 &&
-		newCandle.Candles[0].Close != 6969 &&
+	if newCandle.Candles[0].Open != 1337 ||
+		newCandle.Candles[0].High != 2000 ||
+		newCandle.Candles[0].Low != 1332 ||
+		newCandle.Candles[0].Close != 6969 ||
 		newCandle.Candles[0].Volume != (2520+6420+1337) {
 		t.Error("unexpected updoot")
 	}
@@ -976,4 +976,29 @@ func TestConvertToNewInterval(t *testing.T) {
 	if len(newCandle.Candles) != 1 {
 		t.Error("expected one candle")
 	}
+
+	for i := 4; i < 6; i++ {
+		old.Candles = append(old.Candles, Candle{
+			Time:   time.Now().AddDate(0, 0, i),
+			Open:   7777,
+			High:   8000,
+			Low:    7000,
+			Close:  7777,
+			Volume: 1,
+		})
+	}
+	newCandle, err = ConvertToNewInterval(old, newInterval)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expectec '%v'", err, nil)
+	}
+	if len(newCandle.Candles) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(newCandle.Candles), 2)
+	}
+	if newCandle.Candles[1].Open != 6969 ||
+		newCandle.Candles[1].High != 8000 ||
+		newCandle.Candles[1].Low != 2342 ||
+		newCandle.Candles[1].Close != 7777 ||
+		newCandle.Candles[1].Volume != 113 {
+		t.Errorf("received '%+v' for the second candle", newCandle.Candles[1])
+	}
 }
@@ -1,6 +1,7 @@
 package kraken
 
 import (
+	"errors"
 	"sync"
 
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -24,6 +25,8 @@ var (
 	validBatchOrderType = []string{
 		"edit", "cancel", "send",
 	}
+
+	errIsolatedMarginRequiresLeverage = errors.New("isolated margin is enabled by setting leverage")
 )
 
 // WSFuturesTickerData stores ws ticker data for futures websocket
@@ -393,6 +396,17 @@ type FuturesOpenPositions struct {
 	ServerTime string `json:"serverTime"`
 }
 
+// FuturesLeveragePreferencesData stores the maximum leverage of contracts
+// using isolated margin, contracts without a preference use cross margin
+type FuturesLeveragePreferencesData struct {
+	Result              string `json:"result"`
+	ServerTime          string `json:"serverTime"`
+	LeveragePreferences []struct {
+		Symbol      string  `json:"symbol"`
+		MaxLeverage float64 `json:"maxLeverage"`
+	} `json:"leveragePreferences"`
+}
+
 // FuturesNotificationData stores notification data
 type FuturesNotificationData struct {
 	Notifications []struct {
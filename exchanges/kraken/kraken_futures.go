@@ -243,6 +243,29 @@ func (k *Kraken) FuturesGetTransfers(ctx context.Context, lastTransferTime time.
 	return resp, k.SendFuturesAuthRequest(ctx, http.MethodGet, futuresTransfers, params, nil, &resp)
 }
 
+// FuturesGetLeveragePreferences gets the maximum leverage of contracts using
+// isolated margin
+func (k *Kraken) FuturesGetLeveragePreferences(ctx context.Context) (FuturesLeveragePreferencesData, error) {
+	var resp FuturesLeveragePreferencesData
+	return resp, k.SendFuturesAuthRequest(ctx, http.MethodGet, futuresLeverage, nil, nil, &resp)
+}
+
+// FuturesSetLeveragePreference sets the maximum leverage of a contract which
+// switches it to isolated margin, a zero leverage switches it to cross margin
+func (k *Kraken) FuturesSetLeveragePreference(ctx context.Context, symbol currency.Pair, maxLeverage float64) (GenericResponse, error) {
+	var resp GenericResponse
+	params := url.Values{}
+	symbolValue, err := k.FormatSymbol(symbol, asset.Futures)
+	if err != nil {
+		return resp, err
+	}
+	params.Set("symbol", symbolValue)
+	if maxLeverage != 0 {
+		params.Set("maxLeverage", strconv.FormatFloat(maxLeverage, 'f', -1, 64))
+	}
+	return resp, k.SendFuturesAuthRequest(ctx, http.MethodPut, futuresLeverage, params, nil, &resp)
+}
+
 // GetFuturesAccountData gets account data for futures
 func (k *Kraken) GetFuturesAccountData(ctx context.Context) (FuturesAccountsData, error) {
 	var resp FuturesAccountsData
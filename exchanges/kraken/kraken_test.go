@@ -2,6 +2,7 @@ package kraken
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -18,6 +19,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -2074,3 +2076,129 @@ func TestChecksumCalculation(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestFuturesGetLeveragePreferences(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys not set, skipping test")
+	}
+	_, err := k.FuturesGetLeveragePreferences(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFuturesSetLeveragePreference(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("API keys not set or canManipulateRealOrders set to false, skipping test")
+	}
+	cp, err := currency.NewPairFromString("PF_XBTUSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = k.FuturesSetLeveragePreference(context.Background(), cp, 2)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetFuturesPositions(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys not set, skipping test")
+	}
+	_, err = k.GetFuturesPositions(context.Background(), asset.Futures, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesFundingRates(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetFuturesFundingRates(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	cp, err := currency.NewPairFromString("PI_XBTUSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = k.GetFuturesFundingRates(context.Background(), asset.Futures, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("PF_XBTUSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = k.SetLeverage(context.Background(), asset.Spot, cp, 2)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	err = k.SetLeverage(context.Background(), asset.Futures, cp, 0)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrInvalidLeverage)
+	}
+	err = k.SetLeverage(context.Background(), asset.Futures, currency.Pair{}, 2)
+	if !errors.Is(err, futures.ErrPairRequired) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrPairRequired)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("API keys not set or canManipulateRealOrders set to false, skipping test")
+	}
+	err = k.SetLeverage(context.Background(), asset.Futures, cp, 2)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("PF_XBTUSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = k.SetMarginType(context.Background(), asset.Futures, cp, futures.UnknownMargin)
+	if !errors.Is(err, futures.ErrMarginTypeUnsupported) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrMarginTypeUnsupported)
+	}
+	err = k.SetMarginType(context.Background(), asset.Spot, cp, futures.Cross)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	err = k.SetMarginType(context.Background(), asset.Futures, currency.Pair{}, futures.Cross)
+	if !errors.Is(err, futures.ErrPairRequired) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrPairRequired)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("API keys not set or canManipulateRealOrders set to false, skipping test")
+	}
+	err = k.SetMarginType(context.Background(), asset.Futures, cp, futures.Cross)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetCollateral(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetCollateral(context.Background(), asset.Spot)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys not set, skipping test")
+	}
+	_, err = k.GetCollateral(context.Background(), asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
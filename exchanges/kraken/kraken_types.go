@@ -60,6 +60,7 @@ const (
 	futuresWithdraw          = "/api/v3/withdrawal"
 	futuresTransfers         = "/api/v3/transfers"
 	futuresEditOrder         = "/api/v3/editorder"
+	futuresLeverage          = "/api/v3/leveragepreferences"
 
 	// Rate limit consts
 	krakenRateInterval = time.Second
@@ -805,6 +805,11 @@ func (k *Kraken) wsProcessOrderBook(channelData *WebsocketChannelData, data map[
 			defer k.wsRequestMtx.Unlock()
 			err := k.wsProcessOrderBookUpdate(channelData, askData, bidData, checksum)
 			if err != nil {
+				if errors.Is(err, buffer.ErrOrderbookOutOfSync) {
+					// Books which fail checksum verification are resynced by
+					// the websocket orderbook buffer
+					return err
+				}
 				go func(resub *stream.ChannelSubscription) {
 					// This was locking the main websocket reader routine and a
 					// backlog occurred. So put this into it's own go routine.
@@ -1023,26 +1028,31 @@ func (k *Kraken) wsProcessOrderBookUpdate(channelData *WebsocketChannelData, ask
 			amtDP = len(aSplit[1])
 		}
 	}
-	update.UpdateTime = highestLastUpdate
-	err := k.Websocket.Orderbook.Update(&update)
-	if err != nil {
-		return err
-	}
-
-	book, err := k.Websocket.Orderbook.GetOrderbook(channelData.Pair, asset.Spot)
-	if err != nil {
-		return fmt.Errorf("cannot calculate websocket checksum: book not found for %s %s %w",
-			channelData.Pair,
-			asset.Spot,
-			err)
-	}
-
 	token, err := strconv.ParseInt(checksum, 10, 64)
 	if err != nil {
 		return err
 	}
+	update.UpdateTime = highestLastUpdate
+	update.Checksum = uint32(token)
+	update.PriceDecimals = priceDP
+	update.AmountDecimals = amtDP
+	return k.Websocket.Orderbook.Update(&update)
+}
+
+// verifyOBChecksum validates the checksum of an updated book for the websocket
+// orderbook buffer using the decimal places sent with the update
+func verifyOBChecksum(book *orderbook.Base, u *buffer.Update) error {
+	return validateCRC32(book, u.Checksum, u.PriceDecimals, u.AmountDecimals)
+}
 
-	return validateCRC32(book, uint32(token), priceDP, amtDP)
+// wsResyncOB resubscribes to the orderbook of a pair which has failed checksum
+// verification so that a new snapshot is sent
+func (k *Kraken) wsResyncOB(p currency.Pair, a asset.Item) error {
+	return k.Websocket.ResubscribeToChannel(&stream.ChannelSubscription{
+		Channel:  krakenWsOrderbook,
+		Currency: p,
+		Asset:    a,
+	})
 }
 
 func validateCRC32(b *orderbook.Base, token uint32, decPrice, decAmount int) error {
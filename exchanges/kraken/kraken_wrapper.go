@@ -17,6 +17,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -223,6 +224,8 @@ func (k *Kraken) Setup(exch *config.ExchangeConfig) error {
 		OrderbookBufferLimit:             exch.OrderbookConfig.WebsocketBufferLimit,
 		BufferEnabled:                    exch.OrderbookConfig.WebsocketBufferEnabled,
 		SortBuffer:                       true,
+		OrderbookVerifier:                verifyOBChecksum,
+		OrderbookResync:                  k.wsResyncOB,
 	})
 	if err != nil {
 		return err
@@ -1504,3 +1507,169 @@ func compatibleFillOrderType(fillType string) (order.Type, error) {
 	}
 	return resp, nil
 }
+
+// GetFuturesPositions returns the open futures positions of the account
+func (k *Kraken) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	positions, err := k.FuturesGetOpenPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tickers, err := k.GetFuturesTickers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	markPrices := make(map[string]float64, len(tickers.Tickers))
+	for i := range tickers.Tickers {
+		markPrices[strings.ToUpper(tickers.Tickers[i].Symbol)] = tickers.Tickers[i].MarkPrice
+	}
+	var resp []futures.Position
+	for i := range positions.OpenPositions {
+		cp, err := currency.NewPairFromString(positions.OpenPositions[i].Symbol)
+		if err != nil {
+			return nil, err
+		}
+		if !p.IsEmpty() && !cp.Equal(p) {
+			continue
+		}
+		amount := positions.OpenPositions[i].Size
+		if strings.EqualFold(positions.OpenPositions[i].Side, "short") {
+			amount = -amount
+		}
+		filled, err := time.Parse(time.RFC3339, positions.OpenPositions[i].FillTime)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, futures.Position{
+			Exchange:    k.Name,
+			Asset:       a,
+			Pair:        cp,
+			Amount:      amount,
+			EntryPrice:  positions.OpenPositions[i].Price,
+			MarkPrice:   markPrices[strings.ToUpper(positions.OpenPositions[i].Symbol)],
+			LastUpdated: filled,
+		})
+	}
+	return resp, nil
+}
+
+// GetFuturesFundingRates returns the current funding rates of perpetual
+// futures, Kraken reports the absolute rate paid per contract
+func (k *Kraken) GetFuturesFundingRates(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.FundingRate, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	enabled, err := k.GetEnabledPairs(a)
+	if err != nil {
+		return nil, err
+	}
+	tickers, err := k.GetFuturesTickers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.FundingRate
+	for i := range tickers.Tickers {
+		if !strings.EqualFold(tickers.Tickers[i].Tag, "perpetual") {
+			continue
+		}
+		cp, err := currency.NewPairFromString(tickers.Tickers[i].Symbol)
+		if err != nil {
+			return nil, err
+		}
+		if p.IsEmpty() {
+			if !enabled.Contains(cp, true) {
+				continue
+			}
+		} else if !cp.Equal(p) {
+			continue
+		}
+		resp = append(resp, futures.FundingRate{
+			Exchange:  k.Name,
+			Asset:     a,
+			Pair:      cp,
+			Rate:      tickers.Tickers[i].FundingRate,
+			MarkPrice: tickers.Tickers[i].MarkPrice,
+			Time:      time.Now(),
+		})
+	}
+	return resp, nil
+}
+
+// SetLeverage sets the maximum leverage of a multi-collateral contract, which
+// switches the contract to isolated margin
+func (k *Kraken) SetLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	if a != asset.Futures {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if leverage <= 0 {
+		return futures.ErrInvalidLeverage
+	}
+	if p.IsEmpty() {
+		return futures.ErrPairRequired
+	}
+	_, err := k.FuturesSetLeveragePreference(ctx, p, leverage)
+	return err
+}
+
+// SetMarginType sets whether a multi-collateral contract uses isolated or
+// cross margin. Isolated margin requires a maximum leverage so is only
+// enabled via SetLeverage, setting it succeeds when already enabled
+func (k *Kraken) SetMarginType(ctx context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error {
+	if a != asset.Futures {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if marginType != futures.Isolated && marginType != futures.Cross {
+		return fmt.Errorf("%w %q", futures.ErrMarginTypeUnsupported, marginType)
+	}
+	if p.IsEmpty() {
+		return futures.ErrPairRequired
+	}
+	if marginType == futures.Cross {
+		_, err := k.FuturesSetLeveragePreference(ctx, p, 0)
+		return err
+	}
+	preferences, err := k.FuturesGetLeveragePreferences(ctx)
+	if err != nil {
+		return err
+	}
+	symbol, err := k.FormatSymbol(p, a)
+	if err != nil {
+		return err
+	}
+	for i := range preferences.LeveragePreferences {
+		if strings.EqualFold(preferences.LeveragePreferences[i].Symbol, symbol) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s %w", p, errIsolatedMarginRequiresLeverage)
+}
+
+// GetCollateral returns the balances of the futures margin accounts
+func (k *Kraken) GetCollateral(ctx context.Context, a asset.Item) ([]futures.Collateral, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	accounts, err := k.GetFuturesAccountData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.Collateral
+	for name := range accounts.Accounts {
+		acc := accounts.Accounts[name]
+		if acc.Auxiliary.PortfolioValue == 0 {
+			// Cash accounts hold funds which are not used as margin
+			continue
+		}
+		resp = append(resp, futures.Collateral{
+			Currency:          currency.NewCode(acc.Currency),
+			Total:             acc.Auxiliary.PortfolioValue,
+			Available:         acc.Auxiliary.AvailableFunds,
+			UnrealisedPNL:     acc.Auxiliary.ProfitAndLoss,
+			InitialMargin:     acc.MarginRequirements.InitialMargin,
+			MaintenanceMargin: acc.MarginRequirements.MaintenanceMargin,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,216 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+// DefaultWebsocketDirectory defines the main websocket mock directory
+const DefaultWebsocketDirectory = "../../testdata/websocket_mock/"
+
+var (
+	errWebsocketRecordingPathUnset = errors.New("websocket recording path not supplied")
+	errWebsocketSessionNotStarted  = errors.New("websocket recording session not started")
+)
+
+// WebsocketMock defines the websocket mock JSON file, holding each recorded
+// connection in the order it was established
+type WebsocketMock struct {
+	Sessions []WebsocketSession `json:"sessions"`
+}
+
+// WebsocketSession defines a single recorded websocket connection
+type WebsocketSession struct {
+	// Path is the URL path the connection was established on, the replay
+	// server only serves the session to connections on the same path
+	Path   string           `json:"path"`
+	Frames []WebsocketFrame `json:"frames"`
+	// Closed is set when the connection was dropped rather than shutdown,
+	// the replay server closes the connection once all frames are sent
+	Closed bool `json:"closed,omitempty"`
+}
+
+// WebsocketFrame defines a single recorded websocket message
+type WebsocketFrame struct {
+	// Sent is set for messages sent by the client
+	Sent bool `json:"sent,omitempty"`
+	// Offset is the duration between the connection being established and
+	// the message
+	Offset time.Duration `json:"offset"`
+	Type   int           `json:"type"`
+	// Data holds text messages which are valid JSON, other text messages are
+	// held in Text and binary messages in Binary
+	Data   json.RawMessage `json:"data,omitempty"`
+	Text   string          `json:"text,omitempty"`
+	Binary []byte          `json:"binary,omitempty"`
+}
+
+// Payload returns the message as it was sent over the connection, JSON
+// messages are returned without insignificant whitespace
+func (f *WebsocketFrame) Payload() []byte {
+	switch {
+	case f.Binary != nil:
+		return f.Binary
+	case f.Data != nil:
+		var b bytes.Buffer
+		if json.Compact(&b, f.Data) != nil {
+			return f.Data
+		}
+		return b.Bytes()
+	default:
+		return []byte(f.Text)
+	}
+}
+
+// WebsocketRecorder records the messages sent and received over websocket
+// connections to a JSON file which can be replayed by the websocket VCR
+// server. Each connection established is recorded as a new session
+type WebsocketRecorder struct {
+	path     string
+	m        sync.Mutex
+	sessions []WebsocketSession
+	// current is the index of the session being recorded, -1 when not
+	// connected
+	current int
+	start   time.Time
+}
+
+// NewWebsocketRecorder returns a recorder which writes to the supplied file,
+// replacing any existing recording once the first connection ends
+func NewWebsocketRecorder(path string) (*WebsocketRecorder, error) {
+	if path == "" {
+		return nil, errWebsocketRecordingPathUnset
+	}
+	return &WebsocketRecorder{path: path, current: -1}, nil
+}
+
+// Connect starts recording a new session for a connection established to the
+// supplied URL, ending any session still being recorded
+func (r *WebsocketRecorder) Connect(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.current != -1 {
+		if err = r.end(false); err != nil {
+			return err
+		}
+	}
+	r.sessions = append(r.sessions, WebsocketSession{Path: u.Path})
+	r.current = len(r.sessions) - 1
+	r.start = time.Now()
+	return nil
+}
+
+// Record adds a message to the current session. Only text and binary
+// messages are recorded. Values of sensitive keys in sent JSON messages are
+// removed
+func (r *WebsocketRecorder) Record(sent bool, messageType int, data []byte) error {
+	if messageType != websocket.TextMessage && messageType != websocket.BinaryMessage {
+		return nil
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.current == -1 {
+		return errWebsocketSessionNotStarted
+	}
+	frame := WebsocketFrame{
+		Sent:   sent,
+		Offset: time.Since(r.start),
+		Type:   messageType,
+	}
+	switch {
+	case messageType == websocket.BinaryMessage:
+		frame.Binary = append([]byte(nil), data...)
+	case json.Valid(data):
+		payload := append(json.RawMessage(nil), data...)
+		if sent {
+			var err error
+			payload, err = redactWebsocketMessage(payload)
+			if err != nil {
+				return err
+			}
+		}
+		frame.Data = payload
+	default:
+		frame.Text = string(data)
+	}
+	r.sessions[r.current].Frames = append(r.sessions[r.current].Frames, frame)
+	return nil
+}
+
+// Disconnect ends the current session and writes the recording to file.
+// closed should be set when the connection was dropped rather than shutdown
+// so the replay server drops the connection in turn. It is a no-op when no
+// session is being recorded
+func (r *WebsocketRecorder) Disconnect(closed bool) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.current == -1 {
+		return nil
+	}
+	return r.end(closed)
+}
+
+// end finishes the current session and saves all sessions, the lock must be
+// held
+func (r *WebsocketRecorder) end(closed bool) error {
+	r.sessions[r.current].Closed = closed
+	r.current = -1
+	payload, err := json.MarshalIndent(WebsocketMock{Sessions: r.sessions}, "", " ")
+	if err != nil {
+		return err
+	}
+	return file.Write(r.path, payload)
+}
+
+// redactWebsocketMessage removes the values of sensitive keys such as API
+// keys and signatures from a JSON message
+func redactWebsocketMessage(data json.RawMessage) (json.RawMessage, error) {
+	var intermediary interface{}
+	err := json.Unmarshal(data, &intermediary)
+	if err != nil {
+		return nil, err
+	}
+	if !redactWebsocketValue(intermediary) {
+		return data, nil
+	}
+	return json.Marshal(intermediary)
+}
+
+// redactWebsocketValue recursively blanks the string values of sensitive keys
+// and returns whether anything was changed
+func redactWebsocketValue(v interface{}) bool {
+	var changed bool
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k := range val {
+			if _, ok := val[k].(string); ok && websocketSensitiveKeys[k] {
+				if val[k] != "" {
+					val[k] = ""
+					changed = true
+				}
+				continue
+			}
+			if redactWebsocketValue(val[k]) {
+				changed = true
+			}
+		}
+	case []interface{}:
+		for i := range val {
+			if redactWebsocketValue(val[i]) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
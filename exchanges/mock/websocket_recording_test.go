@@ -0,0 +1,152 @@
+package mock
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestNewWebsocketRecorder(t *testing.T) {
+	t.Parallel()
+	_, err := NewWebsocketRecorder("")
+	if !errors.Is(err, errWebsocketRecordingPathUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errWebsocketRecordingPathUnset)
+	}
+	r, err := NewWebsocketRecorder("test.json")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if r.current != -1 {
+		t.Fatal("recorder should not have a session started")
+	}
+}
+
+func TestWebsocketRecorder(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "websocketrecording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.json")
+
+	r, err := NewWebsocketRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Record(true, websocket.TextMessage, []byte(`{}`))
+	if !errors.Is(err, errWebsocketSessionNotStarted) {
+		t.Fatalf("received '%v', expected '%v'", err, errWebsocketSessionNotStarted)
+	}
+	err = r.Disconnect(false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	err = r.Connect("wss://%%")
+	if err == nil {
+		t.Fatal("expected error parsing URL")
+	}
+
+	err = r.Connect("wss://test.com/ws/v1")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	err = r.Record(true, websocket.TextMessage, []byte(`{"event":"auth","apiKey":"secretKey","authSig":"secretSig","args":[{"sign":"secretSign"}],"nonce":1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Record(false, websocket.TextMessage, []byte(`{"event":"auth","status":"OK"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Record(true, websocket.TextMessage, []byte("ping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Record(false, websocket.BinaryMessage, []byte{0x1f, 0x8b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Record(false, websocket.PongMessage, []byte("pong"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Connecting again ends the current session
+	err = r.Connect("wss://test.com/ws/v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Disconnect(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"secretKey", "secretSig", "secretSign"} {
+		if strings.Contains(string(contents), secret) {
+			t.Fatalf("recording contains sensitive value %s", secret)
+		}
+	}
+	var m WebsocketMock
+	err = json.Unmarshal(contents, &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Sessions) != 2 {
+		t.Fatalf("received '%v', expected '%v'", len(m.Sessions), 2)
+	}
+	if m.Sessions[0].Path != "/ws/v1" || m.Sessions[0].Closed {
+		t.Fatalf("unexpected first session %+v", m.Sessions[0])
+	}
+	if m.Sessions[1].Path != "/ws/v2" || !m.Sessions[1].Closed {
+		t.Fatalf("unexpected second session %+v", m.Sessions[1])
+	}
+	frames := m.Sessions[0].Frames
+	if len(frames) != 4 {
+		t.Fatalf("received '%v', expected '%v'", len(frames), 4)
+	}
+	if !frames[0].Sent || frames[0].Data == nil {
+		t.Fatal("expected sent JSON frame")
+	}
+	if frames[1].Sent || string(frames[1].Payload()) != `{"event":"auth","status":"OK"}` {
+		t.Fatalf("unexpected received frame %s", frames[1].Payload())
+	}
+	if frames[2].Text != "ping" || string(frames[2].Payload()) != "ping" {
+		t.Fatalf("unexpected text frame %+v", frames[2])
+	}
+	if frames[3].Type != websocket.BinaryMessage || len(frames[3].Payload()) != 2 {
+		t.Fatalf("unexpected binary frame %+v", frames[3])
+	}
+}
+
+func TestRedactWebsocketMessage(t *testing.T) {
+	t.Parallel()
+	_, err := redactWebsocketMessage([]byte(`{`))
+	if err == nil {
+		t.Fatal("expected error on invalid JSON")
+	}
+	msg := json.RawMessage(`{"op":"subscribe", "args":["trades"]}`)
+	redacted, err := redactWebsocketMessage(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(redacted) != string(msg) {
+		t.Fatal("message without sensitive values should be unchanged")
+	}
+	redacted, err = redactWebsocketMessage([]byte(`{"op":"login","args":[{"apiKey":"a","passphrase":"b","sign":"c"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(redacted) != `{"args":[{"apiKey":"","passphrase":"","sign":""}],"op":"login"}` {
+		t.Fatalf("unexpected redacted message %s", redacted)
+	}
+}
@@ -0,0 +1,320 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+var errWebsocketMockPathUnset = errors.New("no path to websocket mock file found")
+
+// websocketSensitiveKeys are keys whose string values are removed from sent
+// messages when recording
+var websocketSensitiveKeys = map[string]bool{
+	"apiKey":      true,
+	"api_key":     true,
+	"apikey":      true,
+	"authKey":     true,
+	"signature":   true,
+	"sig":         true,
+	"sign":        true,
+	"authSig":     true,
+	"authPayload": true,
+	"passphrase":  true,
+	"token":       true,
+}
+
+// websocketVolatileKeys are keys whose values change between sessions, such
+// as request IDs and nonces. Their values are not compared when matching
+// sent messages, instead recorded values are substituted with the values
+// received in the messages replayed after them
+var websocketVolatileKeys = map[string]bool{
+	"id":         true,
+	"reqid":      true,
+	"req_id":     true,
+	"requestId":  true,
+	"request_id": true,
+	"cid":        true,
+	"nonce":      true,
+	"authNonce":  true,
+	"tonce":      true,
+	"timestamp":  true,
+	"ts":         true,
+	"expires":    true,
+}
+
+// websocketVCR serves recorded websocket sessions
+type websocketVCR struct {
+	m        sync.Mutex
+	sessions []WebsocketSession
+	used     []bool
+	realTime bool
+	upgrader websocket.Upgrader
+}
+
+// websocketExchange is a request sent by the client followed by the messages
+// replayed in response. The first exchange of a session has no request and
+// holds the messages sent on connection
+type websocketExchange struct {
+	request *WebsocketFrame
+	replies []WebsocketFrame
+}
+
+// NewWebsocketVCRServer starts a new VCR server for replaying recorded
+// websocket sessions and returns its ws:// URL, recorded paths are appended
+// to the URL when connecting. Each connection is served the next unused
+// session recorded on the same path. Recorded messages are replayed when the
+// client sends the request they followed, when realTime is set the recorded
+// delays between messages are kept
+func NewWebsocketVCRServer(path string, realTime bool) (string, error) {
+	if path == "" {
+		return "", errWebsocketMockPathUnset
+	}
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	var mockFile WebsocketMock
+	err = json.Unmarshal(contents, &mockFile)
+	if err != nil {
+		return "", err
+	}
+	vcr := &websocketVCR{
+		sessions: mockFile.Sessions,
+		used:     make([]bool, len(mockFile.Sessions)),
+		realTime: realTime,
+	}
+	server := httptest.NewServer(http.HandlerFunc(vcr.serve))
+	return "ws" + strings.TrimPrefix(server.URL, "http"), nil
+}
+
+// next returns the next unused session recorded on a path
+func (v *websocketVCR) next(path string) *WebsocketSession {
+	v.m.Lock()
+	defer v.m.Unlock()
+	for i := range v.sessions {
+		if !v.used[i] && v.sessions[i].Path == path {
+			v.used[i] = true
+			return &v.sessions[i]
+		}
+	}
+	return nil
+}
+
+// serve replays a recorded session over a websocket connection
+func (v *websocketVCR) serve(w http.ResponseWriter, r *http.Request) {
+	session := v.next(r.URL.Path)
+	if session == nil {
+		http.Error(w, fmt.Sprintf("no recorded websocket session available for path %q", r.URL.Path), http.StatusNotFound)
+		return
+	}
+	conn, err := v.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("Mock Test Failure - websocket upgrade error %v", err)
+		return
+	}
+	defer conn.Close()
+
+	received := make(chan []byte)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(received)
+		for {
+			_, msg, readErr := conn.ReadMessage()
+			if readErr != nil {
+				return
+			}
+			select {
+			case received <- msg:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	exchanges := splitWebsocketSession(session)
+	replacements := make(map[string]string)
+	if err = v.reply(conn, nil, &exchanges[0], replacements); err != nil {
+		return
+	}
+	remaining := len(exchanges) - 1
+	for remaining > 0 {
+		msg, ok := <-received
+		if !ok {
+			return
+		}
+		matched := false
+		for i := 1; i < len(exchanges); i++ {
+			if exchanges[i].request == nil ||
+				!matchWebsocketRequest(exchanges[i].request.Data, msg, replacements) {
+				continue
+			}
+			if err = v.reply(conn, exchanges[i].request, &exchanges[i], replacements); err != nil {
+				return
+			}
+			exchanges[i].request = nil
+			remaining--
+			matched = true
+			break
+		}
+		if !matched {
+			log.Printf("Mock Test Failure - websocket message not matched to recording %s", msg)
+		}
+	}
+
+	if session.Closed {
+		return
+	}
+	// Keep the connection open until the client shuts it down
+	for range received {
+	}
+}
+
+// reply writes the recorded replies of an exchange to the connection
+func (v *websocketVCR) reply(conn *websocket.Conn, request *WebsocketFrame, e *websocketExchange, replacements map[string]string) error {
+	var last time.Duration
+	if request != nil {
+		last = request.Offset
+	}
+	for i := range e.replies {
+		if v.realTime && e.replies[i].Offset > last {
+			time.Sleep(e.replies[i].Offset - last)
+		}
+		last = e.replies[i].Offset
+		payload := e.replies[i].Payload()
+		if e.replies[i].Type == websocket.TextMessage {
+			payload = replaceWebsocketValues(payload, replacements)
+		}
+		err := conn.WriteMessage(e.replies[i].Type, payload)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitWebsocketSession groups the frames of a session into the requests
+// sent by the client and the replies which followed them. Only sent JSON
+// messages are waited for, other sent messages such as text pings are
+// skipped so their replies are replayed without waiting
+func splitWebsocketSession(s *WebsocketSession) []websocketExchange {
+	exchanges := []websocketExchange{{}}
+	for i := range s.Frames {
+		if !s.Frames[i].Sent {
+			last := &exchanges[len(exchanges)-1]
+			last.replies = append(last.replies, s.Frames[i])
+			continue
+		}
+		if s.Frames[i].Data == nil {
+			continue
+		}
+		exchanges = append(exchanges, websocketExchange{request: &s.Frames[i]})
+	}
+	return exchanges
+}
+
+// matchWebsocketRequest matches a message sent by the client with a recorded
+// request. Volatile values which differ are added to the replacements
+func matchWebsocketRequest(recorded json.RawMessage, msg []byte, replacements map[string]string) bool {
+	var want, got interface{}
+	if json.Unmarshal(recorded, &want) != nil || json.Unmarshal(msg, &got) != nil {
+		return false
+	}
+	found := make(map[string]string)
+	if !matchWebsocketValue(want, got, found) {
+		return false
+	}
+	for k, v := range found {
+		replacements[k] = v
+	}
+	return true
+}
+
+// matchWebsocketValue recursively compares JSON values, ignoring the values
+// of volatile and sensitive keys
+func matchWebsocketValue(want, got interface{}, found map[string]string) bool {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok || len(w) != len(g) {
+			return false
+		}
+		for k := range w {
+			gv, ok := g[k]
+			if !ok {
+				return false
+			}
+			if websocketVolatileKeys[k] || websocketSensitiveKeys[k] {
+				addWebsocketReplacement(w[k], gv, found)
+				continue
+			}
+			if !matchWebsocketValue(w[k], gv, found) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok || len(w) != len(g) {
+			return false
+		}
+		for i := range w {
+			if !matchWebsocketValue(w[i], g[i], found) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(want, got)
+	}
+}
+
+// addWebsocketReplacement records the substitution of a recorded volatile
+// scalar value with the value received
+func addWebsocketReplacement(recorded, received interface{}, found map[string]string) {
+	switch recorded.(type) {
+	case string, float64:
+	default:
+		return
+	}
+	if recorded == "" || reflect.DeepEqual(recorded, received) {
+		return
+	}
+	from, err := json.Marshal(recorded)
+	if err != nil {
+		return
+	}
+	to, err := json.Marshal(received)
+	if err != nil {
+		return
+	}
+	found[string(from)] = string(to)
+}
+
+// replaceWebsocketValues substitutes recorded volatile values in a message
+// with the values received from the client. Numbers are only replaced when
+// they are not part of a longer number
+func replaceWebsocketValues(payload []byte, replacements map[string]string) []byte {
+	for from, to := range replacements {
+		if strings.HasPrefix(from, `"`) {
+			payload = bytes.ReplaceAll(payload, []byte(from), []byte(to))
+			continue
+		}
+		re := regexp.MustCompile(`(^|[^0-9.])` + regexp.QuoteMeta(from) + `([^0-9.]|$)`)
+		payload = re.ReplaceAll(payload, []byte("${1}"+to+"${2}"))
+	}
+	return payload
+}
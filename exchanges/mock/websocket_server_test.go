@@ -0,0 +1,169 @@
+package mock
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestNewWebsocketVCRServer(t *testing.T) {
+	t.Parallel()
+	_, err := NewWebsocketVCRServer("", false)
+	if !errors.Is(err, errWebsocketMockPathUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errWebsocketMockPathUnset)
+	}
+
+	dir, err := ioutil.TempDir("", "websocketserver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.json")
+
+	m := WebsocketMock{Sessions: []WebsocketSession{
+		{
+			Path:   "/ws",
+			Closed: true,
+			Frames: []WebsocketFrame{
+				{Type: websocket.TextMessage, Data: json.RawMessage(`{"event":"info"}`)},
+				{Sent: true, Type: websocket.TextMessage, Data: json.RawMessage(`{"event":"subscribe","channel":"book","reqid":5}`)},
+				{Type: websocket.TextMessage, Data: json.RawMessage(`{"event":"subscribed","reqid":5}`)},
+				{Type: websocket.TextMessage, Data: json.RawMessage(`{"book":[15,5.5]}`)},
+				{Sent: true, Type: websocket.TextMessage, Text: "ping"},
+				{Type: websocket.TextMessage, Text: "pong"},
+				{Sent: true, Type: websocket.TextMessage, Data: json.RawMessage(`{"event":"subscribe","channel":"trades","reqid":6}`)},
+				{Type: websocket.TextMessage, Data: json.RawMessage(`{"event":"subscribed","reqid":6}`)},
+			},
+		},
+		{
+			Path: "/ws",
+			Frames: []WebsocketFrame{
+				{Type: websocket.BinaryMessage, Binary: []byte{1, 2, 3}},
+			},
+		},
+	}}
+	payload, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(path, payload, os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewWebsocketVCRServer(filepath.Join(dir, "missing.json"), false)
+	if err == nil {
+		t.Fatal("expected error on missing file")
+	}
+
+	serverURL, err := NewWebsocketVCRServer(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial(serverURL+"/ws", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectWebsocketMessage(t, conn, `{"event":"info"}`)
+
+	// Requests are matched regardless of the order they were recorded in and
+	// volatile values are substituted in the replies
+	err = conn.WriteJSON(map[string]interface{}{"event": "subscribe", "channel": "trades", "reqid": 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectWebsocketMessage(t, conn, `{"event":"subscribed","reqid":100}`)
+
+	// Unmatched messages are ignored
+	err = conn.WriteJSON(map[string]interface{}{"event": "subscribe", "channel": "ticker", "reqid": 8})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = conn.WriteJSON(map[string]interface{}{"event": "subscribe", "channel": "book", "reqid": 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectWebsocketMessage(t, conn, `{"event":"subscribed","reqid":7}`)
+	expectWebsocketMessage(t, conn, `{"book":[15,5.5]}`)
+	expectWebsocketMessage(t, conn, "pong")
+
+	// The recorded session was dropped so the connection is closed
+	_, _, err = conn.ReadMessage()
+	if err == nil {
+		t.Fatal("expected connection to be closed")
+	}
+
+	conn, _, err = websocket.DefaultDialer.Dial(serverURL+"/ws", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mType, msg, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mType != websocket.BinaryMessage || len(msg) != 3 {
+		t.Fatalf("unexpected message %v %v", mType, msg)
+	}
+	err = conn.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// All sessions are used
+	_, _, err = websocket.DefaultDialer.Dial(serverURL+"/ws", nil)
+	if !errors.Is(err, websocket.ErrBadHandshake) {
+		t.Fatalf("received '%v', expected '%v'", err, websocket.ErrBadHandshake)
+	}
+}
+
+func expectWebsocketMessage(t *testing.T, conn *websocket.Conn, expected string) {
+	t.Helper()
+	_, msg, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(msg) != expected {
+		t.Fatalf("received '%s', expected '%s'", msg, expected)
+	}
+}
+
+func TestMatchWebsocketRequest(t *testing.T) {
+	t.Parallel()
+	replacements := make(map[string]string)
+	if matchWebsocketRequest(json.RawMessage(`{"op":"subscribe"}`), []byte(`{`), replacements) {
+		t.Fatal("invalid JSON should not match")
+	}
+	if matchWebsocketRequest(json.RawMessage(`{"op":"subscribe","args":["a"]}`), []byte(`{"op":"subscribe","args":["b"]}`), replacements) {
+		t.Fatal("differing values should not match")
+	}
+	if matchWebsocketRequest(json.RawMessage(`{"op":"subscribe"}`), []byte(`{"op":"subscribe","id":1}`), replacements) {
+		t.Fatal("differing keys should not match")
+	}
+	if len(replacements) != 0 {
+		t.Fatal("replacements should not be added when unmatched")
+	}
+	if !matchWebsocketRequest(json.RawMessage(`{"op":"login","args":[{"apiKey":"","nonce":"abc"}],"id":1}`),
+		[]byte(`{"op":"login","args":[{"apiKey":"key","nonce":"xyz"}],"id":2}`),
+		replacements) {
+		t.Fatal("volatile values should not be compared")
+	}
+	if len(replacements) != 2 || replacements["1"] != "2" || replacements[`"abc"`] != `"xyz"` {
+		t.Fatalf("unexpected replacements %v", replacements)
+	}
+}
+
+func TestReplaceWebsocketValues(t *testing.T) {
+	t.Parallel()
+	replaced := replaceWebsocketValues([]byte(`{"id":5,"nonce":"abc","px":15,"qty":5.5,"ids":[5]}`),
+		map[string]string{"5": "7", `"abc"`: `"xyz"`})
+	if string(replaced) != `{"id":7,"nonce":"xyz","px":15,"qty":5.5,"ids":[7]}` {
+		t.Fatalf("unexpected replaced message %s", replaced)
+	}
+}
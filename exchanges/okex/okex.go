@@ -34,6 +34,7 @@ const (
 	// Futures based endpoints
 	okGroupFuturePosition = "position"
 	okGroupFutureLeverage = "leverage"
+	okGroupMarginMode     = "margin_mode"
 	okGroupFutureOrder    = "order"
 	okGroupFutureHolds    = "holds"
 	okGroupIndices        = "index"
@@ -54,8 +55,16 @@ const (
 	okGroupMarginPairData  = "accounts/%s/availability"
 	okGroupMarginPairsData = "accounts/availability"
 	okGroupInstruments     = "instruments"
+
+	// Perpetual swap leverage sides, setting the leverage of a side also
+	// switches the margin mode of the contract
+	okGroupSwapFixedLong  = 1
+	okGroupSwapFixedShort = 2
+	okGroupSwapCrossed    = 3
 )
 
+var errInvalidInstrumentID = errors.New("invalid instrument ID")
+
 // OKEX bases all account, spot and margin methods off okgroup implementation
 type OKEX struct {
 	okgroup.OKGroup
@@ -240,6 +249,13 @@ func (o *OKEX) SetFuturesLeverage(ctx context.Context, request okgroup.SetFuture
 	return resp, o.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodPost, okGroupFuturesSubsection, requestURL, request, &resp, true)
 }
 
+// SetFuturesMarginMode Switch between the crossed and fixed margin mode of an
+// underlying. Open positions and orders must be closed before switching
+func (o *OKEX) SetFuturesMarginMode(ctx context.Context, request okgroup.SetFuturesMarginModeRequest) (resp okgroup.SetFuturesMarginModeResponse, _ error) {
+	requestURL := fmt.Sprintf("%v/%v", okgroup.OKGroupAccounts, okGroupMarginMode)
+	return resp, o.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodPost, okGroupFuturesSubsection, requestURL, request, &resp, true)
+}
+
 // GetFuturesBillDetails Shows the account’s historical coin in flow and out flow.
 // All paginated requests return the latest information (newest) as the first page sorted by newest (in chronological time) first.
 func (o *OKEX) GetFuturesBillDetails(ctx context.Context, request okgroup.GetSpotBillDetailsForCurrencyRequest) (resp []okgroup.GetSpotBillDetailsForCurrencyResponse, _ error) {
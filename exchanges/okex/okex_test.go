@@ -3,6 +3,7 @@ package okex
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -19,6 +20,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -2325,3 +2327,157 @@ func TestUpdateTickers(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := o.GetFuturesPositions(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys not set, skipping test")
+	}
+	_, err = o.GetFuturesPositions(context.Background(), asset.PerpetualSwap, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = o.GetFuturesPositions(context.Background(), asset.Futures, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesFundingRates(t *testing.T) {
+	t.Parallel()
+	_, err := o.GetFuturesFundingRates(context.Background(), asset.Futures, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	_, err = o.GetFuturesFundingRates(context.Background(),
+		asset.PerpetualSwap,
+		currency.NewPairWithDelimiter("BTC-USD", "SWAP", currency.UnderscoreDelimiter))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPairWithDelimiter("BTC-USD", "SWAP", currency.UnderscoreDelimiter)
+	err := o.SetLeverage(context.Background(), asset.PerpetualSwap, p, 0.5)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrInvalidLeverage)
+	}
+	err = o.SetLeverage(context.Background(), asset.PerpetualSwap, currency.Pair{}, 2)
+	if !errors.Is(err, futures.ErrPairRequired) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrPairRequired)
+	}
+	err = o.SetLeverage(context.Background(), asset.Spot, p, 2)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("API keys not set or canManipulateRealOrders set to false, skipping test")
+	}
+	err = o.SetLeverage(context.Background(), asset.PerpetualSwap, p, 2)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPairWithDelimiter("BTC-USD", "SWAP", currency.UnderscoreDelimiter)
+	err := o.SetMarginType(context.Background(), asset.PerpetualSwap, p, futures.UnknownMargin)
+	if !errors.Is(err, futures.ErrMarginTypeUnsupported) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrMarginTypeUnsupported)
+	}
+	err = o.SetMarginType(context.Background(), asset.PerpetualSwap, currency.Pair{}, futures.Cross)
+	if !errors.Is(err, futures.ErrPairRequired) {
+		t.Errorf("received '%v', expected '%v'", err, futures.ErrPairRequired)
+	}
+	err = o.SetMarginType(context.Background(), asset.Spot, p, futures.Cross)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("API keys not set or canManipulateRealOrders set to false, skipping test")
+	}
+	err = o.SetMarginType(context.Background(), asset.PerpetualSwap, p, futures.Cross)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetCollateral(t *testing.T) {
+	t.Parallel()
+	_, err := o.GetCollateral(context.Background(), asset.Spot)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys not set, skipping test")
+	}
+	_, err = o.GetCollateral(context.Background(), asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConvertPositionSide(t *testing.T) {
+	t.Parallel()
+	_, err := o.convertPositionSide(asset.Futures, &positionSide{instrumentID: "BTC-USD"})
+	if !errors.Is(err, errInvalidInstrumentID) {
+		t.Errorf("received '%v', expected '%v'", err, errInvalidInstrumentID)
+	}
+	position, err := o.convertPositionSide(asset.Futures, &positionSide{
+		instrumentID:     "BTC-USD-210625",
+		short:            true,
+		marginMode:       "crossed",
+		quantity:         "5",
+		entryPrice:       "30000",
+		liquidationPrice: "45000",
+		leverage:         "10",
+		margin:           "0.1",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if position.Amount != -5 {
+		t.Errorf("received '%v', expected '%v'", position.Amount, -5)
+	}
+	if position.Pair.String() != "BTC-USD_210625" {
+		t.Errorf("received '%v', expected '%v'", position.Pair, "BTC-USD_210625")
+	}
+	if position.MarginType != futures.Cross || position.Margin != 0 {
+		t.Errorf("received '%v' '%v', expected '%v' '%v'", position.MarginType, position.Margin, futures.Cross, 0)
+	}
+	if position.Leverage != 10 || position.EntryPrice != 30000 || position.LiquidationPrice != 45000 {
+		t.Errorf("unexpected position %+v", position)
+	}
+}
+
+func TestInstrumentUnderlying(t *testing.T) {
+	t.Parallel()
+	if u := instrumentUnderlying("BTC-USD-210625"); u != "BTC-USD" {
+		t.Errorf("received '%v', expected '%v'", u, "BTC-USD")
+	}
+	if u := instrumentUnderlying("BTC"); u != "BTC" {
+		t.Errorf("received '%v', expected '%v'", u, "BTC")
+	}
+}
+
+func TestParseOKEXFloats(t *testing.T) {
+	t.Parallel()
+	values, err := parseOKEXFloats("1.5", "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if values[0] != 1.5 || values[1] != 0 {
+		t.Errorf("received '%v', expected '%v'", values, []float64{1.5, 0})
+	}
+	_, err = parseOKEXFloats("bad")
+	if err == nil {
+		t.Error("expected error parsing non numeric value")
+	}
+}
@@ -5,14 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -666,3 +669,442 @@ func (o *OKEX) GetRecentTrades(ctx context.Context, p currency.Pair, assetType a
 func (o *OKEX) CancelBatchOrders(_ context.Context, _ []order.Cancel) (order.CancelBatchResponse, error) {
 	return order.CancelBatchResponse{}, common.ErrNotYetImplemented
 }
+
+// GetFuturesPositions returns the open futures or perpetual swap positions of
+// the account. The long and short sides of a contract are returned as
+// separate positions
+func (o *OKEX) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	instrumentID, err := o.futuresInstrument(a, p)
+	if err != nil {
+		return nil, err
+	}
+	var sides []positionSide
+	if a == asset.PerpetualSwap {
+		var positions []okgroup.GetSwapPostionsResponse
+		if instrumentID == "" {
+			positions, err = o.GetSwapPostions(ctx)
+		} else {
+			var position okgroup.GetSwapPostionsResponse
+			position, err = o.GetSwapPostionsForContract(ctx, instrumentID)
+			positions = append(positions, position)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i := range positions {
+			for j := range positions[i].Holding {
+				h := &positions[i].Holding[j]
+				sides = append(sides, positionSide{
+					instrumentID:     h.InstrumentID,
+					short:            strings.EqualFold(h.Side, "short"),
+					marginMode:       positions[i].MarginMode,
+					quantity:         h.Position,
+					entryPrice:       h.AvgCost,
+					liquidationPrice: h.LiquidationPrice,
+					unrealisedPNL:    h.UnrealizedPnl,
+					leverage:         h.Leverage,
+					margin:           h.Margin,
+					updated:          h.Timestamp,
+				})
+			}
+		}
+	} else {
+		var holdings []okgroup.GetFuturePostionsDetails
+		if instrumentID == "" {
+			var positions okgroup.GetFuturesPositionsResponse
+			positions, err = o.GetFuturesPostions(ctx)
+			for i := range positions.Holding {
+				holdings = append(holdings, positions.Holding[i]...)
+			}
+		} else {
+			var positions okgroup.GetFuturesPositionsForCurrencyResponse
+			positions, err = o.GetFuturesPostionsForCurrency(ctx, instrumentID)
+			holdings = positions.Holding
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i := range holdings {
+			updated, err := time.Parse(time.RFC3339, holdings[i].UpdatedAt)
+			if err != nil {
+				return nil, err
+			}
+			long := positionSide{
+				instrumentID:     holdings[i].InstrumentID,
+				marginMode:       holdings[i].MarginMode,
+				quantity:         holdings[i].LongQty,
+				entryPrice:       holdings[i].LongAvgCost,
+				liquidationPrice: holdings[i].LongLiquiPrice,
+				unrealisedPNL:    holdings[i].LongUnrealisedPnl,
+				leverage:         holdings[i].LongLeverage,
+				margin:           holdings[i].LongMargin,
+				updated:          updated,
+			}
+			short := positionSide{
+				instrumentID:     holdings[i].InstrumentID,
+				short:            true,
+				marginMode:       holdings[i].MarginMode,
+				quantity:         holdings[i].ShortQty,
+				entryPrice:       holdings[i].ShortAvgCost,
+				liquidationPrice: holdings[i].ShortLiquiPrice,
+				unrealisedPNL:    holdings[i].ShortUnrealisedPnl,
+				leverage:         holdings[i].ShortLeverage,
+				margin:           holdings[i].ShortMargin,
+				updated:          updated,
+			}
+			if holdings[i].LiquidationPrice != "" {
+				// Crossed margin positions share a liquidation price
+				long.liquidationPrice = holdings[i].LiquidationPrice
+				short.liquidationPrice = holdings[i].LiquidationPrice
+			}
+			sides = append(sides, long, short)
+		}
+	}
+	var resp []futures.Position
+	for i := range sides {
+		position, err := o.convertPositionSide(a, &sides[i])
+		if err != nil {
+			return nil, err
+		}
+		if position.Amount == 0 {
+			continue
+		}
+		resp = append(resp, *position)
+	}
+	return resp, nil
+}
+
+// GetFuturesFundingRates returns the current funding rates of perpetual swaps
+func (o *OKEX) GetFuturesFundingRates(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.FundingRate, error) {
+	if a != asset.PerpetualSwap {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	pairs := currency.Pairs{p}
+	if p.IsEmpty() {
+		var err error
+		pairs, err = o.GetEnabledPairs(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var resp []futures.FundingRate
+	for i := range pairs {
+		instrumentID, err := o.futuresInstrument(a, pairs[i])
+		if err != nil {
+			return nil, err
+		}
+		funding, err := o.GetSwapNextSettlementTime(ctx, instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		nextFunding, err := time.Parse(time.RFC3339, funding.FundingTime)
+		if err != nil {
+			return nil, err
+		}
+		mark, err := o.GetSwapMarkPrice(ctx, instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		markPrice, err := strconv.ParseFloat(mark.MarkPrice, 64)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, futures.FundingRate{
+			Exchange:    o.Name,
+			Asset:       a,
+			Pair:        pairs[i],
+			Rate:        funding.FundingRate,
+			MarkPrice:   markPrice,
+			NextFunding: nextFunding,
+			Time:        time.Now(),
+		})
+	}
+	return resp, nil
+}
+
+// SetLeverage sets the leverage of a pair, OKEX only accepts whole number
+// leverage. Crossed margin futures share the leverage of their underlying and
+// fixed margin contracts have the leverage of both sides set
+func (o *OKEX) SetLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	if leverage < 1 {
+		return futures.ErrInvalidLeverage
+	}
+	if p.IsEmpty() {
+		return futures.ErrPairRequired
+	}
+	instrumentID, err := o.futuresInstrument(a, p)
+	if err != nil {
+		return err
+	}
+	if a == asset.PerpetualSwap {
+		settings, err := o.GetSwapAccountSettingsOfAContract(ctx, instrumentID)
+		if err != nil {
+			return err
+		}
+		marginType, _ := futures.StringToMarginType(settings.MarginMode)
+		return o.setSwapLeverage(ctx, instrumentID, int64(leverage), int64(leverage), marginType)
+	}
+	underlying := instrumentUnderlying(instrumentID)
+	current, err := o.GetFuturesLeverage(ctx, underlying)
+	if err != nil {
+		return err
+	}
+	marginType, _ := futures.StringToMarginType(current.MarginMode)
+	if marginType == futures.Cross {
+		_, err = o.SetFuturesLeverage(ctx, okgroup.SetFuturesLeverageRequest{
+			Currency: underlying,
+			Leverage: int64(leverage),
+		})
+		return err
+	}
+	for _, direction := range []string{"long", "short"} {
+		_, err = o.SetFuturesLeverage(ctx, okgroup.SetFuturesLeverageRequest{
+			Currency:     underlying,
+			InstrumentID: instrumentID,
+			Direction:    direction,
+			Leverage:     int64(leverage),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetMarginType sets whether a pair uses fixed or crossed margin. The margin
+// mode of futures applies to every contract of the underlying
+func (o *OKEX) SetMarginType(ctx context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error {
+	if marginType != futures.Isolated && marginType != futures.Cross {
+		return fmt.Errorf("%w %q", futures.ErrMarginTypeUnsupported, marginType)
+	}
+	if p.IsEmpty() {
+		return futures.ErrPairRequired
+	}
+	instrumentID, err := o.futuresInstrument(a, p)
+	if err != nil {
+		return err
+	}
+	if a == asset.PerpetualSwap {
+		settings, err := o.GetSwapAccountSettingsOfAContract(ctx, instrumentID)
+		if err != nil {
+			return err
+		}
+		return o.setSwapLeverage(ctx,
+			instrumentID,
+			int64(settings.LongLeverage),
+			int64(settings.ShortLeverage),
+			marginType)
+	}
+	marginMode := "fixed"
+	if marginType == futures.Cross {
+		marginMode = "crossed"
+	}
+	_, err = o.SetFuturesMarginMode(ctx, okgroup.SetFuturesMarginModeRequest{
+		Underlying: instrumentUnderlying(instrumentID),
+		MarginMode: marginMode,
+	})
+	return err
+}
+
+// GetCollateral returns the margin balances of the futures or perpetual swap
+// accounts
+func (o *OKEX) GetCollateral(ctx context.Context, a asset.Item) ([]futures.Collateral, error) {
+	var resp []futures.Collateral
+	switch a {
+	case asset.Futures:
+		accounts, err := o.GetFuturesAccountOfAllCurrencies(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for key, account := range accounts.Info {
+			code := account.Currency
+			if code == "" {
+				code = key
+			}
+			collateral, err := okexCollateral(code,
+				account.Equity,
+				account.TotalAvailBalance,
+				account.UnrealizedPnl,
+				account.Margin)
+			if err != nil {
+				return nil, err
+			}
+			if collateral.Total != 0 {
+				resp = append(resp, *collateral)
+			}
+		}
+	case asset.PerpetualSwap:
+		accounts, err := o.GetSwapAccountOfAllCurrency(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range accounts.Info {
+			collateral, err := okexCollateral(accounts.Info[i].Currency,
+				accounts.Info[i].Equity,
+				accounts.Info[i].TotalAvailBalance,
+				accounts.Info[i].UnrealizedPnl,
+				accounts.Info[i].Margin)
+			if err != nil {
+				return nil, err
+			}
+			if collateral.Total != 0 {
+				resp = append(resp, *collateral)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return resp, nil
+}
+
+// positionSide holds the values OKEX returns for one side of a futures or
+// perpetual swap position
+type positionSide struct {
+	instrumentID     string
+	short            bool
+	marginMode       string
+	quantity         string
+	entryPrice       string
+	liquidationPrice string
+	unrealisedPNL    string
+	leverage         string
+	margin           string
+	updated          time.Time
+}
+
+// convertPositionSide converts one side of a position, the amount is negative
+// for short positions
+func (o *OKEX) convertPositionSide(a asset.Item, s *positionSide) (*futures.Position, error) {
+	cp, err := instrumentPair(s.instrumentID)
+	if err != nil {
+		return nil, err
+	}
+	values, err := parseOKEXFloats(s.quantity,
+		s.entryPrice,
+		s.liquidationPrice,
+		s.unrealisedPNL,
+		s.leverage,
+		s.margin)
+	if err != nil {
+		return nil, err
+	}
+	amount, margin := values[0], values[5]
+	if s.short {
+		amount = -amount
+	}
+	marginType, _ := futures.StringToMarginType(s.marginMode)
+	if marginType == futures.Cross {
+		margin = 0
+	}
+	return &futures.Position{
+		Exchange:         o.Name,
+		Asset:            a,
+		Pair:             cp,
+		Amount:           amount,
+		EntryPrice:       values[1],
+		LiquidationPrice: values[2],
+		UnrealisedPNL:    values[3],
+		Leverage:         values[4],
+		MarginType:       marginType,
+		Margin:           margin,
+		LastUpdated:      s.updated,
+	}, nil
+}
+
+// setSwapLeverage sets the leverage of a perpetual swap. Setting the leverage
+// of the long and short sides switches the contract to fixed margin, while
+// setting both at once switches it to crossed margin
+func (o *OKEX) setSwapLeverage(ctx context.Context, instrumentID string, long, short int64, marginType futures.MarginType) error {
+	if marginType == futures.Cross {
+		_, err := o.SetSwapLeverageLevelOfAContract(ctx, okgroup.SetSwapLeverageLevelOfAContractRequest{
+			InstrumentID: instrumentID,
+			Leverage:     long,
+			Side:         okGroupSwapCrossed,
+		})
+		return err
+	}
+	_, err := o.SetSwapLeverageLevelOfAContract(ctx, okgroup.SetSwapLeverageLevelOfAContractRequest{
+		InstrumentID: instrumentID,
+		Leverage:     long,
+		Side:         okGroupSwapFixedLong,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = o.SetSwapLeverageLevelOfAContract(ctx, okgroup.SetSwapLeverageLevelOfAContractRequest{
+		InstrumentID: instrumentID,
+		Leverage:     short,
+		Side:         okGroupSwapFixedShort,
+	})
+	return err
+}
+
+// futuresInstrument returns the instrument ID of a futures or perpetual swap
+// pair, or an empty string when no pair is supplied
+func (o *OKEX) futuresInstrument(a asset.Item, p currency.Pair) (string, error) {
+	if a != asset.Futures && a != asset.PerpetualSwap {
+		return "", fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if p.IsEmpty() {
+		return "", nil
+	}
+	fPair, err := o.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return "", err
+	}
+	return fPair.String(), nil
+}
+
+// instrumentPair converts an instrument ID, such as BTC-USD-SWAP, to the pair
+// format used by the futures and perpetual swap configs
+func instrumentPair(instrumentID string) (currency.Pair, error) {
+	p := strings.Split(instrumentID, currency.DashDelimiter)
+	if len(p) != 3 {
+		return currency.Pair{}, fmt.Errorf("%w %s", errInvalidInstrumentID, instrumentID)
+	}
+	return currency.NewPairWithDelimiter(p[0]+currency.DashDelimiter+p[1],
+		p[2],
+		currency.UnderscoreDelimiter), nil
+}
+
+// instrumentUnderlying returns the underlying of an instrument ID, such as
+// BTC-USD for BTC-USD-210625
+func instrumentUnderlying(instrumentID string) string {
+	i := strings.LastIndex(instrumentID, currency.DashDelimiter)
+	if i == -1 {
+		return instrumentID
+	}
+	return instrumentID[:i]
+}
+
+// okexCollateral converts the balances of a futures or perpetual swap account
+func okexCollateral(code, equity, available, unrealisedPNL, margin string) (*futures.Collateral, error) {
+	values, err := parseOKEXFloats(equity, available, unrealisedPNL, margin)
+	if err != nil {
+		return nil, err
+	}
+	return &futures.Collateral{
+		Currency:      currency.NewCode(code),
+		Total:         values[0],
+		Available:     values[1],
+		UnrealisedPNL: values[2],
+		InitialMargin: values[3],
+	}, nil
+}
+
+// parseOKEXFloats parses the numeric strings returned by OKEX in the order
+// they are supplied, empty strings are parsed as zero
+func parseOKEXFloats(values ...string) ([]float64, error) {
+	resp := make([]float64, len(values))
+	for i := range values {
+		if values[i] == "" {
+			continue
+		}
+		var err error
+		resp[i], err = strconv.ParseFloat(values[i], 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
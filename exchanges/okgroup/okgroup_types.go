@@ -561,6 +561,7 @@ type GetFuturePostionsDetails struct {
 	LongPnlRatio         string `json:"long_pnl_ratio"`
 	LongQty              string `json:"long_qty"`
 	LongSettlementPrice  string `json:"long_settlement_price"`
+	LongUnrealisedPnl    string `json:"long_unrealised_pnl"`
 	MarginMode           string `json:"margin_mode"`
 	RealisedPnl          string `json:"realised_pnl"`
 	ShortAvailQty        string `json:"short_avail_qty"`
@@ -571,19 +572,20 @@ type GetFuturePostionsDetails struct {
 	ShortPnlRatio        string `json:"short_pnl_ratio"`
 	ShortQty             string `json:"short_qty"`
 	ShortSettlementPrice string `json:"short_settlement_price"`
+	ShortUnrealisedPnl   string `json:"short_unrealised_pnl"`
 	UpdatedAt            string `json:"updated_at"`
 }
 
 // FuturesAccountForAllCurrenciesResponse response data for FuturesAccountForAllCurrencies
 type FuturesAccountForAllCurrenciesResponse struct {
-	Info struct {
-		Currency map[string]FuturesCurrencyData
-	} `json:"info"`
+	// Info is keyed by the currency or underlying of the account
+	Info map[string]FuturesCurrencyData `json:"info"`
 }
 
 // FuturesCurrencyData Futures details
 type FuturesCurrencyData struct {
 	Contracts         []FuturesContractsData `json:"contracts,omitempty"`
+	Currency          string                 `json:"currency,omitempty"`
 	Equity            string                 `json:"equity,omitempty"`
 	Margin            string                 `json:"margin,omitempty"`
 	MarginMode        string                 `json:"margin_mode,omitempty"`
@@ -642,6 +644,19 @@ type SetFutureLeverageShortLongData struct {
 	Short int `json:"short"`
 }
 
+// SetFuturesMarginModeRequest request data for SetFuturesMarginMode
+type SetFuturesMarginModeRequest struct {
+	Underlying string `json:"underlying"`  // [required] Underlying, e.g. "BTC-USD"
+	MarginMode string `json:"margin_mode"` // [required] Margin mode: crossed or fixed
+}
+
+// SetFuturesMarginModeResponse response data for SetFuturesMarginMode
+type SetFuturesMarginModeResponse struct {
+	Underlying string `json:"underlying"`
+	MarginMode string `json:"margin_mode"`
+	Result     bool   `json:"result"`
+}
+
 // PlaceFuturesOrderRequest request data for PlaceFuturesOrder
 type PlaceFuturesOrderRequest struct {
 	ClientOid    string  `json:"client_oid,omitempty"`         // [optional] 	the order ID customized by yourself
@@ -926,6 +941,7 @@ type GetSwapPostionsResponseHolding struct {
 	SettlementPrice  string    `json:"settlement_price"`
 	Side             string    `json:"side"`
 	Timestamp        time.Time `json:"timestamp"`
+	UnrealizedPnl    string    `json:"unrealized_pnl"`
 }
 
 // GetSwapAccountOfAllCurrencyResponse response data for GetSwapAccountOfAllCurrency
@@ -935,6 +951,7 @@ type GetSwapAccountOfAllCurrencyResponse struct {
 
 // GetSwapAccountOfAllCurrencyResponseInfo response data for GetSwapAccountOfAllCurrency
 type GetSwapAccountOfAllCurrencyResponseInfo struct {
+	Currency          string    `json:"currency"`
 	Equity            string    `json:"equity"`
 	FixedBalance      string    `json:"fixed_balance"`
 	TotalAvailBalance string    `json:"total_avail_balance"`
@@ -1246,8 +1263,10 @@ type GetSwapOnHoldAmountForOpenOrdersResponse struct {
 
 // GetSwapNextSettlementTimeResponse response data for GetSwapNextSettlementTime
 type GetSwapNextSettlementTimeResponse struct {
-	InstrumentID string `json:"instrument_id"`
-	FundingTime  string `json:"funding_time"`
+	InstrumentID  string  `json:"instrument_id"`
+	FundingTime   string  `json:"funding_time"`
+	FundingRate   float64 `json:"funding_rate,string"`
+	EstimatedRate float64 `json:"estimated_rate,string"`
 }
 
 // GetSwapMarkPriceResponse response data for GetSwapMarkPrice
@@ -599,9 +599,13 @@ func (o *OKGroup) WsProcessOrderBook(respRaw []byte) error {
 			}
 			err := o.WsProcessUpdateOrderbook(&response.Data[i], c, a)
 			if err != nil {
-				err2 := o.wsResubscribeToOrderbook(&response)
-				if err2 != nil {
-					o.Websocket.DataHandler <- err2
+				// Books which fail checksum verification are resynced by the
+				// websocket orderbook buffer
+				if !errors.Is(err, buffer.ErrOrderbookOutOfSync) {
+					err2 := o.wsResubscribeToOrderbook(&response)
+					if err2 != nil {
+						o.Websocket.DataHandler <- err2
+					}
 				}
 				return err
 			}
@@ -693,14 +697,15 @@ func (o *OKGroup) WsProcessPartialOrderBook(wsEventData *WebsocketOrderBook, ins
 	return o.Websocket.Orderbook.LoadSnapshot(&newOrderBook)
 }
 
-// WsProcessUpdateOrderbook updates an existing orderbook using websocket data
-// After merging WS data, it will sort, validate and finally update the existing
-// orderbook
+// WsProcessUpdateOrderbook updates an existing orderbook using websocket data.
+// The checksum of the updated book is verified by the websocket orderbook
+// buffer
 func (o *OKGroup) WsProcessUpdateOrderbook(wsEventData *WebsocketOrderBook, instrument currency.Pair, a asset.Item) error {
 	update := buffer.Update{
 		Asset:      a,
 		Pair:       instrument,
 		UpdateTime: wsEventData.Timestamp,
+		Checksum:   uint32(wsEventData.Checksum),
 	}
 
 	var err error
@@ -713,25 +718,38 @@ func (o *OKGroup) WsProcessUpdateOrderbook(wsEventData *WebsocketOrderBook, inst
 		return err
 	}
 
-	err = o.Websocket.Orderbook.Update(&update)
-	if err != nil {
-		return err
-	}
+	return o.Websocket.Orderbook.Update(&update)
+}
 
-	updatedOb, err := o.Websocket.Orderbook.GetOrderbook(instrument, a)
+// updateOBChecksum calculates the checksum of an updated book for the
+// websocket orderbook buffer to compare with the checksum sent by OKGroup
+func (o *OKGroup) updateOBChecksum(book *orderbook.Base) uint32 {
+	return uint32(o.CalculateUpdateOrderbookChecksum(book))
+}
+
+// wsResyncOB resubscribes to the depth channel of a book which has failed
+// checksum verification so that a new partial is sent
+func (o *OKGroup) wsResyncOB(p currency.Pair, a asset.Item) error {
+	var channel string
+	switch a {
+	case asset.Spot:
+		channel = okGroupWsSpotDepth
+	case asset.Futures:
+		channel = okGroupWsFuturesDepth
+	case asset.PerpetualSwap:
+		channel = okGroupWsSwapDepth
+	default:
+		return fmt.Errorf("%s %w %v", o.Name, asset.ErrNotSupported, a)
+	}
+	fPair, err := o.FormatExchangeCurrency(p, a)
 	if err != nil {
 		return err
 	}
-	checksum := o.CalculateUpdateOrderbookChecksum(updatedOb)
-
-	if checksum != wsEventData.Checksum {
-		// re-sub
-		log.Warnf(log.ExchangeSys, "%s checksum failure for item %s",
-			o.Name,
-			wsEventData.InstrumentID)
-		return errors.New("checksum failed")
-	}
-	return nil
+	return o.Websocket.ResubscribeToChannel(&stream.ChannelSubscription{
+		Channel:  channel,
+		Currency: fPair,
+		Asset:    a,
+	})
 }
 
 // CalculatePartialOrderbookChecksum alternates over the first 25 bid and ask
@@ -524,6 +524,11 @@ var stringsToOrderType = []struct {
 	{"trigger", Trigger, nil},
 	{"TRIGGER", Trigger, nil},
 	{"tRiGgEr", Trigger, nil},
+	{"take profit", TakeProfit, nil},
+	{"TAKE_PROFIT", TakeProfit, nil},
+	{"oco", OCO, nil},
+	{"one cancels other", OCO, nil},
+	{"bracket", Bracket, nil},
 	{"woahMan", UnknownType, errors.New("woahMan not recognised as order type")},
 }
 
@@ -282,6 +282,8 @@ const (
 	UnknownType       Type = "UNKNOWN"
 	Liquidation       Type = "LIQUIDATION"
 	Trigger           Type = "TRIGGER"
+	OCO               Type = "OCO"
+	Bracket           Type = "BRACKET"
 )
 
 // Side enforces a standard for order sides across the code base
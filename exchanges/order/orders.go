@@ -714,6 +714,9 @@ func StringToOrderType(oType string) (Type, error) {
 	case strings.EqualFold(oType, StopLimit.String()),
 		strings.EqualFold(oType, "EXCHANGE STOP LIMIT"):
 		return StopLimit, nil
+	case strings.EqualFold(oType, TakeProfit.String()),
+		strings.EqualFold(oType, "take_profit"):
+		return TakeProfit, nil
 	case strings.EqualFold(oType, TrailingStop.String()),
 		strings.EqualFold(oType, "trailing stop"),
 		strings.EqualFold(oType, "EXCHANGE TRAILING STOP"):
@@ -729,6 +732,11 @@ func StringToOrderType(oType string) (Type, error) {
 		return AnyType, nil
 	case strings.EqualFold(oType, Trigger.String()):
 		return Trigger, nil
+	case strings.EqualFold(oType, OCO.String()),
+		strings.EqualFold(oType, "one cancels other"):
+		return OCO, nil
+	case strings.EqualFold(oType, Bracket.String()):
+		return Bracket, nil
 	default:
 		return UnknownType, errors.New(oType + " not recognised as order type")
 	}
@@ -36,6 +36,12 @@ func newDepth(id uuid.UUID) *Depth {
 	}
 }
 
+// NewDepth returns a depth item which is not stored by the orderbook service,
+// used to rebuild books offline without alerting subscribers
+func NewDepth() *Depth {
+	return newDepth(uuid.Nil)
+}
+
 // Publish alerts any subscribed routines using a dispatch mux
 func (d *Depth) Publish() {
 	err := d.mux.Publish([]uuid.UUID{d.id}, d.Retrieve())
@@ -37,6 +37,16 @@ func TestGetLength(t *testing.T) {
 	}
 }
 
+func TestNewDepth(t *testing.T) {
+	d := NewDepth()
+	if d.id != uuid.Nil {
+		t.Errorf("expected id %v, but received %v", uuid.Nil, d.id)
+	}
+	if d.stack == nil {
+		t.Error("expected stack to be set")
+	}
+}
+
 func TestRetrieve(t *testing.T) {
 	d := newDepth(id)
 	d.asks.load([]Item{{Price: 1337}}, d.stack)
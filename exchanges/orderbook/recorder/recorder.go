@@ -0,0 +1,361 @@
+package recorder
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/file/archive"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	// ErrStopReading can be returned by the function passed to Read to stop
+	// reading records without error
+	ErrStopReading = errors.New("stop reading records")
+
+	errDirectoryUnset    = errors.New("recording directory unset")
+	errRecordIsNil       = errors.New("record is nil")
+	errBookIsNil         = errors.New("orderbook is nil")
+	errUpdateIsNil       = errors.New("orderbook update is nil")
+	errNotDirectory      = errors.New("recording path is not a directory")
+	errNoSnapshot        = errors.New("no snapshot recorded")
+	errUpdatesMissing    = errors.New("updates missing from recording")
+	errUnknownRecordType = errors.New("unknown record type")
+)
+
+// NewSnapshotRecord returns a record holding a copy of every level of the book
+func NewSnapshotRecord(book *orderbook.Base, at time.Time) (*Record, error) {
+	if book == nil {
+		return nil, errBookIsNil
+	}
+	return &Record{
+		Timestamp:        at,
+		Type:             Snapshot,
+		Exchange:         book.Exchange,
+		Asset:            book.Asset,
+		Pair:             book.Pair.Format(currency.DashDelimiter, true),
+		Bids:             append([]orderbook.Item(nil), book.Bids...),
+		Asks:             append([]orderbook.Item(nil), book.Asks...),
+		LastUpdated:      book.LastUpdated,
+		LastUpdateID:     book.LastUpdateID,
+		PriceDuplication: book.PriceDuplication,
+		IsFundingRate:    book.IsFundingRate,
+		IDAlignment:      book.IDAlignment,
+	}, nil
+}
+
+// NewDeltaRecord returns a record holding a copy of an update applied to a
+// book, updateByID denotes that the update matches entries by ID and action
+func NewDeltaRecord(exchange string, u *buffer.Update, updateByID bool, at time.Time) (*Record, error) {
+	if u == nil {
+		return nil, errUpdateIsNil
+	}
+	return &Record{
+		Timestamp:    at,
+		Type:         Delta,
+		Exchange:     exchange,
+		Asset:        u.Asset,
+		Pair:         u.Pair.Format(currency.DashDelimiter, true),
+		Bids:         append([]orderbook.Item(nil), u.Bids...),
+		Asks:         append([]orderbook.Item(nil), u.Asks...),
+		LastUpdated:  u.UpdateTime,
+		LastUpdateID: u.UpdateID,
+		Action:       u.Action,
+		UpdateByID:   updateByID,
+		MaxDepth:     u.MaxDepth,
+	}, nil
+}
+
+// NewGapRecord returns a record marking that updates applied to a book from
+// the supplied time were not recorded
+func NewGapRecord(exchange string, p currency.Pair, a asset.Item, at time.Time) *Record {
+	return &Record{
+		Timestamp: at,
+		Type:      Gap,
+		Exchange:  exchange,
+		Asset:     a,
+		Pair:      p.Format(currency.DashDelimiter, true),
+	}
+}
+
+// NewWriter returns a writer which writes records to FileName in dir. The file
+// is rotated once it reaches maxSize megabytes and the rotated file is
+// compressed
+func NewWriter(dir string, maxSize int64) (*Writer, error) {
+	if dir == "" {
+		return nil, errDirectoryUnset
+	}
+	if maxSize <= 0 {
+		maxSize = DefaultMaxFileSize
+	}
+	err := common.CreateDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	rotate := true
+	return &Writer{
+		rotate: &log.Rotate{
+			FileName:        FileName,
+			Rotate:          &rotate,
+			MaxSize:         maxSize,
+			Directory:       dir,
+			TimestampFormat: rotatedTimestampFormat,
+			OnRotate:        compress,
+		},
+	}, nil
+}
+
+// Write appends a record to the current file
+func (w *Writer) Write(r *Record) error {
+	if r == nil {
+		return errRecordIsNil
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = w.rotate.Write(append(data, '\n'))
+	return err
+}
+
+// Close closes the current file, it is left uncompressed and appended to by
+// the next writer using the same directory
+func (w *Writer) Close() error {
+	return w.rotate.Close()
+}
+
+// compress archives a rotated file and removes the original. The original is
+// kept on failure and can still be read
+func compress(rotated string) {
+	err := archive.Zip(rotated, rotated+ArchiveExtension)
+	if err != nil {
+		log.Errorf(log.OrderBook, "Orderbook recorder unable to compress %s: %v", rotated, err)
+		return
+	}
+	err = os.Remove(rotated)
+	if err != nil {
+		log.Errorf(log.OrderBook, "Orderbook recorder unable to remove %s: %v", rotated, err)
+	}
+}
+
+// NewReader returns a reader for the records written to dir
+func NewReader(dir string) (*Reader, error) {
+	if dir == "" {
+		return nil, errDirectoryUnset
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s %w", dir, errNotDirectory)
+	}
+	return &Reader{dir: dir}, nil
+}
+
+// Files returns the recorded files in the order they were written, rotated
+// files are named by time of rotation and precede the current file
+func (r *Reader) Files() ([]string, error) {
+	infos, err := ioutil.ReadDir(r.dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for i := range infos {
+		name := infos[i].Name()
+		if infos[i].IsDir() ||
+			!(strings.HasSuffix(name, FileName) || strings.HasSuffix(name, FileName+ArchiveExtension)) {
+			continue
+		}
+		if name != FileName &&
+			!strings.HasSuffix(name, ArchiveExtension) &&
+			file.Exists(filepath.Join(r.dir, name+ArchiveExtension)) {
+			// Compressed, removal of the original failed
+			continue
+		}
+		files = append(files, filepath.Join(r.dir, name))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// Read calls fn with every record in the order they were written. Reading
+// stops at the first error returned by fn
+func (r *Reader) Read(fn func(*Record) error) error {
+	files, err := r.Files()
+	if err != nil {
+		return err
+	}
+	for i := range files {
+		if strings.HasSuffix(files[i], ArchiveExtension) {
+			err = readArchive(files[i], fn)
+		} else {
+			err = readFile(files[i], fn)
+		}
+		if err != nil {
+			if errors.Is(err, ErrStopReading) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// BookAt rebuilds the book of an exchange pair and asset as it was at the
+// supplied time from the last snapshot recorded before it and the updates
+// which followed. A book with updates missing between that snapshot and the
+// supplied time cannot be rebuilt
+func (r *Reader) BookAt(exchange string, p currency.Pair, a asset.Item, at time.Time) (*orderbook.Base, error) {
+	var depth *orderbook.Depth
+	var gapAt time.Time
+	err := r.Read(func(rec *Record) error {
+		if rec.Asset != a || !rec.Pair.Equal(p) || !strings.EqualFold(rec.Exchange, exchange) {
+			return nil
+		}
+		if rec.Timestamp.After(at) {
+			return ErrStopReading
+		}
+		switch rec.Type {
+		case Snapshot:
+			if depth == nil {
+				depth = orderbook.NewDepth()
+			}
+			depth.AssignOptions(&orderbook.Base{
+				Exchange:         rec.Exchange,
+				Pair:             rec.Pair,
+				Asset:            rec.Asset,
+				PriceDuplication: rec.PriceDuplication,
+				IsFundingRate:    rec.IsFundingRate,
+				IDAlignment:      rec.IDAlignment,
+			})
+			depth.LoadSnapshot(rec.Bids, rec.Asks, rec.LastUpdateID, rec.LastUpdated, false)
+		case Delta:
+			if depth == nil {
+				// Updates recorded before the first snapshot or after a gap
+				// cannot be applied
+				return nil
+			}
+			err := buffer.ApplyUpdate(depth, &buffer.Update{
+				UpdateID:   rec.LastUpdateID,
+				UpdateTime: rec.LastUpdated,
+				Asset:      rec.Asset,
+				Action:     rec.Action,
+				Bids:       rec.Bids,
+				Asks:       rec.Asks,
+				Pair:       rec.Pair,
+				MaxDepth:   rec.MaxDepth,
+			}, rec.UpdateByID)
+			if err != nil {
+				return fmt.Errorf("%s %s %s cannot apply update recorded at %s: %w",
+					exchange,
+					p,
+					a,
+					rec.Timestamp,
+					err)
+			}
+		case Gap:
+			// The book is invalid until it is replaced by the next snapshot
+			depth = nil
+			gapAt = rec.Timestamp
+		default:
+			return fmt.Errorf("%w %s", errUnknownRecordType, rec.Type)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if depth == nil && !gapAt.IsZero() {
+		return nil, fmt.Errorf("%s %s %s %w from %s with no snapshot recorded before %s",
+			exchange,
+			p,
+			a,
+			errUpdatesMissing,
+			gapAt,
+			at)
+	}
+	if depth == nil {
+		return nil, fmt.Errorf("%s %s %s %w at or before %s",
+			exchange,
+			p,
+			a,
+			errNoSnapshot,
+			at)
+	}
+	return depth.Retrieve(), nil
+}
+
+// readArchive reads the records of each file in a compressed archive
+func readArchive(path string, fn func(*Record) error) error {
+	z, err := zip.OpenReader(path)
+	if err != nil {
+		return err
+	}
+	for i := range z.File {
+		var rc io.ReadCloser
+		rc, err = z.File[i].Open()
+		if err != nil {
+			break
+		}
+		err = decode(rc, fn)
+		if errCls := rc.Close(); errCls != nil {
+			log.Errorf(log.OrderBook, archive.ErrUnableToCloseFile, path, errCls)
+		}
+		if err != nil {
+			break
+		}
+	}
+	if errCls := z.Close(); errCls != nil {
+		log.Errorf(log.OrderBook, archive.ErrUnableToCloseFile, path, errCls)
+	}
+	return err
+}
+
+// readFile reads the records of an uncompressed file
+func readFile(path string, fn func(*Record) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	err = decode(f, fn)
+	if errCls := f.Close(); errCls != nil {
+		log.Errorf(log.OrderBook, archive.ErrUnableToCloseFile, path, errCls)
+	}
+	return err
+}
+
+// decode calls fn with each record in r. A truncated final record, left by
+// an unclean shutdown, is ignored
+func decode(r io.Reader, fn func(*Record) error) error {
+	dec := json.NewDecoder(r)
+	for {
+		var rec Record
+		err := dec.Decode(&rec)
+		if err != nil {
+			if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return err
+		}
+		err = fn(&rec)
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,436 @@
+package recorder
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
+)
+
+const testExchange = "test"
+
+var pair = currency.NewPair(currency.BTC, currency.USDT)
+
+var testDir string
+
+func TestMain(m *testing.M) {
+	var err error
+	testDir, err = ioutil.TempDir("", "gct-recorder")
+	if err != nil {
+		fmt.Printf("failed to create temp dir: %v", err)
+		os.Exit(1)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testDir)
+	if err != nil {
+		fmt.Printf("failed to remove temp dir: %v", err)
+	}
+	os.Exit(t)
+}
+
+func tempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir(testDir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestNewSnapshotRecord(t *testing.T) {
+	t.Parallel()
+	_, err := NewSnapshotRecord(nil, time.Now())
+	if !errors.Is(err, errBookIsNil) {
+		t.Fatalf("received '%v', expected '%v'", err, errBookIsNil)
+	}
+	book := &orderbook.Base{
+		Exchange:     testExchange,
+		Pair:         currency.NewPair(currency.BTC, currency.USDT).Lower(),
+		Asset:        asset.Spot,
+		Bids:         orderbook.Items{{Price: 100, Amount: 1}},
+		LastUpdateID: 1337,
+	}
+	r, err := NewSnapshotRecord(book, time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if r.Type != Snapshot || r.LastUpdateID != 1337 || r.Pair.String() != "BTC-USDT" {
+		t.Fatalf("unexpected record %+v", r)
+	}
+	book.Bids[0].Amount = 2
+	if r.Bids[0].Amount != 1 {
+		t.Fatal("record bids should be copied from the book")
+	}
+}
+
+func TestNewDeltaRecord(t *testing.T) {
+	t.Parallel()
+	_, err := NewDeltaRecord(testExchange, nil, false, time.Now())
+	if !errors.Is(err, errUpdateIsNil) {
+		t.Fatalf("received '%v', expected '%v'", err, errUpdateIsNil)
+	}
+	r, err := NewDeltaRecord(testExchange, &buffer.Update{
+		Pair:   pair,
+		Asset:  asset.Spot,
+		Action: buffer.Delete,
+		Asks:   orderbook.Items{{ID: 1}},
+	}, true, time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if r.Type != Delta || !r.UpdateByID || r.Action != buffer.Delete || len(r.Asks) != 1 {
+		t.Fatalf("unexpected record %+v", r)
+	}
+}
+
+func TestNewWriter(t *testing.T) {
+	t.Parallel()
+	_, err := NewWriter("", 0)
+	if !errors.Is(err, errDirectoryUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errDirectoryUnset)
+	}
+	dir := filepath.Join(tempDir(t), "nested")
+	w, err := NewWriter(dir, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if w.rotate.MaxSize != DefaultMaxFileSize {
+		t.Fatalf("received '%v', expected '%v'", w.rotate.MaxSize, DefaultMaxFileSize)
+	}
+	err = w.Write(nil)
+	if !errors.Is(err, errRecordIsNil) {
+		t.Fatalf("received '%v', expected '%v'", err, errRecordIsNil)
+	}
+}
+
+func TestWriterRotation(t *testing.T) {
+	t.Parallel()
+	dir := tempDir(t)
+	w, err := NewWriter(dir, 1)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	bids := make(orderbook.Items, 2000)
+	for i := range bids {
+		bids[i] = orderbook.Item{Price: float64(10000 - i), Amount: 1.23456789}
+	}
+	start := time.Now()
+	const records = 20
+	for i := 0; i < records; i++ {
+		err = w.Write(&Record{
+			Timestamp:    start.Add(time.Duration(i) * time.Second),
+			Type:         Snapshot,
+			Exchange:     testExchange,
+			Asset:        asset.Spot,
+			Pair:         pair,
+			Bids:         bids,
+			LastUpdateID: int64(i),
+		})
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v', expected '%v'", err, nil)
+		}
+	}
+	err = w.Close()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	r, err := NewReader(dir)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	files, err := r.Files()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(files) < 2 {
+		t.Fatalf("expected rotated files, received %v", files)
+	}
+	for i := range files[:len(files)-1] {
+		if !strings.HasSuffix(files[i], FileName+ArchiveExtension) {
+			t.Fatalf("expected rotated file to be compressed, received %s", files[i])
+		}
+	}
+	if filepath.Base(files[len(files)-1]) != FileName {
+		t.Fatalf("received '%v', expected '%v'", filepath.Base(files[len(files)-1]), FileName)
+	}
+
+	var next int64
+	err = r.Read(func(rec *Record) error {
+		if rec.LastUpdateID != next {
+			t.Errorf("received '%v', expected '%v'", rec.LastUpdateID, next)
+		}
+		next++
+		return nil
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if next != records {
+		t.Fatalf("received '%v', expected '%v'", next, records)
+	}
+
+	next = 0
+	err = r.Read(func(rec *Record) error {
+		next++
+		return ErrStopReading
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if next != 1 {
+		t.Fatalf("received '%v', expected '%v'", next, 1)
+	}
+}
+
+func TestNewReader(t *testing.T) {
+	t.Parallel()
+	_, err := NewReader("")
+	if !errors.Is(err, errDirectoryUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errDirectoryUnset)
+	}
+	dir := tempDir(t)
+	_, err = NewReader(filepath.Join(dir, "missing"))
+	if !os.IsNotExist(err) {
+		t.Fatalf("received '%v', expected '%v'", err, os.ErrNotExist)
+	}
+	f := filepath.Join(dir, FileName)
+	err = ioutil.WriteFile(f, nil, 0660)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewReader(f)
+	if !errors.Is(err, errNotDirectory) {
+		t.Fatalf("received '%v', expected '%v'", err, errNotDirectory)
+	}
+}
+
+func TestBookAt(t *testing.T) {
+	t.Parallel()
+	dir := tempDir(t)
+	w, err := NewWriter(dir, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	write := func(r *Record, err error) {
+		t.Helper()
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v', expected '%v'", err, nil)
+		}
+		if err = w.Write(r); !errors.Is(err, nil) {
+			t.Fatalf("received '%v', expected '%v'", err, nil)
+		}
+	}
+	update := func(at int, u *buffer.Update, updateByID bool) {
+		t.Helper()
+		u.Pair, u.Asset = pair, asset.Spot
+		write(NewDeltaRecord(testExchange, u, updateByID, start.Add(time.Duration(at)*time.Second)))
+	}
+
+	// Updates recorded before the first snapshot are ignored
+	update(0, &buffer.Update{Bids: orderbook.Items{{Price: 1, Amount: 1}}}, false)
+	write(NewSnapshotRecord(&orderbook.Base{
+		Exchange:     testExchange,
+		Pair:         pair,
+		Asset:        asset.Spot,
+		Bids:         orderbook.Items{{Price: 100, Amount: 1, ID: 1}, {Price: 99, Amount: 1, ID: 2}},
+		Asks:         orderbook.Items{{Price: 101, Amount: 1, ID: 3}},
+		LastUpdateID: 10,
+	}, start.Add(time.Second)))
+	update(2, &buffer.Update{Bids: orderbook.Items{{Price: 100, Amount: 0}}, UpdateID: 11}, false)
+	update(3, &buffer.Update{Action: buffer.Delete, Asks: orderbook.Items{{ID: 3}}, UpdateID: 12}, true)
+	// Records of other books are skipped
+	write(NewSnapshotRecord(&orderbook.Base{
+		Exchange: testExchange,
+		Pair:     pair,
+		Asset:    asset.Futures,
+	}, start.Add(4*time.Second)))
+	// A flushed book is recorded as an empty snapshot
+	write(NewSnapshotRecord(&orderbook.Base{
+		Exchange: testExchange,
+		Pair:     pair,
+		Asset:    asset.Spot,
+	}, start.Add(5*time.Second)))
+	if err = w.Close(); !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	r, err := NewReader(dir)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	_, err = r.BookAt(testExchange, pair, asset.Spot, start)
+	if !errors.Is(err, errNoSnapshot) {
+		t.Fatalf("received '%v', expected '%v'", err, errNoSnapshot)
+	}
+
+	book, err := r.BookAt(testExchange, pair, asset.Spot, start.Add(time.Second))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(book.Bids) != 2 || len(book.Asks) != 1 || book.LastUpdateID != 10 {
+		t.Fatalf("unexpected snapshot %+v", book)
+	}
+
+	book, err = r.BookAt(strings.ToUpper(testExchange), pair.Lower(), asset.Spot, start.Add(3*time.Second))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(book.Bids) != 1 || book.Bids[0].Price != 99 || len(book.Asks) != 0 || book.LastUpdateID != 12 {
+		t.Fatalf("unexpected book %+v", book)
+	}
+
+	book, err = r.BookAt(testExchange, pair, asset.Spot, start.Add(time.Minute))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(book.Bids) != 0 || len(book.Asks) != 0 {
+		t.Fatalf("unexpected flushed book %+v", book)
+	}
+
+	_, err = r.BookAt(testExchange, pair, asset.Margin, start.Add(time.Minute))
+	if !errors.Is(err, errNoSnapshot) {
+		t.Fatalf("received '%v', expected '%v'", err, errNoSnapshot)
+	}
+}
+
+func TestBookAtGap(t *testing.T) {
+	t.Parallel()
+	dir := tempDir(t)
+	w, err := NewWriter(dir, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	write := func(r *Record, err error) {
+		t.Helper()
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v', expected '%v'", err, nil)
+		}
+		if err = w.Write(r); !errors.Is(err, nil) {
+			t.Fatalf("received '%v', expected '%v'", err, nil)
+		}
+	}
+	write(NewSnapshotRecord(&orderbook.Base{
+		Exchange: testExchange,
+		Pair:     pair,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 100, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 101, Amount: 1}},
+	}, start))
+	// The update at 2 seconds was dropped, the update which followed cannot
+	// be applied without it
+	write(NewGapRecord(testExchange, pair, asset.Spot, start.Add(2*time.Second)), nil)
+	write(NewDeltaRecord(testExchange, &buffer.Update{
+		Pair:  pair,
+		Asset: asset.Spot,
+		Bids:  orderbook.Items{{Price: 99, Amount: 1}},
+	}, false, start.Add(3*time.Second)))
+	write(NewSnapshotRecord(&orderbook.Base{
+		Exchange: testExchange,
+		Pair:     pair,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 98, Amount: 1}},
+	}, start.Add(4*time.Second)))
+	if err = w.Close(); !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	r, err := NewReader(dir)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	book, err := r.BookAt(testExchange, pair, asset.Spot, start.Add(time.Second))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(book.Bids) != 1 || book.Bids[0].Price != 100 {
+		t.Fatalf("unexpected book before gap %+v", book)
+	}
+	for _, at := range []time.Duration{2 * time.Second, 3 * time.Second} {
+		_, err = r.BookAt(testExchange, pair, asset.Spot, start.Add(at))
+		if !errors.Is(err, errUpdatesMissing) {
+			t.Fatalf("received '%v', expected '%v'", err, errUpdatesMissing)
+		}
+	}
+	book, err = r.BookAt(testExchange, pair, asset.Spot, start.Add(4*time.Second))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(book.Bids) != 1 || book.Bids[0].Price != 98 || len(book.Asks) != 0 {
+		t.Fatalf("unexpected book after gap %+v", book)
+	}
+}
+
+func TestBookAtErrors(t *testing.T) {
+	t.Parallel()
+	dir := tempDir(t)
+	w, err := NewWriter(dir, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	at := time.Now()
+	err = w.Write(&Record{Timestamp: at, Type: Snapshot, Exchange: testExchange, Asset: asset.Spot, Pair: pair})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	err = w.Write(&Record{Timestamp: at, Type: Delta, Exchange: testExchange, Asset: asset.Spot, Pair: pair, UpdateByID: true, Action: buffer.Delete, Bids: orderbook.Items{{ID: 1}}})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if err = w.Close(); !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	r, err := NewReader(dir)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	_, err = r.BookAt(testExchange, pair, asset.Spot, at)
+	if err == nil {
+		t.Fatal("expected error applying delete of missing ID")
+	}
+
+	// Unknown record types and a truncated final record from an unclean
+	// shutdown
+	f, err := os.OpenFile(filepath.Join(dir, FileName), os.O_APPEND|os.O_WRONLY, 0660)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.WriteString(`{"timestamp":"2021-01-01T00:00:00Z","type":"delta","exch`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var count int
+	err = r.Read(func(*Record) error {
+		count++
+		return nil
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if count != 2 {
+		t.Fatalf("received '%v', expected '%v'", count, 2)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, FileName), []byte(`{"timestamp":"2021-01-01T00:00:00Z","type":"level3","exchange":"test","asset":"spot","pair":"BTC-USDT"}`+"\n"), 0660)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.BookAt(testExchange, pair, asset.Spot, at)
+	if !errors.Is(err, errUnknownRecordType) {
+		t.Fatalf("received '%v', expected '%v'", err, errUnknownRecordType)
+	}
+}
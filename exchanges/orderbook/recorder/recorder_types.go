@@ -0,0 +1,76 @@
+package recorder
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	// FileName is the name of the file records are written to, rotated files
+	// are prefixed with the time of rotation
+	FileName = "orderbook.jsonl"
+	// ArchiveExtension is appended to rotated files once compressed
+	ArchiveExtension = ".zip"
+	// DefaultMaxFileSize is the size in megabytes a file is rotated at
+	DefaultMaxFileSize = 100
+
+	// rotatedTimestampFormat has a fixed width so that rotated files sort in
+	// the order they were written
+	rotatedTimestampFormat = "2006-01-02T15-04-05.000000000"
+)
+
+// RecordType defines whether a record holds a full book or an update
+type RecordType string
+
+const (
+	// Snapshot records hold every level of a book
+	Snapshot RecordType = "snapshot"
+	// Delta records hold an update applied to a book
+	Delta RecordType = "delta"
+	// Gap records mark that updates applied to a book were not recorded, the
+	// book cannot be rebuilt from its records until the next snapshot
+	Gap RecordType = "gap"
+)
+
+// Record defines a snapshot or update captured from a book
+type Record struct {
+	// Timestamp is the local time the book was captured or the update was
+	// applied, books are rebuilt against this time
+	Timestamp time.Time        `json:"timestamp"`
+	Type      RecordType       `json:"type"`
+	Exchange  string           `json:"exchange"`
+	Asset     asset.Item       `json:"asset"`
+	Pair      currency.Pair    `json:"pair"`
+	Bids      []orderbook.Item `json:"bids,omitempty"`
+	Asks      []orderbook.Item `json:"asks,omitempty"`
+	// LastUpdated and LastUpdateID are the exchange supplied update time and
+	// sequence ID
+	LastUpdated  time.Time `json:"lastUpdated,omitempty"`
+	LastUpdateID int64     `json:"lastUpdateID,omitempty"`
+
+	// Snapshot options
+	PriceDuplication bool `json:"priceDuplication,omitempty"`
+	IsFundingRate    bool `json:"isFundingRate,omitempty"`
+	IDAlignment      bool `json:"idAlignment,omitempty"`
+
+	// Delta options
+	Action     buffer.Action `json:"action,omitempty"`
+	UpdateByID bool          `json:"updateByID,omitempty"`
+	MaxDepth   int           `json:"maxDepth,omitempty"`
+}
+
+// Writer writes records to a rotating file, files are compressed once they
+// have been rotated out
+type Writer struct {
+	rotate *log.Rotate
+}
+
+// Reader reads the records written by a Writer in the order they were written
+type Reader struct {
+	dir string
+}
@@ -0,0 +1,225 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	// ErrBudgetsUnsupported defines an error when the limiter of a requester
+	// does not track weighted budgets
+	ErrBudgetsUnsupported = errors.New("rate limiter does not support weighted budgets")
+
+	errBudgetStoreIsNil = errors.New("budget store is nil")
+)
+
+// NewBudget returns a weighted budget which allows limit units of weight to be
+// used within each fixed interval window
+func NewBudget(name string, interval time.Duration, limit int) *Budget {
+	return &Budget{
+		name:     name,
+		interval: interval,
+		limit:    limit,
+	}
+}
+
+// Wait reserves weight from the budget, sleeping until the weight is available
+// in the current window. An error is returned if the wait would exceed the
+// context deadline
+func (b *Budget) Wait(ctx context.Context, weight int) error {
+	if b.limit <= 0 || b.interval <= 0 {
+		return nil
+	}
+	for {
+		var delay time.Duration
+		now := time.Now()
+		err := b.update(func(s *BudgetState) {
+			delay = b.reserve(s, now, weight)
+		})
+		if err != nil {
+			return err
+		}
+		if delay <= 0 {
+			return nil
+		}
+		if dl, ok := ctx.Deadline(); ok && dl.Before(now.Add(delay)) {
+			return fmt.Errorf("%s rate limit delay of %s will exceed deadline: %w",
+				b.name,
+				delay,
+				context.DeadlineExceeded)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// HandleResponse corrects the budget from the exchange response. usedHeader
+// is the response header holding the weight used in the current window which
+// is adopted when it exceeds the locally tracked usage. A too many requests
+// or IP ban response pauses the budget for the Retry-After period or until the
+// end of the current window
+func (b *Budget) HandleResponse(resp *http.Response, usedHeader string) error {
+	if resp == nil {
+		return nil
+	}
+	now := time.Now()
+	return b.update(func(s *BudgetState) {
+		b.roll(s, now)
+		if usedHeader != "" {
+			used, err := strconv.Atoi(resp.Header.Get(usedHeader))
+			if err == nil && used > s.Used {
+				s.Used = used
+			}
+		}
+		// Some exchanges respond with 418 once an IP is banned for continuing
+		// to send requests after a 429
+		if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusTeapot {
+			return
+		}
+		until := s.WindowStart.Add(b.interval)
+		if after := RetryAfter(resp, now); after > 0 {
+			until = now.Add(after)
+		}
+		if until.After(s.PausedUntil) {
+			s.PausedUntil = until
+		}
+	})
+}
+
+// Pause stops weight being reserved from the budget until the supplied time
+func (b *Budget) Pause(until time.Time) error {
+	return b.update(func(s *BudgetState) {
+		if until.After(s.PausedUntil) {
+			s.PausedUntil = until
+		}
+	})
+}
+
+// SetStore shares the budget through the store, a nil store returns the budget
+// to being tracked in process only
+func (b *Budget) SetStore(store BudgetStore) {
+	b.mtx.Lock()
+	b.store = store
+	b.mtx.Unlock()
+}
+
+// Status returns the budget usage as last observed by this process
+func (b *Budget) Status() BudgetStatus {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	s := b.state
+	b.roll(&s, time.Now())
+	status := BudgetStatus{
+		Name:     b.name,
+		Limit:    b.limit,
+		Used:     s.Used,
+		Interval: b.interval,
+		Shared:   b.store != nil,
+	}
+	if !s.WindowStart.IsZero() {
+		status.ResetsAt = s.WindowStart.Add(b.interval)
+	}
+	if s.PausedUntil.After(time.Now()) {
+		status.PausedUntil = s.PausedUntil
+	}
+	return status
+}
+
+// update applies fn to the budget state, through the store when the budget is
+// shared so that the state is coordinated between processes
+func (b *Budget) update(fn func(*BudgetState)) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if b.store == nil {
+		fn(&b.state)
+		return nil
+	}
+	return b.store.Update(b.name, func(s *BudgetState) {
+		fn(s)
+		b.state = *s
+	})
+}
+
+// reserve adds weight to the budget and returns zero, or returns the delay
+// until the weight can be reserved
+func (b *Budget) reserve(s *BudgetState, now time.Time, weight int) time.Duration {
+	b.roll(s, now)
+	if s.PausedUntil.After(now) {
+		return s.PausedUntil.Sub(now)
+	}
+	// A weight larger than the limit is allowed through on an unused window
+	// as it would otherwise never be sent
+	if s.Used > 0 && s.Used+weight > b.limit {
+		return s.WindowStart.Add(b.interval).Sub(now)
+	}
+	s.Used += weight
+	return 0
+}
+
+// roll starts a new window when the current window has elapsed
+func (b *Budget) roll(s *BudgetState, now time.Time) {
+	if b.interval <= 0 || now.Before(s.WindowStart.Add(b.interval)) {
+		return
+	}
+	s.WindowStart = now.Truncate(b.interval)
+	s.Used = 0
+}
+
+// GetBudgetStatus returns the usage of the weighted budgets tracked by the
+// limiter
+func (r *Requester) GetBudgetStatus() ([]BudgetStatus, error) {
+	if r == nil {
+		return nil, errRequestSystemIsNil
+	}
+	l, ok := r.limiter.(BudgetLimiter)
+	if !ok {
+		return nil, fmt.Errorf("%s %w", r.Name, ErrBudgetsUnsupported)
+	}
+	budgets := l.Budgets()
+	status := make([]BudgetStatus, len(budgets))
+	for i := range budgets {
+		status[i] = budgets[i].Status()
+	}
+	return status, nil
+}
+
+// SetBudgetStore shares the weighted budgets tracked by the limiter through
+// the store
+func (r *Requester) SetBudgetStore(store BudgetStore) error {
+	if r == nil {
+		return errRequestSystemIsNil
+	}
+	if store == nil {
+		return errBudgetStoreIsNil
+	}
+	l, ok := r.limiter.(BudgetLimiter)
+	if !ok {
+		return fmt.Errorf("%s %w", r.Name, ErrBudgetsUnsupported)
+	}
+	budgets := l.Budgets()
+	for i := range budgets {
+		budgets[i].SetStore(store)
+	}
+	return nil
+}
+
+// handleResponse passes the response to the limiter when it adjusts itself
+// from exchange responses
+func (r *Requester) handleResponse(ep EndpointLimit, resp *http.Response) {
+	if h, ok := r.limiter.(ResponseHandler); ok {
+		if err := h.HandleResponse(ep, resp); err != nil {
+			log.Errorf(log.RequestSys, "%s failed to update rate limiter from response: %v", r.Name, err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	budgetStoreLockTimeout    = 5 * time.Second
+	budgetStoreLockRetryDelay = 5 * time.Millisecond
+)
+
+var (
+	errBudgetStoreDirUnset    = errors.New("budget store directory unset")
+	errBudgetNameUnset        = errors.New("budget name unset")
+	errBudgetStoreLockTimeout = errors.New("timed out acquiring budget store lock")
+)
+
+// FileBudgetStore shares budget state between GoCryptoTrader processes on the
+// same host through a directory of JSON files, guarded by OS advisory locks on
+// lock files which are released when the holding process exits
+type FileBudgetStore struct {
+	dir string
+}
+
+// NewFileBudgetStore returns a budget store which keeps budget state within
+// the supplied directory, creating it if required
+func NewFileBudgetStore(dir string) (*FileBudgetStore, error) {
+	if dir == "" {
+		return nil, errBudgetStoreDirUnset
+	}
+	err := os.MkdirAll(dir, 0770)
+	if err != nil {
+		return nil, err
+	}
+	return &FileBudgetStore{dir: dir}, nil
+}
+
+// Update applies fn to the stored state of the named budget while holding the
+// lock on its lock file
+func (f *FileBudgetStore) Update(name string, fn func(*BudgetState)) error {
+	if name == "" {
+		return errBudgetNameUnset
+	}
+	unlock, err := f.lock(name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	path := filepath.Join(f.dir, name+".json")
+	var s BudgetState
+	contents, err := ioutil.ReadFile(path)
+	switch {
+	case err == nil:
+		err = json.Unmarshal(contents, &s)
+		if err != nil {
+			return fmt.Errorf("cannot read budget %s: %w", name, err)
+		}
+	case !os.IsNotExist(err):
+		return err
+	}
+
+	fn(&s)
+
+	contents, err = json.Marshal(&s)
+	if err != nil {
+		return err
+	}
+	// Write then rename so readers never observe a partially written file
+	tmp := path + ".tmp"
+	err = ioutil.WriteFile(tmp, contents, 0660)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// lock takes the lock on the lock file of the named budget, waiting for
+// another holder to release it. The lock file is never removed so that every
+// process locks the same file
+func (f *FileBudgetStore) lock(name string) (func(), error) {
+	file, err := os.OpenFile(filepath.Join(f.dir, name+".lock"), os.O_CREATE|os.O_RDWR, 0660)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(budgetStoreLockTimeout)
+	for {
+		locked, err := tryLockFile(file)
+		if err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+		if locked {
+			return func() {
+				_ = unlockFile(file)
+				_ = file.Close()
+			}, nil
+		}
+		if time.Now().After(deadline) {
+			_ = file.Close()
+			return nil, fmt.Errorf("%s: %w", name, errBudgetStoreLockTimeout)
+		}
+		time.Sleep(budgetStoreLockRetryDelay)
+	}
+}
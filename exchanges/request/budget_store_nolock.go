@@ -0,0 +1,21 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!windows
+
+package request
+
+import (
+	"errors"
+	"os"
+)
+
+var errBudgetStoreLockUnsupported = errors.New("budget store file locking unsupported on this platform")
+
+// tryLockFile is unsupported on platforms without advisory file locks
+func tryLockFile(*os.File) (bool, error) {
+	return false, errBudgetStoreLockUnsupported
+}
+
+// unlockFile is unsupported on platforms without advisory file locks
+func unlockFile(*os.File) error {
+	return errBudgetStoreLockUnsupported
+}
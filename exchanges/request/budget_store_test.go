@@ -0,0 +1,119 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewFileBudgetStore(t *testing.T) {
+	t.Parallel()
+	_, err := NewFileBudgetStore("")
+	if !errors.Is(err, errBudgetStoreDirUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errBudgetStoreDirUnset)
+	}
+	dir, err := ioutil.TempDir("", "budgetstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	_, err = NewFileBudgetStore(filepath.Join(dir, "nested"))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestFileBudgetStoreUpdate(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "budgetstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := NewFileBudgetStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.Update("", func(*BudgetState) {})
+	if !errors.Is(err, errBudgetNameUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errBudgetNameUnset)
+	}
+
+	// Budgets of the same name in separate processes share their usage
+	b1 := NewBudget("test", time.Hour, 10)
+	b1.SetStore(store)
+	b2 := NewBudget("test", time.Hour, 10)
+	b2.SetStore(store)
+	err = b1.Wait(context.Background(), 6)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	err = b2.Wait(ctx, 6)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("received '%v', expected '%v'", err, context.DeadlineExceeded)
+	}
+	if status := b2.Status(); status.Used != 6 || !status.Shared {
+		t.Fatalf("unexpected status %+v", status)
+	}
+
+	// The lock file is kept once released and does not block later updates
+	if _, err = os.Stat(filepath.Join(dir, "test.lock")); err != nil {
+		t.Fatal(err)
+	}
+	err = b2.Wait(context.Background(), 4)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, "test.json"), []byte("{"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b1.Wait(context.Background(), 1)
+	if err == nil {
+		t.Fatal("expected error reading corrupt budget")
+	}
+}
+
+func TestFileBudgetStoreLockHeld(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "budgetstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := NewFileBudgetStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A slow holder keeps the lock however long it is held for
+	unlock, err := store.lock("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated := make(chan error, 1)
+	go func() {
+		updated <- store.Update("test", func(*BudgetState) {})
+	}()
+	select {
+	case err = <-updated:
+		t.Fatalf("update should wait for the lock to be released, received '%v'", err)
+	case <-time.After(budgetStoreLockRetryDelay * 20):
+	}
+	unlock()
+	select {
+	case err = <-updated:
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v', expected '%v'", err, nil)
+		}
+	case <-time.After(budgetStoreLockTimeout):
+		t.Fatal("update should proceed once the lock is released")
+	}
+}
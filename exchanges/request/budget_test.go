@@ -0,0 +1,203 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// testBudgetLimiter weights every request as one against a single budget
+type testBudgetLimiter struct {
+	budget *Budget
+}
+
+func (l *testBudgetLimiter) Limit(ctx context.Context, _ EndpointLimit) error {
+	return l.budget.Wait(ctx, 1)
+}
+
+func (l *testBudgetLimiter) HandleResponse(_ EndpointLimit, resp *http.Response) error {
+	return l.budget.HandleResponse(resp, "X-Used-Weight")
+}
+
+func (l *testBudgetLimiter) Budgets() []*Budget {
+	return []*Budget{l.budget}
+}
+
+func TestBudgetWait(t *testing.T) {
+	t.Parallel()
+	b := NewBudget("test", time.Hour, 10)
+	ctx := context.Background()
+	err := b.Wait(ctx, 6)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	dlCtx, cancel := context.WithTimeout(ctx, time.Millisecond)
+	defer cancel()
+	err = b.Wait(dlCtx, 6)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("received '%v', expected '%v'", err, context.DeadlineExceeded)
+	}
+
+	status := b.Status()
+	if status.Name != "test" || status.Used != 6 || status.Limit != 10 || status.Shared {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	if !status.ResetsAt.Equal(time.Now().Truncate(time.Hour).Add(time.Hour)) {
+		t.Fatalf("unexpected reset time %v", status.ResetsAt)
+	}
+
+	// Weight exceeding the limit is allowed on an unused window
+	b = NewBudget("test", time.Hour, 10)
+	err = b.Wait(ctx, 50)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	// A new window releases the budget
+	b = NewBudget("test", time.Millisecond*100, 1)
+	err = b.Wait(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.Wait(ctx, 1)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if b.Status().Used != 1 {
+		t.Fatalf("received '%v', expected '%v'", b.Status().Used, 1)
+	}
+}
+
+func TestBudgetHandleResponse(t *testing.T) {
+	t.Parallel()
+	b := NewBudget("test", time.Hour, 100)
+	err := b.HandleResponse(nil, "X-Used-Weight")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	err = b.Wait(context.Background(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	resp.Header.Set("X-Used-Weight", "40")
+	err = b.HandleResponse(resp, "X-Used-Weight")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Status().Used != 40 {
+		t.Fatalf("received '%v', expected '%v'", b.Status().Used, 40)
+	}
+	// Lower usage reported by the exchange does not release reserved weight
+	resp.Header.Set("X-Used-Weight", "20")
+	err = b.HandleResponse(resp, "X-Used-Weight")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Status().Used != 40 {
+		t.Fatalf("received '%v', expected '%v'", b.Status().Used, 40)
+	}
+
+	resp.StatusCode = http.StatusTooManyRequests
+	resp.Header.Set("Retry-After", "30")
+	err = b.HandleResponse(resp, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	status := b.Status()
+	if until := time.Until(status.PausedUntil); until < time.Second*29 || until > time.Second*30 {
+		t.Fatalf("unexpected pause %v", status.PausedUntil)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	err = b.Wait(ctx, 1)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("received '%v', expected '%v'", err, context.DeadlineExceeded)
+	}
+
+	// A ban without Retry-After pauses until the end of the window
+	b = NewBudget("test", time.Hour, 100)
+	err = b.HandleResponse(&http.Response{StatusCode: http.StatusTeapot}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	status = b.Status()
+	if !status.PausedUntil.Equal(status.ResetsAt) {
+		t.Fatalf("received '%v', expected '%v'", status.PausedUntil, status.ResetsAt)
+	}
+
+	b = NewBudget("test", time.Hour, 100)
+	err = b.Pause(time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Status().PausedUntil.IsZero() {
+		t.Fatal("budget should be paused")
+	}
+}
+
+func TestRequesterBudgets(t *testing.T) {
+	t.Parallel()
+	var r *Requester
+	_, err := r.GetBudgetStatus()
+	if !errors.Is(err, errRequestSystemIsNil) {
+		t.Fatalf("received '%v', expected '%v'", err, errRequestSystemIsNil)
+	}
+	err = r.SetBudgetStore(nil)
+	if !errors.Is(err, errRequestSystemIsNil) {
+		t.Fatalf("received '%v', expected '%v'", err, errRequestSystemIsNil)
+	}
+
+	r = New("test", new(http.Client), WithLimiter(NewBasicRateLimit(time.Second, 1)))
+	_, err = r.GetBudgetStatus()
+	if !errors.Is(err, ErrBudgetsUnsupported) {
+		t.Fatalf("received '%v', expected '%v'", err, ErrBudgetsUnsupported)
+	}
+	err = r.SetBudgetStore(&FileBudgetStore{})
+	if !errors.Is(err, ErrBudgetsUnsupported) {
+		t.Fatalf("received '%v', expected '%v'", err, ErrBudgetsUnsupported)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Used-Weight", "7")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	r = New("test", new(http.Client), WithLimiter(&testBudgetLimiter{NewBudget("test", time.Hour, 100)}))
+	var resp interface{}
+	err = r.SendPayload(context.Background(), Unset, func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: server.URL, Result: &resp}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, err := r.GetBudgetStatus()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(status) != 1 || status[0].Used != 7 {
+		t.Fatalf("unexpected status %+v", status)
+	}
+
+	err = r.SetBudgetStore(nil)
+	if !errors.Is(err, errBudgetStoreIsNil) {
+		t.Fatalf("received '%v', expected '%v'", err, errBudgetStoreIsNil)
+	}
+	err = r.SetBudgetStore(&FileBudgetStore{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	status, err = r.GetBudgetStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status[0].Shared {
+		t.Fatal("budget should be shared")
+	}
+}
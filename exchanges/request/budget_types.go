@@ -0,0 +1,54 @@
+package request
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Budget defines a weighted rate limit budget which allows a limit of weight
+// to be used within fixed interval windows, matching exchanges which count
+// request weight per minute
+type Budget struct {
+	name     string
+	interval time.Duration
+	limit    int
+	state    BudgetState
+	store    BudgetStore
+	mtx      sync.Mutex
+}
+
+// BudgetState holds the usage of a budget within its current window
+type BudgetState struct {
+	WindowStart time.Time `json:"windowStart"`
+	Used        int       `json:"used"`
+	PausedUntil time.Time `json:"pausedUntil"`
+}
+
+// BudgetStatus defines the usage of a budget for inspection
+type BudgetStatus struct {
+	Name        string
+	Limit       int
+	Used        int
+	Interval    time.Duration
+	ResetsAt    time.Time
+	PausedUntil time.Time
+	Shared      bool
+}
+
+// BudgetStore coordinates budget state between processes, Update must apply
+// fn to the stored state of the named budget atomically
+type BudgetStore interface {
+	Update(name string, fn func(*BudgetState)) error
+}
+
+// BudgetLimiter is implemented by limiters which track weighted budgets
+type BudgetLimiter interface {
+	Budgets() []*Budget
+}
+
+// ResponseHandler is implemented by limiters which correct themselves from
+// exchange responses e.g. from used weight or Retry-After headers
+type ResponseHandler interface {
+	HandleResponse(EndpointLimit, *http.Response) error
+}
@@ -152,6 +152,9 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 		}
 
 		resp, err := r.HTTPClient.Do(req)
+		if resp != nil {
+			r.handleResponse(endpoint, resp)
+		}
 		if retry, checkErr := r.retryPolicy(resp, err); checkErr != nil {
 			return checkErr
 		} else if retry {
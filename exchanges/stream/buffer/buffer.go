@@ -102,10 +102,24 @@ func (w *Orderbook) Update(u *Update) error {
 			u.Asset)
 	}
 
+	if book.resyncing {
+		// The desync has been reported, updates are dropped until the resync
+		// loads a new snapshot
+		return nil
+	}
+
+	if book.resyncErr != nil {
+		// The book is still flushed after its resync failed, the update is
+		// dropped and a snapshot is requested again
+		cause := book.resyncErr
+		book.resyncErr = nil
+		return w.desync(book, u.Pair, u.Asset, cause)
+	}
+
 	if w.bufferEnabled {
 		processed, err := w.processBufferUpdate(book, u)
 		if err != nil {
-			return err
+			return w.checkDesync(book, u, err)
 		}
 
 		if !processed {
@@ -114,7 +128,7 @@ func (w *Orderbook) Update(u *Update) error {
 	} else {
 		err := w.processObUpdate(book, u)
 		if err != nil {
-			return err
+			return w.checkDesync(book, u, err)
 		}
 	}
 
@@ -177,10 +191,52 @@ func (w *Orderbook) processBufferUpdate(o *orderbookHolder, u *Update) (bool, er
 	return true, nil
 }
 
+// checkDesync flushes and resyncs the book when an update has failed
+// verification
+func (w *Orderbook) checkDesync(o *orderbookHolder, u *Update, err error) error {
+	var vErr *verificationError
+	if !errors.As(err, &vErr) {
+		return err
+	}
+	return w.desync(o, u.Pair, u.Asset, vErr.err)
+}
+
 // processObUpdate processes updates either by its corresponding id or by
-// price level
+// price level, verifying the book when verification is set
 func (w *Orderbook) processObUpdate(o *orderbookHolder, u *Update) error {
+	if w.verifySequence {
+		err := checkSequence(o.ob.LastUpdateID(), u)
+		if err != nil {
+			return err
+		}
+	}
 	if w.updateEntriesByID {
+		err := o.updateByIDAndAction(u)
+		if err != nil {
+			return err
+		}
+	} else {
+		o.updateByPrice(u)
+	}
+	err := w.verify(o, u)
+	if err != nil {
+		return err
+	}
+	if w.recorder != nil {
+		w.recorder.RecordUpdate(w.exchangeName, u, w.updateEntriesByID)
+	}
+	return nil
+}
+
+// ApplyUpdate applies an update to a depth the same way updates are applied to
+// books handled by the buffer, updateByID matches entries by ID and action
+// instead of by price
+func ApplyUpdate(d *orderbook.Depth, u *Update, updateByID bool) error {
+	if u == nil {
+		return fmt.Errorf(packageError, errUpdateIsNil)
+	}
+	o := orderbookHolder{ob: d}
+	if updateByID {
 		return o.updateByIDAndAction(u)
 	}
 	o.updateByPrice(u)
@@ -274,6 +330,9 @@ func (w *Orderbook) LoadSnapshot(book *orderbook.Base) error {
 		book.LastUpdated,
 		false,
 	)
+	holder.resyncing = false
+	holder.resyncErr = nil
+	w.recordSnapshot(holder)
 
 	if holder.ob.VerifyOrderbook { // This is used here so as to not retrieve
 		// book if verification is off.
@@ -326,5 +385,37 @@ func (w *Orderbook) FlushOrderbook(p currency.Pair, a asset.Item) error {
 			errDepthNotFound)
 	}
 	book.ob.Flush()
+	w.recordSnapshot(book)
 	return nil
 }
+
+// SetRecorder sets the recorder which receives every snapshot and update
+// applied to books, a nil recorder stops recording
+func (w *Orderbook) SetRecorder(r Recorder) {
+	w.m.Lock()
+	w.recorder = r
+	w.m.Unlock()
+}
+
+// RecordSnapshots passes a copy of every book to the recorder. Books are
+// copied while updates are blocked so that snapshots are recorded in sequence
+// with the updates applied around them
+func (w *Orderbook) RecordSnapshots() {
+	w.m.Lock()
+	defer w.m.Unlock()
+	for _, m1 := range w.ob {
+		for _, m2 := range m1 {
+			for _, holder := range m2 {
+				w.recordSnapshot(holder)
+			}
+		}
+	}
+}
+
+// recordSnapshot passes a copy of the book to the recorder when set. The
+// orderbook mutex must be held
+func (w *Orderbook) recordSnapshot(o *orderbookHolder) {
+	if w.recorder != nil {
+		w.recorder.RecordSnapshot(o.ob.Retrieve())
+	}
+}
@@ -1049,3 +1049,123 @@ func TestFlushOrderbook(t *testing.T) {
 		t.Fatal("orderbook items not flushed")
 	}
 }
+
+type testRecorder struct {
+	snapshots []*orderbook.Base
+	updates   []*Update
+}
+
+func (r *testRecorder) RecordSnapshot(book *orderbook.Base) {
+	r.snapshots = append(r.snapshots, book)
+}
+
+func (r *testRecorder) RecordUpdate(exchange string, u *Update, updateByID bool) {
+	if exchange != exchangeName || updateByID {
+		return
+	}
+	r.updates = append(r.updates, u)
+}
+
+func TestSetRecorder(t *testing.T) {
+	t.Parallel()
+	holder, _, _, err := createSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &testRecorder{}
+	holder.SetRecorder(r)
+
+	err = holder.Update(&Update{
+		Bids:       itemArray[1],
+		Pair:       cp,
+		UpdateTime: time.Now(),
+		Asset:      asset.Spot,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(r.updates) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(r.updates), 1)
+	}
+
+	err = holder.LoadSnapshot(&orderbook.Base{
+		Exchange: exchangeName,
+		Asks:     orderbook.Items{{Price: 4000, Amount: 1}},
+		Bids:     orderbook.Items{{Price: 3000, Amount: 1}},
+		Asset:    asset.Spot,
+		Pair:     cp,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(r.snapshots) != 1 || len(r.snapshots[0].Bids) != 1 {
+		t.Fatal("snapshot not recorded")
+	}
+
+	err = holder.FlushOrderbook(cp, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(r.snapshots) != 2 || len(r.snapshots[1].Bids) != 0 {
+		t.Fatal("flushed book not recorded")
+	}
+
+	holder.RecordSnapshots()
+	if len(r.snapshots) != 3 {
+		t.Fatalf("received '%v', expected '%v'", len(r.snapshots), 3)
+	}
+
+	holder.SetRecorder(nil)
+	holder.RecordSnapshots()
+	if len(r.snapshots) != 3 {
+		t.Fatalf("received '%v', expected '%v'", len(r.snapshots), 3)
+	}
+	err = holder.Update(&Update{
+		Bids:       itemArray[2],
+		Pair:       cp,
+		UpdateTime: time.Now(),
+		Asset:      asset.Spot,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(r.updates) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(r.updates), 1)
+	}
+}
+
+func TestApplyUpdate(t *testing.T) {
+	t.Parallel()
+	err := ApplyUpdate(orderbook.NewDepth(), nil, false)
+	if !errors.Is(err, errUpdateIsNil) {
+		t.Fatalf("received '%v', expected '%v'", err, errUpdateIsNil)
+	}
+
+	d := orderbook.NewDepth()
+	d.LoadSnapshot(orderbook.Items{{Price: 100, Amount: 1, ID: 1}},
+		orderbook.Items{{Price: 101, Amount: 1, ID: 2}},
+		0,
+		time.Now(),
+		false)
+
+	err = ApplyUpdate(d, &Update{
+		Bids: orderbook.Items{{Price: 99, Amount: 2}},
+	}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if d.GetBidLength() != 2 {
+		t.Fatalf("received '%v', expected '%v'", d.GetBidLength(), 2)
+	}
+
+	err = ApplyUpdate(d, &Update{
+		Action: Delete,
+		Asks:   orderbook.Items{{ID: 2}},
+	}, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if d.GetAskLength() != 0 {
+		t.Fatalf("received '%v', expected '%v'", d.GetAskLength(), 0)
+	}
+}
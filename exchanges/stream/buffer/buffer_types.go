@@ -13,6 +13,15 @@ import (
 // an update.
 var timerDefault = time.Second * 10
 
+const (
+	// resyncAttempts is the number of times a snapshot is requested for a book
+	// which has failed verification before the resync is reported as failed
+	resyncAttempts = 3
+	// defaultResyncBackoff is the delay before the first retry of a failed
+	// resync, it doubles with each retry
+	defaultResyncBackoff = time.Second
+)
+
 // Orderbook defines a local cache of orderbooks for amending, appending
 // and deleting changes and updates the main store for a stream
 type Orderbook struct {
@@ -25,7 +34,15 @@ type Orderbook struct {
 	exchangeName          string
 	dataHandler           chan interface{}
 	verbose               bool
-	m                     sync.Mutex
+	// Verification of books after each update, a book which fails
+	// verification is flushed and resynced
+	verifier       Verifier
+	verifySequence bool
+	resync         Resync
+	resyncBackoff  time.Duration
+	// recorder receives every snapshot loaded and update applied
+	recorder Recorder
+	m        sync.Mutex
 }
 
 // orderbookHolder defines a store of pending updates and a pointer to the
@@ -38,6 +55,15 @@ type orderbookHolder struct {
 	// The sync agent only requires an alert every 15 seconds for a specific
 	// currency.
 	ticker *time.Ticker
+	// resyncing is set when the book has failed verification and updates are
+	// dropped until a new snapshot is loaded
+	resyncing bool
+	// resyncErr is set when every resync attempt has failed, the next update
+	// returns it and requests a new snapshot
+	resyncErr  error
+	desyncs    int64
+	lastDesync time.Time
+	lastErr    error
 }
 
 // Update stores orderbook updates and dictates what features to use when processing
@@ -54,6 +80,61 @@ type Update struct {
 	// should remove any items that are outside of this scope. Kraken is the
 	// only exchange utilising this field.
 	MaxDepth int
+
+	// Checksum is the exchange supplied checksum of the book after the update
+	// has been applied, compared by the verifier set by ChecksumVerifier
+	Checksum uint32
+	// PriceDecimals and AmountDecimals are the decimal places the checksum is
+	// calculated with when an exchange formats the book to calculate it. Kraken
+	// is the only exchange utilising these fields.
+	PriceDecimals  int
+	AmountDecimals int
+	// FirstUpdateID is the first sequence ID covered by an update which spans
+	// a range of IDs ending at UpdateID. Zero denotes an update with a single
+	// sequence ID
+	FirstUpdateID int64
+}
+
+// Verifier checks a book after an update has been applied to it and returns
+// an error when the book no longer matches the exchange
+type Verifier func(book *orderbook.Base, u *Update) error
+
+// Resync requests a new snapshot of a book which has failed verification
+type Resync func(p currency.Pair, a asset.Item) error
+
+// Verification defines the checks applied to books after each update and how
+// books which fail them are resynced. When neither check is set books are only
+// resynced when the exchange calls Invalidate
+type Verification struct {
+	// Verifier is an optional exchange specific check e.g. ChecksumVerifier
+	Verifier Verifier
+	// Sequence checks that update IDs follow on from the previous update
+	// without gaps
+	Sequence bool
+	// Resync is called once a book which has failed verification has been
+	// flushed, the book is updated again once a snapshot is loaded
+	Resync Resync
+}
+
+// DesyncStats defines how often a book has failed verification
+type DesyncStats struct {
+	Pair       currency.Pair
+	Asset      asset.Item
+	Desyncs    int64
+	LastDesync time.Time
+	LastError  error
+	Resyncing  bool
+}
+
+// Recorder receives the snapshots and updates applied to books so that they
+// can be captured. Calls are made while the orderbook is locked and must not
+// block
+type Recorder interface {
+	// RecordSnapshot is called with a copy of the book once a snapshot has
+	// been loaded or the book has been flushed
+	RecordSnapshot(book *orderbook.Base)
+	// RecordUpdate is called once an update has been applied to a book
+	RecordUpdate(exchange string, u *Update, updateByID bool)
 }
 
 // Action defines a set of differing states required to implement an incoming
@@ -0,0 +1,214 @@
+package buffer
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	// ErrOrderbookOutOfSync defines an error when a book has failed
+	// verification and has been flushed to be resynced
+	ErrOrderbookOutOfSync = errors.New("orderbook out of sync")
+
+	errChecksumMismatch  = errors.New("checksum mismatch")
+	errSequenceGap       = errors.New("update sequence gap")
+	errVerificationUnset = errors.New("verification checks unset")
+	errResyncUnset       = errors.New("resync function unset")
+	errResyncFailed      = errors.New("orderbook resync failed")
+)
+
+// verificationError wraps errors returned by the verification checks so that
+// they can be distinguished from errors applying an update
+type verificationError struct {
+	err error
+}
+
+func (v *verificationError) Error() string {
+	return v.err.Error()
+}
+
+func (v *verificationError) Unwrap() error {
+	return v.err
+}
+
+// ChecksumVerifier returns a verifier which compares the checksum calculated
+// for a book by calc with the checksum supplied alongside each update
+func ChecksumVerifier(calc func(*orderbook.Base) uint32) Verifier {
+	return func(book *orderbook.Base, u *Update) error {
+		if checksum := calc(book); checksum != u.Checksum {
+			return fmt.Errorf("%w calculated %d expected %d",
+				errChecksumMismatch,
+				checksum,
+				u.Checksum)
+		}
+		return nil
+	}
+}
+
+// SetupVerification sets the checks applied to books after each update
+func (w *Orderbook) SetupVerification(v *Verification) error {
+	if v == nil {
+		return fmt.Errorf(packageError, errVerificationUnset)
+	}
+	if v.Resync == nil {
+		return fmt.Errorf(packageError, errResyncUnset)
+	}
+	w.m.Lock()
+	w.verifier = v.Verifier
+	w.verifySequence = v.Sequence
+	w.resync = v.Resync
+	w.resyncBackoff = defaultResyncBackoff
+	w.m.Unlock()
+	return nil
+}
+
+// checkSequence checks that an update follows on from the last update applied
+// to the book. Books without a sequence ID are not checked
+func checkSequence(last int64, u *Update) error {
+	if last == 0 {
+		return nil
+	}
+	first := u.FirstUpdateID
+	if first == 0 {
+		first = u.UpdateID
+	}
+	if first > last+1 || u.UpdateID <= last {
+		return &verificationError{fmt.Errorf("%w last update ID %d received %d-%d",
+			errSequenceGap,
+			last,
+			first,
+			u.UpdateID)}
+	}
+	return nil
+}
+
+// verify runs the exchange verifier against the book after an update has been
+// applied
+func (w *Orderbook) verify(o *orderbookHolder, u *Update) error {
+	if w.verifier == nil {
+		return nil
+	}
+	err := w.verifier(o.ob.Retrieve(), u)
+	if err != nil {
+		return &verificationError{err}
+	}
+	return nil
+}
+
+// desync flushes a book which has failed verification and requests a new
+// snapshot, updates are dropped until the snapshot is loaded. The orderbook
+// mutex must be held
+func (w *Orderbook) desync(o *orderbookHolder, p currency.Pair, a asset.Item, cause error) error {
+	o.ob.Flush()
+	w.recordSnapshot(o)
+	*o.buffer = nil
+	o.resyncing = true
+	o.desyncs++
+	o.lastDesync = time.Now()
+	o.lastErr = cause
+	err := fmt.Errorf("%s %s %s %w: %v",
+		w.exchangeName,
+		p,
+		a,
+		ErrOrderbookOutOfSync,
+		cause)
+	log.Warnf(log.WebsocketMgr, "%v, requesting snapshot", err)
+	go w.resyncBook(o, p, a, w.resyncBackoff)
+	return err
+}
+
+// resyncBook requests a new snapshot of a book which has failed verification,
+// retrying with backoff. When every attempt fails the book is left flushed and
+// the failure is returned by its next update, which requests a snapshot again
+func (w *Orderbook) resyncBook(o *orderbookHolder, p currency.Pair, a asset.Item, backoff time.Duration) {
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = w.resync(p, a)
+		if err == nil {
+			return
+		}
+		if attempt == resyncAttempts {
+			break
+		}
+		log.Warnf(log.WebsocketMgr,
+			"%s %s %s orderbook resync attempt %d error: %v, retrying in %s",
+			w.exchangeName,
+			p,
+			a,
+			attempt,
+			err,
+			backoff)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	err = fmt.Errorf("%w after %d attempts: %v", errResyncFailed, resyncAttempts, err)
+	log.Errorf(log.WebsocketMgr, "%s %s %s %v", w.exchangeName, p, a, err)
+	w.m.Lock()
+	defer w.m.Unlock()
+	if !o.resyncing {
+		// A snapshot has been loaded regardless
+		return
+	}
+	o.resyncing = false
+	o.resyncErr = err
+	o.lastErr = err
+}
+
+// Invalidate flushes a book which has failed a check made by the exchange
+// outside of the buffer, e.g. a checksum which is not sent alongside the
+// update it covers, and requests a new snapshot
+func (w *Orderbook) Invalidate(p currency.Pair, a asset.Item, cause error) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.resync == nil {
+		return fmt.Errorf(packageError, errResyncUnset)
+	}
+	book, ok := w.ob[p.Base][p.Quote][a]
+	if !ok {
+		return fmt.Errorf("%w for Exchange %s CurrencyPair: %s AssetType: %s",
+			errDepthNotFound,
+			w.exchangeName,
+			p,
+			a)
+	}
+	if book.resyncing {
+		// A snapshot has already been requested
+		return nil
+	}
+	return w.desync(book, p, a, cause)
+}
+
+// GetDesyncStats returns how often each book has failed verification
+func (w *Orderbook) GetDesyncStats() []DesyncStats {
+	w.m.Lock()
+	defer w.m.Unlock()
+	var stats []DesyncStats
+	for base, m1 := range w.ob {
+		for quote, m2 := range m1 {
+			for a, holder := range m2 {
+				stats = append(stats, DesyncStats{
+					Pair:       currency.Pair{Base: base, Quote: quote},
+					Asset:      a,
+					Desyncs:    holder.desyncs,
+					LastDesync: holder.lastDesync,
+					LastError:  holder.lastErr,
+					Resyncing:  holder.resyncing,
+				})
+			}
+		}
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Pair.String() != stats[j].Pair.String() {
+			return stats[i].Pair.String() < stats[j].Pair.String()
+		}
+		return stats[i].Asset < stats[j].Asset
+	})
+	return stats
+}
@@ -0,0 +1,322 @@
+package buffer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// verifiedSnapshot loads a snapshot into a buffer with verification set,
+// resynced books are sent down the returned channel
+func verifiedSnapshot(t *testing.T, exch string, v *Verification) (*Orderbook, chan currency.Pair) {
+	t.Helper()
+	w := &Orderbook{}
+	err := w.Setup(0, false, false, false, false, false, exch, make(chan interface{}, 100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resynced := make(chan currency.Pair, 1)
+	v.Resync = func(p currency.Pair, _ asset.Item) error {
+		resynced <- p
+		return nil
+	}
+	err = w.SetupVerification(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.LoadSnapshot(&orderbook.Base{
+		Exchange:     exch,
+		Pair:         cp,
+		Asset:        asset.Spot,
+		Bids:         orderbook.Items{{Price: 1000, Amount: 1}},
+		Asks:         orderbook.Items{{Price: 1001, Amount: 1}},
+		LastUpdateID: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w, resynced
+}
+
+func TestSetupVerification(t *testing.T) {
+	t.Parallel()
+	w := Orderbook{}
+	err := w.SetupVerification(nil)
+	if !errors.Is(err, errVerificationUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errVerificationUnset)
+	}
+	err = w.SetupVerification(&Verification{})
+	if !errors.Is(err, errResyncUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errResyncUnset)
+	}
+	err = w.SetupVerification(&Verification{Sequence: true})
+	if !errors.Is(err, errResyncUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errResyncUnset)
+	}
+	err = w.SetupVerification(&Verification{
+		Sequence: true,
+		Resync:   func(currency.Pair, asset.Item) error { return nil },
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if !w.verifySequence || w.resync == nil {
+		t.Fatal("verification not set")
+	}
+}
+
+func TestCheckSequence(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		last     int64
+		update   Update
+		expected error
+	}{
+		{last: 0, update: Update{UpdateID: 50}},
+		{last: 10, update: Update{UpdateID: 11}},
+		{last: 10, update: Update{UpdateID: 12}, expected: errSequenceGap},
+		{last: 10, update: Update{UpdateID: 10}, expected: errSequenceGap},
+		{last: 10, update: Update{FirstUpdateID: 8, UpdateID: 15}},
+		{last: 10, update: Update{FirstUpdateID: 12, UpdateID: 15}, expected: errSequenceGap},
+		{last: 10, update: Update{FirstUpdateID: 5, UpdateID: 9}, expected: errSequenceGap},
+	} {
+		err := checkSequence(tt.last, &tt.update)
+		if !errors.Is(err, tt.expected) {
+			t.Fatalf("%d %+v received '%v', expected '%v'", tt.last, tt.update, err, tt.expected)
+		}
+	}
+}
+
+func TestSequenceVerification(t *testing.T) {
+	t.Parallel()
+	w, resynced := verifiedSnapshot(t, "sequenceVerification", &Verification{Sequence: true})
+	err := w.Update(&Update{
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 999, Amount: 1}},
+		UpdateID: 11,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	err = w.Update(&Update{
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 998, Amount: 1}},
+		UpdateID: 13,
+	})
+	if !errors.Is(err, ErrOrderbookOutOfSync) {
+		t.Fatalf("received '%v', expected '%v'", err, ErrOrderbookOutOfSync)
+	}
+	select {
+	case p := <-resynced:
+		if !p.Equal(cp) {
+			t.Fatalf("received '%v', expected '%v'", p, cp)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("resync not requested")
+	}
+	book, err := w.GetOrderbook(cp, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(book.Bids) != 0 || len(book.Asks) != 0 {
+		t.Fatal("book should be flushed")
+	}
+
+	// Updates are dropped until a snapshot is loaded
+	err = w.Update(&Update{
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 998, Amount: 1}},
+		UpdateID: 14,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	stats := w.GetDesyncStats()
+	if len(stats) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(stats), 1)
+	}
+	if stats[0].Desyncs != 1 || !stats[0].Resyncing || !errors.Is(stats[0].LastError, errSequenceGap) || stats[0].Asset != asset.Spot {
+		t.Fatalf("unexpected stats %+v", stats[0])
+	}
+
+	err = w.LoadSnapshot(&orderbook.Base{
+		Exchange:     "sequenceVerification",
+		Pair:         cp,
+		Asset:        asset.Spot,
+		Bids:         orderbook.Items{{Price: 1000, Amount: 1}},
+		LastUpdateID: 20,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Update(&Update{
+		Pair:          cp,
+		Asset:         asset.Spot,
+		Bids:          orderbook.Items{{Price: 999, Amount: 1}},
+		FirstUpdateID: 18,
+		UpdateID:      22,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if stats = w.GetDesyncStats(); stats[0].Resyncing || stats[0].Desyncs != 1 {
+		t.Fatalf("unexpected stats %+v", stats[0])
+	}
+}
+
+func TestChecksumVerification(t *testing.T) {
+	t.Parallel()
+	w, resynced := verifiedSnapshot(t, "checksumVerification", &Verification{
+		Verifier: ChecksumVerifier(func(book *orderbook.Base) uint32 {
+			return uint32(len(book.Bids))
+		}),
+	})
+	err := w.Update(&Update{
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 999, Amount: 1}},
+		Checksum: 2,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	err = w.Update(&Update{
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 998, Amount: 1}},
+		Checksum: 2,
+	})
+	if !errors.Is(err, ErrOrderbookOutOfSync) {
+		t.Fatalf("received '%v', expected '%v'", err, ErrOrderbookOutOfSync)
+	}
+	select {
+	case <-resynced:
+	case <-time.After(time.Second):
+		t.Fatal("resync not requested")
+	}
+	if stats := w.GetDesyncStats(); !errors.Is(stats[0].LastError, errChecksumMismatch) {
+		t.Fatalf("received '%v', expected '%v'", stats[0].LastError, errChecksumMismatch)
+	}
+}
+
+func TestResyncFailure(t *testing.T) {
+	t.Parallel()
+	w, _ := verifiedSnapshot(t, "resyncFailure", &Verification{Sequence: true})
+	errTest := errors.New("subscription rejected")
+	attempts := make(chan struct{}, resyncAttempts*2)
+	w.m.Lock()
+	w.resync = func(currency.Pair, asset.Item) error {
+		attempts <- struct{}{}
+		return errTest
+	}
+	w.resyncBackoff = time.Millisecond
+	w.m.Unlock()
+
+	err := w.Update(&Update{
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 998, Amount: 1}},
+		UpdateID: 13,
+	})
+	if !errors.Is(err, ErrOrderbookOutOfSync) {
+		t.Fatalf("received '%v', expected '%v'", err, ErrOrderbookOutOfSync)
+	}
+	for i := 0; i < resyncAttempts; i++ {
+		select {
+		case <-attempts:
+		case <-time.After(time.Second):
+			t.Fatalf("received '%v' resync attempts, expected '%v'", i, resyncAttempts)
+		}
+	}
+	deadline := time.Now().Add(time.Second)
+	var stats []DesyncStats
+	for {
+		if stats = w.GetDesyncStats(); !stats[0].Resyncing {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("book still resyncing after every attempt failed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if !errors.Is(stats[0].LastError, errResyncFailed) {
+		t.Fatalf("received '%v', expected '%v'", stats[0].LastError, errResyncFailed)
+	}
+
+	// The next update reports the failure and requests a snapshot again
+	// instead of being applied to the flushed book
+	err = w.Update(&Update{
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 997, Amount: 1}},
+		UpdateID: 14,
+	})
+	if !errors.Is(err, ErrOrderbookOutOfSync) {
+		t.Fatalf("received '%v', expected '%v'", err, ErrOrderbookOutOfSync)
+	}
+	select {
+	case <-attempts:
+	case <-time.After(time.Second):
+		t.Fatal("resync not requested")
+	}
+	book, err := w.GetOrderbook(cp, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(book.Bids) != 0 {
+		t.Fatal("book should be flushed")
+	}
+	if stats = w.GetDesyncStats(); stats[0].Desyncs != 2 || !stats[0].Resyncing {
+		t.Fatalf("unexpected stats %+v", stats[0])
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	t.Parallel()
+	w := &Orderbook{}
+	err := w.Invalidate(cp, asset.Spot, errChecksumMismatch)
+	if !errors.Is(err, errResyncUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errResyncUnset)
+	}
+
+	w, resynced := verifiedSnapshot(t, "invalidate", &Verification{})
+	err = w.Invalidate(cp, asset.Futures, errChecksumMismatch)
+	if !errors.Is(err, errDepthNotFound) {
+		t.Fatalf("received '%v', expected '%v'", err, errDepthNotFound)
+	}
+
+	err = w.Invalidate(cp, asset.Spot, errChecksumMismatch)
+	if !errors.Is(err, ErrOrderbookOutOfSync) {
+		t.Fatalf("received '%v', expected '%v'", err, ErrOrderbookOutOfSync)
+	}
+	select {
+	case <-resynced:
+	case <-time.After(time.Second):
+		t.Fatal("resync not requested")
+	}
+	book, err := w.GetOrderbook(cp, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(book.Bids) != 0 || len(book.Asks) != 0 {
+		t.Fatal("book should be flushed")
+	}
+
+	// A book already resyncing is not invalidated again
+	err = w.Invalidate(cp, asset.Spot, errChecksumMismatch)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if stats := w.GetDesyncStats(); stats[0].Desyncs != 1 || !errors.Is(stats[0].LastError, errChecksumMismatch) {
+		t.Fatalf("unexpected stats %+v", stats[0])
+	}
+}
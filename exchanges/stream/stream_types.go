@@ -48,6 +48,21 @@ type ConnectionSetup struct {
 	Authenticated        bool
 }
 
+// ConnectionStatus defines the health of an individual stream connection
+type ConnectionStatus struct {
+	Name      string
+	URL       string
+	Connected bool
+	// Subscriptions is the number of subscriptions held by the connection,
+	// the standard connection holds all subscriptions when they are not
+	// sharded
+	Subscriptions int
+	// MaxSubscriptions is the subscription limit of a pooled connection
+	MaxSubscriptions int
+	Reconnections    int
+	LastError        string
+}
+
 // PingHandler container for ping handler settings
 type PingHandler struct {
 	Websocket         bool
@@ -93,6 +108,14 @@ type WebsocketPositionUpdated struct {
 	Exchange  string
 }
 
+// ConnectionEstablished is sent to the routine handler once a websocket
+// connection has been established and subscribed, this includes reconnections
+// so any state missed while disconnected can be resynchronised
+type ConnectionEstablished struct {
+	Exchange  string
+	Timestamp time.Time
+}
+
 // UnhandledMessageWarning defines a container for unhandled message warnings
 type UnhandledMessageWarning struct {
 	Message string
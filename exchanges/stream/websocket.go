@@ -11,6 +11,8 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -28,6 +30,14 @@ var (
 	// ErrSubscriptionFailure defines an error when a subscription fails
 	ErrSubscriptionFailure = errors.New("subscription failure")
 	errAlreadyRunning      = errors.New("connection monitor is already running")
+
+	errInvalidMaxSubscriptions = errors.New("max subscriptions per connection cannot be negative")
+	errInvalidMaxConnections   = errors.New("max connections cannot be negative")
+	errShardConnectorUnset     = errors.New("shard connector function needs to be set")
+	errShardSubscriberUnset    = errors.New("shard subscriber function needs to be set")
+	errShardUnsubscriberUnset  = errors.New("features have been set yet shard unsubscriber is not set")
+	errConnectionLimitReached  = errors.New("connection limit reached")
+	errShardClosed             = errors.New("pooled connection closed")
 )
 
 // New initialises the websocket struct
@@ -71,15 +81,40 @@ func (w *Websocket) Setup(s *WebsocketSetup) error {
 
 	w.features = s.Features
 
-	if s.Subscriber == nil {
-		return errSubscriberUnset
+	if s.MaxSubscriptionsPerConnection < 0 {
+		return errInvalidMaxSubscriptions
+	}
+	if s.MaxConnections < 0 {
+		return errInvalidMaxConnections
 	}
-	w.Subscriber = s.Subscriber
+	if s.MaxSubscriptionsPerConnection > 0 {
+		if s.ShardConnector == nil {
+			return errShardConnectorUnset
+		}
+		if s.ShardSubscriber == nil {
+			return errShardSubscriberUnset
+		}
+		if w.features.Unsubscribe && s.ShardUnsubscriber == nil {
+			return errShardUnsubscriberUnset
+		}
+		w.maxSubscriptionsPerConnection = s.MaxSubscriptionsPerConnection
+		w.maxConnections = s.MaxConnections
+		w.shardConnector = s.ShardConnector
+		w.shardSubscriber = s.ShardSubscriber
+		w.shardUnsubscriber = s.ShardUnsubscriber
+		w.Subscriber = w.subscribeShards
+		w.Unsubscriber = w.unsubscribeShards
+	} else {
+		if s.Subscriber == nil {
+			return errSubscriberUnset
+		}
+		w.Subscriber = s.Subscriber
 
-	if w.features.Unsubscribe && s.UnSubscriber == nil {
-		return errors.New("features have been set yet channel unsubscriber is not set")
+		if w.features.Unsubscribe && s.UnSubscriber == nil {
+			return errors.New("features have been set yet channel unsubscriber is not set")
+		}
+		w.Unsubscriber = s.UnSubscriber
 	}
-	w.Unsubscriber = s.UnSubscriber
 
 	if s.GenerateSubscriptions == nil {
 		return errGenerateSubsciptionsUnset
@@ -121,7 +156,7 @@ func (w *Websocket) Setup(s *WebsocketSetup) error {
 	w.Wg = new(sync.WaitGroup)
 	w.SetCanUseAuthenticatedEndpoints(s.AuthenticatedWebsocketAPISupport)
 
-	return w.Orderbook.Setup(s.OrderbookBufferLimit,
+	err = w.Orderbook.Setup(s.OrderbookBufferLimit,
 		s.BufferEnabled,
 		s.SortBuffer,
 		s.SortBufferByUpdateIDs,
@@ -129,6 +164,20 @@ func (w *Websocket) Setup(s *WebsocketSetup) error {
 		s.Verbose,
 		w.exchangeName,
 		w.DataHandler)
+	if err != nil {
+		return err
+	}
+
+	if s.OrderbookVerifier == nil &&
+		!s.VerifyOrderbookSequence &&
+		s.OrderbookResync == nil {
+		return nil
+	}
+	return w.Orderbook.SetupVerification(&buffer.Verification{
+		Verifier: s.OrderbookVerifier,
+		Sequence: s.VerifyOrderbookSequence,
+		Resync:   s.OrderbookResync,
+	})
 }
 
 // SetupNewConnection sets up an auth or unauth streaming connection
@@ -169,12 +218,14 @@ func (w *Websocket) SetupNewConnection(c ConnectionSetup) error {
 		Wg:                w.Wg,
 		Match:             w.Match,
 		RateLimit:         c.RateLimit,
+		Recorder:          w.recorder,
 	}
 
 	if c.Authenticated {
 		w.AuthConn = newConn
 	} else {
 		w.Conn = newConn
+		w.shardSetup = c
 	}
 
 	return nil
@@ -231,6 +282,14 @@ func (w *Websocket) Connect() error {
 	if err != nil {
 		return fmt.Errorf("%v %w: %v", w.exchangeName, ErrSubscriptionFailure, err)
 	}
+
+	select {
+	case w.ToRoutine <- ConnectionEstablished{Exchange: w.exchangeName, Timestamp: time.Now()}:
+	default:
+		log.Warnf(log.WebsocketMgr,
+			"%s exchange backlog in websocket processing detected, connection established event dropped",
+			w.exchangeName)
+	}
 	return nil
 }
 
@@ -406,6 +465,8 @@ func (w *Websocket) Shutdown() error {
 		}
 	}
 
+	w.shutdownShards()
+
 	// flush any subscriptions from last connection if needed
 	w.subscriptionMutex.Lock()
 	w.subscriptions = nil
@@ -785,6 +846,20 @@ func (w *Websocket) GetProxyAddress() string {
 	return w.proxyAddr
 }
 
+// SetRecorder sets a recorder for the messages sent and received over the
+// websocket connections so sessions can be replayed by the websocket mock
+// server in testing. Existing connections are recorded from their next
+// connection, a nil recorder stops recording
+func (w *Websocket) SetRecorder(r *mock.WebsocketRecorder) {
+	w.recorder = r
+	if c, ok := w.Conn.(*WebsocketConnection); ok && c != nil {
+		c.Recorder = r
+	}
+	if c, ok := w.AuthConn.(*WebsocketConnection); ok && c != nil {
+		c.Recorder = r
+	}
+}
+
 // GetName returns exchange name
 func (w *Websocket) GetName() string {
 	return w.exchangeName
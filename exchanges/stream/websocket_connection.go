@@ -5,6 +5,7 @@ import (
 	"compress/flate"
 	"compress/gzip"
 	"crypto/rand"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"math/big"
@@ -86,6 +87,9 @@ func (w *WebsocketConnection) Dial(dialer *websocket.Dialer, headers http.Header
 	default:
 	}
 	w.setConnectedStatus(true)
+	if w.Recorder != nil {
+		w.record(w.Recorder.Connect(w.URL))
+	}
 	return nil
 }
 
@@ -113,6 +117,13 @@ func (w *WebsocketConnection) SendJSONMessage(data interface{}) error {
 				w.ExchangeName)
 		}
 	}
+	if w.Recorder != nil {
+		payload, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		w.record(w.Recorder.Record(true, websocket.TextMessage, payload))
+	}
 	return w.Connection.WriteJSON(data)
 }
 
@@ -143,6 +154,9 @@ func (w *WebsocketConnection) SendRawMessage(messageType int, message []byte) er
 		return fmt.Errorf("%v websocket connection: cannot send message to a disconnected websocket",
 			w.ExchangeName)
 	}
+	if w.Recorder != nil {
+		w.record(w.Recorder.Record(true, messageType, message))
+	}
 	return w.Connection.WriteMessage(messageType, message)
 }
 
@@ -206,6 +220,9 @@ func (w *WebsocketConnection) ReadMessage() Response {
 	if err != nil {
 		if isDisconnectionError(err) {
 			w.setConnectedStatus(false)
+			if w.Recorder != nil {
+				w.record(w.Recorder.Disconnect(true))
+			}
 			select {
 			case w.readMessageErrors <- err:
 			default:
@@ -225,6 +242,10 @@ func (w *WebsocketConnection) ReadMessage() Response {
 	default: // causes contention, just bypass if there is no receiver.
 	}
 
+	if w.Recorder != nil {
+		w.record(w.Recorder.Record(false, mType, resp))
+	}
+
 	var standardMessage []byte
 	switch mType {
 	case websocket.TextMessage:
@@ -304,9 +325,23 @@ func (w *WebsocketConnection) Shutdown() error {
 	if w == nil || w.Connection == nil {
 		return nil
 	}
+	if w.Recorder != nil {
+		w.record(w.Recorder.Disconnect(false))
+	}
 	return w.Connection.UnderlyingConn().Close()
 }
 
+// record logs websocket recording errors, recording failures do not affect
+// the connection
+func (w *WebsocketConnection) record(err error) {
+	if err != nil {
+		log.Errorf(log.WebsocketMgr,
+			"%v websocket connection: recording error: %v",
+			w.ExchangeName,
+			err)
+	}
+}
+
 // SetURL sets connection URL
 func (w *WebsocketConnection) SetURL(url string) {
 	w.URL = url
@@ -0,0 +1,328 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// IsSharded returns whether subscriptions are spread across a pool of
+// connections
+func (w *Websocket) IsSharded() bool {
+	return w.maxSubscriptionsPerConnection > 0
+}
+
+// subscribeShards subscribes channels over the pooled connections, filling
+// existing connections up to the subscription limit before establishing new
+// connections
+func (w *Websocket) subscribeShards(channels []ChannelSubscription) error {
+	w.shardMutex.Lock()
+	defer w.shardMutex.Unlock()
+	for i := 0; len(channels) > 0; i++ {
+		var s *connectionShard
+		if i < len(w.shards) {
+			s = w.shards[i]
+			if !s.connected {
+				continue
+			}
+		} else {
+			if w.maxConnections > 0 && len(w.shards) >= w.maxConnections {
+				return fmt.Errorf("%s websocket: %w at %d connections, %d subscriptions could not be made",
+					w.exchangeName,
+					errConnectionLimitReached,
+					w.maxConnections,
+					len(channels))
+			}
+			var err error
+			s, err = w.newShard()
+			if err != nil {
+				return err
+			}
+		}
+		free := w.maxSubscriptionsPerConnection - len(s.subscriptions)
+		if free <= 0 {
+			continue
+		}
+		if free > len(channels) {
+			free = len(channels)
+		}
+		err := w.shardSubscriber(s.conn, channels[:free])
+		if err != nil {
+			return err
+		}
+		s.subscriptions = append(s.subscriptions, channels[:free]...)
+		channels = channels[free:]
+	}
+	return nil
+}
+
+// unsubscribeShards unsubscribes channels from the pooled connections which
+// hold them. Connections left without subscriptions are shut down
+func (w *Websocket) unsubscribeShards(channels []ChannelSubscription) error {
+	w.shardMutex.Lock()
+	defer w.shardMutex.Unlock()
+	for i := 0; i < len(w.shards); i++ {
+		s := w.shards[i]
+		var matched, remaining []ChannelSubscription
+	subscriptions:
+		for x := range s.subscriptions {
+			for y := range channels {
+				if s.subscriptions[x].Equal(&channels[y]) {
+					matched = append(matched, s.subscriptions[x])
+					continue subscriptions
+				}
+			}
+			remaining = append(remaining, s.subscriptions[x])
+		}
+		if len(matched) == 0 {
+			continue
+		}
+		if s.connected {
+			err := w.shardUnsubscriber(s.conn, matched)
+			if err != nil {
+				return err
+			}
+		} else {
+			// Subscriptions are restored on reconnection so only need to be
+			// removed from the list
+			w.RemoveSuccessfulUnsubscriptions(matched...)
+		}
+		s.subscriptions = remaining
+		if len(remaining) != 0 {
+			continue
+		}
+		w.closeShard(s)
+		w.shards = append(w.shards[:i], w.shards[i+1:]...)
+		i--
+	}
+	return nil
+}
+
+// newShard establishes a new pooled connection and monitors it for
+// disconnection, the shard mutex must be held
+func (w *Websocket) newShard() (*connectionShard, error) {
+	connectionURL := w.GetWebsocketURL()
+	if w.shardSetup.URL != "" {
+		connectionURL = w.shardSetup.URL
+	}
+	errs := make(chan error, 1)
+	conn := &WebsocketConnection{
+		ExchangeName:      w.exchangeName,
+		URL:               connectionURL,
+		ProxyURL:          w.GetProxyAddress(),
+		Verbose:           w.verbose,
+		ResponseMaxLimit:  w.shardSetup.ResponseMaxLimit,
+		Traffic:           w.TrafficAlert,
+		readMessageErrors: errs,
+		ShutdownC:         w.ShutdownC,
+		Wg:                w.Wg,
+		Match:             w.Match,
+		RateLimit:         w.shardSetup.RateLimit,
+		Recorder:          w.recorder,
+	}
+	err := w.shardConnector(conn)
+	if err != nil {
+		return nil, fmt.Errorf("%v websocket: cannot establish pooled connection: %w",
+			w.exchangeName,
+			err)
+	}
+	w.nextShardID++
+	s := &connectionShard{
+		id:        w.nextShardID,
+		conn:      conn,
+		errors:    errs,
+		shutdown:  make(chan struct{}),
+		connected: true,
+	}
+	w.shards = append(w.shards, s)
+	if w.verbose {
+		log.Debugf(log.WebsocketMgr,
+			"%v websocket: shard %d connected to %s\n",
+			w.exchangeName,
+			s.id,
+			connectionURL)
+	}
+	w.Wg.Add(1)
+	go w.shardMonitor(s)
+	return s, nil
+}
+
+// shardMonitor reconnects and resubscribes a pooled connection when it is
+// disconnected, independently of the other connections
+func (w *Websocket) shardMonitor(s *connectionShard) {
+	defer w.Wg.Done()
+	for {
+		select {
+		case <-w.ShutdownC:
+			return
+		case <-s.shutdown:
+			return
+		case err := <-s.errors:
+			w.shardMutex.Lock()
+			s.connected = false
+			s.lastErr = err.Error()
+			w.shardMutex.Unlock()
+			log.Warnf(log.WebsocketMgr,
+				"%v websocket: shard %d has been disconnected. Reason: %v",
+				w.exchangeName,
+				s.id,
+				err)
+			if !w.reconnectShard(s) {
+				return
+			}
+		}
+	}
+}
+
+// reconnectShard attempts to reconnect a pooled connection until it succeeds
+// or the shard is shut down
+func (w *Websocket) reconnectShard(s *connectionShard) bool {
+	timer := time.NewTimer(connectionMonitorDelay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.ShutdownC:
+			return false
+		case <-s.shutdown:
+			return false
+		case <-timer.C:
+		}
+		err := w.resubscribeShard(s)
+		if errors.Is(err, errShardClosed) {
+			return false
+		}
+		if err == nil {
+			log.Infof(log.WebsocketMgr,
+				"%v websocket: shard %d reconnected",
+				w.exchangeName,
+				s.id)
+			return true
+		}
+		log.Errorf(log.WebsocketMgr,
+			"%v websocket: shard %d reconnection error: %v",
+			w.exchangeName,
+			s.id,
+			err)
+		timer.Reset(connectionMonitorDelay)
+	}
+}
+
+// resubscribeShard reconnects a pooled connection and restores its
+// subscriptions
+func (w *Websocket) resubscribeShard(s *connectionShard) error {
+	err := w.shardConnector(s.conn)
+	if err != nil {
+		w.shardMutex.Lock()
+		s.lastErr = err.Error()
+		w.shardMutex.Unlock()
+		return err
+	}
+
+	w.subscriptionMutex.Lock()
+	defer w.subscriptionMutex.Unlock()
+	w.shardMutex.Lock()
+	defer w.shardMutex.Unlock()
+	select {
+	case <-s.shutdown:
+		// Shard was removed while reconnecting
+		if shutErr := s.conn.Shutdown(); shutErr != nil {
+			return shutErr
+		}
+		return errShardClosed
+	default:
+	}
+	if len(s.subscriptions) != 0 {
+		w.RemoveSuccessfulUnsubscriptions(s.subscriptions...)
+		err = w.shardSubscriber(s.conn, s.subscriptions)
+		if err != nil {
+			s.lastErr = err.Error()
+			if shutErr := s.conn.Shutdown(); shutErr != nil {
+				log.Errorf(log.WebsocketMgr,
+					"%v websocket: shard %d shutdown error: %v",
+					w.exchangeName,
+					s.id,
+					shutErr)
+			}
+			return err
+		}
+	}
+	s.connected = true
+	s.reconnections++
+	s.lastErr = ""
+	return nil
+}
+
+// shutdownShards shuts down and removes all pooled connections
+func (w *Websocket) shutdownShards() {
+	w.shardMutex.Lock()
+	defer w.shardMutex.Unlock()
+	for i := range w.shards {
+		w.closeShard(w.shards[i])
+	}
+	w.shards = nil
+}
+
+// closeShard stops monitoring a pooled connection and shuts it down, a
+// disconnected connection is shut down by its monitor if it is reconnecting.
+// The shard mutex must be held
+func (w *Websocket) closeShard(s *connectionShard) {
+	close(s.shutdown)
+	if !s.connected {
+		return
+	}
+	err := s.conn.Shutdown()
+	if err != nil {
+		log.Errorf(log.WebsocketMgr,
+			"%v websocket: shard %d shutdown error: %v",
+			w.exchangeName,
+			s.id,
+			err)
+	}
+}
+
+// GetConnectionStatus returns the health of the standard, authenticated and
+// pooled connections
+func (w *Websocket) GetConnectionStatus() []ConnectionStatus {
+	var status []ConnectionStatus
+	if w.Conn != nil {
+		s := ConnectionStatus{
+			Name:      "standard",
+			URL:       w.Conn.GetURL(),
+			Connected: isConnectionConnected(w.Conn),
+		}
+		if !w.IsSharded() {
+			s.Subscriptions = len(w.GetSubscriptions())
+		}
+		status = append(status, s)
+	}
+	if w.AuthConn != nil {
+		status = append(status, ConnectionStatus{
+			Name:      "authenticated",
+			URL:       w.AuthConn.GetURL(),
+			Connected: isConnectionConnected(w.AuthConn),
+		})
+	}
+	w.shardMutex.Lock()
+	defer w.shardMutex.Unlock()
+	for i := range w.shards {
+		status = append(status, ConnectionStatus{
+			Name:             fmt.Sprintf("shard %d", w.shards[i].id),
+			URL:              w.shards[i].conn.GetURL(),
+			Connected:        w.shards[i].connected,
+			Subscriptions:    len(w.shards[i].subscriptions),
+			MaxSubscriptions: w.maxSubscriptionsPerConnection,
+			Reconnections:    w.shards[i].reconnections,
+			LastError:        w.shards[i].lastErr,
+		})
+	}
+	return status
+}
+
+// isConnectionConnected returns the connection status of connections which
+// report it
+func isConnectionConnected(c Connection) bool {
+	conn, ok := c.(interface{ IsConnected() bool })
+	return ok && conn.IsConnected()
+}
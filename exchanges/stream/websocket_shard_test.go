@@ -0,0 +1,258 @@
+package stream
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+)
+
+// shardTestServer accepts websocket connections and records the channels
+// subscribed over each of them
+type shardTestServer struct {
+	m        sync.Mutex
+	conns    []*websocket.Conn
+	channels [][]string
+	upgrader websocket.Upgrader
+}
+
+func (s *shardTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	s.m.Lock()
+	id := len(s.conns)
+	s.conns = append(s.conns, conn)
+	s.channels = append(s.channels, nil)
+	s.m.Unlock()
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var channels []string
+		if err := json.Unmarshal(msg, &channels); err != nil {
+			continue
+		}
+		s.m.Lock()
+		s.channels[id] = append(s.channels[id], channels...)
+		s.m.Unlock()
+	}
+}
+
+func (s *shardTestServer) subscribed(id int) []string {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if id >= len(s.channels) {
+		return nil
+	}
+	return append([]string(nil), s.channels[id]...)
+}
+
+func (s *shardTestServer) drop(id int) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.conns[id].UnderlyingConn().Close()
+}
+
+func newShardedWebsocket(t *testing.T, url string, maxSubs, maxConns int) *Websocket {
+	t.Helper()
+	w := New()
+	w.setConnectedStatus(true)
+	err := w.Setup(&WebsocketSetup{
+		Enabled:                       true,
+		WebsocketTimeout:              time.Second * 5,
+		DefaultURL:                    url,
+		ExchangeName:                  "exchangeName",
+		RunningURL:                    url,
+		Features:                      &protocol.Features{Subscribe: true, Unsubscribe: true},
+		GenerateSubscriptions:         func() ([]ChannelSubscription, error) { return nil, nil },
+		MaxSubscriptionsPerConnection: maxSubs,
+		MaxConnections:                maxConns,
+		ShardConnector: func(c Connection) error {
+			err := c.Dial(&websocket.Dialer{}, nil)
+			if err != nil {
+				return err
+			}
+			w.Wg.Add(1)
+			go func() {
+				defer w.Wg.Done()
+				for {
+					if resp := c.ReadMessage(); resp.Raw == nil {
+						return
+					}
+				}
+			}()
+			return nil
+		},
+		ShardSubscriber: func(c Connection, subs []ChannelSubscription) error {
+			channels := make([]string, len(subs))
+			for i := range subs {
+				channels[i] = subs[i].Channel
+			}
+			err := c.SendJSONMessage(channels)
+			if err != nil {
+				return err
+			}
+			w.AddSuccessfulSubscriptions(subs...)
+			return nil
+		},
+		ShardUnsubscriber: func(c Connection, subs []ChannelSubscription) error {
+			w.RemoveSuccessfulUnsubscriptions(subs...)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func TestSetupSharding(t *testing.T) {
+	t.Parallel()
+	setup := *defaultSetup
+	setup.MaxSubscriptionsPerConnection = -1
+	err := New().Setup(&setup)
+	if !errors.Is(err, errInvalidMaxSubscriptions) {
+		t.Fatalf("received '%v', expected '%v'", err, errInvalidMaxSubscriptions)
+	}
+	setup.MaxSubscriptionsPerConnection = 1
+	setup.MaxConnections = -1
+	err = New().Setup(&setup)
+	if !errors.Is(err, errInvalidMaxConnections) {
+		t.Fatalf("received '%v', expected '%v'", err, errInvalidMaxConnections)
+	}
+	setup.MaxConnections = 0
+	err = New().Setup(&setup)
+	if !errors.Is(err, errShardConnectorUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errShardConnectorUnset)
+	}
+	setup.ShardConnector = func(Connection) error { return nil }
+	err = New().Setup(&setup)
+	if !errors.Is(err, errShardSubscriberUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errShardSubscriberUnset)
+	}
+	setup.ShardSubscriber = func(Connection, []ChannelSubscription) error { return nil }
+	err = New().Setup(&setup)
+	if !errors.Is(err, errShardUnsubscriberUnset) {
+		t.Fatalf("received '%v', expected '%v'", err, errShardUnsubscriberUnset)
+	}
+	setup.ShardUnsubscriber = func(Connection, []ChannelSubscription) error { return nil }
+	setup.Subscriber = nil
+	w := New()
+	err = w.Setup(&setup)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if !w.IsSharded() {
+		t.Fatal("websocket should be sharded")
+	}
+}
+
+func TestSubscribeShards(t *testing.T) {
+	t.Parallel()
+	server := &shardTestServer{}
+	s := httptest.NewServer(server)
+	defer s.Close()
+	w := newShardedWebsocket(t, "ws"+strings.TrimPrefix(s.URL, "http"), 2, 2)
+
+	err := w.SubscribeToChannels([]ChannelSubscription{{Channel: "1"}, {Channel: "2"}, {Channel: "3"}})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	status := w.GetConnectionStatus()
+	if len(status) != 2 {
+		t.Fatalf("received '%v', expected '%v'", len(status), 2)
+	}
+	if status[0].Name != "shard 1" || status[0].Subscriptions != 2 || status[0].MaxSubscriptions != 2 || !status[0].Connected {
+		t.Fatalf("unexpected status %+v", status[0])
+	}
+	if status[1].Subscriptions != 1 {
+		t.Fatalf("received '%v', expected '%v'", status[1].Subscriptions, 1)
+	}
+	if len(w.GetSubscriptions()) != 3 {
+		t.Fatalf("received '%v', expected '%v'", len(w.GetSubscriptions()), 3)
+	}
+
+	err = w.subscribeShards([]ChannelSubscription{{Channel: "4"}, {Channel: "5"}})
+	if !errors.Is(err, errConnectionLimitReached) {
+		t.Fatalf("received '%v', expected '%v'", err, errConnectionLimitReached)
+	}
+	if len(w.GetSubscriptions()) != 4 {
+		t.Fatalf("received '%v', expected '%v'", len(w.GetSubscriptions()), 4)
+	}
+
+	// Unsubscribing all channels from a connection shuts it down
+	err = w.UnsubscribeChannels([]ChannelSubscription{{Channel: "1"}, {Channel: "2"}})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	status = w.GetConnectionStatus()
+	if len(status) != 1 || status[0].Name != "shard 2" {
+		t.Fatalf("unexpected status %+v", status)
+	}
+
+	err = w.Shutdown()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(w.GetConnectionStatus()) != 0 {
+		t.Fatal("shards should be removed on shutdown")
+	}
+}
+
+func TestShardReconnect(t *testing.T) {
+	t.Parallel()
+	server := &shardTestServer{}
+	s := httptest.NewServer(server)
+	defer s.Close()
+	w := newShardedWebsocket(t, "ws"+strings.TrimPrefix(s.URL, "http"), 2, 0)
+
+	err := w.SubscribeToChannels([]ChannelSubscription{{Channel: "1"}, {Channel: "2"}, {Channel: "3"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = server.drop(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the dropped connection is reconnected and resubscribed
+	deadline := time.Now().Add(connectionMonitorDelay * 3)
+	for len(server.subscribed(2)) != 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("dropped connection was not resubscribed")
+		}
+		time.Sleep(time.Millisecond * 50)
+	}
+	status := w.GetConnectionStatus()
+	if len(status) != 2 {
+		t.Fatalf("received '%v', expected '%v'", len(status), 2)
+	}
+	for status[0].Reconnections != 1 || !status[0].Connected {
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected status %+v", status[0])
+		}
+		time.Sleep(time.Millisecond * 50)
+		status = w.GetConnectionStatus()
+	}
+	if status[1].Reconnections != 0 || !status[1].Connected {
+		t.Fatalf("unexpected status %+v", status[1])
+	}
+	if len(w.GetSubscriptions()) != 3 {
+		t.Fatalf("received '%v', expected '%v'", len(w.GetSubscriptions()), 3)
+	}
+
+	err = w.Shutdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
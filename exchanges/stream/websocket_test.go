@@ -7,8 +7,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -17,6 +20,8 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 )
 
@@ -263,6 +268,9 @@ func TestConnectionMessageErrors(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	if _, ok := (<-ws.ToRoutine).(ConnectionEstablished); !ok {
+		t.Error("expected connection established event after connecting")
+	}
 
 	ws.TrafficAlert <- struct{}{}
 
@@ -1299,3 +1307,149 @@ func TestWebsocketConnectionShutdown(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSetupOrderbookVerification(t *testing.T) {
+	t.Parallel()
+	setup := *defaultSetup
+	setup.VerifyOrderbookSequence = true
+	err := New().Setup(&setup)
+	if err == nil {
+		t.Fatal("expected error when resync is unset")
+	}
+	setup.OrderbookResync = func(currency.Pair, asset.Item) error { return nil }
+	err = New().Setup(&setup)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestSetRecorder(t *testing.T) {
+	t.Parallel()
+	r, err := mock.NewWebsocketRecorder("test.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	web := Websocket{
+		Wg:                new(sync.WaitGroup),
+		ShutdownC:         make(chan struct{}),
+		Init:              true,
+		TrafficAlert:      make(chan struct{}),
+		ReadMessageErrors: make(chan error),
+		DataHandler:       make(chan interface{}),
+	}
+	err = web.Setup(defaultSetup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = web.SetupNewConnection(ConnectionSetup{URL: "urlstring"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	web.SetRecorder(r)
+	if web.Conn.(*WebsocketConnection).Recorder != r {
+		t.Fatal("recorder not set on existing connection")
+	}
+	err = web.SetupNewConnection(ConnectionSetup{URL: "urlstring", Authenticated: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if web.AuthConn.(*WebsocketConnection).Recorder != r {
+		t.Fatal("recorder not set on new connection")
+	}
+	web.SetRecorder(nil)
+	if web.Conn.(*WebsocketConnection).Recorder != nil ||
+		web.AuthConn.(*WebsocketConnection).Recorder != nil {
+		t.Fatal("recorder not removed")
+	}
+}
+
+func TestWebsocketConnectionRecording(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "websocketrecording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	mockPath := filepath.Join(dir, "mock.json")
+	recordingPath := filepath.Join(dir, "recording.json")
+
+	m := mock.WebsocketMock{Sessions: []mock.WebsocketSession{{
+		Path: "/ws",
+		Frames: []mock.WebsocketFrame{
+			{Type: websocket.TextMessage, Data: json.RawMessage(`{"event":"info"}`)},
+			{Sent: true, Type: websocket.TextMessage, Data: json.RawMessage(`{"event":"subscribe","pair":["XBT/USD"],"reqid":1,"subscription":{"name":"book"}}`)},
+			{Type: websocket.TextMessage, Data: json.RawMessage(`{"event":"subscribed","reqid":1}`)},
+		},
+	}}}
+	payload, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(mockPath, payload, os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Record a session through the connection, then replay the recording
+	for _, path := range []string{mockPath, recordingPath} {
+		serverURL, err := mock.NewWebsocketVCRServer(path, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wc := WebsocketConnection{
+			ExchangeName:     "test",
+			URL:              serverURL + "/ws",
+			ResponseMaxLimit: time.Second,
+			Traffic:          make(chan struct{}, 1),
+		}
+		if path == mockPath {
+			wc.Recorder, err = mock.NewWebsocketRecorder(recordingPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		err = wc.Dial(&websocket.Dialer{}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := wc.ReadMessage()
+		if string(resp.Raw) != `{"event":"info"}` {
+			t.Fatalf("received '%s', expected '%s'", resp.Raw, `{"event":"info"}`)
+		}
+		err = wc.SendJSONMessage(testRequest{
+			Event:        "subscribe",
+			RequestID:    wc.GenerateMessageID(false),
+			Pairs:        []string{"XBT/USD"},
+			Subscription: testRequestData{Name: "book"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp = wc.ReadMessage()
+		var r testResponse
+		err = json.Unmarshal(resp.Raw, &r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.RequestID == 0 || r.RequestID == 1 {
+			t.Fatalf("request ID not substituted, received %v", r.RequestID)
+		}
+		err = wc.Shutdown()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	contents, err := ioutil.ReadFile(recordingPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var recorded mock.WebsocketMock
+	err = json.Unmarshal(contents, &recorded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recorded.Sessions) != 1 || len(recorded.Sessions[0].Frames) != 3 {
+		t.Fatalf("unexpected recording %s", contents)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
 )
@@ -82,6 +83,23 @@ type Websocket struct {
 	Conn Connection
 	// Authenticated stream connection
 	AuthConn Connection
+
+	// recorder records the traffic of new connections for replay in testing
+	recorder *mock.WebsocketRecorder
+
+	// Connection sharding spreads subscriptions across a pool of connections
+	// for exchanges which limit subscriptions per connection
+	maxSubscriptionsPerConnection int
+	maxConnections                int
+	shardConnector                func(Connection) error
+	shardSubscriber               func(Connection, []ChannelSubscription) error
+	shardUnsubscriber             func(Connection, []ChannelSubscription) error
+	// shardSetup holds the standard connection setup which is used for
+	// pooled connections
+	shardSetup  ConnectionSetup
+	shardMutex  sync.Mutex
+	shards      []*connectionShard
+	nextShardID int
 }
 
 // WebsocketSetup defines variables for setting up a websocket connection
@@ -105,6 +123,23 @@ type WebsocketSetup struct {
 	SortBuffer            bool
 	SortBufferByUpdateIDs bool
 	UpdateEntriesByID     bool
+	// Orderbook verification, when a verifier or sequence checking is set
+	// books are checked after each update. A book which fails verification,
+	// or which the exchange invalidates, is flushed and OrderbookResync is
+	// called to request a new snapshot
+	OrderbookVerifier       buffer.Verifier
+	VerifyOrderbookSequence bool
+	OrderbookResync         buffer.Resync
+	// Connection sharding, when MaxSubscriptionsPerConnection is set
+	// subscriptions are spread across a pool of connections which are
+	// established by ShardConnector and subscribed by ShardSubscriber in
+	// place of Subscriber. MaxConnections limits the size of the pool, zero
+	// is unlimited
+	MaxSubscriptionsPerConnection int
+	MaxConnections                int
+	ShardConnector                func(Connection) error
+	ShardSubscriber               func(Connection, []ChannelSubscription) error
+	ShardUnsubscriber             func(Connection, []ChannelSubscription) error
 }
 
 // WebsocketConnection contains all the data needed to send a message to a WS
@@ -129,4 +164,21 @@ type WebsocketConnection struct {
 	ResponseMaxLimit  time.Duration
 	Traffic           chan struct{}
 	readMessageErrors chan error
+
+	// Recorder records the messages sent and received when set, for replay
+	// by the websocket mock server
+	Recorder *mock.WebsocketRecorder
+}
+
+// connectionShard is a pooled connection holding a share of the
+// subscriptions when subscriptions are sharded
+type connectionShard struct {
+	id            int
+	conn          Connection
+	errors        chan error
+	shutdown      chan struct{}
+	subscriptions []ChannelSubscription
+	connected     bool
+	reconnections int
+	lastErr       string
 }
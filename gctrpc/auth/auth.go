@@ -3,6 +3,18 @@ package auth
 import (
 	"context"
 	"encoding/base64"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	errMetadataMissing      = errors.New("unable to extract metadata")
+	errAuthorisationMissing = errors.New("authorization header missing")
+	errBasicAuthMissing     = errors.New("basic not found in authorization header")
+	errInvalidEncoding      = errors.New("unable to base64 decode authorization header")
+	errCredentialsMismatch  = errors.New("username/password mismatch")
 )
 
 // BasicAuth stores a basic auth username/password
@@ -24,3 +36,39 @@ func (b BasicAuth) GetRequestMetadata(ctx context.Context, in ...string) (map[st
 func (BasicAuth) RequireTransportSecurity() bool {
 	return true
 }
+
+// Authenticate ensures an incoming request's basic auth credentials match
+// the username and password. It is used by gRPC servers as an auth interceptor
+func (b BasicAuth) Authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, errMetadataMissing
+	}
+
+	authStr, ok := md["authorization"]
+	if !ok {
+		return ctx, errAuthorisationMissing
+	}
+
+	if !strings.Contains(authStr[0], "Basic") {
+		return ctx, errBasicAuthMissing
+	}
+
+	split := strings.Split(authStr[0], " ")
+	if len(split) != 2 {
+		return ctx, errBasicAuthMissing
+	}
+	decoded, err := base64.StdEncoding.DecodeString(split[1])
+	if err != nil {
+		return ctx, errInvalidEncoding
+	}
+
+	credentials := strings.SplitN(string(decoded), ":", 2)
+	if len(credentials) != 2 ||
+		credentials[0] != b.Username ||
+		credentials[1] != b.Password {
+		return ctx, errCredentialsMismatch
+	}
+
+	return ctx, nil
+}
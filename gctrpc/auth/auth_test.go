@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+	b := BasicAuth{Username: "admin", Password: "pass:word"}
+	_, err := b.Authenticate(context.Background())
+	if !errors.Is(err, errMetadataMissing) {
+		t.Errorf("received '%v' expected '%v'", err, errMetadataMissing)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+	_, err = b.Authenticate(ctx)
+	if !errors.Is(err, errAuthorisationMissing) {
+		t.Errorf("received '%v' expected '%v'", err, errAuthorisationMissing)
+	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer 1337"))
+	_, err = b.Authenticate(ctx)
+	if !errors.Is(err, errBasicAuthMissing) {
+		t.Errorf("received '%v' expected '%v'", err, errBasicAuthMissing)
+	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic ???"))
+	_, err = b.Authenticate(ctx)
+	if !errors.Is(err, errInvalidEncoding) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidEncoding)
+	}
+
+	md, err := BasicAuth{Username: "admin", Password: "password"}.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.New(md))
+	_, err = b.Authenticate(ctx)
+	if !errors.Is(err, errCredentialsMismatch) {
+		t.Errorf("received '%v' expected '%v'", err, errCredentialsMismatch)
+	}
+
+	md, err = b.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.New(md))
+	_, err = b.Authenticate(ctx)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
@@ -111,6 +111,22 @@ type GoCryptoTraderClient interface {
 	CurrencyStateDeposit(ctx context.Context, in *CurrencyStateDepositRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	CurrencyStateWithdraw(ctx context.Context, in *CurrencyStateWithdrawRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	CurrencyStateTradingPair(ctx context.Context, in *CurrencyStateTradingPairRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	SubmitExecutionOrder(ctx context.Context, in *SubmitExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error)
+	GetExecutionOrders(ctx context.Context, in *GetExecutionOrdersRequest, opts ...grpc.CallOption) (*GetExecutionOrdersResponse, error)
+	SetExecutionOrderStatus(ctx context.Context, in *SetExecutionOrderStatusRequest, opts ...grpc.CallOption) (*ExecutionOrder, error)
+	SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetPositions(ctx context.Context, in *GetPositionsRequest, opts ...grpc.CallOption) (*GetPositionsResponse, error)
+	GetPnL(ctx context.Context, in *GetPnLRequest, opts ...grpc.CallOption) (*GetPnLResponse, error)
+	GetFuturesPositions(ctx context.Context, in *GetFuturesPositionsRequest, opts ...grpc.CallOption) (*GetFuturesPositionsResponse, error)
+	GetFundingRates(ctx context.Context, in *GetFundingRatesRequest, opts ...grpc.CallOption) (*GetFundingRatesResponse, error)
+	SetLeverage(ctx context.Context, in *SetLeverageRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	SetMarginType(ctx context.Context, in *SetMarginTypeRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetCollateral(ctx context.Context, in *GetCollateralRequest, opts ...grpc.CallOption) (*GetCollateralResponse, error)
+	GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error)
+	GetArbitrageOpportunityStream(ctx context.Context, in *GetArbitrageOpportunityStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetArbitrageOpportunityStreamClient, error)
+	GetTriangularArbitrageOpportunities(ctx context.Context, in *GetTriangularArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetTriangularArbitrageOpportunitiesResponse, error)
+	GetTriangularArbitrageStream(ctx context.Context, in *GetTriangularArbitrageStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTriangularArbitrageStreamClient, error)
+	GetRateLimitBudgets(ctx context.Context, in *GetRateLimitBudgetsRequest, opts ...grpc.CallOption) (*GetRateLimitBudgetsResponse, error)
 }
 
 type goCryptoTraderClient struct {
@@ -1096,6 +1112,196 @@ func (c *goCryptoTraderClient) CurrencyStateTradingPair(ctx context.Context, in
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) SubmitExecutionOrder(ctx context.Context, in *SubmitExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error) {
+	out := new(ExecutionOrder)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitExecutionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExecutionOrders(ctx context.Context, in *GetExecutionOrdersRequest, opts ...grpc.CallOption) (*GetExecutionOrdersResponse, error) {
+	out := new(GetExecutionOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExecutionOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetExecutionOrderStatus(ctx context.Context, in *SetExecutionOrderStatusRequest, opts ...grpc.CallOption) (*ExecutionOrder, error) {
+	out := new(ExecutionOrder)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetExecutionOrderStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetKillSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPositions(ctx context.Context, in *GetPositionsRequest, opts ...grpc.CallOption) (*GetPositionsResponse, error) {
+	out := new(GetPositionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPnL(ctx context.Context, in *GetPnLRequest, opts ...grpc.CallOption) (*GetPnLResponse, error) {
+	out := new(GetPnLResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPnL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetFuturesPositions(ctx context.Context, in *GetFuturesPositionsRequest, opts ...grpc.CallOption) (*GetFuturesPositionsResponse, error) {
+	out := new(GetFuturesPositionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFuturesPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetFundingRates(ctx context.Context, in *GetFundingRatesRequest, opts ...grpc.CallOption) (*GetFundingRatesResponse, error) {
+	out := new(GetFundingRatesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFundingRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetLeverage(ctx context.Context, in *SetLeverageRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetLeverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetMarginType(ctx context.Context, in *SetMarginTypeRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetMarginType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCollateral(ctx context.Context, in *GetCollateralRequest, opts ...grpc.CallOption) (*GetCollateralResponse, error) {
+	out := new(GetCollateralResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error) {
+	out := new(GetArbitrageOpportunitiesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetArbitrageOpportunities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetArbitrageOpportunityStream(ctx context.Context, in *GetArbitrageOpportunityStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetArbitrageOpportunityStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[6], "/gctrpc.GoCryptoTrader/GetArbitrageOpportunityStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetArbitrageOpportunityStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetArbitrageOpportunityStreamClient interface {
+	Recv() (*ArbitrageOpportunity, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetArbitrageOpportunityStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetArbitrageOpportunityStreamClient) Recv() (*ArbitrageOpportunity, error) {
+	m := new(ArbitrageOpportunity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetTriangularArbitrageOpportunities(ctx context.Context, in *GetTriangularArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetTriangularArbitrageOpportunitiesResponse, error) {
+	out := new(GetTriangularArbitrageOpportunitiesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTriangularArbitrageOpportunities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTriangularArbitrageStream(ctx context.Context, in *GetTriangularArbitrageStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTriangularArbitrageStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[7], "/gctrpc.GoCryptoTrader/GetTriangularArbitrageStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetTriangularArbitrageStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetTriangularArbitrageStreamClient interface {
+	Recv() (*TriangularArbitrageOpportunity, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetTriangularArbitrageStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetTriangularArbitrageStreamClient) Recv() (*TriangularArbitrageOpportunity, error) {
+	m := new(TriangularArbitrageOpportunity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetRateLimitBudgets(ctx context.Context, in *GetRateLimitBudgetsRequest, opts ...grpc.CallOption) (*GetRateLimitBudgetsResponse, error) {
+	out := new(GetRateLimitBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRateLimitBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoCryptoTraderServer is the server API for GoCryptoTrader service.
 // All implementations must embed UnimplementedGoCryptoTraderServer
 // for forward compatibility
@@ -1193,6 +1399,22 @@ type GoCryptoTraderServer interface {
 	CurrencyStateDeposit(context.Context, *CurrencyStateDepositRequest) (*GenericResponse, error)
 	CurrencyStateWithdraw(context.Context, *CurrencyStateWithdrawRequest) (*GenericResponse, error)
 	CurrencyStateTradingPair(context.Context, *CurrencyStateTradingPairRequest) (*GenericResponse, error)
+	SubmitExecutionOrder(context.Context, *SubmitExecutionOrderRequest) (*ExecutionOrder, error)
+	GetExecutionOrders(context.Context, *GetExecutionOrdersRequest) (*GetExecutionOrdersResponse, error)
+	SetExecutionOrderStatus(context.Context, *SetExecutionOrderStatusRequest) (*ExecutionOrder, error)
+	SetKillSwitch(context.Context, *SetKillSwitchRequest) (*GenericResponse, error)
+	GetPositions(context.Context, *GetPositionsRequest) (*GetPositionsResponse, error)
+	GetPnL(context.Context, *GetPnLRequest) (*GetPnLResponse, error)
+	GetFuturesPositions(context.Context, *GetFuturesPositionsRequest) (*GetFuturesPositionsResponse, error)
+	GetFundingRates(context.Context, *GetFundingRatesRequest) (*GetFundingRatesResponse, error)
+	SetLeverage(context.Context, *SetLeverageRequest) (*GenericResponse, error)
+	SetMarginType(context.Context, *SetMarginTypeRequest) (*GenericResponse, error)
+	GetCollateral(context.Context, *GetCollateralRequest) (*GetCollateralResponse, error)
+	GetArbitrageOpportunities(context.Context, *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error)
+	GetArbitrageOpportunityStream(*GetArbitrageOpportunityStreamRequest, GoCryptoTrader_GetArbitrageOpportunityStreamServer) error
+	GetTriangularArbitrageOpportunities(context.Context, *GetTriangularArbitrageOpportunitiesRequest) (*GetTriangularArbitrageOpportunitiesResponse, error)
+	GetTriangularArbitrageStream(*GetTriangularArbitrageStreamRequest, GoCryptoTrader_GetTriangularArbitrageStreamServer) error
+	GetRateLimitBudgets(context.Context, *GetRateLimitBudgetsRequest) (*GetRateLimitBudgetsResponse, error)
 	mustEmbedUnimplementedGoCryptoTraderServer()
 }
 
@@ -1479,6 +1701,54 @@ func (UnimplementedGoCryptoTraderServer) CurrencyStateWithdraw(context.Context,
 func (UnimplementedGoCryptoTraderServer) CurrencyStateTradingPair(context.Context, *CurrencyStateTradingPairRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateTradingPair not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) SubmitExecutionOrder(context.Context, *SubmitExecutionOrderRequest) (*ExecutionOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitExecutionOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExecutionOrders(context.Context, *GetExecutionOrdersRequest) (*GetExecutionOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetExecutionOrderStatus(context.Context, *SetExecutionOrderStatusRequest) (*ExecutionOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExecutionOrderStatus not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetKillSwitch(context.Context, *SetKillSwitchRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKillSwitch not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPositions(context.Context, *GetPositionsRequest) (*GetPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPositions not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPnL(context.Context, *GetPnLRequest) (*GetPnLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPnL not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetFuturesPositions(context.Context, *GetFuturesPositionsRequest) (*GetFuturesPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFuturesPositions not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetFundingRates(context.Context, *GetFundingRatesRequest) (*GetFundingRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingRates not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetLeverage(context.Context, *SetLeverageRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLeverage not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetMarginType(context.Context, *SetMarginTypeRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarginType not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetCollateral(context.Context, *GetCollateralRequest) (*GetCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollateral not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetArbitrageOpportunities(context.Context, *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunities not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTriangularArbitrageOpportunities(context.Context, *GetTriangularArbitrageOpportunitiesRequest) (*GetTriangularArbitrageOpportunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTriangularArbitrageOpportunities not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetRateLimitBudgets(context.Context, *GetRateLimitBudgetsRequest) (*GetRateLimitBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateLimitBudgets not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTriangularArbitrageStream(*GetTriangularArbitrageStreamRequest, GoCryptoTrader_GetTriangularArbitrageStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTriangularArbitrageStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetArbitrageOpportunityStream(*GetArbitrageOpportunityStreamRequest, GoCryptoTrader_GetArbitrageOpportunityStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunityStream not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) mustEmbedUnimplementedGoCryptoTraderServer() {}
 
 // UnsafeGoCryptoTraderServer may be embedded to opt out of forward compatibility for this service.
@@ -3184,6 +3454,300 @@ func _GoCryptoTrader_CurrencyStateTradingPair_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTrader_SubmitExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitExecutionOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SubmitExecutionOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitExecutionOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SubmitExecutionOrder(ctx, req.(*SubmitExecutionOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExecutionOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExecutionOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExecutionOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExecutionOrders(ctx, req.(*GetExecutionOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SetExecutionOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExecutionOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SetExecutionOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SetExecutionOrderStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SetExecutionOrderStatus(ctx, req.(*SetExecutionOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SetKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SetKillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SetKillSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SetKillSwitch(ctx, req.(*SetKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPositions(ctx, req.(*GetPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPnL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPnLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPnL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPnL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPnL(ctx, req.(*GetPnLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetFuturesPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFuturesPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetFuturesPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFuturesPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetFuturesPositions(ctx, req.(*GetFuturesPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetFundingRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetFundingRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFundingRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetFundingRates(ctx, req.(*GetFundingRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SetLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLeverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SetLeverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLeverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SetLeverage(ctx, req.(*SetLeverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SetMarginType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMarginTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SetMarginType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SetMarginType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SetMarginType(ctx, req.(*SetMarginTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetCollateral(ctx, req.(*GetCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetArbitrageOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArbitrageOpportunitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetArbitrageOpportunities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetArbitrageOpportunities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetArbitrageOpportunities(ctx, req.(*GetArbitrageOpportunitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetArbitrageOpportunityStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetArbitrageOpportunityStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetArbitrageOpportunityStream(m, &goCryptoTraderGetArbitrageOpportunityStreamServer{stream})
+}
+
+type GoCryptoTrader_GetArbitrageOpportunityStreamServer interface {
+	Send(*ArbitrageOpportunity) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetArbitrageOpportunityStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetArbitrageOpportunityStreamServer) Send(m *ArbitrageOpportunity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetTriangularArbitrageOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTriangularArbitrageOpportunitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTriangularArbitrageOpportunities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTriangularArbitrageOpportunities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTriangularArbitrageOpportunities(ctx, req.(*GetTriangularArbitrageOpportunitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTriangularArbitrageStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTriangularArbitrageStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetTriangularArbitrageStream(m, &goCryptoTraderGetTriangularArbitrageStreamServer{stream})
+}
+
+type GoCryptoTrader_GetTriangularArbitrageStreamServer interface {
+	Send(*TriangularArbitrageOpportunity) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetTriangularArbitrageStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetTriangularArbitrageStreamServer) Send(m *TriangularArbitrageOpportunity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetRateLimitBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetRateLimitBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRateLimitBudgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetRateLimitBudgets(ctx, req.(*GetRateLimitBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GoCryptoTrader_ServiceDesc is the grpc.ServiceDesc for GoCryptoTrader service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3539,6 +4103,62 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrencyStateTradingPair",
 			Handler:    _GoCryptoTrader_CurrencyStateTradingPair_Handler,
 		},
+		{
+			MethodName: "SubmitExecutionOrder",
+			Handler:    _GoCryptoTrader_SubmitExecutionOrder_Handler,
+		},
+		{
+			MethodName: "GetExecutionOrders",
+			Handler:    _GoCryptoTrader_GetExecutionOrders_Handler,
+		},
+		{
+			MethodName: "SetExecutionOrderStatus",
+			Handler:    _GoCryptoTrader_SetExecutionOrderStatus_Handler,
+		},
+		{
+			MethodName: "SetKillSwitch",
+			Handler:    _GoCryptoTrader_SetKillSwitch_Handler,
+		},
+		{
+			MethodName: "GetPositions",
+			Handler:    _GoCryptoTrader_GetPositions_Handler,
+		},
+		{
+			MethodName: "GetPnL",
+			Handler:    _GoCryptoTrader_GetPnL_Handler,
+		},
+		{
+			MethodName: "GetFuturesPositions",
+			Handler:    _GoCryptoTrader_GetFuturesPositions_Handler,
+		},
+		{
+			MethodName: "GetFundingRates",
+			Handler:    _GoCryptoTrader_GetFundingRates_Handler,
+		},
+		{
+			MethodName: "SetLeverage",
+			Handler:    _GoCryptoTrader_SetLeverage_Handler,
+		},
+		{
+			MethodName: "SetMarginType",
+			Handler:    _GoCryptoTrader_SetMarginType_Handler,
+		},
+		{
+			MethodName: "GetCollateral",
+			Handler:    _GoCryptoTrader_GetCollateral_Handler,
+		},
+		{
+			MethodName: "GetArbitrageOpportunities",
+			Handler:    _GoCryptoTrader_GetArbitrageOpportunities_Handler,
+		},
+		{
+			MethodName: "GetTriangularArbitrageOpportunities",
+			Handler:    _GoCryptoTrader_GetTriangularArbitrageOpportunities_Handler,
+		},
+		{
+			MethodName: "GetRateLimitBudgets",
+			Handler:    _GoCryptoTrader_GetRateLimitBudgets_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3571,6 +4191,16 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTrader_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetArbitrageOpportunityStream",
+			Handler:       _GoCryptoTrader_GetArbitrageOpportunityStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetTriangularArbitrageStream",
+			Handler:       _GoCryptoTrader_GetTriangularArbitrageStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
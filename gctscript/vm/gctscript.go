@@ -1,8 +1,10 @@
 package vm
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/d5/tengo/v2"
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -27,6 +29,26 @@ func (g *GctScriptManager) New() *VM {
 	return vm
 }
 
+// NewStandalone returns a VM which is not tracked or limited by a GctScriptManager
+// and does not record script events. This allows other subsystems such as the
+// backtester to load and run scripts without the scripting subsystem running
+func NewStandalone(config *Config) (*VM, error) {
+	if config == nil {
+		return nil, errors.New("config must be provided for virtual machine")
+	}
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	return &VM{
+		ID:         newUUID,
+		Script:     pool.Get().(*tengo.Script),
+		config:     config,
+		standalone: true,
+		unregister: func() error { return nil },
+	}, nil
+}
+
 // Validate will attempt to execute a script in a test/non-live environment
 // to confirm it passes requirements for execution
 func (g *GctScriptManager) Validate(file string) (err error) {
@@ -222,7 +222,7 @@ func (vm *VM) ShortName() string {
 }
 
 func (vm *VM) event(status, executionType string) {
-	if validator.IsTestExecution.Load() == true {
+	if vm.standalone || validator.IsTestExecution.Load() == true {
 		return
 	}
 
@@ -54,6 +54,37 @@ func TestNewVM(t *testing.T) {
 	}
 }
 
+func TestNewStandalone(t *testing.T) {
+	_, err := NewStandalone(nil)
+	if err == nil {
+		t.Error("expected error when config is nil")
+	}
+	x, err := NewStandalone(configHelper(true, true, maxTestVirtualMachines))
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := VMSCount.Len()
+	err = x.Load(testScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = x.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = x.RunCtx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if VMSCount.Len() != count {
+		t.Error("standalone VM should not be tracked")
+	}
+	err = x.Shutdown()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestVMLoad(t *testing.T) {
 	manager := GctScriptManager{
 		config:  configHelper(true, true, maxTestVirtualMachines),
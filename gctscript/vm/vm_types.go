@@ -57,5 +57,6 @@ type VM struct {
 	NextRun    time.Time
 	S          chan struct{}
 	config     *Config
+	standalone bool
 	unregister func() error
 }
@@ -45,7 +45,7 @@ func (r *Rotate) Write(output []byte) (n int, err error) {
 }
 
 func (r *Rotate) openOrCreateFile(n int64) error {
-	logFile := filepath.Join(LogPath, r.FileName)
+	logFile := filepath.Join(r.directory(), r.FileName)
 
 	info, err := os.Stat(logFile)
 	if err != nil {
@@ -73,17 +73,20 @@ func (r *Rotate) openOrCreateFile(n int64) error {
 }
 
 func (r *Rotate) openNew() error {
-	name := filepath.Join(LogPath, r.FileName)
+	name := filepath.Join(r.directory(), r.FileName)
 	_, err := os.Stat(name)
 
 	if err == nil {
-		timestamp := time.Now().Format("2006-01-02T15-04-05")
-		newName := filepath.Join(LogPath, timestamp+"-"+r.FileName)
+		timestamp := time.Now().Format(r.timestampFormat())
+		newName := filepath.Join(r.directory(), timestamp+"-"+r.FileName)
 
 		err = file.Move(name, newName)
 		if err != nil {
 			return fmt.Errorf("can't rename log file: %s", err)
 		}
+		if r.OnRotate != nil {
+			r.OnRotate(newName)
+		}
 	}
 
 	file, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
@@ -126,6 +129,20 @@ func (r *Rotate) rotateFile() (err error) {
 	return nil
 }
 
+func (r *Rotate) directory() string {
+	if r.Directory == "" {
+		return LogPath
+	}
+	return r.Directory
+}
+
+func (r *Rotate) timestampFormat() string {
+	if r.TimestampFormat == "" {
+		return defaultTimestampFormat
+	}
+	return r.TimestampFormat
+}
+
 func (r *Rotate) maxSize() int64 {
 	if r.MaxSize == 0 {
 		return int64(defaultMaxSize * megabyte)
@@ -6,8 +6,9 @@ import (
 )
 
 const (
-	defaultMaxSize = 250
-	megabyte       = 1024 * 1024
+	defaultMaxSize         = 250
+	megabyte               = 1024 * 1024
+	defaultTimestampFormat = "2006-01-02T15-04-05"
 )
 
 // Rotate struct for each instance of Rotate
@@ -15,6 +16,14 @@ type Rotate struct {
 	FileName string
 	Rotate   *bool
 	MaxSize  int64
+	// Directory overrides LogPath as the directory files are written to
+	Directory string
+	// TimestampFormat overrides the format of the time prefixed to rotated
+	// files
+	TimestampFormat string
+	// OnRotate is called with the path of each file once it has been rotated
+	// out, allowing it to be archived
+	OnRotate func(rotated string)
 
 	size   int64
 	output *os.File
@@ -48,6 +48,14 @@ func main() {
 	flag.BoolVar(&settings.EnableCoinmarketcapAnalysis, "coinmarketcap", false, "overrides config and runs currency analysis")
 	flag.BoolVar(&settings.EnableEventManager, "eventmanager", true, "enables the event manager")
 	flag.BoolVar(&settings.EnableOrderManager, "ordermanager", true, "enables the order manager")
+	flag.BoolVar(&settings.EnableExecutionManager, "executionmanager", false, "enables the execution manager for TWAP, VWAP and iceberg orders, requires the order manager")
+	flag.BoolVar(&settings.EnableFillLedger, "fillledger", false, "enables the fill ledger which tracks positions and PnL from order fills, requires the order manager")
+	flag.StringVar(&settings.FillLedgerCostBasis, "fillledgercostbasis", string(engine.FIFO), "the cost basis used by the fill ledger to realise PnL, FIFO or AVERAGE")
+	flag.BoolVar(&settings.EnableArbitrageScanner, "arbitragescanner", false, "enables the arbitrage scanner which reports cross exchange spreads from live orderbooks")
+	flag.Float64Var(&settings.ArbitrageMinimumProfit, "arbitrageminprofit", 0, "the minimum profit percentage after fees for an arbitrage opportunity to be reported")
+	flag.BoolVar(&settings.ArbitrageNotify, "arbitragenotify", false, "pushes arbitrage opportunities to the communications relayers")
+	flag.BoolVar(&settings.EnableDeadMansSwitch, "deadmansswitch", false, "enables the dead man's switch which cancels open orders when connectivity is lost, requires the order manager")
+	flag.BoolVar(&settings.EnableOrderbookRecorder, "orderbookrecorder", false, "enables the orderbook recorder which captures snapshots and websocket updates to disk")
 	flag.BoolVar(&settings.EnableDepositAddressManager, "depositaddressmanager", true, "enables the deposit address manager")
 	flag.BoolVar(&settings.EnableConnectivityMonitor, "connectivitymonitor", true, "enables the connectivity monitor")
 	flag.BoolVar(&settings.EnableDatabaseManager, "databasemanager", true, "enables database manager")
@@ -85,6 +93,7 @@ func main() {
 	flag.BoolVar(&settings.EnableExchangeVerbose, "exchangeverbose", false, "increases exchange logging verbosity")
 	flag.BoolVar(&settings.ExchangePurgeCredentials, "exchangepurgecredentials", false, "purges the stored exchange API credentials")
 	flag.BoolVar(&settings.EnableExchangeHTTPRateLimiter, "ratelimiter", true, "enables the rate limiter for HTTP requests")
+	flag.StringVar(&settings.RateLimitStoreDirectory, "ratelimitstore", "", "sets a directory used to share exchange HTTP rate limit budgets between GoCryptoTrader processes on the same host")
 	flag.IntVar(&settings.MaxHTTPRequestJobsLimit, "requestjobslimit", int(request.DefaultMaxRequestJobs), "sets the max amount of jobs the HTTP request package stores")
 	flag.IntVar(&settings.RequestMaxRetryAttempts, "httpmaxretryattempts", request.DefaultMaxRetryAttempts, "sets the number of retry attempts after a retryable HTTP failure")
 	flag.DurationVar(&settings.HTTPTimeout, "httptimeout", time.Duration(0), "sets the HTTP timeout value for HTTP requests")